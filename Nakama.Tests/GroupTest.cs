@@ -48,8 +48,8 @@ namespace Nakama.Tests.Api
 
             Assert.NotNull(group);
             Assert.NotNull(group.Id);
-            Assert.NotNull(group.CreateTime);
-            Assert.NotNull(group.UpdateTime);
+            Assert.NotEqual(default(DateTime), group.CreateTime);
+            Assert.NotEqual(default(DateTime), group.UpdateTime);
             Assert.Equal(1, group.EdgeCount);
             Assert.Equal(name, group.Name);
             Assert.Equal(desc, group.Description);
@@ -68,8 +68,8 @@ namespace Nakama.Tests.Api
 
             Assert.NotNull(group);
             Assert.NotNull(group.Id);
-            Assert.NotNull(group.CreateTime);
-            Assert.NotNull(group.UpdateTime);
+            Assert.NotEqual(default(DateTime), group.CreateTime);
+            Assert.NotEqual(default(DateTime), group.UpdateTime);
             Assert.Null(group.AvatarUrl);
             Assert.Null(group.Description);
             Assert.Equal(1, group.EdgeCount);
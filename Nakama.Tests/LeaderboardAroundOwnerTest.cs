@@ -33,10 +33,10 @@ namespace Nakama.Tests.Api
             var recordArray = records.Records.ToArray();
 
             Assert.Equal(4, recordArray.Length);
-            Assert.Equal("109", recordArray[0].Score);
-            Assert.Equal("108", recordArray[1].Score);
-            Assert.Equal("107", recordArray[2].Score);
-            Assert.Equal("106", recordArray[3].Score);
+            Assert.Equal(109, recordArray[0].Score);
+            Assert.Equal(108, recordArray[1].Score);
+            Assert.Equal(107, recordArray[2].Score);
+            Assert.Equal(106, recordArray[3].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -46,10 +46,10 @@ namespace Nakama.Tests.Api
             var recordArray = records.Records.ToArray();
 
             Assert.Equal(4, recordArray.Length);
-            Assert.Equal("103", recordArray[0].Score);
-            Assert.Equal("102", recordArray[1].Score);
-            Assert.Equal("101", recordArray[2].Score);
-            Assert.Equal("100", recordArray[3].Score);
+            Assert.Equal(103, recordArray[0].Score);
+            Assert.Equal(102, recordArray[1].Score);
+            Assert.Equal(101, recordArray[2].Score);
+            Assert.Equal(100, recordArray[3].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -59,10 +59,10 @@ namespace Nakama.Tests.Api
             var recordArray = records.Records.ToArray();
 
             Assert.Equal(4, recordArray.Length);
-            Assert.Equal("109", recordArray[0].Score);
-            Assert.Equal("108", recordArray[1].Score);
-            Assert.Equal("107", recordArray[2].Score);
-            Assert.Equal("106", recordArray[3].Score);
+            Assert.Equal(109, recordArray[0].Score);
+            Assert.Equal(108, recordArray[1].Score);
+            Assert.Equal(107, recordArray[2].Score);
+            Assert.Equal(106, recordArray[3].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -72,10 +72,10 @@ namespace Nakama.Tests.Api
             var recordArray = records.Records.ToArray();
 
             Assert.Equal(4, recordArray.Length);
-            Assert.Equal("103", recordArray[0].Score);
-            Assert.Equal("102", recordArray[1].Score);
-            Assert.Equal("101", recordArray[2].Score);
-            Assert.Equal("100", recordArray[3].Score);
+            Assert.Equal(103, recordArray[0].Score);
+            Assert.Equal(102, recordArray[1].Score);
+            Assert.Equal(101, recordArray[2].Score);
+            Assert.Equal(100, recordArray[3].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -86,10 +86,10 @@ namespace Nakama.Tests.Api
 
             Assert.Equal(4, recordArray.Length);
             // owner score is 104
-            Assert.Equal("105", recordArray[0].Score);
-            Assert.Equal("104", recordArray[1].Score);
-            Assert.Equal("103", recordArray[2].Score);
-            Assert.Equal("102", recordArray[3].Score);
+            Assert.Equal(105, recordArray[0].Score);
+            Assert.Equal(104, recordArray[1].Score);
+            Assert.Equal(103, recordArray[2].Score);
+            Assert.Equal(102, recordArray[3].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -99,10 +99,10 @@ namespace Nakama.Tests.Api
             var recordArray = records.Records.ToArray();
 
             Assert.Equal(4, recordArray.Length);
-            Assert.Equal("103", recordArray[0].Score);
-            Assert.Equal("102", recordArray[1].Score);
-            Assert.Equal("101", recordArray[2].Score);
-            Assert.Equal("100", recordArray[3].Score);
+            Assert.Equal(103, recordArray[0].Score);
+            Assert.Equal(102, recordArray[1].Score);
+            Assert.Equal(101, recordArray[2].Score);
+            Assert.Equal(100, recordArray[3].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -113,9 +113,9 @@ namespace Nakama.Tests.Api
 
             Assert.Equal(3, recordArray.Length);
             // owner score is 101
-            Assert.Equal("102", recordArray[0].Score);
-            Assert.Equal("101", recordArray[1].Score);
-            Assert.Equal("100", recordArray[2].Score);
+            Assert.Equal(102, recordArray[0].Score);
+            Assert.Equal(101, recordArray[1].Score);
+            Assert.Equal(100, recordArray[2].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -130,7 +130,7 @@ namespace Nakama.Tests.Api
             IApiLeaderboardRecordList records = await CreateAndFetchRecords(numRecords: 1, limit: 1, ownerIndex: 0);
             var recordArray = records.Records.ToArray();
             Assert.Single(recordArray);
-            Assert.Equal("100", recordArray[0].Score);
+            Assert.Equal(100, recordArray[0].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -139,8 +139,8 @@ namespace Nakama.Tests.Api
             IApiLeaderboardRecordList records = await CreateAndFetchRecords(numRecords: 2, limit: 2, ownerIndex: 1);
             var recordArray = records.Records.ToArray();
             Assert.Equal(2, recordArray.Length);
-            Assert.Equal("101", recordArray[0].Score);
-            Assert.Equal("100", recordArray[1].Score);
+            Assert.Equal(101, recordArray[0].Score);
+            Assert.Equal(100, recordArray[1].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -149,8 +149,8 @@ namespace Nakama.Tests.Api
             IApiLeaderboardRecordList records = await CreateAndFetchRecords(numRecords: 3, limit: 2, ownerIndex: 1);
             var recordArray = records.Records.ToArray();
             Assert.Equal(2, recordArray.Length);
-            Assert.Equal("101", recordArray[0].Score);
-            Assert.Equal("100", recordArray[1].Score);
+            Assert.Equal(101, recordArray[0].Score);
+            Assert.Equal(100, recordArray[1].Score);
         }
 
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -160,9 +160,9 @@ namespace Nakama.Tests.Api
             var recordArray = records.Records.ToArray();
 
             Assert.Equal(3, recordArray.Length);
-            Assert.Equal("102", recordArray[0].Score);
-            Assert.Equal("101", recordArray[1].Score);
-            Assert.Equal("100", recordArray[2].Score);
+            Assert.Equal(102, recordArray[0].Score);
+            Assert.Equal(101, recordArray[1].Score);
+            Assert.Equal(100, recordArray[2].Score);
         }
         
         [Fact(Timeout = TestsUtil.TIMEOUT_MILLISECONDS)]
@@ -173,7 +173,7 @@ namespace Nakama.Tests.Api
             var recordArray = records.Records.ToArray();
             
             Assert.Equal(1, recordArray.Length);
-            Assert.Equal("101", recordArray[0].Score);
+            Assert.Equal(101, recordArray[0].Score);
             Assert.NotNull(records.NextCursor);
             Assert.NotNull(records.PrevCursor);
 
@@ -181,7 +181,7 @@ namespace Nakama.Tests.Api
             recordArray = nextRecords.Records.ToArray();
             
             Assert.Single(recordArray);
-            Assert.Equal("100", recordArray[0].Score);
+            Assert.Equal(100, recordArray[0].Score);
             Assert.Null(nextRecords.NextCursor);
             Assert.NotNull(nextRecords.PrevCursor);
             
@@ -189,7 +189,7 @@ namespace Nakama.Tests.Api
             recordArray = prevRecords.Records.ToArray();
             
             Assert.Single(recordArray);
-            Assert.Equal("102", recordArray[0].Score);
+            Assert.Equal(102, recordArray[0].Score);
             Assert.NotNull(prevRecords.NextCursor);
             Assert.Null(prevRecords.PrevCursor);
         }
@@ -46,12 +46,12 @@ namespace Nakama.Tests.Api
             var record = await _client.WriteLeaderboardRecordAsync(session, _leaderboardId, score, subscore, metadata);
 
             Assert.NotNull(record);
-            Assert.NotEmpty(record.CreateTime);
-            Assert.NotEmpty(record.UpdateTime);
+            Assert.NotEqual(default(DateTime), record.CreateTime);
+            Assert.NotEqual(default(DateTime), record.UpdateTime);
             Assert.Equal(_leaderboardId, record.LeaderboardId);
             Assert.Equal(1, record.NumScore);
-            Assert.Equal(score, long.Parse(record.Score));
-            Assert.Equal(subscore, long.Parse(record.Subscore));
+            Assert.Equal(score, record.Score);
+            Assert.Equal(subscore, record.Subscore);
             Assert.Equal(session.UserId, record.OwnerId);
             Assert.Equal(session.Username, record.Username);
         }
@@ -3,16 +3,17 @@ namespace Nakama
 {
     using System;
     using System.Collections.Generic;
+    using System.Globalization;
+    using System.IO;
     using System.Runtime.Serialization;
     using System.Text;
     using System.Threading;
     using System.Threading.Tasks;
-    using TinyJson;
 
     /// <summary>
     /// An exception generated for <c>HttpResponse</c> objects don't return a success status.
     /// </summary>
-    public sealed class ApiResponseException : Exception
+    public class ApiResponseException : Exception
     {
         public long StatusCode { get; }
 
@@ -34,4083 +35,11855 @@ namespace Nakama
         {
         }
 
+        protected ApiResponseException(ApiResponseException e) : base(e.Message, e)
+        {
+            StatusCode = e.StatusCode;
+            GrpcStatusCode = e.GrpcStatusCode;
+            foreach (var key in e.Data.Keys)
+            {
+                Data[key] = e.Data[key];
+            }
+        }
+
         public override string ToString()
         {
-            return $"ApiResponseException(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
+            return $"{GetType().Name}(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
         }
     }
 
     /// <summary>
-    /// Update fields in a given group.
+    /// A failure the server reported with a gRPC status code.
     /// </summary>
-    public interface IApiUpdateGroupRequest
+    public class ApiStatusException : ApiResponseException
     {
-
-        /// <summary>
-        /// Avatar URL.
-        /// </summary>
-        string AvatarUrl { get; }
-
-        /// <summary>
-        /// Description string.
-        /// </summary>
-        string Description { get; }
-
         /// <summary>
-        /// Lang tag.
+        /// The error response, with the details the <see cref="IHttpAdapter"/> passed on in the exception data.
         /// </summary>
-        string LangTag { get; }
+        public IRpcStatus Status { get; }
 
-        /// <summary>
-        /// Name.
-        /// </summary>
-        string Name { get; }
+        public ApiStatusException(ApiResponseException e) : base(e)
+        {
+            var status = new Dictionary<string, object>
+            {
+                {"code", e.GrpcStatusCode},
+                {"message", e.Message}
+            };
+            if (e.Data.Contains("details"))
+            {
+                status["details"] = e.Data["details"];
+            }
+            var writer = new ApiJsonWriter();
+            writer.WriteAny(status);
+            Status = new ApiJsonReader(writer.ToString()).ReadObject<RpcStatus>();
+        }
 
         /// <summary>
-        /// Open is true if anyone should be allowed to join, or false if joins must be approved by a group admin.
+        /// The exception for the gRPC status code of a failure, or null when the code is not a known one.
         /// </summary>
-        bool Open { get; }
+        internal static ApiStatusException FromResponse(ApiResponseException e)
+        {
+            switch (e.GrpcStatusCode)
+            {
+                case 1:
+                    return new CancelledException(e);
+                case 2:
+                    return new UnknownException(e);
+                case 3:
+                    return new InvalidArgumentException(e);
+                case 4:
+                    return new DeadlineExceededException(e);
+                case 5:
+                    return new NotFoundException(e);
+                case 6:
+                    return new AlreadyExistsException(e);
+                case 7:
+                    return new PermissionDeniedException(e);
+                case 8:
+                    return new ResourceExhaustedException(e);
+                case 9:
+                    return new FailedPreconditionException(e);
+                case 10:
+                    return new AbortedException(e);
+                case 11:
+                    return new OutOfRangeException(e);
+                case 12:
+                    return new UnimplementedException(e);
+                case 13:
+                    return new InternalException(e);
+                case 14:
+                    return new UnavailableException(e);
+                case 15:
+                    return new DataLossException(e);
+                case 16:
+                    return new UnauthenticatedException(e);
+                default:
+                    return null;
+            }
+        }
     }
 
-    /// <inheritdoc />
-    internal class ApiUpdateGroupRequest : IApiUpdateGroupRequest
+    /// <summary>
+    /// The operation was cancelled, typically by the caller.
+    /// </summary>
+    public class CancelledException : ApiStatusException
     {
-
-        /// <inheritdoc />
-        [DataMember(Name="avatar_url"), Preserve]
-        public string AvatarUrl { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="description"), Preserve]
-        public string Description { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="lang_tag"), Preserve]
-        public string LangTag { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="open"), Preserve]
-        public bool Open { get; set; }
-
-        public override string ToString()
+        public CancelledException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "Description: ", Description, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "Open: ", Open, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// A friend of a friend.
+    /// An unknown error.
     /// </summary>
-    public interface IFriendsOfFriendsListFriendOfFriend
+    public class UnknownException : ApiStatusException
     {
-
-        /// <summary>
-        /// The user who referred its friend.
-        /// </summary>
-        string Referrer { get; }
-
-        /// <summary>
-        /// User.
-        /// </summary>
-        IApiUser User { get; }
+        public UnknownException(ApiResponseException e) : base(e)
+        {
+        }
     }
 
-    /// <inheritdoc />
-    internal class FriendsOfFriendsListFriendOfFriend : IFriendsOfFriendsListFriendOfFriend
+    /// <summary>
+    /// The client specified an invalid argument.
+    /// </summary>
+    public class InvalidArgumentException : ApiStatusException
     {
-
-        /// <inheritdoc />
-        [DataMember(Name="referrer"), Preserve]
-        public string Referrer { get; set; }
-
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IApiUser User => _user;
-        [DataMember(Name="user"), Preserve]
-        public ApiUser _user { get; set; }
-
-        public override string ToString()
+        public InvalidArgumentException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "Referrer: ", Referrer, ", ");
-            output = string.Concat(output, "User: ", User, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// A single user-role pair.
+    /// The deadline expired before the operation could complete.
     /// </summary>
-    public interface IGroupUserListGroupUser
+    public class DeadlineExceededException : ApiStatusException
     {
-
-        /// <summary>
-        /// Their relationship to the group.
-        /// </summary>
-        int State { get; }
-
-        /// <summary>
-        /// User.
-        /// </summary>
-        IApiUser User { get; }
+        public DeadlineExceededException(ApiResponseException e) : base(e)
+        {
+        }
     }
 
-    /// <inheritdoc />
-    internal class GroupUserListGroupUser : IGroupUserListGroupUser
+    /// <summary>
+    /// Some requested entity was not found.
+    /// </summary>
+    public class NotFoundException : ApiStatusException
     {
-
-        /// <inheritdoc />
-        [DataMember(Name="state"), Preserve]
-        public int State { get; set; }
-
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IApiUser User => _user;
-        [DataMember(Name="user"), Preserve]
-        public ApiUser _user { get; set; }
-
-        public override string ToString()
+        public NotFoundException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "State: ", State, ", ");
-            output = string.Concat(output, "User: ", User, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// A single group-role pair.
+    /// The entity a client attempted to create already exists.
     /// </summary>
-    public interface IUserGroupListUserGroup
+    public class AlreadyExistsException : ApiStatusException
     {
-
-        /// <summary>
-        /// Group.
-        /// </summary>
-        IApiGroup Group { get; }
-
-        /// <summary>
-        /// The user's relationship to the group.
-        /// </summary>
-        int State { get; }
+        public AlreadyExistsException(ApiResponseException e) : base(e)
+        {
+        }
     }
 
-    /// <inheritdoc />
-    internal class UserGroupListUserGroup : IUserGroupListUserGroup
+    /// <summary>
+    /// The caller does not have permission to execute the operation.
+    /// </summary>
+    public class PermissionDeniedException : ApiStatusException
     {
-
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IApiGroup Group => _group;
-        [DataMember(Name="group"), Preserve]
-        public ApiGroup _group { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="state"), Preserve]
-        public int State { get; set; }
-
-        public override string ToString()
+        public PermissionDeniedException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "Group: ", Group, ", ");
-            output = string.Concat(output, "State: ", State, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// Record values to write.
+    /// Some resource has been exhausted, such as a per-user quota.
     /// </summary>
-    public interface IWriteLeaderboardRecordRequestLeaderboardRecordWrite
+    public class ResourceExhaustedException : ApiStatusException
     {
+        public ResourceExhaustedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <summary>
-        /// Optional record metadata.
-        /// </summary>
-        string Metadata { get; }
-
-        /// <summary>
-        /// Operator override.
-        /// </summary>
-        ApiOperator Operator { get; }
-
-        /// <summary>
-        /// The score value to submit.
-        /// </summary>
-        string Score { get; }
-
-        /// <summary>
-        /// An optional secondary value.
-        /// </summary>
-        string Subscore { get; }
+    /// <summary>
+    /// The system is not in a state required for the operation's execution.
+    /// </summary>
+    public class FailedPreconditionException : ApiStatusException
+    {
+        public FailedPreconditionException(ApiResponseException e) : base(e)
+        {
+        }
     }
 
-    /// <inheritdoc />
-    internal class WriteLeaderboardRecordRequestLeaderboardRecordWrite : IWriteLeaderboardRecordRequestLeaderboardRecordWrite
+    /// <summary>
+    /// The operation was aborted, typically due to a concurrency issue.
+    /// </summary>
+    public class AbortedException : ApiStatusException
     {
+        public AbortedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <inheritdoc />
-        [DataMember(Name="metadata"), Preserve]
-        public string Metadata { get; set; }
+    /// <summary>
+    /// The operation was attempted past the valid range.
+    /// </summary>
+    public class OutOfRangeException : ApiStatusException
+    {
+        public OutOfRangeException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public ApiOperator Operator => _operator;
-        [DataMember(Name="operator"), Preserve]
-        public ApiOperator _operator { get; set; }
+    /// <summary>
+    /// The operation is not implemented or not supported.
+    /// </summary>
+    public class UnimplementedException : ApiStatusException
+    {
+        public UnimplementedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <inheritdoc />
-        [DataMember(Name="score"), Preserve]
-        public string Score { get; set; }
+    /// <summary>
+    /// An internal error of the server.
+    /// </summary>
+    public class InternalException : ApiStatusException
+    {
+        public InternalException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <inheritdoc />
-        [DataMember(Name="subscore"), Preserve]
-        public string Subscore { get; set; }
+    /// <summary>
+    /// The service is currently unavailable.
+    /// </summary>
+    public class UnavailableException : ApiStatusException
+    {
+        public UnavailableException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        public override string ToString()
+    /// <summary>
+    /// Unrecoverable data loss or corruption.
+    /// </summary>
+    public class DataLossException : ApiStatusException
+    {
+        public DataLossException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "Operator: ", Operator, ", ");
-            output = string.Concat(output, "Score: ", Score, ", ");
-            output = string.Concat(output, "Subscore: ", Subscore, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// Record values to write.
+    /// The request does not have valid authentication credentials.
     /// </summary>
-    public interface IWriteTournamentRecordRequestTournamentRecordWrite
+    public class UnauthenticatedException : ApiStatusException
     {
+        public UnauthenticatedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
 
+    /// <summary>
+    /// Options of a single request, which override those of the client.
+    /// </summary>
+    public class RequestOptions
+    {
         /// <summary>
-        /// A JSON object of additional properties (optional).
+        /// The timeout of the request in seconds, in place of the timeout of the client.
         /// </summary>
-        string Metadata { get; }
+        public int? Timeout { get; set; }
 
         /// <summary>
-        /// Operator override.
+        /// Headers sent with the request, which replace those of the same name set by the method.
         /// </summary>
-        ApiOperator Operator { get; }
+        public IDictionary<string, string> Headers { get; set; }
 
         /// <summary>
-        /// The score value to submit.
+        /// The token which cancels the request, unless one is passed to the method.
         /// </summary>
-        string Score { get; }
+        public CancellationToken? CancellationToken { get; set; }
 
         /// <summary>
-        /// An optional secondary value.
+        /// The retry configuration of the request, used by clients which retry it.
         /// </summary>
-        string Subscore { get; }
+        public RetryConfiguration RetryConfiguration { get; set; }
     }
 
-    /// <inheritdoc />
-    internal class WriteTournamentRecordRequestTournamentRecordWrite : IWriteTournamentRecordRequestTournamentRecordWrite
+    /// <summary>
+    /// A model which reads and writes its own members as JSON, without reflection.
+    /// </summary>
+    internal interface IApiJsonObject
     {
+        /// <summary>
+        /// Reads the value of a member, and returns false when the model has no member of that name.
+        /// </summary>
+        bool ReadMember(string name, ApiJsonReader reader);
 
-        /// <inheritdoc />
-        [DataMember(Name="metadata"), Preserve]
-        public string Metadata { get; set; }
-
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public ApiOperator Operator => _operator;
-        [DataMember(Name="operator"), Preserve]
-        public ApiOperator _operator { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="score"), Preserve]
-        public string Score { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="subscore"), Preserve]
-        public string Subscore { get; set; }
-
-        public override string ToString()
-        {
-            var output = "";
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "Operator: ", Operator, ", ");
-            output = string.Concat(output, "Score: ", Score, ", ");
-            output = string.Concat(output, "Subscore: ", Subscore, ", ");
-            return output;
-        }
+        /// <summary>
+        /// Writes the members which are set.
+        /// </summary>
+        void WriteMembers(ApiJsonWriter writer);
     }
 
     /// <summary>
-    /// A user with additional account details. Always the current user.
+    /// A forward-only reader of JSON text, which values are read from in the order they appear.
     /// </summary>
-    public interface IApiAccount
+    internal sealed class ApiJsonReader
     {
+        private readonly string _json;
+        private int _position;
 
-        /// <summary>
+        public ApiJsonReader(string json)
+        {
+            _json = json;
+        }
+
+        /// <summary>
+        /// Reads an object into a new model, or returns null for a JSON null. Members the model doesn't know are skipped.
+        /// </summary>
+        public T ReadObject<T>() where T : class, IApiJsonObject, new()
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var value = new T();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var name = ReadName();
+                    if (!value.ReadMember(name, this))
+                    {
+                        Skip();
+                    }
+                } while (Consume(','));
+                Expect('}');
+            }
+            return value;
+        }
+
+        public List<T> ReadList<T>(Func<T> readItem)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var list = new List<T>();
+            Expect('[');
+            if (!Consume(']'))
+            {
+                do
+                {
+                    list.Add(readItem());
+                } while (Consume(','));
+                Expect(']');
+            }
+            return list;
+        }
+
+        public Dictionary<string, T> ReadMap<T>(Func<T> readValue)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var map = new Dictionary<string, T>();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var key = ReadName();
+                    map[key] = readValue();
+                } while (Consume(','));
+                Expect('}');
+            }
+            return map;
+        }
+
+        public T? ReadNullable<T>(Func<T> read) where T : struct => ReadNull() ? (T?) null : read();
+
+        /// <summary>
+        /// Reads a string, or the text of a number or literal in its place.
+        /// </summary>
+        public string ReadString()
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+            if (Peek() != '"')
+            {
+                return ReadLiteral();
+            }
+
+            _position++;
+            StringBuilder builder = null;
+            var start = _position;
+            while (_position < _json.Length)
+            {
+                var c = _json[_position];
+                if (c == '"')
+                {
+                    var value = builder == null
+                        ? _json.Substring(start, _position - start)
+                        : builder.Append(_json, start, _position - start).ToString();
+                    _position++;
+                    return value;
+                }
+                if (c != '\\')
+                {
+                    _position++;
+                    continue;
+                }
+
+                if (builder == null)
+                {
+                    builder = new StringBuilder();
+                }
+                builder.Append(_json, start, _position - start);
+                if (++_position >= _json.Length)
+                {
+                    break;
+                }
+                switch (_json[_position])
+                {
+                    case 'b':
+                        builder.Append('\b');
+                        break;
+                    case 'f':
+                        builder.Append('\f');
+                        break;
+                    case 'n':
+                        builder.Append('\n');
+                        break;
+                    case 'r':
+                        builder.Append('\r');
+                        break;
+                    case 't':
+                        builder.Append('\t');
+                        break;
+                    case 'u':
+                        if (_position + 4 >= _json.Length ||
+                            !int.TryParse(_json.Substring(_position + 1, 4), NumberStyles.AllowHexSpecifier,
+                                CultureInfo.InvariantCulture, out var code))
+                        {
+                            throw Error("invalid unicode escape");
+                        }
+                        builder.Append((char) code);
+                        _position += 4;
+                        break;
+                    default:
+                        builder.Append(_json[_position]);
+                        break;
+                }
+                start = ++_position;
+            }
+            throw Error("unterminated string");
+        }
+
+        public int ReadInt32() => int.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public long ReadInt64() => long.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public ulong ReadUInt64() => ulong.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public double ReadDouble() => double.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public float ReadSingle() => float.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public bool ReadBoolean() => ReadString() == "true";
+
+        /// <summary>
+        /// Skips the next value, whatever its type.
+        /// </summary>
+        public void Skip()
+        {
+            switch (Peek())
+            {
+                case '{':
+                    ReadMap(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                case '[':
+                    ReadList(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                default:
+                    ReadString();
+                    break;
+            }
+        }
+
+        private string ReadName()
+        {
+            if (Peek() != '"')
+            {
+                throw Error("expected a member name");
+            }
+            var name = ReadString();
+            Expect(':');
+            return name;
+        }
+
+        // Numbers which don't fit a double are sent as strings, and a null is read as the default value.
+        private string ReadNumber() => ReadString() ?? "0";
+
+        private string ReadLiteral()
+        {
+            var start = _position;
+            while (_position < _json.Length && ",:]} \t\r\n".IndexOf(_json[_position]) < 0)
+            {
+                _position++;
+            }
+            if (_position == start)
+            {
+                throw Error("expected a value");
+            }
+            return _json.Substring(start, _position - start);
+        }
+
+        private bool ReadNull()
+        {
+            if (Peek() != 'n' || string.CompareOrdinal(_json, _position, "null", 0, 4) != 0)
+            {
+                return false;
+            }
+            _position += 4;
+            return true;
+        }
+
+        private bool Consume(char c)
+        {
+            if (Peek() != c)
+            {
+                return false;
+            }
+            _position++;
+            return true;
+        }
+
+        private void Expect(char c)
+        {
+            if (!Consume(c))
+            {
+                throw Error(string.Concat("expected '", c.ToString(), "'"));
+            }
+        }
+
+        private char Peek()
+        {
+            while (_position < _json.Length && char.IsWhiteSpace(_json[_position]))
+            {
+                _position++;
+            }
+            return _position < _json.Length ? _json[_position] : '\0';
+        }
+
+        private FormatException Error(string message) =>
+            new FormatException(string.Concat("Invalid JSON at position ",
+                _position.ToString(CultureInfo.InvariantCulture), ": ", message));
+    }
+
+    /// <summary>
+    /// A writer of JSON text, which values are written to in order.
+    /// </summary>
+    internal sealed class ApiJsonWriter
+    {
+        private readonly StringBuilder _builder = new StringBuilder();
+        private bool _separate;
+
+        public void WriteName(string name)
+        {
+            WriteString(name);
+            _builder.Append(':');
+            _separate = false;
+        }
+
+        public void WriteObject(IApiJsonObject value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            value.WriteMembers(this);
+            _builder.Append('}');
+            _separate = true;
+        }
+
+        public void WriteList<T>(IEnumerable<T> values, Action<T> writeItem)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('[');
+            _separate = false;
+            foreach (var value in values)
+            {
+                writeItem(value);
+            }
+            _builder.Append(']');
+            _separate = true;
+        }
+
+        public void WriteMap<T>(IDictionary<string, T> values, Action<T> writeValue)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            foreach (var kvp in values)
+            {
+                WriteName(kvp.Key);
+                writeValue(kvp.Value);
+            }
+            _builder.Append('}');
+            _separate = true;
+        }
+
+        public void WriteString(string value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('"');
+            foreach (var c in value)
+            {
+                switch (c)
+                {
+                    case '"':
+                        _builder.Append("\\\"");
+                        break;
+                    case '\\':
+                        _builder.Append("\\\\");
+                        break;
+                    case '\n':
+                        _builder.Append("\\n");
+                        break;
+                    case '\r':
+                        _builder.Append("\\r");
+                        break;
+                    case '\t':
+                        _builder.Append("\\t");
+                        break;
+                    default:
+                        if (c < ' ')
+                        {
+                            _builder.Append("\\u").Append(((int) c).ToString("x4", CultureInfo.InvariantCulture));
+                        }
+                        else
+                        {
+                            _builder.Append(c);
+                        }
+                        break;
+                }
+            }
+            _builder.Append('"');
+            _separate = true;
+        }
+
+        public void WriteInt32(int value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteInt64(long value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteUInt64(ulong value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteDouble(double value)
+        {
+            // JSON has no literal for NaN or infinities, which are sent as strings.
+            if (double.IsNaN(value) || double.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
+
+        public void WriteSingle(float value)
+        {
+            // Formatted as a float, so that it isn't widened to a double with digits it never had, e.g. 0.1f.
+            if (float.IsNaN(value) || float.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
+
+        public void WriteBoolean(bool value) => WriteLiteral(value ? "true" : "false");
+
+        public void WriteNull() => WriteLiteral("null");
+
+        /// <summary>
+        /// Writes a value of unknown type, as parsed into dictionaries, lists and primitives.
+        /// </summary>
+        public void WriteAny(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    WriteNull();
+                    break;
+                case string s:
+                    WriteString(s);
+                    break;
+                case bool b:
+                    WriteBoolean(b);
+                    break;
+                case int i:
+                    WriteInt32(i);
+                    break;
+                case long l:
+                    WriteInt64(l);
+                    break;
+                case double d:
+                    WriteDouble(d);
+                    break;
+                case float f:
+                    WriteSingle(f);
+                    break;
+                case IApiJsonObject o:
+                    WriteObject(o);
+                    break;
+                case IDictionary<string, object> map:
+                    WriteMap(map, WriteAny);
+                    break;
+                case System.Collections.IEnumerable list:
+                    Separate();
+                    _builder.Append('[');
+                    _separate = false;
+                    foreach (var item in list)
+                    {
+                        WriteAny(item);
+                    }
+                    _builder.Append(']');
+                    _separate = true;
+                    break;
+                default:
+                    WriteString(Convert.ToString(value, CultureInfo.InvariantCulture));
+                    break;
+            }
+        }
+
+        public override string ToString() => _builder.ToString();
+
+        private void WriteLiteral(string value)
+        {
+            Separate();
+            _builder.Append(value);
+            _separate = true;
+        }
+
+        private void Separate()
+        {
+            if (_separate)
+            {
+                _builder.Append(',');
+            }
+        }
+    }
+
+    /// <summary>
+    /// Update fields in a given group.
+    /// </summary>
+    public interface IApiUpdateGroupRequest
+    {
+
+        /// <summary>
+        /// Avatar URL.
+        /// </summary>
+        string AvatarUrl { get; }
+
+        /// <summary>
+        /// Description string.
+        /// </summary>
+        string Description { get; }
+
+        /// <summary>
+        /// Lang tag.
+        /// </summary>
+        string LangTag { get; }
+
+        /// <summary>
+        /// Name.
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// Open is true if anyone should be allowed to join, or false if joins must be approved by a group admin.
+        /// </summary>
+        bool? Open { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiUpdateGroupRequest Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiUpdateGroupRequest : IApiUpdateGroupRequest, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="avatar_url"), Preserve]
+        public string AvatarUrl { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="description"), Preserve]
+        public string Description { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="lang_tag"), Preserve]
+        public string LangTag { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="open"), Preserve]
+        public bool? Open { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "description":
+                    Description = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "open":
+                    Open = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (Description != null)
+            {
+                writer.WriteName("description");
+                writer.WriteString(Description);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (Open != null)
+            {
+                writer.WriteName("open");
+                writer.WriteBoolean(Open.Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiUpdateGroupRequest Clone() => (ApiUpdateGroupRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiUpdateGroupRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiUpdateGroupRequest) obj;
+            return AvatarUrl == other.AvatarUrl &&
+                Description == other.Description &&
+                LangTag == other.LangTag &&
+                Name == other.Name &&
+                Nullable.Equals(Open, other.Open);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Description?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + Open.GetHashCode();
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("Description: " + ApiClient.FormatValue(Description));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Open: " + ApiClient.FormatValue(Open));
+        }
+    }
+
+    /// <summary>
+    /// A friend of a friend.
+    /// </summary>
+    public interface IFriendsOfFriendsListFriendOfFriend
+    {
+
+        /// <summary>
+        /// The user who referred its friend.
+        /// </summary>
+        string Referrer { get; }
+
+        /// <summary>
+        /// User.
+        /// </summary>
+        IApiUser User { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IFriendsOfFriendsListFriendOfFriend Clone();
+    }
+
+    /// <inheritdoc />
+    internal class FriendsOfFriendsListFriendOfFriend : IFriendsOfFriendsListFriendOfFriend, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="referrer"), Preserve]
+        public string Referrer { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IApiUser User => _user;
+        [DataMember(Name="user"), Preserve]
+        public ApiUser _user { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "referrer":
+                    Referrer = reader.ReadString();
+                    return true;
+                case "user":
+                    _user = reader.ReadObject<ApiUser>();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Referrer != null)
+            {
+                writer.WriteName("referrer");
+                writer.WriteString(Referrer);
+            }
+            if (_user != null)
+            {
+                writer.WriteName("user");
+                writer.WriteObject(_user);
+            }
+        }
+
+        /// <inheritdoc />
+        public IFriendsOfFriendsListFriendOfFriend Clone() => (FriendsOfFriendsListFriendOfFriend) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (FriendsOfFriendsListFriendOfFriend) MemberwiseClone();
+            clone._user = (ApiUser) _user?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (FriendsOfFriendsListFriendOfFriend) obj;
+            return Referrer == other.Referrer &&
+                Equals(_user, other._user);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Referrer?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_user?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Referrer: " + ApiClient.FormatValue(Referrer));
+            members.Add("User: " + ApiClient.FormatValue(_user));
+        }
+    }
+
+    /// <summary>
+    /// A single user-role pair.
+    /// </summary>
+    public interface IGroupUserListGroupUser
+    {
+
+        /// <summary>
+        /// Their relationship to the group.
+        /// </summary>
+        int? State { get; }
+
+        /// <summary>
+        /// User.
+        /// </summary>
+        IApiUser User { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IGroupUserListGroupUser Clone();
+    }
+
+    /// <inheritdoc />
+    internal class GroupUserListGroupUser : IGroupUserListGroupUser, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="state"), Preserve]
+        public int? State { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IApiUser User => _user;
+        [DataMember(Name="user"), Preserve]
+        public ApiUser _user { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "state":
+                    State = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "user":
+                    _user = reader.ReadObject<ApiUser>();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (State != null)
+            {
+                writer.WriteName("state");
+                writer.WriteInt32(State.Value);
+            }
+            if (_user != null)
+            {
+                writer.WriteName("user");
+                writer.WriteObject(_user);
+            }
+        }
+
+        /// <inheritdoc />
+        public IGroupUserListGroupUser Clone() => (GroupUserListGroupUser) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (GroupUserListGroupUser) MemberwiseClone();
+            clone._user = (ApiUser) _user?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (GroupUserListGroupUser) obj;
+            return Nullable.Equals(State, other.State) &&
+                Equals(_user, other._user);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + State.GetHashCode();
+                hash = hash * 31 + (_user?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("State: " + ApiClient.FormatValue(State));
+            members.Add("User: " + ApiClient.FormatValue(_user));
+        }
+    }
+
+    /// <summary>
+    /// A single group-role pair.
+    /// </summary>
+    public interface IUserGroupListUserGroup
+    {
+
+        /// <summary>
+        /// Group.
+        /// </summary>
+        IApiGroup Group { get; }
+
+        /// <summary>
+        /// The user's relationship to the group.
+        /// </summary>
+        int? State { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IUserGroupListUserGroup Clone();
+    }
+
+    /// <inheritdoc />
+    internal class UserGroupListUserGroup : IUserGroupListUserGroup, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IApiGroup Group => _group;
+        [DataMember(Name="group"), Preserve]
+        public ApiGroup _group { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="state"), Preserve]
+        public int? State { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "group":
+                    _group = reader.ReadObject<ApiGroup>();
+                    return true;
+                case "state":
+                    State = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_group != null)
+            {
+                writer.WriteName("group");
+                writer.WriteObject(_group);
+            }
+            if (State != null)
+            {
+                writer.WriteName("state");
+                writer.WriteInt32(State.Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IUserGroupListUserGroup Clone() => (UserGroupListUserGroup) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (UserGroupListUserGroup) MemberwiseClone();
+            clone._group = (ApiGroup) _group?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (UserGroupListUserGroup) obj;
+            return Equals(_group, other._group) &&
+                Nullable.Equals(State, other.State);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_group?.GetHashCode() ?? 0);
+                hash = hash * 31 + State.GetHashCode();
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Group: " + ApiClient.FormatValue(_group));
+            members.Add("State: " + ApiClient.FormatValue(State));
+        }
+    }
+
+    /// <summary>
+    /// Record values to write.
+    /// </summary>
+    public interface IWriteLeaderboardRecordRequestLeaderboardRecordWrite
+    {
+
+        /// <summary>
+        /// Optional record metadata.
+        /// </summary>
+        string Metadata { get; }
+
+        /// <summary>
+        /// Operator override.
+        /// </summary>
+        ApiOperator Operator { get; }
+
+        /// <summary>
+        /// The score value to submit.
+        /// </summary>
+        long Score { get; }
+
+        /// <summary>
+        /// An optional secondary value.
+        /// </summary>
+        long Subscore { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IWriteLeaderboardRecordRequestLeaderboardRecordWrite Clone();
+    }
+
+    /// <inheritdoc />
+    internal class WriteLeaderboardRecordRequestLeaderboardRecordWrite : IWriteLeaderboardRecordRequestLeaderboardRecordWrite, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="metadata"), Preserve]
+        public string Metadata { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public ApiOperator Operator
+        {
+            get => ApiOperatorConverter.Parse(_operator);
+            set => _operator = ApiOperatorConverter.Format(value);
+        }
+        [DataMember(Name="operator"), Preserve]
+        public string _operator { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Score
+        {
+            get => ApiClient.ParseInt64(_score);
+            set => _score = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="score"), Preserve]
+        public string _score { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Subscore
+        {
+            get => ApiClient.ParseInt64(_subscore);
+            set => _subscore = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="subscore"), Preserve]
+        public string _subscore { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "operator":
+                    _operator = reader.ReadString();
+                    return true;
+                case "score":
+                    _score = reader.ReadString();
+                    return true;
+                case "subscore":
+                    _subscore = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (_operator != null)
+            {
+                writer.WriteName("operator");
+                writer.WriteString(_operator);
+            }
+            if (_score != null)
+            {
+                writer.WriteName("score");
+                writer.WriteString(_score);
+            }
+            if (_subscore != null)
+            {
+                writer.WriteName("subscore");
+                writer.WriteString(_subscore);
+            }
+        }
+
+        /// <inheritdoc />
+        public IWriteLeaderboardRecordRequestLeaderboardRecordWrite Clone() => (WriteLeaderboardRecordRequestLeaderboardRecordWrite) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (WriteLeaderboardRecordRequestLeaderboardRecordWrite) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (WriteLeaderboardRecordRequestLeaderboardRecordWrite) obj;
+            return Metadata == other.Metadata &&
+                _operator == other._operator &&
+                _score == other._score &&
+                _subscore == other._subscore;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_operator?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_score?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_subscore?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("Operator: " + ApiClient.FormatEncoded(_operator));
+            members.Add("Score: " + ApiClient.FormatValue(Score));
+            members.Add("Subscore: " + ApiClient.FormatValue(Subscore));
+        }
+    }
+
+    /// <summary>
+    /// Record values to write.
+    /// </summary>
+    public interface IWriteTournamentRecordRequestTournamentRecordWrite
+    {
+
+        /// <summary>
+        /// A JSON object of additional properties (optional).
+        /// </summary>
+        string Metadata { get; }
+
+        /// <summary>
+        /// Operator override.
+        /// </summary>
+        ApiOperator Operator { get; }
+
+        /// <summary>
+        /// The score value to submit.
+        /// </summary>
+        long Score { get; }
+
+        /// <summary>
+        /// An optional secondary value.
+        /// </summary>
+        long Subscore { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IWriteTournamentRecordRequestTournamentRecordWrite Clone();
+    }
+
+    /// <inheritdoc />
+    internal class WriteTournamentRecordRequestTournamentRecordWrite : IWriteTournamentRecordRequestTournamentRecordWrite, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="metadata"), Preserve]
+        public string Metadata { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public ApiOperator Operator
+        {
+            get => ApiOperatorConverter.Parse(_operator);
+            set => _operator = ApiOperatorConverter.Format(value);
+        }
+        [DataMember(Name="operator"), Preserve]
+        public string _operator { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Score
+        {
+            get => ApiClient.ParseInt64(_score);
+            set => _score = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="score"), Preserve]
+        public string _score { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Subscore
+        {
+            get => ApiClient.ParseInt64(_subscore);
+            set => _subscore = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="subscore"), Preserve]
+        public string _subscore { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "operator":
+                    _operator = reader.ReadString();
+                    return true;
+                case "score":
+                    _score = reader.ReadString();
+                    return true;
+                case "subscore":
+                    _subscore = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (_operator != null)
+            {
+                writer.WriteName("operator");
+                writer.WriteString(_operator);
+            }
+            if (_score != null)
+            {
+                writer.WriteName("score");
+                writer.WriteString(_score);
+            }
+            if (_subscore != null)
+            {
+                writer.WriteName("subscore");
+                writer.WriteString(_subscore);
+            }
+        }
+
+        /// <inheritdoc />
+        public IWriteTournamentRecordRequestTournamentRecordWrite Clone() => (WriteTournamentRecordRequestTournamentRecordWrite) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (WriteTournamentRecordRequestTournamentRecordWrite) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (WriteTournamentRecordRequestTournamentRecordWrite) obj;
+            return Metadata == other.Metadata &&
+                _operator == other._operator &&
+                _score == other._score &&
+                _subscore == other._subscore;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_operator?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_score?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_subscore?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("Operator: " + ApiClient.FormatEncoded(_operator));
+            members.Add("Score: " + ApiClient.FormatValue(Score));
+            members.Add("Subscore: " + ApiClient.FormatValue(Subscore));
+        }
+    }
+
+    /// <summary>
+    /// A user with additional account details. Always the current user.
+    /// </summary>
+    public interface IApiAccount
+    {
+
+        /// <summary>
         /// The custom id in the user's account.
         /// </summary>
-        string CustomId { get; }
+        string CustomId { get; }
+
+        /// <summary>
+        /// The devices which belong to the user's account.
+        /// </summary>
+        IEnumerable<IApiAccountDevice> Devices { get; }
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user's account was disabled/banned.
+        /// </summary>
+        DateTime DisableTime { get; }
+
+        /// <summary>
+        /// The email address of the user.
+        /// </summary>
+        string Email { get; }
+
+        /// <summary>
+        /// The user object.
+        /// </summary>
+        IApiUser User { get; }
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user's email was verified.
+        /// </summary>
+        DateTime VerifyTime { get; }
+
+        /// <summary>
+        /// The user's wallet data.
+        /// </summary>
+        string Wallet { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccount Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccount : IApiAccount, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="custom_id"), Preserve]
+        public string CustomId { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiAccountDevice> Devices => _devices ?? new List<ApiAccountDevice>(0);
+        [DataMember(Name="devices"), Preserve]
+        public List<ApiAccountDevice> _devices { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime DisableTime
+        {
+            get => ApiClient.ParseDateTime(_disableTime);
+            set => _disableTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="disable_time"), Preserve]
+        public string _disableTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="email"), Preserve]
+        public string Email { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IApiUser User => _user;
+        [DataMember(Name="user"), Preserve]
+        public ApiUser _user { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime VerifyTime
+        {
+            get => ApiClient.ParseDateTime(_verifyTime);
+            set => _verifyTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="verify_time"), Preserve]
+        public string _verifyTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="wallet"), Preserve]
+        public string Wallet { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "custom_id":
+                    CustomId = reader.ReadString();
+                    return true;
+                case "devices":
+                    _devices = reader.ReadList(reader.ReadObject<ApiAccountDevice>);
+                    return true;
+                case "disable_time":
+                    _disableTime = reader.ReadString();
+                    return true;
+                case "email":
+                    Email = reader.ReadString();
+                    return true;
+                case "user":
+                    _user = reader.ReadObject<ApiUser>();
+                    return true;
+                case "verify_time":
+                    _verifyTime = reader.ReadString();
+                    return true;
+                case "wallet":
+                    Wallet = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (CustomId != null)
+            {
+                writer.WriteName("custom_id");
+                writer.WriteString(CustomId);
+            }
+            if (_devices != null)
+            {
+                writer.WriteName("devices");
+                writer.WriteList(_devices, writer.WriteObject);
+            }
+            if (_disableTime != null)
+            {
+                writer.WriteName("disable_time");
+                writer.WriteString(_disableTime);
+            }
+            if (Email != null)
+            {
+                writer.WriteName("email");
+                writer.WriteString(Email);
+            }
+            if (_user != null)
+            {
+                writer.WriteName("user");
+                writer.WriteObject(_user);
+            }
+            if (_verifyTime != null)
+            {
+                writer.WriteName("verify_time");
+                writer.WriteString(_verifyTime);
+            }
+            if (Wallet != null)
+            {
+                writer.WriteName("wallet");
+                writer.WriteString(Wallet);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccount Clone() => (ApiAccount) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccount) MemberwiseClone();
+            clone._devices = _devices?.ConvertAll(item => (ApiAccountDevice) item?.CloneModel());
+            clone._user = (ApiUser) _user?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccount) obj;
+            return CustomId == other.CustomId &&
+                ApiClient.ListEquals(_devices, other._devices) &&
+                _disableTime == other._disableTime &&
+                Email == other.Email &&
+                Equals(_user, other._user) &&
+                _verifyTime == other._verifyTime &&
+                Wallet == other.Wallet;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (CustomId?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_devices);
+                hash = hash * 31 + (_disableTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Email?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_user?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_verifyTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Wallet?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CustomId: " + ApiClient.FormatValue(CustomId));
+            members.Add("Devices: " + ApiClient.FormatList(_devices));
+            members.Add("DisableTime: " + ApiClient.FormatValue(DisableTime));
+            members.Add("Email: " + ApiClient.FormatValue(Email));
+            members.Add("User: " + ApiClient.FormatValue(_user));
+            members.Add("VerifyTime: " + ApiClient.FormatValue(VerifyTime));
+            members.Add("Wallet: " + ApiClient.FormatValue(Wallet));
+        }
+    }
+
+    /// <summary>
+    /// Send a Apple Sign In token to the server. Used with authenticate/link/unlink.
+    /// </summary>
+    public interface IApiAccountApple
+    {
+
+        /// <summary>
+        /// The ID token received from Apple to validate.
+        /// </summary>
+        string Token { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountApple Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountApple : IApiAccountApple, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountApple Clone() => (ApiAccountApple) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountApple) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountApple) obj;
+            return Token == other.Token &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Token: " + ApiClient.FormatValue(Token));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// Send a custom ID to the server. Used with authenticate/link/unlink.
+    /// </summary>
+    public interface IApiAccountCustom
+    {
+
+        /// <summary>
+        /// A custom identifier.
+        /// </summary>
+        string Id { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountCustom Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountCustom : IApiAccountCustom, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountCustom Clone() => (ApiAccountCustom) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountCustom) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountCustom) obj;
+            return Id == other.Id &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// Send a device to the server. Used with authenticate/link/unlink and user.
+    /// </summary>
+    public interface IApiAccountDevice
+    {
+
+        /// <summary>
+        /// A device identifier. Should be obtained by a platform-specific device API.
+        /// </summary>
+        string Id { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountDevice Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountDevice : IApiAccountDevice, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountDevice Clone() => (ApiAccountDevice) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountDevice) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountDevice) obj;
+            return Id == other.Id &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// Send an email with password to the server. Used with authenticate/link/unlink.
+    /// </summary>
+    public interface IApiAccountEmail
+    {
+
+        /// <summary>
+        /// A valid RFC-5322 email address.
+        /// </summary>
+        string Email { get; }
+
+        /// <summary>
+        /// A password for the user account.  Ignored with unlink operations.
+        /// </summary>
+        string Password { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountEmail Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountEmail : IApiAccountEmail, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="email"), Preserve]
+        public string Email { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="password"), Preserve]
+        public string Password { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "email":
+                    Email = reader.ReadString();
+                    return true;
+                case "password":
+                    Password = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Email != null)
+            {
+                writer.WriteName("email");
+                writer.WriteString(Email);
+            }
+            if (Password != null)
+            {
+                writer.WriteName("password");
+                writer.WriteString(Password);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountEmail Clone() => (ApiAccountEmail) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountEmail) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountEmail) obj;
+            return Email == other.Email &&
+                Password == other.Password &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Email?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Password?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Email: " + ApiClient.FormatValue(Email));
+            members.Add("Password: " + ApiClient.FormatValue(Password));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// Send a Facebook token to the server. Used with authenticate/link/unlink.
+    /// </summary>
+    public interface IApiAccountFacebook
+    {
+
+        /// <summary>
+        /// The OAuth token received from Facebook to access their profile API.
+        /// </summary>
+        string Token { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountFacebook Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountFacebook : IApiAccountFacebook, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountFacebook Clone() => (ApiAccountFacebook) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountFacebook) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountFacebook) obj;
+            return Token == other.Token &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Token: " + ApiClient.FormatValue(Token));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// Send a Facebook Instant Game token to the server. Used with authenticate/link/unlink.
+    /// </summary>
+    public interface IApiAccountFacebookInstantGame
+    {
+
+        /// <summary>
+        /// The OAuth token received from a Facebook Instant Game that may be decoded with the Application Secret (must be available with the nakama configuration)
+        /// </summary>
+        string SignedPlayerInfo { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountFacebookInstantGame Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountFacebookInstantGame : IApiAccountFacebookInstantGame, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="signed_player_info"), Preserve]
+        public string SignedPlayerInfo { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "signed_player_info":
+                    SignedPlayerInfo = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (SignedPlayerInfo != null)
+            {
+                writer.WriteName("signed_player_info");
+                writer.WriteString(SignedPlayerInfo);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountFacebookInstantGame Clone() => (ApiAccountFacebookInstantGame) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountFacebookInstantGame) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountFacebookInstantGame) obj;
+            return SignedPlayerInfo == other.SignedPlayerInfo &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (SignedPlayerInfo?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("SignedPlayerInfo: " + ApiClient.FormatValue(SignedPlayerInfo));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// Send Apple's Game Center account credentials to the server. Used with authenticate/link/unlink.  https://developer.apple.com/documentation/gamekit/gklocalplayer/1515407-generateidentityverificationsign
+    /// </summary>
+    public interface IApiAccountGameCenter
+    {
+
+        /// <summary>
+        /// Bundle ID (generated by GameCenter).
+        /// </summary>
+        string BundleId { get; }
+
+        /// <summary>
+        /// Player ID (generated by GameCenter).
+        /// </summary>
+        string PlayerId { get; }
+
+        /// <summary>
+        /// The URL for the public encryption key.
+        /// </summary>
+        string PublicKeyUrl { get; }
+
+        /// <summary>
+        /// A random "NSString" used to compute the hash and keep it randomized.
+        /// </summary>
+        string Salt { get; }
+
+        /// <summary>
+        /// The verification signature data generated.
+        /// </summary>
+        string Signature { get; }
+
+        /// <summary>
+        /// Time since UNIX epoch when the signature was created.
+        /// </summary>
+        long TimestampSeconds { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountGameCenter Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountGameCenter : IApiAccountGameCenter, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="bundle_id"), Preserve]
+        public string BundleId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="player_id"), Preserve]
+        public string PlayerId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="public_key_url"), Preserve]
+        public string PublicKeyUrl { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="salt"), Preserve]
+        public string Salt { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="signature"), Preserve]
+        public string Signature { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long TimestampSeconds
+        {
+            get => ApiClient.ParseInt64(_timestampSeconds);
+            set => _timestampSeconds = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="timestamp_seconds"), Preserve]
+        public string _timestampSeconds { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "bundle_id":
+                    BundleId = reader.ReadString();
+                    return true;
+                case "player_id":
+                    PlayerId = reader.ReadString();
+                    return true;
+                case "public_key_url":
+                    PublicKeyUrl = reader.ReadString();
+                    return true;
+                case "salt":
+                    Salt = reader.ReadString();
+                    return true;
+                case "signature":
+                    Signature = reader.ReadString();
+                    return true;
+                case "timestamp_seconds":
+                    _timestampSeconds = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (BundleId != null)
+            {
+                writer.WriteName("bundle_id");
+                writer.WriteString(BundleId);
+            }
+            if (PlayerId != null)
+            {
+                writer.WriteName("player_id");
+                writer.WriteString(PlayerId);
+            }
+            if (PublicKeyUrl != null)
+            {
+                writer.WriteName("public_key_url");
+                writer.WriteString(PublicKeyUrl);
+            }
+            if (Salt != null)
+            {
+                writer.WriteName("salt");
+                writer.WriteString(Salt);
+            }
+            if (Signature != null)
+            {
+                writer.WriteName("signature");
+                writer.WriteString(Signature);
+            }
+            if (_timestampSeconds != null)
+            {
+                writer.WriteName("timestamp_seconds");
+                writer.WriteString(_timestampSeconds);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountGameCenter Clone() => (ApiAccountGameCenter) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountGameCenter) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountGameCenter) obj;
+            return BundleId == other.BundleId &&
+                PlayerId == other.PlayerId &&
+                PublicKeyUrl == other.PublicKeyUrl &&
+                Salt == other.Salt &&
+                Signature == other.Signature &&
+                _timestampSeconds == other._timestampSeconds &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (BundleId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PlayerId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PublicKeyUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Salt?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Signature?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_timestampSeconds?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("BundleId: " + ApiClient.FormatValue(BundleId));
+            members.Add("PlayerId: " + ApiClient.FormatValue(PlayerId));
+            members.Add("PublicKeyUrl: " + ApiClient.FormatValue(PublicKeyUrl));
+            members.Add("Salt: " + ApiClient.FormatValue(Salt));
+            members.Add("Signature: " + ApiClient.FormatValue(Signature));
+            members.Add("TimestampSeconds: " + ApiClient.FormatValue(TimestampSeconds));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// Send a Google token to the server. Used with authenticate/link/unlink.
+    /// </summary>
+    public interface IApiAccountGoogle
+    {
+
+        /// <summary>
+        /// The OAuth token received from Google to access their profile API.
+        /// </summary>
+        string Token { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountGoogle Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountGoogle : IApiAccountGoogle, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountGoogle Clone() => (ApiAccountGoogle) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountGoogle) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountGoogle) obj;
+            return Token == other.Token &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Token: " + ApiClient.FormatValue(Token));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// Send a Steam token to the server. Used with authenticate/link/unlink.
+    /// </summary>
+    public interface IApiAccountSteam
+    {
+
+        /// <summary>
+        /// The account token received from Steam to access their profile API.
+        /// </summary>
+        string Token { get; }
+
+        /// <summary>
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountSteam Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiAccountSteam : IApiAccountSteam, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountSteam Clone() => (ApiAccountSteam) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountSteam) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountSteam) obj;
+            return Token == other.Token &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Token: " + ApiClient.FormatValue(Token));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
+        }
+    }
+
+    /// <summary>
+    /// A message sent on a channel.
+    /// </summary>
+    public interface IApiChannelMessage
+    {
+
+        /// <summary>
+        /// The channel this message belongs to.
+        /// </summary>
+        string ChannelId { get; }
+
+        /// <summary>
+        /// The code representing a message type or category.
+        /// </summary>
+        int? Code { get; }
+
+        /// <summary>
+        /// The content payload.
+        /// </summary>
+        string Content { get; }
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the message was created.
+        /// </summary>
+        DateTime CreateTime { get; }
+
+        /// <summary>
+        /// The ID of the group, or an empty string if this message was not sent through a group channel.
+        /// </summary>
+        string GroupId { get; }
+
+        /// <summary>
+        /// The unique ID of this message.
+        /// </summary>
+        string MessageId { get; }
+
+        /// <summary>
+        /// True if the message was persisted to the channel's history, false otherwise.
+        /// </summary>
+        bool? Persistent { get; }
+
+        /// <summary>
+        /// The name of the chat room, or an empty string if this message was not sent through a chat room.
+        /// </summary>
+        string RoomName { get; }
+
+        /// <summary>
+        /// Message sender, usually a user ID.
+        /// </summary>
+        string SenderId { get; }
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the message was last updated.
+        /// </summary>
+        DateTime UpdateTime { get; }
+
+        /// <summary>
+        /// The ID of the first DM user, or an empty string if this message was not sent through a DM chat.
+        /// </summary>
+        string UserIdOne { get; }
+
+        /// <summary>
+        /// The ID of the second DM user, or an empty string if this message was not sent through a DM chat.
+        /// </summary>
+        string UserIdTwo { get; }
+
+        /// <summary>
+        /// The username of the message sender, if any.
+        /// </summary>
+        string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiChannelMessage Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiChannelMessage : IApiChannelMessage, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="channel_id"), Preserve]
+        public string ChannelId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="code"), Preserve]
+        public int? Code { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="content"), Preserve]
+        public string Content { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="group_id"), Preserve]
+        public string GroupId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="message_id"), Preserve]
+        public string MessageId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="persistent"), Preserve]
+        public bool? Persistent { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="room_name"), Preserve]
+        public string RoomName { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="sender_id"), Preserve]
+        public string SenderId { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="user_id_one"), Preserve]
+        public string UserIdOne { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="user_id_two"), Preserve]
+        public string UserIdTwo { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="username"), Preserve]
+        public string Username { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "channel_id":
+                    ChannelId = reader.ReadString();
+                    return true;
+                case "code":
+                    Code = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "content":
+                    Content = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "group_id":
+                    GroupId = reader.ReadString();
+                    return true;
+                case "message_id":
+                    MessageId = reader.ReadString();
+                    return true;
+                case "persistent":
+                    Persistent = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "room_name":
+                    RoomName = reader.ReadString();
+                    return true;
+                case "sender_id":
+                    SenderId = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id_one":
+                    UserIdOne = reader.ReadString();
+                    return true;
+                case "user_id_two":
+                    UserIdTwo = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (ChannelId != null)
+            {
+                writer.WriteName("channel_id");
+                writer.WriteString(ChannelId);
+            }
+            if (Code != null)
+            {
+                writer.WriteName("code");
+                writer.WriteInt32(Code.Value);
+            }
+            if (Content != null)
+            {
+                writer.WriteName("content");
+                writer.WriteString(Content);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (GroupId != null)
+            {
+                writer.WriteName("group_id");
+                writer.WriteString(GroupId);
+            }
+            if (MessageId != null)
+            {
+                writer.WriteName("message_id");
+                writer.WriteString(MessageId);
+            }
+            if (Persistent != null)
+            {
+                writer.WriteName("persistent");
+                writer.WriteBoolean(Persistent.Value);
+            }
+            if (RoomName != null)
+            {
+                writer.WriteName("room_name");
+                writer.WriteString(RoomName);
+            }
+            if (SenderId != null)
+            {
+                writer.WriteName("sender_id");
+                writer.WriteString(SenderId);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserIdOne != null)
+            {
+                writer.WriteName("user_id_one");
+                writer.WriteString(UserIdOne);
+            }
+            if (UserIdTwo != null)
+            {
+                writer.WriteName("user_id_two");
+                writer.WriteString(UserIdTwo);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiChannelMessage Clone() => (ApiChannelMessage) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiChannelMessage) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiChannelMessage) obj;
+            return ChannelId == other.ChannelId &&
+                Nullable.Equals(Code, other.Code) &&
+                Content == other.Content &&
+                _createTime == other._createTime &&
+                GroupId == other.GroupId &&
+                MessageId == other.MessageId &&
+                Nullable.Equals(Persistent, other.Persistent) &&
+                RoomName == other.RoomName &&
+                SenderId == other.SenderId &&
+                _updateTime == other._updateTime &&
+                UserIdOne == other.UserIdOne &&
+                UserIdTwo == other.UserIdTwo &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (ChannelId?.GetHashCode() ?? 0);
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Content?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (GroupId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (MessageId?.GetHashCode() ?? 0);
+                hash = hash * 31 + Persistent.GetHashCode();
+                hash = hash * 31 + (RoomName?.GetHashCode() ?? 0);
+                hash = hash * 31 + (SenderId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserIdOne?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserIdTwo?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ChannelId: " + ApiClient.FormatValue(ChannelId));
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Content: " + ApiClient.FormatValue(Content));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("GroupId: " + ApiClient.FormatValue(GroupId));
+            members.Add("MessageId: " + ApiClient.FormatValue(MessageId));
+            members.Add("Persistent: " + ApiClient.FormatValue(Persistent));
+            members.Add("RoomName: " + ApiClient.FormatValue(RoomName));
+            members.Add("SenderId: " + ApiClient.FormatValue(SenderId));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserIdOne: " + ApiClient.FormatValue(UserIdOne));
+            members.Add("UserIdTwo: " + ApiClient.FormatValue(UserIdTwo));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
+        }
+    }
+
+    /// <summary>
+    /// A list of channel messages, usually a result of a list operation.
+    /// </summary>
+    public interface IApiChannelMessageList
+    {
+
+        /// <summary>
+        /// Cacheable cursor to list newer messages. Durable and designed to be stored, unlike next/prev cursors.
+        /// </summary>
+        string CacheableCursor { get; }
+
+        /// <summary>
+        /// A list of messages.
+        /// </summary>
+        IEnumerable<IApiChannelMessage> Messages { get; }
+
+        /// <summary>
+        /// The cursor to send when retrieving the next page, if any.
+        /// </summary>
+        string NextCursor { get; }
+
+        /// <summary>
+        /// The cursor to send when retrieving the previous page, if any.
+        /// </summary>
+        string PrevCursor { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiChannelMessageList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiChannelMessageList : IApiChannelMessageList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="cacheable_cursor"), Preserve]
+        public string CacheableCursor { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiChannelMessage> Messages => _messages ?? new List<ApiChannelMessage>(0);
+        [DataMember(Name="messages"), Preserve]
+        public List<ApiChannelMessage> _messages { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="next_cursor"), Preserve]
+        public string NextCursor { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="prev_cursor"), Preserve]
+        public string PrevCursor { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cacheable_cursor":
+                    CacheableCursor = reader.ReadString();
+                    return true;
+                case "messages":
+                    _messages = reader.ReadList(reader.ReadObject<ApiChannelMessage>);
+                    return true;
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (CacheableCursor != null)
+            {
+                writer.WriteName("cacheable_cursor");
+                writer.WriteString(CacheableCursor);
+            }
+            if (_messages != null)
+            {
+                writer.WriteName("messages");
+                writer.WriteList(_messages, writer.WriteObject);
+            }
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiChannelMessageList Clone() => (ApiChannelMessageList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiChannelMessageList) MemberwiseClone();
+            clone._messages = _messages?.ConvertAll(item => (ApiChannelMessage) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiChannelMessageList) obj;
+            return CacheableCursor == other.CacheableCursor &&
+                ApiClient.ListEquals(_messages, other._messages) &&
+                NextCursor == other.NextCursor &&
+                PrevCursor == other.PrevCursor;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (CacheableCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_messages);
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CacheableCursor: " + ApiClient.FormatValue(CacheableCursor));
+            members.Add("Messages: " + ApiClient.FormatList(_messages));
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
+        }
+    }
+
+    /// <summary>
+    /// Create a group with the current user as owner.
+    /// </summary>
+    public interface IApiCreateGroupRequest
+    {
+
+        /// <summary>
+        /// A URL for an avatar image.
+        /// </summary>
+        string AvatarUrl { get; }
+
+        /// <summary>
+        /// A description for the group.
+        /// </summary>
+        string Description { get; }
+
+        /// <summary>
+        /// The language expected to be a tag which follows the BCP-47 spec.
+        /// </summary>
+        string LangTag { get; }
+
+        /// <summary>
+        /// Maximum number of group members.
+        /// </summary>
+        int MaxCount { get; }
+
+        /// <summary>
+        /// A unique name for the group.
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// Mark a group as open or not where only admins can accept members.
+        /// </summary>
+        bool Open { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiCreateGroupRequest Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiCreateGroupRequest : IApiCreateGroupRequest, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="avatar_url"), Preserve]
+        public string AvatarUrl { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="description"), Preserve]
+        public string Description { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="lang_tag"), Preserve]
+        public string LangTag { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="max_count"), Preserve]
+        public int MaxCount { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="open"), Preserve]
+        public bool Open { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "description":
+                    Description = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "max_count":
+                    MaxCount = reader.ReadInt32();
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "open":
+                    Open = reader.ReadBoolean();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (Description != null)
+            {
+                writer.WriteName("description");
+                writer.WriteString(Description);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            writer.WriteName("max_count");
+            writer.WriteInt32(MaxCount);
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            writer.WriteName("open");
+            writer.WriteBoolean(Open);
+        }
+
+        /// <inheritdoc />
+        public IApiCreateGroupRequest Clone() => (ApiCreateGroupRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiCreateGroupRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiCreateGroupRequest) obj;
+            return AvatarUrl == other.AvatarUrl &&
+                Description == other.Description &&
+                LangTag == other.LangTag &&
+                MaxCount == other.MaxCount &&
+                Name == other.Name &&
+                Open == other.Open;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Description?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + MaxCount.GetHashCode();
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + Open.GetHashCode();
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("Description: " + ApiClient.FormatValue(Description));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("MaxCount: " + ApiClient.FormatValue(MaxCount));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Open: " + ApiClient.FormatValue(Open));
+        }
+    }
+
+    /// <summary>
+    /// Storage objects to delete.
+    /// </summary>
+    public interface IApiDeleteStorageObjectId
+    {
+
+        /// <summary>
+        /// The collection which stores the object.
+        /// </summary>
+        string Collection { get; }
+
+        /// <summary>
+        /// The key of the object within the collection.
+        /// </summary>
+        string Key { get; }
+
+        /// <summary>
+        /// The version hash of the object.
+        /// </summary>
+        string Version { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiDeleteStorageObjectId Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiDeleteStorageObjectId : IApiDeleteStorageObjectId, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="collection"), Preserve]
+        public string Collection { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="key"), Preserve]
+        public string Key { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="version"), Preserve]
+        public string Version { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "collection":
+                    Collection = reader.ReadString();
+                    return true;
+                case "key":
+                    Key = reader.ReadString();
+                    return true;
+                case "version":
+                    Version = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collection != null)
+            {
+                writer.WriteName("collection");
+                writer.WriteString(Collection);
+            }
+            if (Key != null)
+            {
+                writer.WriteName("key");
+                writer.WriteString(Key);
+            }
+            if (Version != null)
+            {
+                writer.WriteName("version");
+                writer.WriteString(Version);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiDeleteStorageObjectId Clone() => (ApiDeleteStorageObjectId) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiDeleteStorageObjectId) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiDeleteStorageObjectId) obj;
+            return Collection == other.Collection &&
+                Key == other.Key &&
+                Version == other.Version;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Collection?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Key?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Version?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collection: " + ApiClient.FormatValue(Collection));
+            members.Add("Key: " + ApiClient.FormatValue(Key));
+            members.Add("Version: " + ApiClient.FormatValue(Version));
+        }
+    }
+
+    /// <summary>
+    /// Batch delete storage objects.
+    /// </summary>
+    public interface IApiDeleteStorageObjectsRequest
+    {
+
+        /// <summary>
+        /// Batch of storage objects.
+        /// </summary>
+        IEnumerable<IApiDeleteStorageObjectId> ObjectIds { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiDeleteStorageObjectsRequest Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiDeleteStorageObjectsRequest : IApiDeleteStorageObjectsRequest, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiDeleteStorageObjectId> ObjectIds => _objectIds ?? new List<ApiDeleteStorageObjectId>(0);
+        [DataMember(Name="object_ids"), Preserve]
+        public List<ApiDeleteStorageObjectId> _objectIds { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "object_ids":
+                    _objectIds = reader.ReadList(reader.ReadObject<ApiDeleteStorageObjectId>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_objectIds != null)
+            {
+                writer.WriteName("object_ids");
+                writer.WriteList(_objectIds, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiDeleteStorageObjectsRequest Clone() => (ApiDeleteStorageObjectsRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiDeleteStorageObjectsRequest) MemberwiseClone();
+            clone._objectIds = _objectIds?.ConvertAll(item => (ApiDeleteStorageObjectId) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiDeleteStorageObjectsRequest) obj;
+            return ApiClient.ListEquals(_objectIds, other._objectIds);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_objectIds);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ObjectIds: " + ApiClient.FormatList(_objectIds));
+        }
+    }
+
+    /// <summary>
+    /// Represents an event to be passed through the server to registered event handlers.
+    /// </summary>
+    public interface IApiEvent
+    {
+
+        /// <summary>
+        /// True if the event came directly from a client call, false otherwise.
+        /// </summary>
+        bool External { get; }
+
+        /// <summary>
+        /// An event name, type, category, or identifier.
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// Arbitrary event property values.
+        /// </summary>
+        IDictionary<string, string> Properties { get; }
+
+        /// <summary>
+        /// The time when the event was triggered.
+        /// </summary>
+        DateTime Timestamp { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiEvent Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiEvent : IApiEvent, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="external"), Preserve]
+        public bool External { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Properties => _properties ?? new Dictionary<string, string>();
+        [DataMember(Name="properties"), Preserve]
+        public Dictionary<string, string> _properties { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime Timestamp
+        {
+            get => ApiClient.ParseDateTime(_timestamp);
+            set => _timestamp = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="timestamp"), Preserve]
+        public string _timestamp { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "external":
+                    External = reader.ReadBoolean();
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "properties":
+                    _properties = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "timestamp":
+                    _timestamp = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("external");
+            writer.WriteBoolean(External);
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (_properties != null)
+            {
+                writer.WriteName("properties");
+                writer.WriteMap(_properties, writer.WriteString);
+            }
+            if (_timestamp != null)
+            {
+                writer.WriteName("timestamp");
+                writer.WriteString(_timestamp);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiEvent Clone() => (ApiEvent) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiEvent) MemberwiseClone();
+            clone._properties = ApiClient.ConvertMap<string, string>(_properties, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiEvent) obj;
+            return External == other.External &&
+                Name == other.Name &&
+                ApiClient.MapEquals(_properties, other._properties) &&
+                _timestamp == other._timestamp;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + External.GetHashCode();
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_properties);
+                hash = hash * 31 + (_timestamp?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("External: " + ApiClient.FormatValue(External));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Properties: " + ApiClient.FormatMap(_properties));
+            members.Add("Timestamp: " + ApiClient.FormatValue(Timestamp));
+        }
+    }
+
+    /// <summary>
+    /// A friend of a user.
+    /// </summary>
+    public interface IApiFriend
+    {
+
+        /// <summary>
+        /// Metadata.
+        /// </summary>
+        string Metadata { get; }
+
+        /// <summary>
+        /// The friend status.  one of "Friend.State".
+        /// </summary>
+        int? State { get; }
+
+        /// <summary>
+        /// Time of the latest relationship update.
+        /// </summary>
+        DateTime UpdateTime { get; }
+
+        /// <summary>
+        /// The user object.
+        /// </summary>
+        IApiUser User { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFriend Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiFriend : IApiFriend, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="metadata"), Preserve]
+        public string Metadata { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="state"), Preserve]
+        public int? State { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IApiUser User => _user;
+        [DataMember(Name="user"), Preserve]
+        public ApiUser _user { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "state":
+                    State = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user":
+                    _user = reader.ReadObject<ApiUser>();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (State != null)
+            {
+                writer.WriteName("state");
+                writer.WriteInt32(State.Value);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (_user != null)
+            {
+                writer.WriteName("user");
+                writer.WriteObject(_user);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFriend Clone() => (ApiFriend) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFriend) MemberwiseClone();
+            clone._user = (ApiUser) _user?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFriend) obj;
+            return Metadata == other.Metadata &&
+                Nullable.Equals(State, other.State) &&
+                _updateTime == other._updateTime &&
+                Equals(_user, other._user);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + State.GetHashCode();
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_user?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("State: " + ApiClient.FormatValue(State));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("User: " + ApiClient.FormatValue(_user));
+        }
+    }
+
+    /// <summary>
+    /// A collection of zero or more friends of the user.
+    /// </summary>
+    public interface IApiFriendList
+    {
+
+        /// <summary>
+        /// Cursor for the next page of results, if any.
+        /// </summary>
+        string Cursor { get; }
+
+        /// <summary>
+        /// The Friend objects.
+        /// </summary>
+        IEnumerable<IApiFriend> Friends { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFriendList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiFriendList : IApiFriendList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiFriend> Friends => _friends ?? new List<ApiFriend>(0);
+        [DataMember(Name="friends"), Preserve]
+        public List<ApiFriend> _friends { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "friends":
+                    _friends = reader.ReadList(reader.ReadObject<ApiFriend>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_friends != null)
+            {
+                writer.WriteName("friends");
+                writer.WriteList(_friends, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFriendList Clone() => (ApiFriendList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFriendList) MemberwiseClone();
+            clone._friends = _friends?.ConvertAll(item => (ApiFriend) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFriendList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_friends, other._friends);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_friends);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("Friends: " + ApiClient.FormatList(_friends));
+        }
+    }
+
+    /// <summary>
+    /// A List of friends of friends
+    /// </summary>
+    public interface IApiFriendsOfFriendsList
+    {
+
+        /// <summary>
+        /// Cursor for the next page of results, if any.
+        /// </summary>
+        string Cursor { get; }
+
+        /// <summary>
+        /// User friends of friends.
+        /// </summary>
+        IEnumerable<IFriendsOfFriendsListFriendOfFriend> FriendsOfFriends { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFriendsOfFriendsList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiFriendsOfFriendsList : IApiFriendsOfFriendsList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IFriendsOfFriendsListFriendOfFriend> FriendsOfFriends => _friendsOfFriends ?? new List<FriendsOfFriendsListFriendOfFriend>(0);
+        [DataMember(Name="friends_of_friends"), Preserve]
+        public List<FriendsOfFriendsListFriendOfFriend> _friendsOfFriends { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "friends_of_friends":
+                    _friendsOfFriends = reader.ReadList(reader.ReadObject<FriendsOfFriendsListFriendOfFriend>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_friendsOfFriends != null)
+            {
+                writer.WriteName("friends_of_friends");
+                writer.WriteList(_friendsOfFriends, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFriendsOfFriendsList Clone() => (ApiFriendsOfFriendsList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFriendsOfFriendsList) MemberwiseClone();
+            clone._friendsOfFriends = _friendsOfFriends?.ConvertAll(item => (FriendsOfFriendsListFriendOfFriend) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFriendsOfFriendsList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_friendsOfFriends, other._friendsOfFriends);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_friendsOfFriends);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("FriendsOfFriends: " + ApiClient.FormatList(_friendsOfFriends));
+        }
+    }
+
+    /// <summary>
+    /// A group in the server.
+    /// </summary>
+    public interface IApiGroup
+    {
+
+        /// <summary>
+        /// A URL for an avatar image.
+        /// </summary>
+        string AvatarUrl { get; }
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the group was created.
+        /// </summary>
+        DateTime CreateTime { get; }
+
+        /// <summary>
+        /// The id of the user who created the group.
+        /// </summary>
+        string CreatorId { get; }
+
+        /// <summary>
+        /// A description for the group.
+        /// </summary>
+        string Description { get; }
+
+        /// <summary>
+        /// The current count of all members in the group.
+        /// </summary>
+        int EdgeCount { get; }
+
+        /// <summary>
+        /// The id of a group.
+        /// </summary>
+        string Id { get; }
+
+        /// <summary>
+        /// The language expected to be a tag which follows the BCP-47 spec.
+        /// </summary>
+        string LangTag { get; }
+
+        /// <summary>
+        /// The maximum number of members allowed.
+        /// </summary>
+        int MaxCount { get; }
+
+        /// <summary>
+        /// Additional information stored as a JSON object.
+        /// </summary>
+        string Metadata { get; }
+
+        /// <summary>
+        /// The unique name of the group.
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// Anyone can join open groups, otherwise only admins can accept members.
+        /// </summary>
+        bool? Open { get; }
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the group was last updated.
+        /// </summary>
+        DateTime UpdateTime { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiGroup Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiGroup : IApiGroup, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="avatar_url"), Preserve]
+        public string AvatarUrl { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="creator_id"), Preserve]
+        public string CreatorId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="description"), Preserve]
+        public string Description { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="edge_count"), Preserve]
+        public int EdgeCount { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="lang_tag"), Preserve]
+        public string LangTag { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="max_count"), Preserve]
+        public int MaxCount { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="metadata"), Preserve]
+        public string Metadata { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="open"), Preserve]
+        public bool? Open { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "creator_id":
+                    CreatorId = reader.ReadString();
+                    return true;
+                case "description":
+                    Description = reader.ReadString();
+                    return true;
+                case "edge_count":
+                    EdgeCount = reader.ReadInt32();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "max_count":
+                    MaxCount = reader.ReadInt32();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "open":
+                    Open = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (CreatorId != null)
+            {
+                writer.WriteName("creator_id");
+                writer.WriteString(CreatorId);
+            }
+            if (Description != null)
+            {
+                writer.WriteName("description");
+                writer.WriteString(Description);
+            }
+            writer.WriteName("edge_count");
+            writer.WriteInt32(EdgeCount);
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            writer.WriteName("max_count");
+            writer.WriteInt32(MaxCount);
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (Open != null)
+            {
+                writer.WriteName("open");
+                writer.WriteBoolean(Open.Value);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiGroup Clone() => (ApiGroup) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiGroup) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiGroup) obj;
+            return AvatarUrl == other.AvatarUrl &&
+                _createTime == other._createTime &&
+                CreatorId == other.CreatorId &&
+                Description == other.Description &&
+                EdgeCount == other.EdgeCount &&
+                Id == other.Id &&
+                LangTag == other.LangTag &&
+                MaxCount == other.MaxCount &&
+                Metadata == other.Metadata &&
+                Name == other.Name &&
+                Nullable.Equals(Open, other.Open) &&
+                _updateTime == other._updateTime;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (CreatorId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Description?.GetHashCode() ?? 0);
+                hash = hash * 31 + EdgeCount.GetHashCode();
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + MaxCount.GetHashCode();
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + Open.GetHashCode();
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("CreatorId: " + ApiClient.FormatValue(CreatorId));
+            members.Add("Description: " + ApiClient.FormatValue(Description));
+            members.Add("EdgeCount: " + ApiClient.FormatValue(EdgeCount));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("MaxCount: " + ApiClient.FormatValue(MaxCount));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Open: " + ApiClient.FormatValue(Open));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+        }
+    }
+
+    /// <summary>
+    /// One or more groups returned from a listing operation.
+    /// </summary>
+    public interface IApiGroupList
+    {
+
+        /// <summary>
+        /// A cursor used to get the next page.
+        /// </summary>
+        string Cursor { get; }
+
+        /// <summary>
+        /// One or more groups.
+        /// </summary>
+        IEnumerable<IApiGroup> Groups { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiGroupList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiGroupList : IApiGroupList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiGroup> Groups => _groups ?? new List<ApiGroup>(0);
+        [DataMember(Name="groups"), Preserve]
+        public List<ApiGroup> _groups { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "groups":
+                    _groups = reader.ReadList(reader.ReadObject<ApiGroup>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_groups != null)
+            {
+                writer.WriteName("groups");
+                writer.WriteList(_groups, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiGroupList Clone() => (ApiGroupList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiGroupList) MemberwiseClone();
+            clone._groups = _groups?.ConvertAll(item => (ApiGroup) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiGroupList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_groups, other._groups);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_groups);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("Groups: " + ApiClient.FormatList(_groups));
+        }
+    }
+
+    /// <summary>
+    /// A list of users belonging to a group, along with their role.
+    /// </summary>
+    public interface IApiGroupUserList
+    {
+
+        /// <summary>
+        /// Cursor for the next page of results, if any.
+        /// </summary>
+        string Cursor { get; }
+
+        /// <summary>
+        /// User-role pairs for a group.
+        /// </summary>
+        IEnumerable<IGroupUserListGroupUser> GroupUsers { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiGroupUserList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiGroupUserList : IApiGroupUserList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IGroupUserListGroupUser> GroupUsers => _groupUsers ?? new List<GroupUserListGroupUser>(0);
+        [DataMember(Name="group_users"), Preserve]
+        public List<GroupUserListGroupUser> _groupUsers { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "group_users":
+                    _groupUsers = reader.ReadList(reader.ReadObject<GroupUserListGroupUser>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_groupUsers != null)
+            {
+                writer.WriteName("group_users");
+                writer.WriteList(_groupUsers, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiGroupUserList Clone() => (ApiGroupUserList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiGroupUserList) MemberwiseClone();
+            clone._groupUsers = _groupUsers?.ConvertAll(item => (GroupUserListGroupUser) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiGroupUserList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_groupUsers, other._groupUsers);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_groupUsers);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("GroupUsers: " + ApiClient.FormatList(_groupUsers));
+        }
+    }
+
+    /// <summary>
+    /// Represents a complete leaderboard record with all scores and associated metadata.
+    /// </summary>
+    public interface IApiLeaderboardRecord
+    {
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record was created.
+        /// </summary>
+        DateTime CreateTime { get; }
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record expires.
+        /// </summary>
+        DateTime ExpiryTime { get; }
+
+        /// <summary>
+        /// The ID of the leaderboard this score belongs to.
+        /// </summary>
+        string LeaderboardId { get; }
+
+        /// <summary>
+        /// The maximum number of score updates allowed by the owner.
+        /// </summary>
+        long MaxNumScore { get; }
+
+        /// <summary>
+        /// Metadata.
+        /// </summary>
+        string Metadata { get; }
+
+        /// <summary>
+        /// The number of submissions to this score record.
+        /// </summary>
+        int NumScore { get; }
+
+        /// <summary>
+        /// The ID of the score owner, usually a user or group.
+        /// </summary>
+        string OwnerId { get; }
+
+        /// <summary>
+        /// The rank of this record.
+        /// </summary>
+        long Rank { get; }
+
+        /// <summary>
+        /// The score value.
+        /// </summary>
+        long Score { get; }
+
+        /// <summary>
+        /// An optional subscore value.
+        /// </summary>
+        long Subscore { get; }
+
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record was updated.
+        /// </summary>
+        DateTime UpdateTime { get; }
+
+        /// <summary>
+        /// The username of the score owner, if the owner is a user.
+        /// </summary>
+        string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiLeaderboardRecord Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiLeaderboardRecord : IApiLeaderboardRecord, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime ExpiryTime
+        {
+            get => ApiClient.ParseDateTime(_expiryTime);
+            set => _expiryTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="expiry_time"), Preserve]
+        public string _expiryTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="leaderboard_id"), Preserve]
+        public string LeaderboardId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="max_num_score"), Preserve]
+        public long MaxNumScore { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="metadata"), Preserve]
+        public string Metadata { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="num_score"), Preserve]
+        public int NumScore { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="owner_id"), Preserve]
+        public string OwnerId { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Rank
+        {
+            get => ApiClient.ParseInt64(_rank);
+            set => _rank = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="rank"), Preserve]
+        public string _rank { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Score
+        {
+            get => ApiClient.ParseInt64(_score);
+            set => _score = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="score"), Preserve]
+        public string _score { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Subscore
+        {
+            get => ApiClient.ParseInt64(_subscore);
+            set => _subscore = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="subscore"), Preserve]
+        public string _subscore { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="username"), Preserve]
+        public string Username { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "expiry_time":
+                    _expiryTime = reader.ReadString();
+                    return true;
+                case "leaderboard_id":
+                    LeaderboardId = reader.ReadString();
+                    return true;
+                case "max_num_score":
+                    MaxNumScore = reader.ReadInt64();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "num_score":
+                    NumScore = reader.ReadInt32();
+                    return true;
+                case "owner_id":
+                    OwnerId = reader.ReadString();
+                    return true;
+                case "rank":
+                    _rank = reader.ReadString();
+                    return true;
+                case "score":
+                    _score = reader.ReadString();
+                    return true;
+                case "subscore":
+                    _subscore = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (_expiryTime != null)
+            {
+                writer.WriteName("expiry_time");
+                writer.WriteString(_expiryTime);
+            }
+            if (LeaderboardId != null)
+            {
+                writer.WriteName("leaderboard_id");
+                writer.WriteString(LeaderboardId);
+            }
+            writer.WriteName("max_num_score");
+            writer.WriteInt64(MaxNumScore);
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            writer.WriteName("num_score");
+            writer.WriteInt32(NumScore);
+            if (OwnerId != null)
+            {
+                writer.WriteName("owner_id");
+                writer.WriteString(OwnerId);
+            }
+            if (_rank != null)
+            {
+                writer.WriteName("rank");
+                writer.WriteString(_rank);
+            }
+            if (_score != null)
+            {
+                writer.WriteName("score");
+                writer.WriteString(_score);
+            }
+            if (_subscore != null)
+            {
+                writer.WriteName("subscore");
+                writer.WriteString(_subscore);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiLeaderboardRecord Clone() => (ApiLeaderboardRecord) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiLeaderboardRecord) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiLeaderboardRecord) obj;
+            return _createTime == other._createTime &&
+                _expiryTime == other._expiryTime &&
+                LeaderboardId == other.LeaderboardId &&
+                MaxNumScore == other.MaxNumScore &&
+                Metadata == other.Metadata &&
+                NumScore == other.NumScore &&
+                OwnerId == other.OwnerId &&
+                _rank == other._rank &&
+                _score == other._score &&
+                _subscore == other._subscore &&
+                _updateTime == other._updateTime &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_expiryTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LeaderboardId?.GetHashCode() ?? 0);
+                hash = hash * 31 + MaxNumScore.GetHashCode();
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + NumScore.GetHashCode();
+                hash = hash * 31 + (OwnerId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_rank?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_score?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_subscore?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("ExpiryTime: " + ApiClient.FormatValue(ExpiryTime));
+            members.Add("LeaderboardId: " + ApiClient.FormatValue(LeaderboardId));
+            members.Add("MaxNumScore: " + ApiClient.FormatValue(MaxNumScore));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("NumScore: " + ApiClient.FormatValue(NumScore));
+            members.Add("OwnerId: " + ApiClient.FormatValue(OwnerId));
+            members.Add("Rank: " + ApiClient.FormatValue(Rank));
+            members.Add("Score: " + ApiClient.FormatValue(Score));
+            members.Add("Subscore: " + ApiClient.FormatValue(Subscore));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
+        }
+    }
+
+    /// <summary>
+    /// A set of leaderboard records, may be part of a leaderboard records page or a batch of individual records.
+    /// </summary>
+    public interface IApiLeaderboardRecordList
+    {
 
         /// <summary>
-        /// The devices which belong to the user's account.
+        /// The cursor to send when retrieving the next page, if any.
         /// </summary>
-        IEnumerable<IApiAccountDevice> Devices { get; }
+        string NextCursor { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user's account was disabled/banned.
+        /// A batched set of leaderboard records belonging to specified owners.
         /// </summary>
-        string DisableTime { get; }
+        IEnumerable<IApiLeaderboardRecord> OwnerRecords { get; }
 
         /// <summary>
-        /// The email address of the user.
+        /// The cursor to send when retrieving the previous page, if any.
         /// </summary>
-        string Email { get; }
+        string PrevCursor { get; }
 
         /// <summary>
-        /// The user object.
+        /// The total number of ranks available.
         /// </summary>
-        IApiUser User { get; }
+        long RankCount { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user's email was verified.
+        /// A list of leaderboard records.
         /// </summary>
-        string VerifyTime { get; }
+        IEnumerable<IApiLeaderboardRecord> Records { get; }
 
         /// <summary>
-        /// The user's wallet data.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Wallet { get; }
+        IApiLeaderboardRecordList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiAccount : IApiAccount
+    internal class ApiLeaderboardRecordList : IApiLeaderboardRecordList, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="custom_id"), Preserve]
-        public string CustomId { get; set; }
+        [DataMember(Name="next_cursor"), Preserve]
+        public string NextCursor { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IEnumerable<IApiAccountDevice> Devices => _devices ?? new List<ApiAccountDevice>(0);
-        [DataMember(Name="devices"), Preserve]
-        public List<ApiAccountDevice> _devices { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="disable_time"), Preserve]
-        public string DisableTime { get; set; }
+        public IEnumerable<IApiLeaderboardRecord> OwnerRecords => _ownerRecords ?? new List<ApiLeaderboardRecord>(0);
+        [DataMember(Name="owner_records"), Preserve]
+        public List<ApiLeaderboardRecord> _ownerRecords { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="email"), Preserve]
-        public string Email { get; set; }
+        [DataMember(Name="prev_cursor"), Preserve]
+        public string PrevCursor { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IApiUser User => _user;
-        [DataMember(Name="user"), Preserve]
-        public ApiUser _user { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="verify_time"), Preserve]
-        public string VerifyTime { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="wallet"), Preserve]
-        public string Wallet { get; set; }
-
-        public override string ToString()
+        public long RankCount
         {
-            var output = "";
-            output = string.Concat(output, "CustomId: ", CustomId, ", ");
-            output = string.Concat(output, "Devices: [", string.Join(", ", Devices), "], ");
-            output = string.Concat(output, "DisableTime: ", DisableTime, ", ");
-            output = string.Concat(output, "Email: ", Email, ", ");
-            output = string.Concat(output, "User: ", User, ", ");
-            output = string.Concat(output, "VerifyTime: ", VerifyTime, ", ");
-            output = string.Concat(output, "Wallet: ", Wallet, ", ");
-            return output;
+            get => ApiClient.ParseInt64(_rankCount);
+            set => _rankCount = ApiClient.FormatInt64(value);
         }
-    }
-
-    /// <summary>
-    /// Send a Apple Sign In token to the server. Used with authenticate/link/unlink.
-    /// </summary>
-    public interface IApiAccountApple
-    {
-
-        /// <summary>
-        /// The ID token received from Apple to validate.
-        /// </summary>
-        string Token { get; }
-
-        /// <summary>
-        /// Extra information that will be bundled in the session token.
-        /// </summary>
-        IDictionary<string, string> Vars { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiAccountApple : IApiAccountApple
-    {
-
-        /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
+        [DataMember(Name="rank_count"), Preserve]
+        public string _rankCount { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
+        public IEnumerable<IApiLeaderboardRecord> Records => _records ?? new List<ApiLeaderboardRecord>(0);
+        [DataMember(Name="records"), Preserve]
+        public List<ApiLeaderboardRecord> _records { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Token: ", Token, ", ");
-
-            var varsString = "";
-            foreach (var kvp in Vars)
+            switch (name)
             {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "owner_records":
+                    _ownerRecords = reader.ReadList(reader.ReadObject<ApiLeaderboardRecord>);
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                case "rank_count":
+                    _rankCount = reader.ReadString();
+                    return true;
+                case "records":
+                    _records = reader.ReadList(reader.ReadObject<ApiLeaderboardRecord>);
+                    return true;
+                default:
+                    return false;
             }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
         }
-    }
-
-    /// <summary>
-    /// Send a custom ID to the server. Used with authenticate/link/unlink.
-    /// </summary>
-    public interface IApiAccountCustom
-    {
-
-        /// <summary>
-        /// A custom identifier.
-        /// </summary>
-        string Id { get; }
-
-        /// <summary>
-        /// Extra information that will be bundled in the session token.
-        /// </summary>
-        IDictionary<string, string> Vars { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiAccountCustom : IApiAccountCustom
-    {
-
-        /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
-
-        public override string ToString()
+        public virtual void WriteMembers(ApiJsonWriter writer)
         {
-            var output = "";
-            output = string.Concat(output, "Id: ", Id, ", ");
-
-            var varsString = "";
-            foreach (var kvp in Vars)
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (_ownerRecords != null)
             {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                writer.WriteName("owner_records");
+                writer.WriteList(_ownerRecords, writer.WriteObject);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+            if (_rankCount != null)
+            {
+                writer.WriteName("rank_count");
+                writer.WriteString(_rankCount);
+            }
+            if (_records != null)
+            {
+                writer.WriteName("records");
+                writer.WriteList(_records, writer.WriteObject);
             }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
         }
-    }
-
-    /// <summary>
-    /// Send a device to the server. Used with authenticate/link/unlink and user.
-    /// </summary>
-    public interface IApiAccountDevice
-    {
 
-        /// <summary>
-        /// A device identifier. Should be obtained by a platform-specific device API.
-        /// </summary>
-        string Id { get; }
+        /// <inheritdoc />
+        public IApiLeaderboardRecordList Clone() => (ApiLeaderboardRecordList) CloneModel();
 
-        /// <summary>
-        /// Extra information that will be bundled in the session token.
-        /// </summary>
-        IDictionary<string, string> Vars { get; }
-    }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiLeaderboardRecordList) MemberwiseClone();
+            clone._ownerRecords = _ownerRecords?.ConvertAll(item => (ApiLeaderboardRecord) item?.CloneModel());
+            clone._records = _records?.ConvertAll(item => (ApiLeaderboardRecord) item?.CloneModel());
+            return clone;
+        }
 
-    /// <inheritdoc />
-    internal class ApiAccountDevice : IApiAccountDevice
-    {
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
+            var other = (ApiLeaderboardRecordList) obj;
+            return NextCursor == other.NextCursor &&
+                ApiClient.ListEquals(_ownerRecords, other._ownerRecords) &&
+                PrevCursor == other.PrevCursor &&
+                _rankCount == other._rankCount &&
+                ApiClient.ListEquals(_records, other._records);
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_ownerRecords);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_rankCount?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_records);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Id: ", Id, ", ");
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-            var varsString = "";
-            foreach (var kvp in Vars)
-            {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
-            }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("OwnerRecords: " + ApiClient.FormatList(_ownerRecords));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
+            members.Add("RankCount: " + ApiClient.FormatValue(RankCount));
+            members.Add("Records: " + ApiClient.FormatList(_records));
         }
     }
 
     /// <summary>
-    /// Send an email with password to the server. Used with authenticate/link/unlink.
+    /// Link Steam to the current user's account.
     /// </summary>
-    public interface IApiAccountEmail
+    public interface IApiLinkSteamRequest
     {
 
         /// <summary>
-        /// A valid RFC-5322 email address.
+        /// The Facebook account details.
         /// </summary>
-        string Email { get; }
+        IApiAccountSteam Account { get; }
 
         /// <summary>
-        /// A password for the user account.  Ignored with unlink operations.
+        /// Import Steam friends for the user.
         /// </summary>
-        string Password { get; }
+        bool? Sync { get; }
 
         /// <summary>
-        /// Extra information that will be bundled in the session token.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IDictionary<string, string> Vars { get; }
+        IApiLinkSteamRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiAccountEmail : IApiAccountEmail
+    internal class ApiLinkSteamRequest : IApiLinkSteamRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="email"), Preserve]
-        public string Email { get; set; }
+        [IgnoreDataMember]
+        public IApiAccountSteam Account => _account;
+        [DataMember(Name="account"), Preserve]
+        public ApiAccountSteam _account { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="password"), Preserve]
-        public string Password { get; set; }
+        [DataMember(Name="sync"), Preserve]
+        public bool? Sync { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
-
-        public override string ToString()
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Email: ", Email, ", ");
-            output = string.Concat(output, "Password: ", Password, ", ");
-
-            var varsString = "";
-            foreach (var kvp in Vars)
+            switch (name)
             {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                case "account":
+                    _account = reader.ReadObject<ApiAccountSteam>();
+                    return true;
+                case "sync":
+                    Sync = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                default:
+                    return false;
             }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
         }
-    }
 
-    /// <summary>
-    /// Send a Facebook token to the server. Used with authenticate/link/unlink.
-    /// </summary>
-    public interface IApiAccountFacebook
-    {
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_account != null)
+            {
+                writer.WriteName("account");
+                writer.WriteObject(_account);
+            }
+            if (Sync != null)
+            {
+                writer.WriteName("sync");
+                writer.WriteBoolean(Sync.Value);
+            }
+        }
 
-        /// <summary>
-        /// The OAuth token received from Facebook to access their profile API.
-        /// </summary>
-        string Token { get; }
+        /// <inheritdoc />
+        public IApiLinkSteamRequest Clone() => (ApiLinkSteamRequest) CloneModel();
 
-        /// <summary>
-        /// Extra information that will be bundled in the session token.
-        /// </summary>
-        IDictionary<string, string> Vars { get; }
-    }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiLinkSteamRequest) MemberwiseClone();
+            clone._account = (ApiAccountSteam) _account?.CloneModel();
+            return clone;
+        }
 
-    /// <inheritdoc />
-    internal class ApiAccountFacebook : IApiAccountFacebook
-    {
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
+            var other = (ApiLinkSteamRequest) obj;
+            return Equals(_account, other._account) &&
+                Nullable.Equals(Sync, other.Sync);
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_account?.GetHashCode() ?? 0);
+                hash = hash * 31 + Sync.GetHashCode();
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Token: ", Token, ", ");
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-            var varsString = "";
-            foreach (var kvp in Vars)
-            {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
-            }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Account: " + ApiClient.FormatValue(_account));
+            members.Add("Sync: " + ApiClient.FormatValue(Sync));
         }
     }
 
     /// <summary>
-    /// Send a Facebook Instant Game token to the server. Used with authenticate/link/unlink.
+    /// List user subscriptions.
     /// </summary>
-    public interface IApiAccountFacebookInstantGame
+    public interface IApiListSubscriptionsRequest
     {
 
         /// <summary>
-        /// The OAuth token received from a Facebook Instant Game that may be decoded with the Application Secret (must be available with the nakama configuration)
+        /// Cursor to retrieve a page of records from
         /// </summary>
-        string SignedPlayerInfo { get; }
+        string Cursor { get; }
 
         /// <summary>
-        /// Extra information that will be bundled in the session token.
+        /// Max number of results per page
         /// </summary>
-        IDictionary<string, string> Vars { get; }
+        int? Limit { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiListSubscriptionsRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiAccountFacebookInstantGame : IApiAccountFacebookInstantGame
+    internal class ApiListSubscriptionsRequest : IApiListSubscriptionsRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="signed_player_info"), Preserve]
-        public string SignedPlayerInfo { get; set; }
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
+        [DataMember(Name="limit"), Preserve]
+        public int? Limit { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "SignedPlayerInfo: ", SignedPlayerInfo, ", ");
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "limit":
+                    Limit = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (Limit != null)
+            {
+                writer.WriteName("limit");
+                writer.WriteInt32(Limit.Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiListSubscriptionsRequest Clone() => (ApiListSubscriptionsRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiListSubscriptionsRequest) MemberwiseClone();
+            return clone;
+        }
 
-            var varsString = "";
-            foreach (var kvp in Vars)
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiListSubscriptionsRequest) obj;
+            return Cursor == other.Cursor &&
+                Nullable.Equals(Limit, other.Limit);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
             {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + Limit.GetHashCode();
+                return hash;
             }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("Limit: " + ApiClient.FormatValue(Limit));
         }
     }
 
     /// <summary>
-    /// Send Apple's Game Center account credentials to the server. Used with authenticate/link/unlink.  https://developer.apple.com/documentation/gamekit/gklocalplayer/1515407-generateidentityverificationsign
+    /// Represents a realtime match.
     /// </summary>
-    public interface IApiAccountGameCenter
+    public interface IApiMatch
     {
 
         /// <summary>
-        /// Bundle ID (generated by GameCenter).
+        /// True if it's an server-managed authoritative match, false otherwise.
         /// </summary>
-        string BundleId { get; }
+        bool Authoritative { get; }
 
         /// <summary>
-        /// Player ID (generated by GameCenter).
+        /// Handler name
         /// </summary>
-        string PlayerId { get; }
+        string HandlerName { get; }
 
         /// <summary>
-        /// The URL for the public encryption key.
+        /// Match label, if any.
         /// </summary>
-        string PublicKeyUrl { get; }
+        string Label { get; }
 
         /// <summary>
-        /// A random "NSString" used to compute the hash and keep it randomized.
+        /// The ID of the match, can be used to join.
         /// </summary>
-        string Salt { get; }
+        string MatchId { get; }
 
         /// <summary>
-        /// The verification signature data generated.
+        /// Current number of users in the match.
         /// </summary>
-        string Signature { get; }
+        int Size { get; }
 
         /// <summary>
-        /// Time since UNIX epoch when the signature was created.
+        /// Tick Rate
         /// </summary>
-        string TimestampSeconds { get; }
+        int TickRate { get; }
 
         /// <summary>
-        /// Extra information that will be bundled in the session token.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IDictionary<string, string> Vars { get; }
+        IApiMatch Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiAccountGameCenter : IApiAccountGameCenter
+    internal class ApiMatch : IApiMatch, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="bundle_id"), Preserve]
-        public string BundleId { get; set; }
+        [DataMember(Name="authoritative"), Preserve]
+        public bool Authoritative { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="player_id"), Preserve]
-        public string PlayerId { get; set; }
+        [DataMember(Name="handler_name"), Preserve]
+        public string HandlerName { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="public_key_url"), Preserve]
-        public string PublicKeyUrl { get; set; }
+        [DataMember(Name="label"), Preserve]
+        public string Label { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="salt"), Preserve]
-        public string Salt { get; set; }
+        [DataMember(Name="match_id"), Preserve]
+        public string MatchId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="signature"), Preserve]
-        public string Signature { get; set; }
+        [DataMember(Name="size"), Preserve]
+        public int Size { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="timestamp_seconds"), Preserve]
-        public string TimestampSeconds { get; set; }
+        [DataMember(Name="tick_rate"), Preserve]
+        public int TickRate { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
-
-        public override string ToString()
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "BundleId: ", BundleId, ", ");
-            output = string.Concat(output, "PlayerId: ", PlayerId, ", ");
-            output = string.Concat(output, "PublicKeyUrl: ", PublicKeyUrl, ", ");
-            output = string.Concat(output, "Salt: ", Salt, ", ");
-            output = string.Concat(output, "Signature: ", Signature, ", ");
-            output = string.Concat(output, "TimestampSeconds: ", TimestampSeconds, ", ");
-
-            var varsString = "";
-            foreach (var kvp in Vars)
+            switch (name)
             {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                case "authoritative":
+                    Authoritative = reader.ReadBoolean();
+                    return true;
+                case "handler_name":
+                    HandlerName = reader.ReadString();
+                    return true;
+                case "label":
+                    Label = reader.ReadString();
+                    return true;
+                case "match_id":
+                    MatchId = reader.ReadString();
+                    return true;
+                case "size":
+                    Size = reader.ReadInt32();
+                    return true;
+                case "tick_rate":
+                    TickRate = reader.ReadInt32();
+                    return true;
+                default:
+                    return false;
             }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
         }
-    }
 
-    /// <summary>
-    /// Send a Google token to the server. Used with authenticate/link/unlink.
-    /// </summary>
-    public interface IApiAccountGoogle
-    {
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("authoritative");
+            writer.WriteBoolean(Authoritative);
+            if (HandlerName != null)
+            {
+                writer.WriteName("handler_name");
+                writer.WriteString(HandlerName);
+            }
+            if (Label != null)
+            {
+                writer.WriteName("label");
+                writer.WriteString(Label);
+            }
+            if (MatchId != null)
+            {
+                writer.WriteName("match_id");
+                writer.WriteString(MatchId);
+            }
+            writer.WriteName("size");
+            writer.WriteInt32(Size);
+            writer.WriteName("tick_rate");
+            writer.WriteInt32(TickRate);
+        }
 
-        /// <summary>
-        /// The OAuth token received from Google to access their profile API.
-        /// </summary>
-        string Token { get; }
+        /// <inheritdoc />
+        public IApiMatch Clone() => (ApiMatch) CloneModel();
 
-        /// <summary>
-        /// Extra information that will be bundled in the session token.
-        /// </summary>
-        IDictionary<string, string> Vars { get; }
-    }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiMatch) MemberwiseClone();
+            return clone;
+        }
 
-    /// <inheritdoc />
-    internal class ApiAccountGoogle : IApiAccountGoogle
-    {
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
+            var other = (ApiMatch) obj;
+            return Authoritative == other.Authoritative &&
+                HandlerName == other.HandlerName &&
+                Label == other.Label &&
+                MatchId == other.MatchId &&
+                Size == other.Size &&
+                TickRate == other.TickRate;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Authoritative.GetHashCode();
+                hash = hash * 31 + (HandlerName?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Label?.GetHashCode() ?? 0);
+                hash = hash * 31 + (MatchId?.GetHashCode() ?? 0);
+                hash = hash * 31 + Size.GetHashCode();
+                hash = hash * 31 + TickRate.GetHashCode();
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Token: ", Token, ", ");
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-            var varsString = "";
-            foreach (var kvp in Vars)
-            {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
-            }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Authoritative: " + ApiClient.FormatValue(Authoritative));
+            members.Add("HandlerName: " + ApiClient.FormatValue(HandlerName));
+            members.Add("Label: " + ApiClient.FormatValue(Label));
+            members.Add("MatchId: " + ApiClient.FormatValue(MatchId));
+            members.Add("Size: " + ApiClient.FormatValue(Size));
+            members.Add("TickRate: " + ApiClient.FormatValue(TickRate));
         }
     }
 
     /// <summary>
-    /// Send a Steam token to the server. Used with authenticate/link/unlink.
+    /// A list of realtime matches.
     /// </summary>
-    public interface IApiAccountSteam
+    public interface IApiMatchList
     {
 
         /// <summary>
-        /// The account token received from Steam to access their profile API.
+        /// A number of matches corresponding to a list operation.
         /// </summary>
-        string Token { get; }
+        IEnumerable<IApiMatch> Matches { get; }
 
         /// <summary>
-        /// Extra information that will be bundled in the session token.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IDictionary<string, string> Vars { get; }
+        IApiMatchList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiAccountSteam : IApiAccountSteam
+    internal class ApiMatchList : IApiMatchList, IApiJsonObject
     {
 
-        /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
-
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
+        public IEnumerable<IApiMatch> Matches => _matches ?? new List<ApiMatch>(0);
+        [DataMember(Name="matches"), Preserve]
+        public List<ApiMatch> _matches { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Token: ", Token, ", ");
-
-            var varsString = "";
-            foreach (var kvp in Vars)
+            switch (name)
             {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                case "matches":
+                    _matches = reader.ReadList(reader.ReadObject<ApiMatch>);
+                    return true;
+                default:
+                    return false;
             }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
         }
-    }
-
-    /// <summary>
-    /// A message sent on a channel.
-    /// </summary>
-    public interface IApiChannelMessage
-    {
-
-        /// <summary>
-        /// The channel this message belongs to.
-        /// </summary>
-        string ChannelId { get; }
 
-        /// <summary>
-        /// The code representing a message type or category.
-        /// </summary>
-        int Code { get; }
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_matches != null)
+            {
+                writer.WriteName("matches");
+                writer.WriteList(_matches, writer.WriteObject);
+            }
+        }
 
-        /// <summary>
-        /// The content payload.
-        /// </summary>
-        string Content { get; }
+        /// <inheritdoc />
+        public IApiMatchList Clone() => (ApiMatchList) CloneModel();
 
-        /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the message was created.
-        /// </summary>
-        string CreateTime { get; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiMatchList) MemberwiseClone();
+            clone._matches = _matches?.ConvertAll(item => (ApiMatch) item?.CloneModel());
+            return clone;
+        }
 
-        /// <summary>
-        /// The ID of the group, or an empty string if this message was not sent through a group channel.
-        /// </summary>
-        string GroupId { get; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <summary>
-        /// The unique ID of this message.
-        /// </summary>
-        string MessageId { get; }
+            var other = (ApiMatchList) obj;
+            return ApiClient.ListEquals(_matches, other._matches);
+        }
 
-        /// <summary>
-        /// True if the message was persisted to the channel's history, false otherwise.
-        /// </summary>
-        bool Persistent { get; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_matches);
+                return hash;
+            }
+        }
 
-        /// <summary>
-        /// The name of the chat room, or an empty string if this message was not sent through a chat room.
-        /// </summary>
-        string RoomName { get; }
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-        /// <summary>
-        /// Message sender, usually a user ID.
-        /// </summary>
-        string SenderId { get; }
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Matches: " + ApiClient.FormatList(_matches));
+        }
+    }
 
-        /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the message was last updated.
-        /// </summary>
-        string UpdateTime { get; }
+    /// <summary>
+    /// Matchmaker ticket completion stats
+    /// </summary>
+    public interface IApiMatchmakerCompletionStats
+    {
 
         /// <summary>
-        /// The ID of the first DM user, or an empty string if this message was not sent through a DM chat.
+        /// 
         /// </summary>
-        string UserIdOne { get; }
+        DateTime CompleteTime { get; }
 
         /// <summary>
-        /// The ID of the second DM user, or an empty string if this message was not sent through a DM chat.
+        /// 
         /// </summary>
-        string UserIdTwo { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
-        /// The username of the message sender, if any.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Username { get; }
+        IApiMatchmakerCompletionStats Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiChannelMessage : IApiChannelMessage
+    internal class ApiMatchmakerCompletionStats : IApiMatchmakerCompletionStats, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="channel_id"), Preserve]
-        public string ChannelId { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="code"), Preserve]
-        public int Code { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="content"), Preserve]
-        public string Content { get; set; }
+        [IgnoreDataMember]
+        public DateTime CompleteTime
+        {
+            get => ApiClient.ParseDateTime(_completeTime);
+            set => _completeTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="complete_time"), Preserve]
+        public string _completeTime { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="group_id"), Preserve]
-        public string GroupId { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="message_id"), Preserve]
-        public string MessageId { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="persistent"), Preserve]
-        public bool Persistent { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "complete_time":
+                    _completeTime = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="room_name"), Preserve]
-        public string RoomName { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_completeTime != null)
+            {
+                writer.WriteName("complete_time");
+                writer.WriteString(_completeTime);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="sender_id"), Preserve]
-        public string SenderId { get; set; }
+        public IApiMatchmakerCompletionStats Clone() => (ApiMatchmakerCompletionStats) CloneModel();
 
-        /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiMatchmakerCompletionStats) MemberwiseClone();
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="user_id_one"), Preserve]
-        public string UserIdOne { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="user_id_two"), Preserve]
-        public string UserIdTwo { get; set; }
+            var other = (ApiMatchmakerCompletionStats) obj;
+            return _completeTime == other._completeTime &&
+                _createTime == other._createTime;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="username"), Preserve]
-        public string Username { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_completeTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ChannelId: ", ChannelId, ", ");
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Content: ", Content, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "GroupId: ", GroupId, ", ");
-            output = string.Concat(output, "MessageId: ", MessageId, ", ");
-            output = string.Concat(output, "Persistent: ", Persistent, ", ");
-            output = string.Concat(output, "RoomName: ", RoomName, ", ");
-            output = string.Concat(output, "SenderId: ", SenderId, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserIdOne: ", UserIdOne, ", ");
-            output = string.Concat(output, "UserIdTwo: ", UserIdTwo, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CompleteTime: " + ApiClient.FormatValue(CompleteTime));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
         }
     }
 
     /// <summary>
-    /// A list of channel messages, usually a result of a list operation.
+    /// Matchmaker stats
     /// </summary>
-    public interface IApiChannelMessageList
+    public interface IApiMatchmakerStats
     {
 
         /// <summary>
-        /// Cacheable cursor to list newer messages. Durable and designed to be stored, unlike next/prev cursors.
+        /// 
         /// </summary>
-        string CacheableCursor { get; }
+        IEnumerable<IApiMatchmakerCompletionStats> Completions { get; }
 
         /// <summary>
-        /// A list of messages.
+        /// 
         /// </summary>
-        IEnumerable<IApiChannelMessage> Messages { get; }
+        DateTime OldestTicketCreateTime { get; }
 
         /// <summary>
-        /// The cursor to send when retrieving the next page, if any.
+        /// 
         /// </summary>
-        string NextCursor { get; }
+        int TicketCount { get; }
 
         /// <summary>
-        /// The cursor to send when retrieving the previous page, if any.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string PrevCursor { get; }
+        IApiMatchmakerStats Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiChannelMessageList : IApiChannelMessageList
+    internal class ApiMatchmakerStats : IApiMatchmakerStats, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="cacheable_cursor"), Preserve]
-        public string CacheableCursor { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IApiMatchmakerCompletionStats> Completions => _completions ?? new List<ApiMatchmakerCompletionStats>(0);
+        [DataMember(Name="completions"), Preserve]
+        public List<ApiMatchmakerCompletionStats> _completions { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IEnumerable<IApiChannelMessage> Messages => _messages ?? new List<ApiChannelMessage>(0);
-        [DataMember(Name="messages"), Preserve]
-        public List<ApiChannelMessage> _messages { get; set; }
+        public DateTime OldestTicketCreateTime
+        {
+            get => ApiClient.ParseDateTime(_oldestTicketCreateTime);
+            set => _oldestTicketCreateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="oldest_ticket_create_time"), Preserve]
+        public string _oldestTicketCreateTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="next_cursor"), Preserve]
-        public string NextCursor { get; set; }
+        [DataMember(Name="ticket_count"), Preserve]
+        public int TicketCount { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="prev_cursor"), Preserve]
-        public string PrevCursor { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "completions":
+                    _completions = reader.ReadList(reader.ReadObject<ApiMatchmakerCompletionStats>);
+                    return true;
+                case "oldest_ticket_create_time":
+                    _oldestTicketCreateTime = reader.ReadString();
+                    return true;
+                case "ticket_count":
+                    TicketCount = reader.ReadInt32();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_completions != null)
+            {
+                writer.WriteName("completions");
+                writer.WriteList(_completions, writer.WriteObject);
+            }
+            if (_oldestTicketCreateTime != null)
+            {
+                writer.WriteName("oldest_ticket_create_time");
+                writer.WriteString(_oldestTicketCreateTime);
+            }
+            writer.WriteName("ticket_count");
+            writer.WriteInt32(TicketCount);
+        }
+
+        /// <inheritdoc />
+        public IApiMatchmakerStats Clone() => (ApiMatchmakerStats) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiMatchmakerStats) MemberwiseClone();
+            clone._completions = _completions?.ConvertAll(item => (ApiMatchmakerCompletionStats) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiMatchmakerStats) obj;
+            return ApiClient.ListEquals(_completions, other._completions) &&
+                _oldestTicketCreateTime == other._oldestTicketCreateTime &&
+                TicketCount == other.TicketCount;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_completions);
+                hash = hash * 31 + (_oldestTicketCreateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + TicketCount.GetHashCode();
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CacheableCursor: ", CacheableCursor, ", ");
-            output = string.Concat(output, "Messages: [", string.Join(", ", Messages), "], ");
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Completions: " + ApiClient.FormatList(_completions));
+            members.Add("OldestTicketCreateTime: " + ApiClient.FormatValue(OldestTicketCreateTime));
+            members.Add("TicketCount: " + ApiClient.FormatValue(TicketCount));
         }
     }
 
     /// <summary>
-    /// Create a group with the current user as owner.
+    /// A notification in the server.
     /// </summary>
-    public interface IApiCreateGroupRequest
+    public interface IApiNotification
     {
 
         /// <summary>
-        /// A URL for an avatar image.
+        /// Category code for this notification.
         /// </summary>
-        string AvatarUrl { get; }
+        int Code { get; }
 
         /// <summary>
-        /// A description for the group.
+        /// Content of the notification in JSON.
         /// </summary>
-        string Description { get; }
+        string Content { get; }
 
         /// <summary>
-        /// The language expected to be a tag which follows the BCP-47 spec.
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the notification was created.
         /// </summary>
-        string LangTag { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
-        /// Maximum number of group members.
+        /// ID of the Notification.
         /// </summary>
-        int MaxCount { get; }
+        string Id { get; }
 
         /// <summary>
-        /// A unique name for the group.
+        /// True if this notification was persisted to the database.
         /// </summary>
-        string Name { get; }
+        bool Persistent { get; }
 
         /// <summary>
-        /// Mark a group as open or not where only admins can accept members.
+        /// ID of the sender, if a user. Otherwise 'null'.
         /// </summary>
-        bool Open { get; }
+        string SenderId { get; }
+
+        /// <summary>
+        /// Subject of the notification.
+        /// </summary>
+        string Subject { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiNotification Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiCreateGroupRequest : IApiCreateGroupRequest
+    internal class ApiNotification : IApiNotification, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="avatar_url"), Preserve]
-        public string AvatarUrl { get; set; }
+        [DataMember(Name="code"), Preserve]
+        public int Code { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="description"), Preserve]
-        public string Description { get; set; }
+        [DataMember(Name="content"), Preserve]
+        public string Content { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="lang_tag"), Preserve]
-        public string LangTag { get; set; }
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="max_count"), Preserve]
-        public int MaxCount { get; set; }
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
+        [DataMember(Name="persistent"), Preserve]
+        public bool Persistent { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="open"), Preserve]
-        public bool Open { get; set; }
+        [DataMember(Name="sender_id"), Preserve]
+        public string SenderId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="subject"), Preserve]
+        public string Subject { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "code":
+                    Code = reader.ReadInt32();
+                    return true;
+                case "content":
+                    Content = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "persistent":
+                    Persistent = reader.ReadBoolean();
+                    return true;
+                case "sender_id":
+                    SenderId = reader.ReadString();
+                    return true;
+                case "subject":
+                    Subject = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("code");
+            writer.WriteInt32(Code);
+            if (Content != null)
+            {
+                writer.WriteName("content");
+                writer.WriteString(Content);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            writer.WriteName("persistent");
+            writer.WriteBoolean(Persistent);
+            if (SenderId != null)
+            {
+                writer.WriteName("sender_id");
+                writer.WriteString(SenderId);
+            }
+            if (Subject != null)
+            {
+                writer.WriteName("subject");
+                writer.WriteString(Subject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiNotification Clone() => (ApiNotification) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiNotification) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiNotification) obj;
+            return Code == other.Code &&
+                Content == other.Content &&
+                _createTime == other._createTime &&
+                Id == other.Id &&
+                Persistent == other.Persistent &&
+                SenderId == other.SenderId &&
+                Subject == other.Subject;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Content?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + Persistent.GetHashCode();
+                hash = hash * 31 + (SenderId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Subject?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "Description: ", Description, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "MaxCount: ", MaxCount, ", ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "Open: ", Open, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Content: " + ApiClient.FormatValue(Content));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Persistent: " + ApiClient.FormatValue(Persistent));
+            members.Add("SenderId: " + ApiClient.FormatValue(SenderId));
+            members.Add("Subject: " + ApiClient.FormatValue(Subject));
         }
     }
 
     /// <summary>
-    /// Storage objects to delete.
+    /// A collection of zero or more notifications.
     /// </summary>
-    public interface IApiDeleteStorageObjectId
+    public interface IApiNotificationList
     {
 
         /// <summary>
-        /// The collection which stores the object.
+        /// Use this cursor to paginate notifications. Cache this to catch up to new notifications.
         /// </summary>
-        string Collection { get; }
+        string CacheableCursor { get; }
 
         /// <summary>
-        /// The key of the object within the collection.
+        /// Collection of notifications.
         /// </summary>
-        string Key { get; }
+        IEnumerable<IApiNotification> Notifications { get; }
 
         /// <summary>
-        /// The version hash of the object.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Version { get; }
+        IApiNotificationList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiDeleteStorageObjectId : IApiDeleteStorageObjectId
+    internal class ApiNotificationList : IApiNotificationList, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="collection"), Preserve]
-        public string Collection { get; set; }
+        [DataMember(Name="cacheable_cursor"), Preserve]
+        public string CacheableCursor { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="key"), Preserve]
-        public string Key { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IApiNotification> Notifications => _notifications ?? new List<ApiNotification>(0);
+        [DataMember(Name="notifications"), Preserve]
+        public List<ApiNotification> _notifications { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="version"), Preserve]
-        public string Version { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cacheable_cursor":
+                    CacheableCursor = reader.ReadString();
+                    return true;
+                case "notifications":
+                    _notifications = reader.ReadList(reader.ReadObject<ApiNotification>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
         {
-            var output = "";
-            output = string.Concat(output, "Collection: ", Collection, ", ");
-            output = string.Concat(output, "Key: ", Key, ", ");
-            output = string.Concat(output, "Version: ", Version, ", ");
-            return output;
+            if (CacheableCursor != null)
+            {
+                writer.WriteName("cacheable_cursor");
+                writer.WriteString(CacheableCursor);
+            }
+            if (_notifications != null)
+            {
+                writer.WriteName("notifications");
+                writer.WriteList(_notifications, writer.WriteObject);
+            }
         }
-    }
 
-    /// <summary>
-    /// Batch delete storage objects.
-    /// </summary>
-    public interface IApiDeleteStorageObjectsRequest
-    {
+        /// <inheritdoc />
+        public IApiNotificationList Clone() => (ApiNotificationList) CloneModel();
 
-        /// <summary>
-        /// Batch of storage objects.
-        /// </summary>
-        IEnumerable<IApiDeleteStorageObjectId> ObjectIds { get; }
-    }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiNotificationList) MemberwiseClone();
+            clone._notifications = _notifications?.ConvertAll(item => (ApiNotification) item?.CloneModel());
+            return clone;
+        }
 
-    /// <inheritdoc />
-    internal class ApiDeleteStorageObjectsRequest : IApiDeleteStorageObjectsRequest
-    {
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiDeleteStorageObjectId> ObjectIds => _objectIds ?? new List<ApiDeleteStorageObjectId>(0);
-        [DataMember(Name="object_ids"), Preserve]
-        public List<ApiDeleteStorageObjectId> _objectIds { get; set; }
+            var other = (ApiNotificationList) obj;
+            return CacheableCursor == other.CacheableCursor &&
+                ApiClient.ListEquals(_notifications, other._notifications);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (CacheableCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_notifications);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ObjectIds: [", string.Join(", ", ObjectIds), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CacheableCursor: " + ApiClient.FormatValue(CacheableCursor));
+            members.Add("Notifications: " + ApiClient.FormatList(_notifications));
         }
     }
 
     /// <summary>
-    /// Represents an event to be passed through the server to registered event handlers.
+    /// Operator that can be used to override the one set in the leaderboard.
     /// </summary>
-    public interface IApiEvent
+    public enum ApiOperator
     {
-
         /// <summary>
-        /// True if the event came directly from a client call, false otherwise.
+        /// Do not override the leaderboard operator.
         /// </summary>
-        bool External { get; }
-
+        NO_OVERRIDE = 0,
         /// <summary>
-        /// An event name, type, category, or identifier.
+        /// Override the leaderboard operator with BEST.
         /// </summary>
-        string Name { get; }
-
+        BEST = 1,
         /// <summary>
-        /// Arbitrary event property values.
+        /// Override the leaderboard operator with SET.
         /// </summary>
-        IDictionary<string, string> Properties { get; }
-
+        SET = 2,
         /// <summary>
-        /// The time when the event was triggered.
+        /// 
+        /// </summary>
+        INCREMENT = 3,
+        /// <summary>
+        /// 
+        /// </summary>
+        DECREMENT = 4,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
         /// </summary>
-        string Timestamp { get; }
+        Unknown = -1,
     }
 
-    /// <inheritdoc />
-    internal class ApiEvent : IApiEvent
+    /// <summary>
+    /// Converts <see cref="ApiOperator"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ApiOperatorConverter
     {
-
-        /// <inheritdoc />
-        [DataMember(Name="external"), Preserve]
-        public bool External { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
-
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Properties => _properties ?? new Dictionary<string, string>();
-        [DataMember(Name="properties"), Preserve]
-        public Dictionary<string, string> _properties { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="timestamp"), Preserve]
-        public string Timestamp { get; set; }
-
-        public override string ToString()
+        public static ApiOperator Parse(string value)
         {
-            var output = "";
-            output = string.Concat(output, "External: ", External, ", ");
-            output = string.Concat(output, "Name: ", Name, ", ");
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ApiOperator);
+                case "NO_OVERRIDE":
+                case "0":
+                    return ApiOperator.NO_OVERRIDE;
+                case "BEST":
+                case "1":
+                    return ApiOperator.BEST;
+                case "SET":
+                case "2":
+                    return ApiOperator.SET;
+                case "INCREMENT":
+                case "3":
+                    return ApiOperator.INCREMENT;
+                case "DECREMENT":
+                case "4":
+                    return ApiOperator.DECREMENT;
+                default:
+                    return ApiOperator.Unknown;
+            }
+        }
 
-            var propertiesString = "";
-            foreach (var kvp in Properties)
+        public static string Format(ApiOperator value)
+        {
+            switch (value)
             {
-                propertiesString = string.Concat(propertiesString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                case ApiOperator.NO_OVERRIDE:
+                    return "NO_OVERRIDE";
+                case ApiOperator.BEST:
+                    return "BEST";
+                case ApiOperator.SET:
+                    return "SET";
+                case ApiOperator.INCREMENT:
+                    return "INCREMENT";
+                case ApiOperator.DECREMENT:
+                    return "DECREMENT";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
             }
-            output = string.Concat(output, "Properties: [" + propertiesString + "]");
-            output = string.Concat(output, "Timestamp: ", Timestamp, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// A friend of a user.
+    /// Incoming information about a party.
     /// </summary>
-    public interface IApiFriend
+    public interface IApiParty
     {
 
         /// <summary>
-        /// Metadata.
+        /// Hidden flag.
         /// </summary>
-        string Metadata { get; }
+        bool Hidden { get; }
 
         /// <summary>
-        /// The friend status.  one of "Friend.State".
+        /// The party label, if any.
         /// </summary>
-        int State { get; }
+        string Label { get; }
 
         /// <summary>
-        /// Time of the latest relationship update.
+        /// Maximum number of party members.
         /// </summary>
-        string UpdateTime { get; }
+        int MaxSize { get; }
 
         /// <summary>
-        /// The user object.
+        /// Open flag.
         /// </summary>
-        IApiUser User { get; }
+        bool Open { get; }
+
+        /// <summary>
+        /// Unique party identifier.
+        /// </summary>
+        string PartyId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiParty Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiFriend : IApiFriend
+    internal class ApiParty : IApiParty, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="metadata"), Preserve]
-        public string Metadata { get; set; }
+        [DataMember(Name="hidden"), Preserve]
+        public bool Hidden { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="state"), Preserve]
-        public int State { get; set; }
+        [DataMember(Name="label"), Preserve]
+        public string Label { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        [DataMember(Name="max_size"), Preserve]
+        public int MaxSize { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IApiUser User => _user;
-        [DataMember(Name="user"), Preserve]
-        public ApiUser _user { get; set; }
+        [DataMember(Name="open"), Preserve]
+        public bool Open { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="party_id"), Preserve]
+        public string PartyId { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "hidden":
+                    Hidden = reader.ReadBoolean();
+                    return true;
+                case "label":
+                    Label = reader.ReadString();
+                    return true;
+                case "max_size":
+                    MaxSize = reader.ReadInt32();
+                    return true;
+                case "open":
+                    Open = reader.ReadBoolean();
+                    return true;
+                case "party_id":
+                    PartyId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("hidden");
+            writer.WriteBoolean(Hidden);
+            if (Label != null)
+            {
+                writer.WriteName("label");
+                writer.WriteString(Label);
+            }
+            writer.WriteName("max_size");
+            writer.WriteInt32(MaxSize);
+            writer.WriteName("open");
+            writer.WriteBoolean(Open);
+            if (PartyId != null)
+            {
+                writer.WriteName("party_id");
+                writer.WriteString(PartyId);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiParty Clone() => (ApiParty) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiParty) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiParty) obj;
+            return Hidden == other.Hidden &&
+                Label == other.Label &&
+                MaxSize == other.MaxSize &&
+                Open == other.Open &&
+                PartyId == other.PartyId;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Hidden.GetHashCode();
+                hash = hash * 31 + (Label?.GetHashCode() ?? 0);
+                hash = hash * 31 + MaxSize.GetHashCode();
+                hash = hash * 31 + Open.GetHashCode();
+                hash = hash * 31 + (PartyId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "State: ", State, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "User: ", User, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Hidden: " + ApiClient.FormatValue(Hidden));
+            members.Add("Label: " + ApiClient.FormatValue(Label));
+            members.Add("MaxSize: " + ApiClient.FormatValue(MaxSize));
+            members.Add("Open: " + ApiClient.FormatValue(Open));
+            members.Add("PartyId: " + ApiClient.FormatValue(PartyId));
         }
     }
 
     /// <summary>
-    /// A collection of zero or more friends of the user.
+    /// A list of realtime matches.
     /// </summary>
-    public interface IApiFriendList
+    public interface IApiPartyList
     {
 
         /// <summary>
-        /// Cursor for the next page of results, if any.
+        /// A cursor to send when retrieving the next page, if any.
         /// </summary>
         string Cursor { get; }
 
         /// <summary>
-        /// The Friend objects.
+        /// A number of parties corresponding to a list operation.
         /// </summary>
-        IEnumerable<IApiFriend> Friends { get; }
+        IEnumerable<IApiParty> Parties { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiPartyList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiFriendList : IApiFriendList
+    internal class ApiPartyList : IApiPartyList, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="cursor"), Preserve]
         public string Cursor { get; set; }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiFriend> Friends => _friends ?? new List<ApiFriend>(0);
-        [DataMember(Name="friends"), Preserve]
-        public List<ApiFriend> _friends { get; set; }
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiParty> Parties => _parties ?? new List<ApiParty>(0);
+        [DataMember(Name="parties"), Preserve]
+        public List<ApiParty> _parties { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "parties":
+                    _parties = reader.ReadList(reader.ReadObject<ApiParty>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_parties != null)
+            {
+                writer.WriteName("parties");
+                writer.WriteList(_parties, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiPartyList Clone() => (ApiPartyList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiPartyList) MemberwiseClone();
+            clone._parties = _parties?.ConvertAll(item => (ApiParty) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiPartyList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_parties, other._parties);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_parties);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "Friends: [", string.Join(", ", Friends), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("Parties: " + ApiClient.FormatList(_parties));
         }
     }
 
     /// <summary>
-    /// A List of friends of friends
+    /// Storage objects to get.
     /// </summary>
-    public interface IApiFriendsOfFriendsList
+    public interface IApiReadStorageObjectId
     {
 
         /// <summary>
-        /// Cursor for the next page of results, if any.
+        /// The collection which stores the object.
         /// </summary>
-        string Cursor { get; }
+        string Collection { get; }
 
         /// <summary>
-        /// User friends of friends.
+        /// The key of the object within the collection.
         /// </summary>
-        IEnumerable<IFriendsOfFriendsListFriendOfFriend> FriendsOfFriends { get; }
+        string Key { get; }
+
+        /// <summary>
+        /// The user owner of the object.
+        /// </summary>
+        string UserId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiReadStorageObjectId Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiFriendsOfFriendsList : IApiFriendsOfFriendsList
+    internal class ApiReadStorageObjectId : IApiReadStorageObjectId, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
+        [DataMember(Name="collection"), Preserve]
+        public string Collection { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IFriendsOfFriendsListFriendOfFriend> FriendsOfFriends => _friendsOfFriends ?? new List<FriendsOfFriendsListFriendOfFriend>(0);
-        [DataMember(Name="friends_of_friends"), Preserve]
-        public List<FriendsOfFriendsListFriendOfFriend> _friendsOfFriends { get; set; }
+        [DataMember(Name="key"), Preserve]
+        public string Key { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        [DataMember(Name="user_id"), Preserve]
+        public string UserId { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "FriendsOfFriends: [", string.Join(", ", FriendsOfFriends), "], ");
-            return output;
+            switch (name)
+            {
+                case "collection":
+                    Collection = reader.ReadString();
+                    return true;
+                case "key":
+                    Key = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
         }
-    }
-
-    /// <summary>
-    /// A group in the server.
-    /// </summary>
-    public interface IApiGroup
-    {
-
-        /// <summary>
-        /// A URL for an avatar image.
-        /// </summary>
-        string AvatarUrl { get; }
 
-        /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the group was created.
-        /// </summary>
-        string CreateTime { get; }
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collection != null)
+            {
+                writer.WriteName("collection");
+                writer.WriteString(Collection);
+            }
+            if (Key != null)
+            {
+                writer.WriteName("key");
+                writer.WriteString(Key);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
 
-        /// <summary>
-        /// The id of the user who created the group.
-        /// </summary>
-        string CreatorId { get; }
+        /// <inheritdoc />
+        public IApiReadStorageObjectId Clone() => (ApiReadStorageObjectId) CloneModel();
 
-        /// <summary>
-        /// A description for the group.
-        /// </summary>
-        string Description { get; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiReadStorageObjectId) MemberwiseClone();
+            return clone;
+        }
 
-        /// <summary>
-        /// The current count of all members in the group.
-        /// </summary>
-        int EdgeCount { get; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <summary>
-        /// The id of a group.
-        /// </summary>
-        string Id { get; }
+            var other = (ApiReadStorageObjectId) obj;
+            return Collection == other.Collection &&
+                Key == other.Key &&
+                UserId == other.UserId;
+        }
 
-        /// <summary>
-        /// The language expected to be a tag which follows the BCP-47 spec.
-        /// </summary>
-        string LangTag { get; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Collection?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Key?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
-        /// <summary>
-        /// The maximum number of members allowed.
-        /// </summary>
-        int MaxCount { get; }
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-        /// <summary>
-        /// Additional information stored as a JSON object.
-        /// </summary>
-        string Metadata { get; }
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collection: " + ApiClient.FormatValue(Collection));
+            members.Add("Key: " + ApiClient.FormatValue(Key));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
+        }
+    }
 
-        /// <summary>
-        /// The unique name of the group.
-        /// </summary>
-        string Name { get; }
+    /// <summary>
+    /// Batch get storage objects.
+    /// </summary>
+    public interface IApiReadStorageObjectsRequest
+    {
 
         /// <summary>
-        /// Anyone can join open groups, otherwise only admins can accept members.
+        /// Batch of storage objects.
         /// </summary>
-        bool Open { get; }
+        IEnumerable<IApiReadStorageObjectId> ObjectIds { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the group was last updated.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string UpdateTime { get; }
+        IApiReadStorageObjectsRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiGroup : IApiGroup
+    internal class ApiReadStorageObjectsRequest : IApiReadStorageObjectsRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="avatar_url"), Preserve]
-        public string AvatarUrl { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="creator_id"), Preserve]
-        public string CreatorId { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="description"), Preserve]
-        public string Description { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="edge_count"), Preserve]
-        public int EdgeCount { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IApiReadStorageObjectId> ObjectIds => _objectIds ?? new List<ApiReadStorageObjectId>(0);
+        [DataMember(Name="object_ids"), Preserve]
+        public List<ApiReadStorageObjectId> _objectIds { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "object_ids":
+                    _objectIds = reader.ReadList(reader.ReadObject<ApiReadStorageObjectId>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="lang_tag"), Preserve]
-        public string LangTag { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_objectIds != null)
+            {
+                writer.WriteName("object_ids");
+                writer.WriteList(_objectIds, writer.WriteObject);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="max_count"), Preserve]
-        public int MaxCount { get; set; }
+        public IApiReadStorageObjectsRequest Clone() => (ApiReadStorageObjectsRequest) CloneModel();
 
-        /// <inheritdoc />
-        [DataMember(Name="metadata"), Preserve]
-        public string Metadata { get; set; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiReadStorageObjectsRequest) MemberwiseClone();
+            clone._objectIds = _objectIds?.ConvertAll(item => (ApiReadStorageObjectId) item?.CloneModel());
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="open"), Preserve]
-        public bool Open { get; set; }
+            var other = (ApiReadStorageObjectsRequest) obj;
+            return ApiClient.ListEquals(_objectIds, other._objectIds);
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_objectIds);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "CreatorId: ", CreatorId, ", ");
-            output = string.Concat(output, "Description: ", Description, ", ");
-            output = string.Concat(output, "EdgeCount: ", EdgeCount, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "MaxCount: ", MaxCount, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "Open: ", Open, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ObjectIds: " + ApiClient.FormatList(_objectIds));
         }
     }
 
     /// <summary>
-    /// One or more groups returned from a listing operation.
+    /// Execute an Lua function on the server.
     /// </summary>
-    public interface IApiGroupList
+    public interface IApiRpc
     {
 
         /// <summary>
-        /// A cursor used to get the next page.
+        /// The authentication key used when executed as a non-client HTTP request.
         /// </summary>
-        string Cursor { get; }
+        string HttpKey { get; }
 
         /// <summary>
-        /// One or more groups.
+        /// The identifier of the function.
         /// </summary>
-        IEnumerable<IApiGroup> Groups { get; }
+        string Id { get; }
+
+        /// <summary>
+        /// The payload of the function which must be a JSON object.
+        /// </summary>
+        string Payload { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiRpc Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiGroupList : IApiGroupList
+    internal class ApiRpc : IApiRpc, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
+        [DataMember(Name="http_key"), Preserve]
+        public string HttpKey { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiGroup> Groups => _groups ?? new List<ApiGroup>(0);
-        [DataMember(Name="groups"), Preserve]
-        public List<ApiGroup> _groups { get; set; }
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        [DataMember(Name="payload"), Preserve]
+        public string Payload { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "Groups: [", string.Join(", ", Groups), "], ");
-            return output;
+            switch (name)
+            {
+                case "http_key":
+                    HttpKey = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "payload":
+                    Payload = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
         }
-    }
-
-    /// <summary>
-    /// A list of users belonging to a group, along with their role.
-    /// </summary>
-    public interface IApiGroupUserList
-    {
 
-        /// <summary>
-        /// Cursor for the next page of results, if any.
-        /// </summary>
-        string Cursor { get; }
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (HttpKey != null)
+            {
+                writer.WriteName("http_key");
+                writer.WriteString(HttpKey);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (Payload != null)
+            {
+                writer.WriteName("payload");
+                writer.WriteString(Payload);
+            }
+        }
 
-        /// <summary>
-        /// User-role pairs for a group.
-        /// </summary>
-        IEnumerable<IGroupUserListGroupUser> GroupUsers { get; }
-    }
+        /// <inheritdoc />
+        public IApiRpc Clone() => (ApiRpc) CloneModel();
 
-    /// <inheritdoc />
-    internal class ApiGroupUserList : IApiGroupUserList
-    {
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiRpc) MemberwiseClone();
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IGroupUserListGroupUser> GroupUsers => _groupUsers ?? new List<GroupUserListGroupUser>(0);
-        [DataMember(Name="group_users"), Preserve]
-        public List<GroupUserListGroupUser> _groupUsers { get; set; }
+            var other = (ApiRpc) obj;
+            return HttpKey == other.HttpKey &&
+                Id == other.Id &&
+                Payload == other.Payload;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (HttpKey?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Payload?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "GroupUsers: [", string.Join(", ", GroupUsers), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("HttpKey: " + ApiClient.FormatValue(HttpKey));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Payload: " + ApiClient.FormatValue(Payload));
         }
     }
 
     /// <summary>
-    /// Represents a complete leaderboard record with all scores and associated metadata.
+    /// A user's session used to authenticate messages.
     /// </summary>
-    public interface IApiLeaderboardRecord
+    public interface IApiSession
     {
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record was created.
-        /// </summary>
-        string CreateTime { get; }
-
-        /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record expires.
-        /// </summary>
-        string ExpiryTime { get; }
-
-        /// <summary>
-        /// The ID of the leaderboard this score belongs to.
-        /// </summary>
-        string LeaderboardId { get; }
-
-        /// <summary>
-        /// The maximum number of score updates allowed by the owner.
-        /// </summary>
-        int MaxNumScore { get; }
-
-        /// <summary>
-        /// Metadata.
-        /// </summary>
-        string Metadata { get; }
-
-        /// <summary>
-        /// The number of submissions to this score record.
-        /// </summary>
-        int NumScore { get; }
-
-        /// <summary>
-        /// The ID of the score owner, usually a user or group.
-        /// </summary>
-        string OwnerId { get; }
-
-        /// <summary>
-        /// The rank of this record.
-        /// </summary>
-        string Rank { get; }
-
-        /// <summary>
-        /// The score value.
+        /// True if the corresponding account was just created, false otherwise.
         /// </summary>
-        string Score { get; }
+        bool Created { get; }
 
         /// <summary>
-        /// An optional subscore value.
+        /// Refresh token that can be used for session token renewal.
         /// </summary>
-        string Subscore { get; }
+        string RefreshToken { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record was updated.
+        /// Authentication credentials.
         /// </summary>
-        string UpdateTime { get; }
+        string Token { get; }
 
         /// <summary>
-        /// The username of the score owner, if the owner is a user.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Username { get; }
+        IApiSession Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiLeaderboardRecord : IApiLeaderboardRecord
+    internal class ApiSession : IApiSession, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="expiry_time"), Preserve]
-        public string ExpiryTime { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="leaderboard_id"), Preserve]
-        public string LeaderboardId { get; set; }
+        [DataMember(Name="created"), Preserve]
+        public bool Created { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="max_num_score"), Preserve]
-        public int MaxNumScore { get; set; }
+        [DataMember(Name="refresh_token"), Preserve]
+        public string RefreshToken { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="metadata"), Preserve]
-        public string Metadata { get; set; }
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="num_score"), Preserve]
-        public int NumScore { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "created":
+                    Created = reader.ReadBoolean();
+                    return true;
+                case "refresh_token":
+                    RefreshToken = reader.ReadString();
+                    return true;
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="owner_id"), Preserve]
-        public string OwnerId { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("created");
+            writer.WriteBoolean(Created);
+            if (RefreshToken != null)
+            {
+                writer.WriteName("refresh_token");
+                writer.WriteString(RefreshToken);
+            }
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="rank"), Preserve]
-        public string Rank { get; set; }
+        public IApiSession Clone() => (ApiSession) CloneModel();
 
-        /// <inheritdoc />
-        [DataMember(Name="score"), Preserve]
-        public string Score { get; set; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiSession) MemberwiseClone();
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="subscore"), Preserve]
-        public string Subscore { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+            var other = (ApiSession) obj;
+            return Created == other.Created &&
+                RefreshToken == other.RefreshToken &&
+                Token == other.Token;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="username"), Preserve]
-        public string Username { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Created.GetHashCode();
+                hash = hash * 31 + (RefreshToken?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "ExpiryTime: ", ExpiryTime, ", ");
-            output = string.Concat(output, "LeaderboardId: ", LeaderboardId, ", ");
-            output = string.Concat(output, "MaxNumScore: ", MaxNumScore, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "NumScore: ", NumScore, ", ");
-            output = string.Concat(output, "OwnerId: ", OwnerId, ", ");
-            output = string.Concat(output, "Rank: ", Rank, ", ");
-            output = string.Concat(output, "Score: ", Score, ", ");
-            output = string.Concat(output, "Subscore: ", Subscore, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Created: " + ApiClient.FormatValue(Created));
+            members.Add("RefreshToken: " + ApiClient.FormatValue(RefreshToken));
+            members.Add("Token: " + ApiClient.FormatValue(Token));
         }
     }
 
     /// <summary>
-    /// A set of leaderboard records, may be part of a leaderboard records page or a batch of individual records.
+    /// Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
     /// </summary>
-    public interface IApiLeaderboardRecordList
+    public interface IApiSessionLogoutRequest
     {
 
         /// <summary>
-        /// The cursor to send when retrieving the next page, if any.
-        /// </summary>
-        string NextCursor { get; }
-
-        /// <summary>
-        /// A batched set of leaderboard records belonging to specified owners.
-        /// </summary>
-        IEnumerable<IApiLeaderboardRecord> OwnerRecords { get; }
-
-        /// <summary>
-        /// The cursor to send when retrieving the previous page, if any.
+        /// Refresh token to invalidate.
         /// </summary>
-        string PrevCursor { get; }
+        string RefreshToken { get; }
 
         /// <summary>
-        /// The total number of ranks available.
+        /// Session token to log out.
         /// </summary>
-        string RankCount { get; }
+        string Token { get; }
 
         /// <summary>
-        /// A list of leaderboard records.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IEnumerable<IApiLeaderboardRecord> Records { get; }
+        IApiSessionLogoutRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiLeaderboardRecordList : IApiLeaderboardRecordList
+    internal class ApiSessionLogoutRequest : IApiSessionLogoutRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="next_cursor"), Preserve]
-        public string NextCursor { get; set; }
+        [DataMember(Name="refresh_token"), Preserve]
+        public string RefreshToken { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiLeaderboardRecord> OwnerRecords => _ownerRecords ?? new List<ApiLeaderboardRecord>(0);
-        [DataMember(Name="owner_records"), Preserve]
-        public List<ApiLeaderboardRecord> _ownerRecords { get; set; }
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="prev_cursor"), Preserve]
-        public string PrevCursor { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "refresh_token":
+                    RefreshToken = reader.ReadString();
+                    return true;
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="rank_count"), Preserve]
-        public string RankCount { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (RefreshToken != null)
+            {
+                writer.WriteName("refresh_token");
+                writer.WriteString(RefreshToken);
+            }
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+        }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiLeaderboardRecord> Records => _records ?? new List<ApiLeaderboardRecord>(0);
-        [DataMember(Name="records"), Preserve]
-        public List<ApiLeaderboardRecord> _records { get; set; }
+        public IApiSessionLogoutRequest Clone() => (ApiSessionLogoutRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiSessionLogoutRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiSessionLogoutRequest) obj;
+            return RefreshToken == other.RefreshToken &&
+                Token == other.Token;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (RefreshToken?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "OwnerRecords: [", string.Join(", ", OwnerRecords), "], ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            output = string.Concat(output, "RankCount: ", RankCount, ", ");
-            output = string.Concat(output, "Records: [", string.Join(", ", Records), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("RefreshToken: " + ApiClient.FormatValue(RefreshToken));
+            members.Add("Token: " + ApiClient.FormatValue(Token));
         }
     }
 
     /// <summary>
-    /// Link Steam to the current user's account.
+    /// Authenticate against the server with a refresh token.
     /// </summary>
-    public interface IApiLinkSteamRequest
+    public interface IApiSessionRefreshRequest
     {
 
         /// <summary>
-        /// The Facebook account details.
+        /// Refresh token.
         /// </summary>
-        IApiAccountSteam Account { get; }
+        string Token { get; }
 
         /// <summary>
-        /// Import Steam friends for the user.
+        /// Extra information that will be bundled in the session token.
+        /// </summary>
+        IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        bool Sync { get; }
+        IApiSessionRefreshRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiLinkSteamRequest : IApiLinkSteamRequest
+    internal class ApiSessionRefreshRequest : IApiSessionRefreshRequest, IApiJsonObject
     {
 
+        /// <inheritdoc />
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
+
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IApiAccountSteam Account => _account;
-        [DataMember(Name="account"), Preserve]
-        public ApiAccountSteam _account { get; set; }
+        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
+        [DataMember(Name="vars"), Preserve]
+        public Dictionary<string, string> _vars { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiSessionRefreshRequest Clone() => (ApiSessionRefreshRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiSessionRefreshRequest) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="sync"), Preserve]
-        public bool Sync { get; set; }
+            var other = (ApiSessionRefreshRequest) obj;
+            return Token == other.Token &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Account: ", Account, ", ");
-            output = string.Concat(output, "Sync: ", Sync, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Token: " + ApiClient.FormatValue(Token));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
         }
     }
 
     /// <summary>
-    /// List user subscriptions.
+    /// An object within the storage engine.
     /// </summary>
-    public interface IApiListSubscriptionsRequest
+    public interface IApiStorageObject
     {
 
         /// <summary>
-        /// Cursor to retrieve a page of records from
+        /// The collection which stores the object.
         /// </summary>
-        string Cursor { get; }
+        string Collection { get; }
 
         /// <summary>
-        /// Max number of results per page
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was created.
         /// </summary>
-        int Limit { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiListSubscriptionsRequest : IApiListSubscriptionsRequest
-    {
-
-        /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="limit"), Preserve]
-        public int Limit { get; set; }
+        DateTime CreateTime { get; }
 
-        public override string ToString()
-        {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "Limit: ", Limit, ", ");
-            return output;
-        }
-    }
+        /// <summary>
+        /// The key of the object within the collection.
+        /// </summary>
+        string Key { get; }
 
-    /// <summary>
-    /// Represents a realtime match.
-    /// </summary>
-    public interface IApiMatch
-    {
+        /// <summary>
+        /// The read access permissions for the object.
+        /// </summary>
+        int PermissionRead { get; }
 
         /// <summary>
-        /// True if it's an server-managed authoritative match, false otherwise.
+        /// The write access permissions for the object.
         /// </summary>
-        bool Authoritative { get; }
+        int PermissionWrite { get; }
 
         /// <summary>
-        /// Handler name
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was last updated.
         /// </summary>
-        string HandlerName { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
-        /// Match label, if any.
+        /// The user owner of the object.
         /// </summary>
-        string Label { get; }
+        string UserId { get; }
 
         /// <summary>
-        /// The ID of the match, can be used to join.
+        /// The value of the object.
         /// </summary>
-        string MatchId { get; }
+        string Value { get; }
 
         /// <summary>
-        /// Current number of users in the match.
+        /// The version hash of the object.
         /// </summary>
-        int Size { get; }
+        string Version { get; }
 
         /// <summary>
-        /// Tick Rate
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        int TickRate { get; }
+        IApiStorageObject Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiMatch : IApiMatch
+    internal class ApiStorageObject : IApiStorageObject, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="authoritative"), Preserve]
-        public bool Authoritative { get; set; }
+        [DataMember(Name="collection"), Preserve]
+        public string Collection { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="handler_name"), Preserve]
-        public string HandlerName { get; set; }
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="label"), Preserve]
-        public string Label { get; set; }
+        [DataMember(Name="key"), Preserve]
+        public string Key { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="match_id"), Preserve]
-        public string MatchId { get; set; }
+        [DataMember(Name="permission_read"), Preserve]
+        public int PermissionRead { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="size"), Preserve]
-        public int Size { get; set; }
+        [DataMember(Name="permission_write"), Preserve]
+        public int PermissionWrite { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="tick_rate"), Preserve]
-        public int TickRate { get; set; }
-
-        public override string ToString()
+        [IgnoreDataMember]
+        public DateTime UpdateTime
         {
-            var output = "";
-            output = string.Concat(output, "Authoritative: ", Authoritative, ", ");
-            output = string.Concat(output, "HandlerName: ", HandlerName, ", ");
-            output = string.Concat(output, "Label: ", Label, ", ");
-            output = string.Concat(output, "MatchId: ", MatchId, ", ");
-            output = string.Concat(output, "Size: ", Size, ", ");
-            output = string.Concat(output, "TickRate: ", TickRate, ", ");
-            return output;
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
         }
-    }
-
-    /// <summary>
-    /// A list of realtime matches.
-    /// </summary>
-    public interface IApiMatchList
-    {
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
 
-        /// <summary>
-        /// A number of matches corresponding to a list operation.
-        /// </summary>
-        IEnumerable<IApiMatch> Matches { get; }
-    }
+        /// <inheritdoc />
+        [DataMember(Name="user_id"), Preserve]
+        public string UserId { get; set; }
 
-    /// <inheritdoc />
-    internal class ApiMatchList : IApiMatchList
-    {
+        /// <inheritdoc />
+        [DataMember(Name="value"), Preserve]
+        public string Value { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiMatch> Matches => _matches ?? new List<ApiMatch>(0);
-        [DataMember(Name="matches"), Preserve]
-        public List<ApiMatch> _matches { get; set; }
+        [DataMember(Name="version"), Preserve]
+        public string Version { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Matches: [", string.Join(", ", Matches), "], ");
-            return output;
+            switch (name)
+            {
+                case "collection":
+                    Collection = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "key":
+                    Key = reader.ReadString();
+                    return true;
+                case "permission_read":
+                    PermissionRead = reader.ReadInt32();
+                    return true;
+                case "permission_write":
+                    PermissionWrite = reader.ReadInt32();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                case "version":
+                    Version = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
         }
-    }
-
-    /// <summary>
-    /// Matchmaker ticket completion stats
-    /// </summary>
-    public interface IApiMatchmakerCompletionStats
-    {
-
-        /// <summary>
-        /// 
-        /// </summary>
-        string CompleteTime { get; }
-
-        /// <summary>
-        /// 
-        /// </summary>
-        string CreateTime { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiMatchmakerCompletionStats : IApiMatchmakerCompletionStats
-    {
 
         /// <inheritdoc />
-        [DataMember(Name="complete_time"), Preserve]
-        public string CompleteTime { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collection != null)
+            {
+                writer.WriteName("collection");
+                writer.WriteString(Collection);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Key != null)
+            {
+                writer.WriteName("key");
+                writer.WriteString(Key);
+            }
+            writer.WriteName("permission_read");
+            writer.WriteInt32(PermissionRead);
+            writer.WriteName("permission_write");
+            writer.WriteInt32(PermissionWrite);
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+            if (Version != null)
+            {
+                writer.WriteName("version");
+                writer.WriteString(Version);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public IApiStorageObject Clone() => (ApiStorageObject) CloneModel();
 
-        public override string ToString()
+        internal virtual object CloneModel()
         {
-            var output = "";
-            output = string.Concat(output, "CompleteTime: ", CompleteTime, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            return output;
+            var clone = (ApiStorageObject) MemberwiseClone();
+            return clone;
         }
-    }
-
-    /// <summary>
-    /// Matchmaker stats
-    /// </summary>
-    public interface IApiMatchmakerStats
-    {
-
-        /// <summary>
-        /// 
-        /// </summary>
-        IEnumerable<IApiMatchmakerCompletionStats> Completions { get; }
-
-        /// <summary>
-        /// 
-        /// </summary>
-        string OldestTicketCreateTime { get; }
-
-        /// <summary>
-        /// 
-        /// </summary>
-        int TicketCount { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiMatchmakerStats : IApiMatchmakerStats
-    {
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiMatchmakerCompletionStats> Completions => _completions ?? new List<ApiMatchmakerCompletionStats>(0);
-        [DataMember(Name="completions"), Preserve]
-        public List<ApiMatchmakerCompletionStats> _completions { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="oldest_ticket_create_time"), Preserve]
-        public string OldestTicketCreateTime { get; set; }
+            var other = (ApiStorageObject) obj;
+            return Collection == other.Collection &&
+                _createTime == other._createTime &&
+                Key == other.Key &&
+                PermissionRead == other.PermissionRead &&
+                PermissionWrite == other.PermissionWrite &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId &&
+                Value == other.Value &&
+                Version == other.Version;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="ticket_count"), Preserve]
-        public int TicketCount { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Collection?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Key?.GetHashCode() ?? 0);
+                hash = hash * 31 + PermissionRead.GetHashCode();
+                hash = hash * 31 + PermissionWrite.GetHashCode();
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Version?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Completions: [", string.Join(", ", Completions), "], ");
-            output = string.Concat(output, "OldestTicketCreateTime: ", OldestTicketCreateTime, ", ");
-            output = string.Concat(output, "TicketCount: ", TicketCount, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collection: " + ApiClient.FormatValue(Collection));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Key: " + ApiClient.FormatValue(Key));
+            members.Add("PermissionRead: " + ApiClient.FormatValue(PermissionRead));
+            members.Add("PermissionWrite: " + ApiClient.FormatValue(PermissionWrite));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
+            members.Add("Version: " + ApiClient.FormatValue(Version));
         }
     }
 
     /// <summary>
-    /// A notification in the server.
+    /// A storage acknowledgement.
     /// </summary>
-    public interface IApiNotification
+    public interface IApiStorageObjectAck
     {
 
         /// <summary>
-        /// Category code for this notification.
+        /// The collection which stores the object.
         /// </summary>
-        int Code { get; }
+        string Collection { get; }
 
         /// <summary>
-        /// Content of the notification in JSON.
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was created.
         /// </summary>
-        string Content { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the notification was created.
+        /// The key of the object within the collection.
         /// </summary>
-        string CreateTime { get; }
+        string Key { get; }
 
         /// <summary>
-        /// ID of the Notification.
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was last updated.
         /// </summary>
-        string Id { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
-        /// True if this notification was persisted to the database.
+        /// The owner of the object.
         /// </summary>
-        bool Persistent { get; }
+        string UserId { get; }
 
         /// <summary>
-        /// ID of the sender, if a user. Otherwise 'null'.
+        /// The version hash of the object.
         /// </summary>
-        string SenderId { get; }
+        string Version { get; }
 
         /// <summary>
-        /// Subject of the notification.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Subject { get; }
+        IApiStorageObjectAck Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiNotification : IApiNotification
+    internal class ApiStorageObjectAck : IApiStorageObjectAck, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="code"), Preserve]
-        public int Code { get; set; }
+        [DataMember(Name="collection"), Preserve]
+        public string Collection { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="key"), Preserve]
+        public string Key { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="content"), Preserve]
-        public string Content { get; set; }
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        [DataMember(Name="user_id"), Preserve]
+        public string UserId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
+        [DataMember(Name="version"), Preserve]
+        public string Version { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="persistent"), Preserve]
-        public bool Persistent { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "collection":
+                    Collection = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "key":
+                    Key = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                case "version":
+                    Version = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="sender_id"), Preserve]
-        public string SenderId { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collection != null)
+            {
+                writer.WriteName("collection");
+                writer.WriteString(Collection);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Key != null)
+            {
+                writer.WriteName("key");
+                writer.WriteString(Key);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+            if (Version != null)
+            {
+                writer.WriteName("version");
+                writer.WriteString(Version);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="subject"), Preserve]
-        public string Subject { get; set; }
+        public IApiStorageObjectAck Clone() => (ApiStorageObjectAck) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiStorageObjectAck) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiStorageObjectAck) obj;
+            return Collection == other.Collection &&
+                _createTime == other._createTime &&
+                Key == other.Key &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId &&
+                Version == other.Version;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Collection?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Key?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Version?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Content: ", Content, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "Persistent: ", Persistent, ", ");
-            output = string.Concat(output, "SenderId: ", SenderId, ", ");
-            output = string.Concat(output, "Subject: ", Subject, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collection: " + ApiClient.FormatValue(Collection));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Key: " + ApiClient.FormatValue(Key));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
+            members.Add("Version: " + ApiClient.FormatValue(Version));
         }
     }
 
     /// <summary>
-    /// A collection of zero or more notifications.
+    /// Batch of acknowledgements for the storage object write.
     /// </summary>
-    public interface IApiNotificationList
+    public interface IApiStorageObjectAcks
     {
 
         /// <summary>
-        /// Use this cursor to paginate notifications. Cache this to catch up to new notifications.
+        /// Batch of storage write acknowledgements.
         /// </summary>
-        string CacheableCursor { get; }
+        IEnumerable<IApiStorageObjectAck> Acks { get; }
 
         /// <summary>
-        /// Collection of notifications.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IEnumerable<IApiNotification> Notifications { get; }
+        IApiStorageObjectAcks Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiNotificationList : IApiNotificationList
+    internal class ApiStorageObjectAcks : IApiStorageObjectAcks, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="cacheable_cursor"), Preserve]
-        public string CacheableCursor { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IApiStorageObjectAck> Acks => _acks ?? new List<ApiStorageObjectAck>(0);
+        [DataMember(Name="acks"), Preserve]
+        public List<ApiStorageObjectAck> _acks { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiNotification> Notifications => _notifications ?? new List<ApiNotification>(0);
-        [DataMember(Name="notifications"), Preserve]
-        public List<ApiNotification> _notifications { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "acks":
+                    _acks = reader.ReadList(reader.ReadObject<ApiStorageObjectAck>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_acks != null)
+            {
+                writer.WriteName("acks");
+                writer.WriteList(_acks, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiStorageObjectAcks Clone() => (ApiStorageObjectAcks) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiStorageObjectAcks) MemberwiseClone();
+            clone._acks = _acks?.ConvertAll(item => (ApiStorageObjectAck) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiStorageObjectAcks) obj;
+            return ApiClient.ListEquals(_acks, other._acks);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_acks);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CacheableCursor: ", CacheableCursor, ", ");
-            output = string.Concat(output, "Notifications: [", string.Join(", ", Notifications), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
         }
-    }
 
-    /// <summary>
-    /// 
-    /// </summary>
-    public enum ApiOperator
-    {
-        /// <summary>
-        /// Operator that can be used to override the one set in the leaderboard.
-        /// </summary>
-        NO_OVERRIDE = 0,
-        /// <summary>
-        /// 
-        /// </summary>
-        BEST = 1,
-        /// <summary>
-        ///  - NO_OVERRIDE: Do not override the leaderboard operator.
-        /// </summary>
-        SET = 2,
-        /// <summary>
-        ///  - BEST: Override the leaderboard operator with BEST.
-        /// </summary>
-        INCREMENT = 3,
-        /// <summary>
-        ///  - SET: Override the leaderboard operator with SET.
-        /// </summary>
-        DECREMENT = 4,
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Acks: " + ApiClient.FormatList(_acks));
+        }
     }
 
     /// <summary>
-    /// Incoming information about a party.
+    /// List of storage objects.
     /// </summary>
-    public interface IApiParty
+    public interface IApiStorageObjectList
     {
 
         /// <summary>
-        /// Hidden flag.
-        /// </summary>
-        bool Hidden { get; }
-
-        /// <summary>
-        /// The party label, if any.
-        /// </summary>
-        string Label { get; }
-
-        /// <summary>
-        /// Maximum number of party members.
+        /// The cursor for the next page of results, if any.
         /// </summary>
-        int MaxSize { get; }
+        string Cursor { get; }
 
         /// <summary>
-        /// Open flag.
+        /// The list of storage objects.
         /// </summary>
-        bool Open { get; }
+        IEnumerable<IApiStorageObject> Objects { get; }
 
         /// <summary>
-        /// Unique party identifier.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string PartyId { get; }
+        IApiStorageObjectList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiParty : IApiParty
+    internal class ApiStorageObjectList : IApiStorageObjectList, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="hidden"), Preserve]
-        public bool Hidden { get; set; }
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="label"), Preserve]
-        public string Label { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IApiStorageObject> Objects => _objects ?? new List<ApiStorageObject>(0);
+        [DataMember(Name="objects"), Preserve]
+        public List<ApiStorageObject> _objects { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="max_size"), Preserve]
-        public int MaxSize { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "objects":
+                    _objects = reader.ReadList(reader.ReadObject<ApiStorageObject>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="open"), Preserve]
-        public bool Open { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_objects != null)
+            {
+                writer.WriteName("objects");
+                writer.WriteList(_objects, writer.WriteObject);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="party_id"), Preserve]
-        public string PartyId { get; set; }
+        public IApiStorageObjectList Clone() => (ApiStorageObjectList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiStorageObjectList) MemberwiseClone();
+            clone._objects = _objects?.ConvertAll(item => (ApiStorageObject) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiStorageObjectList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_objects, other._objects);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_objects);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Hidden: ", Hidden, ", ");
-            output = string.Concat(output, "Label: ", Label, ", ");
-            output = string.Concat(output, "MaxSize: ", MaxSize, ", ");
-            output = string.Concat(output, "Open: ", Open, ", ");
-            output = string.Concat(output, "PartyId: ", PartyId, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("Objects: " + ApiClient.FormatList(_objects));
         }
     }
 
     /// <summary>
-    /// A list of realtime matches.
+    /// Batch of storage objects.
     /// </summary>
-    public interface IApiPartyList
+    public interface IApiStorageObjects
     {
 
         /// <summary>
-        /// A cursor to send when retrieving the next page, if any.
+        /// The batch of storage objects.
         /// </summary>
-        string Cursor { get; }
+        IEnumerable<IApiStorageObject> Objects { get; }
 
         /// <summary>
-        /// A number of parties corresponding to a list operation.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IEnumerable<IApiParty> Parties { get; }
+        IApiStorageObjects Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiPartyList : IApiPartyList
+    internal class ApiStorageObjects : IApiStorageObjects, IApiJsonObject
     {
 
-        /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
-
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IEnumerable<IApiParty> Parties => _parties ?? new List<ApiParty>(0);
-        [DataMember(Name="parties"), Preserve]
-        public List<ApiParty> _parties { get; set; }
+        public IEnumerable<IApiStorageObject> Objects => _objects ?? new List<ApiStorageObject>(0);
+        [DataMember(Name="objects"), Preserve]
+        public List<ApiStorageObject> _objects { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "Parties: [", string.Join(", ", Parties), "], ");
-            return output;
+            switch (name)
+            {
+                case "objects":
+                    _objects = reader.ReadList(reader.ReadObject<ApiStorageObject>);
+                    return true;
+                default:
+                    return false;
+            }
         }
-    }
-
-    /// <summary>
-    /// Storage objects to get.
-    /// </summary>
-    public interface IApiReadStorageObjectId
-    {
-
-        /// <summary>
-        /// The collection which stores the object.
-        /// </summary>
-        string Collection { get; }
 
-        /// <summary>
-        /// The key of the object within the collection.
-        /// </summary>
-        string Key { get; }
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_objects != null)
+            {
+                writer.WriteName("objects");
+                writer.WriteList(_objects, writer.WriteObject);
+            }
+        }
 
-        /// <summary>
-        /// The user owner of the object.
-        /// </summary>
-        string UserId { get; }
-    }
+        /// <inheritdoc />
+        public IApiStorageObjects Clone() => (ApiStorageObjects) CloneModel();
 
-    /// <inheritdoc />
-    internal class ApiReadStorageObjectId : IApiReadStorageObjectId
-    {
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiStorageObjects) MemberwiseClone();
+            clone._objects = _objects?.ConvertAll(item => (ApiStorageObject) item?.CloneModel());
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="collection"), Preserve]
-        public string Collection { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="key"), Preserve]
-        public string Key { get; set; }
+            var other = (ApiStorageObjects) obj;
+            return ApiClient.ListEquals(_objects, other._objects);
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="user_id"), Preserve]
-        public string UserId { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_objects);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Collection: ", Collection, ", ");
-            output = string.Concat(output, "Key: ", Key, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Objects: " + ApiClient.FormatList(_objects));
         }
     }
 
     /// <summary>
-    /// Batch get storage objects.
+    /// Environment where a purchase/subscription took place,
     /// </summary>
-    public interface IApiReadStorageObjectsRequest
+    public enum ApiStoreEnvironment
     {
-
         /// <summary>
-        /// Batch of storage objects.
+        /// Unknown environment.
         /// </summary>
-        IEnumerable<IApiReadStorageObjectId> ObjectIds { get; }
+        UNKNOWN = 0,
+        /// <summary>
+        /// Sandbox/test environment.
+        /// </summary>
+        SANDBOX = 1,
+        /// <summary>
+        /// Production environment.
+        /// </summary>
+        PRODUCTION = 2,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
+        /// </summary>
+        Unrecognized = -1,
     }
 
-    /// <inheritdoc />
-    internal class ApiReadStorageObjectsRequest : IApiReadStorageObjectsRequest
+    /// <summary>
+    /// Converts <see cref="ApiStoreEnvironment"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ApiStoreEnvironmentConverter
     {
+        public static ApiStoreEnvironment Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ApiStoreEnvironment);
+                case "UNKNOWN":
+                case "0":
+                    return ApiStoreEnvironment.UNKNOWN;
+                case "SANDBOX":
+                case "1":
+                    return ApiStoreEnvironment.SANDBOX;
+                case "PRODUCTION":
+                case "2":
+                    return ApiStoreEnvironment.PRODUCTION;
+                default:
+                    return ApiStoreEnvironment.Unrecognized;
+            }
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiReadStorageObjectId> ObjectIds => _objectIds ?? new List<ApiReadStorageObjectId>(0);
-        [DataMember(Name="object_ids"), Preserve]
-        public List<ApiReadStorageObjectId> _objectIds { get; set; }
-
-        public override string ToString()
+        public static string Format(ApiStoreEnvironment value)
         {
-            var output = "";
-            output = string.Concat(output, "ObjectIds: [", string.Join(", ", ObjectIds), "], ");
-            return output;
+            switch (value)
+            {
+                case ApiStoreEnvironment.UNKNOWN:
+                    return "UNKNOWN";
+                case ApiStoreEnvironment.SANDBOX:
+                    return "SANDBOX";
+                case ApiStoreEnvironment.PRODUCTION:
+                    return "PRODUCTION";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
         }
     }
 
     /// <summary>
-    /// Execute an Lua function on the server.
+    /// Validation Provider,
     /// </summary>
-    public interface IApiRpc
+    public enum ApiStoreProvider
     {
-
         /// <summary>
-        /// The authentication key used when executed as a non-client HTTP request.
+        /// Apple App Store
         /// </summary>
-        string HttpKey { get; }
-
+        APPLE_APP_STORE = 0,
         /// <summary>
-        /// The identifier of the function.
+        /// Google Play Store
         /// </summary>
-        string Id { get; }
-
+        GOOGLE_PLAY_STORE = 1,
         /// <summary>
-        /// The payload of the function which must be a JSON object.
+        /// Huawei App Gallery
         /// </summary>
-        string Payload { get; }
+        HUAWEI_APP_GALLERY = 2,
+        /// <summary>
+        /// Facebook Instant Store
+        /// </summary>
+        FACEBOOK_INSTANT_STORE = 3,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
+        /// </summary>
+        Unknown = -1,
     }
 
-    /// <inheritdoc />
-    internal class ApiRpc : IApiRpc
+    /// <summary>
+    /// Converts <see cref="ApiStoreProvider"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ApiStoreProviderConverter
     {
+        public static ApiStoreProvider Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ApiStoreProvider);
+                case "APPLE_APP_STORE":
+                case "0":
+                    return ApiStoreProvider.APPLE_APP_STORE;
+                case "GOOGLE_PLAY_STORE":
+                case "1":
+                    return ApiStoreProvider.GOOGLE_PLAY_STORE;
+                case "HUAWEI_APP_GALLERY":
+                case "2":
+                    return ApiStoreProvider.HUAWEI_APP_GALLERY;
+                case "FACEBOOK_INSTANT_STORE":
+                case "3":
+                    return ApiStoreProvider.FACEBOOK_INSTANT_STORE;
+                default:
+                    return ApiStoreProvider.Unknown;
+            }
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="http_key"), Preserve]
-        public string HttpKey { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="payload"), Preserve]
-        public string Payload { get; set; }
-
-        public override string ToString()
+        public static string Format(ApiStoreProvider value)
         {
-            var output = "";
-            output = string.Concat(output, "HttpKey: ", HttpKey, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "Payload: ", Payload, ", ");
-            return output;
+            switch (value)
+            {
+                case ApiStoreProvider.APPLE_APP_STORE:
+                    return "APPLE_APP_STORE";
+                case ApiStoreProvider.GOOGLE_PLAY_STORE:
+                    return "GOOGLE_PLAY_STORE";
+                case ApiStoreProvider.HUAWEI_APP_GALLERY:
+                    return "HUAWEI_APP_GALLERY";
+                case ApiStoreProvider.FACEBOOK_INSTANT_STORE:
+                    return "FACEBOOK_INSTANT_STORE";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
         }
     }
 
     /// <summary>
-    /// A user's session used to authenticate messages.
+    /// A list of validated subscriptions stored by Nakama.
     /// </summary>
-    public interface IApiSession
+    public interface IApiSubscriptionList
     {
 
         /// <summary>
-        /// True if the corresponding account was just created, false otherwise.
+        /// The cursor to send when retrieving the next page, if any.
         /// </summary>
-        bool Created { get; }
+        string Cursor { get; }
 
         /// <summary>
-        /// Refresh token that can be used for session token renewal.
+        /// The cursor to send when retrieving the previous page, if any.
         /// </summary>
-        string RefreshToken { get; }
+        string PrevCursor { get; }
 
         /// <summary>
-        /// Authentication credentials.
+        /// Stored validated subscriptions.
         /// </summary>
-        string Token { get; }
+        IEnumerable<IApiValidatedSubscription> ValidatedSubscriptions { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiSubscriptionList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiSession : IApiSession
+    internal class ApiSubscriptionList : IApiSubscriptionList, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="created"), Preserve]
-        public bool Created { get; set; }
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="refresh_token"), Preserve]
-        public string RefreshToken { get; set; }
+        [DataMember(Name="prev_cursor"), Preserve]
+        public string PrevCursor { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IApiValidatedSubscription> ValidatedSubscriptions => _validatedSubscriptions ?? new List<ApiValidatedSubscription>(0);
+        [DataMember(Name="validated_subscriptions"), Preserve]
+        public List<ApiValidatedSubscription> _validatedSubscriptions { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Created: ", Created, ", ");
-            output = string.Concat(output, "RefreshToken: ", RefreshToken, ", ");
-            output = string.Concat(output, "Token: ", Token, ", ");
-            return output;
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                case "validated_subscriptions":
+                    _validatedSubscriptions = reader.ReadList(reader.ReadObject<ApiValidatedSubscription>);
+                    return true;
+                default:
+                    return false;
+            }
         }
-    }
 
-    /// <summary>
-    /// Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
-    /// </summary>
-    public interface IApiSessionLogoutRequest
-    {
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+            if (_validatedSubscriptions != null)
+            {
+                writer.WriteName("validated_subscriptions");
+                writer.WriteList(_validatedSubscriptions, writer.WriteObject);
+            }
+        }
 
-        /// <summary>
-        /// Refresh token to invalidate.
-        /// </summary>
-        string RefreshToken { get; }
+        /// <inheritdoc />
+        public IApiSubscriptionList Clone() => (ApiSubscriptionList) CloneModel();
 
-        /// <summary>
-        /// Session token to log out.
-        /// </summary>
-        string Token { get; }
-    }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiSubscriptionList) MemberwiseClone();
+            clone._validatedSubscriptions = _validatedSubscriptions?.ConvertAll(item => (ApiValidatedSubscription) item?.CloneModel());
+            return clone;
+        }
 
-    /// <inheritdoc />
-    internal class ApiSessionLogoutRequest : IApiSessionLogoutRequest
-    {
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="refresh_token"), Preserve]
-        public string RefreshToken { get; set; }
+            var other = (ApiSubscriptionList) obj;
+            return Cursor == other.Cursor &&
+                PrevCursor == other.PrevCursor &&
+                ApiClient.ListEquals(_validatedSubscriptions, other._validatedSubscriptions);
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_validatedSubscriptions);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "RefreshToken: ", RefreshToken, ", ");
-            output = string.Concat(output, "Token: ", Token, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
+            members.Add("ValidatedSubscriptions: " + ApiClient.FormatList(_validatedSubscriptions));
         }
     }
 
     /// <summary>
-    /// Authenticate against the server with a refresh token.
+    /// A tournament on the server.
     /// </summary>
-    public interface IApiSessionRefreshRequest
+    public interface IApiTournament
     {
 
         /// <summary>
-        /// Refresh token.
+        /// Whether the leaderboard was created authoritatively or not.
         /// </summary>
-        string Token { get; }
+        bool Authoritative { get; }
 
         /// <summary>
-        /// Extra information that will be bundled in the session token.
+        /// True if the tournament is active and can enter. A computed value.
         /// </summary>
-        IDictionary<string, string> Vars { get; }
-    }
+        bool CanEnter { get; }
 
-    /// <inheritdoc />
-    internal class ApiSessionRefreshRequest : IApiSessionRefreshRequest
-    {
+        /// <summary>
+        /// The category of the tournament. e.g. "vip" could be category 1.
+        /// </summary>
+        long Category { get; }
 
-        /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the tournament was created.
+        /// </summary>
+        DateTime CreateTime { get; }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Vars => _vars ?? new Dictionary<string, string>();
-        [DataMember(Name="vars"), Preserve]
-        public Dictionary<string, string> _vars { get; set; }
+        /// <summary>
+        /// The description of the tournament. May be blank.
+        /// </summary>
+        string Description { get; }
 
-        public override string ToString()
-        {
-            var output = "";
-            output = string.Concat(output, "Token: ", Token, ", ");
+        /// <summary>
+        /// Duration of the tournament in seconds.
+        /// </summary>
+        long Duration { get; }
 
-            var varsString = "";
-            foreach (var kvp in Vars)
-            {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
-            }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
-        }
-    }
+        /// <summary>
+        /// The UNIX time when the tournament stops being active until next reset. A computed value.
+        /// </summary>
+        long EndActive { get; }
 
-    /// <summary>
-    /// An object within the storage engine.
-    /// </summary>
-    public interface IApiStorageObject
-    {
+        /// <summary>
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the tournament will be stopped.
+        /// </summary>
+        DateTime EndTime { get; }
 
         /// <summary>
-        /// The collection which stores the object.
+        /// The ID of the tournament.
+        /// </summary>
+        string Id { get; }
+
+        /// <summary>
+        /// Whether the user must join the tournament before being able to submit scores.
+        /// </summary>
+        bool JoinRequired { get; }
+
+        /// <summary>
+        /// The maximum score updates allowed per player for the current tournament.
+        /// </summary>
+        long MaxNumScore { get; }
+
+        /// <summary>
+        /// The maximum number of players for the tournament.
+        /// </summary>
+        long MaxSize { get; }
+
+        /// <summary>
+        /// Additional information stored as a JSON object.
+        /// </summary>
+        string Metadata { get; }
+
+        /// <summary>
+        /// The UNIX time when the tournament is next playable. A computed value.
         /// </summary>
-        string Collection { get; }
+        long NextReset { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was created.
+        /// Operator.
         /// </summary>
-        string CreateTime { get; }
+        ApiOperator Operator { get; }
 
         /// <summary>
-        /// The key of the object within the collection.
+        /// The UNIX time when the tournament was last reset. A computed value.
         /// </summary>
-        string Key { get; }
+        long PrevReset { get; }
 
         /// <summary>
-        /// The read access permissions for the object.
+        /// The current number of players in the tournament.
         /// </summary>
-        int PermissionRead { get; }
+        long Size { get; }
 
         /// <summary>
-        /// The write access permissions for the object.
+        /// ASC (0) or DESC (1) sort mode of scores in the tournament.
         /// </summary>
-        int PermissionWrite { get; }
+        long SortOrder { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was last updated.
+        /// The UNIX time when the tournament start being active. A computed value.
         /// </summary>
-        string UpdateTime { get; }
+        long StartActive { get; }
 
         /// <summary>
-        /// The user owner of the object.
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the tournament will start.
         /// </summary>
-        string UserId { get; }
+        DateTime StartTime { get; }
 
         /// <summary>
-        /// The value of the object.
+        /// The title for the tournament.
         /// </summary>
-        string Value { get; }
+        string Title { get; }
 
         /// <summary>
-        /// The version hash of the object.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Version { get; }
+        IApiTournament Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiStorageObject : IApiStorageObject
+    internal class ApiTournament : IApiTournament, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="collection"), Preserve]
-        public string Collection { get; set; }
+        [DataMember(Name="authoritative"), Preserve]
+        public bool Authoritative { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        [DataMember(Name="can_enter"), Preserve]
+        public bool CanEnter { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="key"), Preserve]
-        public string Key { get; set; }
+        [DataMember(Name="category"), Preserve]
+        public long Category { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="permission_read"), Preserve]
-        public int PermissionRead { get; set; }
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="permission_write"), Preserve]
-        public int PermissionWrite { get; set; }
+        [DataMember(Name="description"), Preserve]
+        public string Description { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        [DataMember(Name="duration"), Preserve]
+        public long Duration { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="user_id"), Preserve]
-        public string UserId { get; set; }
+        [DataMember(Name="end_active"), Preserve]
+        public long EndActive { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="value"), Preserve]
-        public string Value { get; set; }
+        [IgnoreDataMember]
+        public DateTime EndTime
+        {
+            get => ApiClient.ParseDateTime(_endTime);
+            set => _endTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="end_time"), Preserve]
+        public string _endTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="version"), Preserve]
-        public string Version { get; set; }
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
 
-        public override string ToString()
-        {
-            var output = "";
-            output = string.Concat(output, "Collection: ", Collection, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Key: ", Key, ", ");
-            output = string.Concat(output, "PermissionRead: ", PermissionRead, ", ");
-            output = string.Concat(output, "PermissionWrite: ", PermissionWrite, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            output = string.Concat(output, "Version: ", Version, ", ");
-            return output;
-        }
-    }
+        /// <inheritdoc />
+        [DataMember(Name="join_required"), Preserve]
+        public bool JoinRequired { get; set; }
 
-    /// <summary>
-    /// A storage acknowledgement.
-    /// </summary>
-    public interface IApiStorageObjectAck
-    {
+        /// <inheritdoc />
+        [DataMember(Name="max_num_score"), Preserve]
+        public long MaxNumScore { get; set; }
 
-        /// <summary>
-        /// The collection which stores the object.
-        /// </summary>
-        string Collection { get; }
+        /// <inheritdoc />
+        [DataMember(Name="max_size"), Preserve]
+        public long MaxSize { get; set; }
 
-        /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was created.
-        /// </summary>
-        string CreateTime { get; }
+        /// <inheritdoc />
+        [DataMember(Name="metadata"), Preserve]
+        public string Metadata { get; set; }
 
-        /// <summary>
-        /// The key of the object within the collection.
-        /// </summary>
-        string Key { get; }
+        /// <inheritdoc />
+        [DataMember(Name="next_reset"), Preserve]
+        public long NextReset { get; set; }
 
-        /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was last updated.
-        /// </summary>
-        string UpdateTime { get; }
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public ApiOperator Operator
+        {
+            get => ApiOperatorConverter.Parse(_operator);
+            set => _operator = ApiOperatorConverter.Format(value);
+        }
+        [DataMember(Name="operator"), Preserve]
+        public string _operator { get; set; }
 
-        /// <summary>
-        /// The owner of the object.
-        /// </summary>
-        string UserId { get; }
+        /// <inheritdoc />
+        [DataMember(Name="prev_reset"), Preserve]
+        public long PrevReset { get; set; }
 
-        /// <summary>
-        /// The version hash of the object.
-        /// </summary>
-        string Version { get; }
-    }
+        /// <inheritdoc />
+        [DataMember(Name="size"), Preserve]
+        public long Size { get; set; }
 
-    /// <inheritdoc />
-    internal class ApiStorageObjectAck : IApiStorageObjectAck
-    {
+        /// <inheritdoc />
+        [DataMember(Name="sort_order"), Preserve]
+        public long SortOrder { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="collection"), Preserve]
-        public string Collection { get; set; }
+        [DataMember(Name="start_active"), Preserve]
+        public long StartActive { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        [IgnoreDataMember]
+        public DateTime StartTime
+        {
+            get => ApiClient.ParseDateTime(_startTime);
+            set => _startTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="start_time"), Preserve]
+        public string _startTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="key"), Preserve]
-        public string Key { get; set; }
+        [DataMember(Name="title"), Preserve]
+        public string Title { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "authoritative":
+                    Authoritative = reader.ReadBoolean();
+                    return true;
+                case "can_enter":
+                    CanEnter = reader.ReadBoolean();
+                    return true;
+                case "category":
+                    Category = reader.ReadInt64();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "description":
+                    Description = reader.ReadString();
+                    return true;
+                case "duration":
+                    Duration = reader.ReadInt64();
+                    return true;
+                case "end_active":
+                    EndActive = reader.ReadInt64();
+                    return true;
+                case "end_time":
+                    _endTime = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "join_required":
+                    JoinRequired = reader.ReadBoolean();
+                    return true;
+                case "max_num_score":
+                    MaxNumScore = reader.ReadInt64();
+                    return true;
+                case "max_size":
+                    MaxSize = reader.ReadInt64();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "next_reset":
+                    NextReset = reader.ReadInt64();
+                    return true;
+                case "operator":
+                    _operator = reader.ReadString();
+                    return true;
+                case "prev_reset":
+                    PrevReset = reader.ReadInt64();
+                    return true;
+                case "size":
+                    Size = reader.ReadInt64();
+                    return true;
+                case "sort_order":
+                    SortOrder = reader.ReadInt64();
+                    return true;
+                case "start_active":
+                    StartActive = reader.ReadInt64();
+                    return true;
+                case "start_time":
+                    _startTime = reader.ReadString();
+                    return true;
+                case "title":
+                    Title = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="user_id"), Preserve]
-        public string UserId { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("authoritative");
+            writer.WriteBoolean(Authoritative);
+            writer.WriteName("can_enter");
+            writer.WriteBoolean(CanEnter);
+            writer.WriteName("category");
+            writer.WriteInt64(Category);
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Description != null)
+            {
+                writer.WriteName("description");
+                writer.WriteString(Description);
+            }
+            writer.WriteName("duration");
+            writer.WriteInt64(Duration);
+            writer.WriteName("end_active");
+            writer.WriteInt64(EndActive);
+            if (_endTime != null)
+            {
+                writer.WriteName("end_time");
+                writer.WriteString(_endTime);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            writer.WriteName("join_required");
+            writer.WriteBoolean(JoinRequired);
+            writer.WriteName("max_num_score");
+            writer.WriteInt64(MaxNumScore);
+            writer.WriteName("max_size");
+            writer.WriteInt64(MaxSize);
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            writer.WriteName("next_reset");
+            writer.WriteInt64(NextReset);
+            if (_operator != null)
+            {
+                writer.WriteName("operator");
+                writer.WriteString(_operator);
+            }
+            writer.WriteName("prev_reset");
+            writer.WriteInt64(PrevReset);
+            writer.WriteName("size");
+            writer.WriteInt64(Size);
+            writer.WriteName("sort_order");
+            writer.WriteInt64(SortOrder);
+            writer.WriteName("start_active");
+            writer.WriteInt64(StartActive);
+            if (_startTime != null)
+            {
+                writer.WriteName("start_time");
+                writer.WriteString(_startTime);
+            }
+            if (Title != null)
+            {
+                writer.WriteName("title");
+                writer.WriteString(Title);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="version"), Preserve]
-        public string Version { get; set; }
+        public IApiTournament Clone() => (ApiTournament) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiTournament) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiTournament) obj;
+            return Authoritative == other.Authoritative &&
+                CanEnter == other.CanEnter &&
+                Category == other.Category &&
+                _createTime == other._createTime &&
+                Description == other.Description &&
+                Duration == other.Duration &&
+                EndActive == other.EndActive &&
+                _endTime == other._endTime &&
+                Id == other.Id &&
+                JoinRequired == other.JoinRequired &&
+                MaxNumScore == other.MaxNumScore &&
+                MaxSize == other.MaxSize &&
+                Metadata == other.Metadata &&
+                NextReset == other.NextReset &&
+                _operator == other._operator &&
+                PrevReset == other.PrevReset &&
+                Size == other.Size &&
+                SortOrder == other.SortOrder &&
+                StartActive == other.StartActive &&
+                _startTime == other._startTime &&
+                Title == other.Title;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Authoritative.GetHashCode();
+                hash = hash * 31 + CanEnter.GetHashCode();
+                hash = hash * 31 + Category.GetHashCode();
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Description?.GetHashCode() ?? 0);
+                hash = hash * 31 + Duration.GetHashCode();
+                hash = hash * 31 + EndActive.GetHashCode();
+                hash = hash * 31 + (_endTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + JoinRequired.GetHashCode();
+                hash = hash * 31 + MaxNumScore.GetHashCode();
+                hash = hash * 31 + MaxSize.GetHashCode();
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + NextReset.GetHashCode();
+                hash = hash * 31 + (_operator?.GetHashCode() ?? 0);
+                hash = hash * 31 + PrevReset.GetHashCode();
+                hash = hash * 31 + Size.GetHashCode();
+                hash = hash * 31 + SortOrder.GetHashCode();
+                hash = hash * 31 + StartActive.GetHashCode();
+                hash = hash * 31 + (_startTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Title?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Collection: ", Collection, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Key: ", Key, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            output = string.Concat(output, "Version: ", Version, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Authoritative: " + ApiClient.FormatValue(Authoritative));
+            members.Add("CanEnter: " + ApiClient.FormatValue(CanEnter));
+            members.Add("Category: " + ApiClient.FormatValue(Category));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Description: " + ApiClient.FormatValue(Description));
+            members.Add("Duration: " + ApiClient.FormatValue(Duration));
+            members.Add("EndActive: " + ApiClient.FormatValue(EndActive));
+            members.Add("EndTime: " + ApiClient.FormatValue(EndTime));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("JoinRequired: " + ApiClient.FormatValue(JoinRequired));
+            members.Add("MaxNumScore: " + ApiClient.FormatValue(MaxNumScore));
+            members.Add("MaxSize: " + ApiClient.FormatValue(MaxSize));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("NextReset: " + ApiClient.FormatValue(NextReset));
+            members.Add("Operator: " + ApiClient.FormatEncoded(_operator));
+            members.Add("PrevReset: " + ApiClient.FormatValue(PrevReset));
+            members.Add("Size: " + ApiClient.FormatValue(Size));
+            members.Add("SortOrder: " + ApiClient.FormatValue(SortOrder));
+            members.Add("StartActive: " + ApiClient.FormatValue(StartActive));
+            members.Add("StartTime: " + ApiClient.FormatValue(StartTime));
+            members.Add("Title: " + ApiClient.FormatValue(Title));
         }
     }
 
     /// <summary>
-    /// Batch of acknowledgements for the storage object write.
+    /// A list of tournaments.
     /// </summary>
-    public interface IApiStorageObjectAcks
+    public interface IApiTournamentList
     {
 
         /// <summary>
-        /// Batch of storage write acknowledgements.
+        /// A pagination cursor (optional).
         /// </summary>
-        IEnumerable<IApiStorageObjectAck> Acks { get; }
+        string Cursor { get; }
+
+        /// <summary>
+        /// The list of tournaments returned.
+        /// </summary>
+        IEnumerable<IApiTournament> Tournaments { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiTournamentList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiStorageObjectAcks : IApiStorageObjectAcks
+    internal class ApiTournamentList : IApiTournamentList, IApiJsonObject
     {
 
+        /// <inheritdoc />
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
+
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IEnumerable<IApiStorageObjectAck> Acks => _acks ?? new List<ApiStorageObjectAck>(0);
-        [DataMember(Name="acks"), Preserve]
-        public List<ApiStorageObjectAck> _acks { get; set; }
+        public IEnumerable<IApiTournament> Tournaments => _tournaments ?? new List<ApiTournament>(0);
+        [DataMember(Name="tournaments"), Preserve]
+        public List<ApiTournament> _tournaments { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "tournaments":
+                    _tournaments = reader.ReadList(reader.ReadObject<ApiTournament>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_tournaments != null)
+            {
+                writer.WriteName("tournaments");
+                writer.WriteList(_tournaments, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiTournamentList Clone() => (ApiTournamentList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiTournamentList) MemberwiseClone();
+            clone._tournaments = _tournaments?.ConvertAll(item => (ApiTournament) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiTournamentList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_tournaments, other._tournaments);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_tournaments);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Acks: [", string.Join(", ", Acks), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("Tournaments: " + ApiClient.FormatList(_tournaments));
         }
     }
 
     /// <summary>
-    /// List of storage objects.
+    /// A set of tournament records which may be part of a tournament records page or a batch of individual records.
     /// </summary>
-    public interface IApiStorageObjectList
+    public interface IApiTournamentRecordList
     {
 
         /// <summary>
-        /// The cursor for the next page of results, if any.
+        /// The cursor to send when retireving the next page (optional).
+        /// </summary>
+        string NextCursor { get; }
+
+        /// <summary>
+        /// A batched set of tournament records belonging to specified owners.
+        /// </summary>
+        IEnumerable<IApiLeaderboardRecord> OwnerRecords { get; }
+
+        /// <summary>
+        /// The cursor to send when retrieving the previous page (optional).
+        /// </summary>
+        string PrevCursor { get; }
+
+        /// <summary>
+        /// The total number of ranks available.
+        /// </summary>
+        long RankCount { get; }
+
+        /// <summary>
+        /// A list of tournament records.
         /// </summary>
-        string Cursor { get; }
+        IEnumerable<IApiLeaderboardRecord> Records { get; }
 
         /// <summary>
-        /// The list of storage objects.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IEnumerable<IApiStorageObject> Objects { get; }
+        IApiTournamentRecordList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiStorageObjectList : IApiStorageObjectList
+    internal class ApiTournamentRecordList : IApiTournamentRecordList, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
+        [DataMember(Name="next_cursor"), Preserve]
+        public string NextCursor { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IEnumerable<IApiStorageObject> Objects => _objects ?? new List<ApiStorageObject>(0);
-        [DataMember(Name="objects"), Preserve]
-        public List<ApiStorageObject> _objects { get; set; }
+        public IEnumerable<IApiLeaderboardRecord> OwnerRecords => _ownerRecords ?? new List<ApiLeaderboardRecord>(0);
+        [DataMember(Name="owner_records"), Preserve]
+        public List<ApiLeaderboardRecord> _ownerRecords { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        [DataMember(Name="prev_cursor"), Preserve]
+        public string PrevCursor { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long RankCount
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "Objects: [", string.Join(", ", Objects), "], ");
-            return output;
+            get => ApiClient.ParseInt64(_rankCount);
+            set => _rankCount = ApiClient.FormatInt64(value);
         }
-    }
+        [DataMember(Name="rank_count"), Preserve]
+        public string _rankCount { get; set; }
 
-    /// <summary>
-    /// Batch of storage objects.
-    /// </summary>
-    public interface IApiStorageObjects
-    {
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiLeaderboardRecord> Records => _records ?? new List<ApiLeaderboardRecord>(0);
+        [DataMember(Name="records"), Preserve]
+        public List<ApiLeaderboardRecord> _records { get; set; }
 
-        /// <summary>
-        /// The batch of storage objects.
-        /// </summary>
-        IEnumerable<IApiStorageObject> Objects { get; }
-    }
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "owner_records":
+                    _ownerRecords = reader.ReadList(reader.ReadObject<ApiLeaderboardRecord>);
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                case "rank_count":
+                    _rankCount = reader.ReadString();
+                    return true;
+                case "records":
+                    _records = reader.ReadList(reader.ReadObject<ApiLeaderboardRecord>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
-    /// <inheritdoc />
-    internal class ApiStorageObjects : IApiStorageObjects
-    {
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (_ownerRecords != null)
+            {
+                writer.WriteName("owner_records");
+                writer.WriteList(_ownerRecords, writer.WriteObject);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+            if (_rankCount != null)
+            {
+                writer.WriteName("rank_count");
+                writer.WriteString(_rankCount);
+            }
+            if (_records != null)
+            {
+                writer.WriteName("records");
+                writer.WriteList(_records, writer.WriteObject);
+            }
+        }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiStorageObject> Objects => _objects ?? new List<ApiStorageObject>(0);
-        [DataMember(Name="objects"), Preserve]
-        public List<ApiStorageObject> _objects { get; set; }
+        public IApiTournamentRecordList Clone() => (ApiTournamentRecordList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiTournamentRecordList) MemberwiseClone();
+            clone._ownerRecords = _ownerRecords?.ConvertAll(item => (ApiLeaderboardRecord) item?.CloneModel());
+            clone._records = _records?.ConvertAll(item => (ApiLeaderboardRecord) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiTournamentRecordList) obj;
+            return NextCursor == other.NextCursor &&
+                ApiClient.ListEquals(_ownerRecords, other._ownerRecords) &&
+                PrevCursor == other.PrevCursor &&
+                _rankCount == other._rankCount &&
+                ApiClient.ListEquals(_records, other._records);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_ownerRecords);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_rankCount?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_records);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Objects: [", string.Join(", ", Objects), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
         }
-    }
 
-    /// <summary>
-    /// Environment where a purchase/subscription took place,
-    /// </summary>
-    public enum ApiStoreEnvironment
-    {
-        /// <summary>
-        /// - UNKNOWN: Unknown environment.
-        /// </summary>
-        UNKNOWN = 0,
-        /// <summary>
-        ///  - SANDBOX: Sandbox/test environment.
-        /// </summary>
-        SANDBOX = 1,
-        /// <summary>
-        ///  - PRODUCTION: Production environment.
-        /// </summary>
-        PRODUCTION = 2,
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("OwnerRecords: " + ApiClient.FormatList(_ownerRecords));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
+            members.Add("RankCount: " + ApiClient.FormatValue(RankCount));
+            members.Add("Records: " + ApiClient.FormatList(_records));
+        }
     }
 
     /// <summary>
-    /// Validation Provider,
+    /// Update a user's account details.
     /// </summary>
-    public enum ApiStoreProvider
+    public interface IApiUpdateAccountRequest
     {
+
         /// <summary>
-        /// - APPLE_APP_STORE: Apple App Store
+        /// A URL for an avatar image.
         /// </summary>
-        APPLE_APP_STORE = 0,
+        string AvatarUrl { get; }
+
         /// <summary>
-        ///  - GOOGLE_PLAY_STORE: Google Play Store
+        /// The display name of the user.
         /// </summary>
-        GOOGLE_PLAY_STORE = 1,
+        string DisplayName { get; }
+
         /// <summary>
-        ///  - HUAWEI_APP_GALLERY: Huawei App Gallery
+        /// The language expected to be a tag which follows the BCP-47 spec.
         /// </summary>
-        HUAWEI_APP_GALLERY = 2,
+        string LangTag { get; }
+
         /// <summary>
-        ///  - FACEBOOK_INSTANT_STORE: Facebook Instant Store
+        /// The location set by the user.
         /// </summary>
-        FACEBOOK_INSTANT_STORE = 3,
-    }
-
-    /// <summary>
-    /// A list of validated subscriptions stored by Nakama.
-    /// </summary>
-    public interface IApiSubscriptionList
-    {
+        string Location { get; }
 
         /// <summary>
-        /// The cursor to send when retrieving the next page, if any.
+        /// The timezone set by the user.
         /// </summary>
-        string Cursor { get; }
+        string Timezone { get; }
 
         /// <summary>
-        /// The cursor to send when retrieving the previous page, if any.
+        /// The username of the user's account.
         /// </summary>
-        string PrevCursor { get; }
+        string Username { get; }
 
         /// <summary>
-        /// Stored validated subscriptions.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IEnumerable<IApiValidatedSubscription> ValidatedSubscriptions { get; }
+        IApiUpdateAccountRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiSubscriptionList : IApiSubscriptionList
+    internal class ApiUpdateAccountRequest : IApiUpdateAccountRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
+        [DataMember(Name="avatar_url"), Preserve]
+        public string AvatarUrl { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="prev_cursor"), Preserve]
-        public string PrevCursor { get; set; }
+        [DataMember(Name="display_name"), Preserve]
+        public string DisplayName { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiValidatedSubscription> ValidatedSubscriptions => _validatedSubscriptions ?? new List<ApiValidatedSubscription>(0);
-        [DataMember(Name="validated_subscriptions"), Preserve]
-        public List<ApiValidatedSubscription> _validatedSubscriptions { get; set; }
+        [DataMember(Name="lang_tag"), Preserve]
+        public string LangTag { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="location"), Preserve]
+        public string Location { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="timezone"), Preserve]
+        public string Timezone { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="username"), Preserve]
+        public string Username { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "display_name":
+                    DisplayName = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "location":
+                    Location = reader.ReadString();
+                    return true;
+                case "timezone":
+                    Timezone = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (DisplayName != null)
+            {
+                writer.WriteName("display_name");
+                writer.WriteString(DisplayName);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            if (Location != null)
+            {
+                writer.WriteName("location");
+                writer.WriteString(Location);
+            }
+            if (Timezone != null)
+            {
+                writer.WriteName("timezone");
+                writer.WriteString(Timezone);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiUpdateAccountRequest Clone() => (ApiUpdateAccountRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiUpdateAccountRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiUpdateAccountRequest) obj;
+            return AvatarUrl == other.AvatarUrl &&
+                DisplayName == other.DisplayName &&
+                LangTag == other.LangTag &&
+                Location == other.Location &&
+                Timezone == other.Timezone &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (DisplayName?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Location?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Timezone?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            output = string.Concat(output, "ValidatedSubscriptions: [", string.Join(", ", ValidatedSubscriptions), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("DisplayName: " + ApiClient.FormatValue(DisplayName));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("Location: " + ApiClient.FormatValue(Location));
+            members.Add("Timezone: " + ApiClient.FormatValue(Timezone));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
         }
     }
 
     /// <summary>
-    /// A tournament on the server.
+    /// A user in the server.
     /// </summary>
-    public interface IApiTournament
+    public interface IApiUser
     {
 
         /// <summary>
-        /// Whether the leaderboard was created authoritatively or not.
-        /// </summary>
-        bool Authoritative { get; }
-
-        /// <summary>
-        /// True if the tournament is active and can enter. A computed value.
-        /// </summary>
-        bool CanEnter { get; }
-
-        /// <summary>
-        /// The category of the tournament. e.g. "vip" could be category 1.
+        /// The Apple Sign In ID in the user's account.
         /// </summary>
-        int Category { get; }
+        string AppleId { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the tournament was created.
+        /// A URL for an avatar image.
         /// </summary>
-        string CreateTime { get; }
+        string AvatarUrl { get; }
 
         /// <summary>
-        /// The description of the tournament. May be blank.
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user was created.
         /// </summary>
-        string Description { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
-        /// Duration of the tournament in seconds.
+        /// The display name of the user.
         /// </summary>
-        int Duration { get; }
+        string DisplayName { get; }
 
         /// <summary>
-        /// The UNIX time when the tournament stops being active until next reset. A computed value.
+        /// Number of related edges to this user.
         /// </summary>
-        int EndActive { get; }
+        int EdgeCount { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the tournament will be stopped.
+        /// The Facebook id in the user's account.
         /// </summary>
-        string EndTime { get; }
+        string FacebookId { get; }
 
         /// <summary>
-        /// The ID of the tournament.
+        /// The Facebook Instant Game ID in the user's account.
         /// </summary>
-        string Id { get; }
+        string FacebookInstantGameId { get; }
 
         /// <summary>
-        /// Whether the user must join the tournament before being able to submit scores.
+        /// The Apple Game Center in of the user's account.
         /// </summary>
-        bool JoinRequired { get; }
+        string GamecenterId { get; }
 
         /// <summary>
-        /// The maximum score updates allowed per player for the current tournament.
+        /// The Google id in the user's account.
         /// </summary>
-        int MaxNumScore { get; }
+        string GoogleId { get; }
 
         /// <summary>
-        /// The maximum number of players for the tournament.
+        /// The id of the user's account.
         /// </summary>
-        int MaxSize { get; }
+        string Id { get; }
 
         /// <summary>
-        /// Additional information stored as a JSON object.
+        /// The language expected to be a tag which follows the BCP-47 spec.
         /// </summary>
-        string Metadata { get; }
+        string LangTag { get; }
 
         /// <summary>
-        /// The UNIX time when the tournament is next playable. A computed value.
+        /// The location set by the user.
         /// </summary>
-        int NextReset { get; }
+        string Location { get; }
 
         /// <summary>
-        /// Operator.
+        /// Additional information stored as a JSON object.
         /// </summary>
-        ApiOperator Operator { get; }
+        string Metadata { get; }
 
         /// <summary>
-        /// The UNIX time when the tournament was last reset. A computed value.
+        /// Indicates whether the user is currently online.
         /// </summary>
-        int PrevReset { get; }
+        bool Online { get; }
 
         /// <summary>
-        /// The current number of players in the tournament.
+        /// The Steam id in the user's account.
         /// </summary>
-        int Size { get; }
+        string SteamId { get; }
 
         /// <summary>
-        /// ASC (0) or DESC (1) sort mode of scores in the tournament.
+        /// The timezone set by the user.
         /// </summary>
-        int SortOrder { get; }
+        string Timezone { get; }
 
         /// <summary>
-        /// The UNIX time when the tournament start being active. A computed value.
+        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user was last updated.
         /// </summary>
-        int StartActive { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the tournament will start.
+        /// The username of the user's account.
         /// </summary>
-        string StartTime { get; }
+        string Username { get; }
 
         /// <summary>
-        /// The title for the tournament.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Title { get; }
+        IApiUser Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiTournament : IApiTournament
+    internal class ApiUser : IApiUser, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="authoritative"), Preserve]
-        public bool Authoritative { get; set; }
+        [DataMember(Name="apple_id"), Preserve]
+        public string AppleId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="can_enter"), Preserve]
-        public bool CanEnter { get; set; }
+        [DataMember(Name="avatar_url"), Preserve]
+        public string AvatarUrl { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="category"), Preserve]
-        public int Category { get; set; }
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        [DataMember(Name="display_name"), Preserve]
+        public string DisplayName { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="description"), Preserve]
-        public string Description { get; set; }
+        [DataMember(Name="edge_count"), Preserve]
+        public int EdgeCount { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="duration"), Preserve]
-        public int Duration { get; set; }
+        [DataMember(Name="facebook_id"), Preserve]
+        public string FacebookId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="end_active"), Preserve]
-        public int EndActive { get; set; }
+        [DataMember(Name="facebook_instant_game_id"), Preserve]
+        public string FacebookInstantGameId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="end_time"), Preserve]
-        public string EndTime { get; set; }
+        [DataMember(Name="gamecenter_id"), Preserve]
+        public string GamecenterId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
+        [DataMember(Name="google_id"), Preserve]
+        public string GoogleId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="join_required"), Preserve]
-        public bool JoinRequired { get; set; }
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="max_num_score"), Preserve]
-        public int MaxNumScore { get; set; }
+        [DataMember(Name="lang_tag"), Preserve]
+        public string LangTag { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="max_size"), Preserve]
-        public int MaxSize { get; set; }
+        [DataMember(Name="location"), Preserve]
+        public string Location { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="metadata"), Preserve]
         public string Metadata { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="next_reset"), Preserve]
-        public int NextReset { get; set; }
+        [DataMember(Name="online"), Preserve]
+        public bool Online { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public ApiOperator Operator => _operator;
-        [DataMember(Name="operator"), Preserve]
-        public ApiOperator _operator { get; set; }
+        [DataMember(Name="steam_id"), Preserve]
+        public string SteamId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="prev_reset"), Preserve]
-        public int PrevReset { get; set; }
+        [DataMember(Name="timezone"), Preserve]
+        public string Timezone { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="size"), Preserve]
-        public int Size { get; set; }
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="sort_order"), Preserve]
-        public int SortOrder { get; set; }
+        [DataMember(Name="username"), Preserve]
+        public string Username { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="start_active"), Preserve]
-        public int StartActive { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "apple_id":
+                    AppleId = reader.ReadString();
+                    return true;
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "display_name":
+                    DisplayName = reader.ReadString();
+                    return true;
+                case "edge_count":
+                    EdgeCount = reader.ReadInt32();
+                    return true;
+                case "facebook_id":
+                    FacebookId = reader.ReadString();
+                    return true;
+                case "facebook_instant_game_id":
+                    FacebookInstantGameId = reader.ReadString();
+                    return true;
+                case "gamecenter_id":
+                    GamecenterId = reader.ReadString();
+                    return true;
+                case "google_id":
+                    GoogleId = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "location":
+                    Location = reader.ReadString();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "online":
+                    Online = reader.ReadBoolean();
+                    return true;
+                case "steam_id":
+                    SteamId = reader.ReadString();
+                    return true;
+                case "timezone":
+                    Timezone = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="start_time"), Preserve]
-        public string StartTime { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AppleId != null)
+            {
+                writer.WriteName("apple_id");
+                writer.WriteString(AppleId);
+            }
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (DisplayName != null)
+            {
+                writer.WriteName("display_name");
+                writer.WriteString(DisplayName);
+            }
+            writer.WriteName("edge_count");
+            writer.WriteInt32(EdgeCount);
+            if (FacebookId != null)
+            {
+                writer.WriteName("facebook_id");
+                writer.WriteString(FacebookId);
+            }
+            if (FacebookInstantGameId != null)
+            {
+                writer.WriteName("facebook_instant_game_id");
+                writer.WriteString(FacebookInstantGameId);
+            }
+            if (GamecenterId != null)
+            {
+                writer.WriteName("gamecenter_id");
+                writer.WriteString(GamecenterId);
+            }
+            if (GoogleId != null)
+            {
+                writer.WriteName("google_id");
+                writer.WriteString(GoogleId);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            if (Location != null)
+            {
+                writer.WriteName("location");
+                writer.WriteString(Location);
+            }
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            writer.WriteName("online");
+            writer.WriteBoolean(Online);
+            if (SteamId != null)
+            {
+                writer.WriteName("steam_id");
+                writer.WriteString(SteamId);
+            }
+            if (Timezone != null)
+            {
+                writer.WriteName("timezone");
+                writer.WriteString(Timezone);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="title"), Preserve]
-        public string Title { get; set; }
+        public IApiUser Clone() => (ApiUser) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiUser) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiUser) obj;
+            return AppleId == other.AppleId &&
+                AvatarUrl == other.AvatarUrl &&
+                _createTime == other._createTime &&
+                DisplayName == other.DisplayName &&
+                EdgeCount == other.EdgeCount &&
+                FacebookId == other.FacebookId &&
+                FacebookInstantGameId == other.FacebookInstantGameId &&
+                GamecenterId == other.GamecenterId &&
+                GoogleId == other.GoogleId &&
+                Id == other.Id &&
+                LangTag == other.LangTag &&
+                Location == other.Location &&
+                Metadata == other.Metadata &&
+                Online == other.Online &&
+                SteamId == other.SteamId &&
+                Timezone == other.Timezone &&
+                _updateTime == other._updateTime &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (AppleId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (DisplayName?.GetHashCode() ?? 0);
+                hash = hash * 31 + EdgeCount.GetHashCode();
+                hash = hash * 31 + (FacebookId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (FacebookInstantGameId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (GamecenterId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (GoogleId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Location?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + Online.GetHashCode();
+                hash = hash * 31 + (SteamId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Timezone?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Authoritative: ", Authoritative, ", ");
-            output = string.Concat(output, "CanEnter: ", CanEnter, ", ");
-            output = string.Concat(output, "Category: ", Category, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Description: ", Description, ", ");
-            output = string.Concat(output, "Duration: ", Duration, ", ");
-            output = string.Concat(output, "EndActive: ", EndActive, ", ");
-            output = string.Concat(output, "EndTime: ", EndTime, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "JoinRequired: ", JoinRequired, ", ");
-            output = string.Concat(output, "MaxNumScore: ", MaxNumScore, ", ");
-            output = string.Concat(output, "MaxSize: ", MaxSize, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "NextReset: ", NextReset, ", ");
-            output = string.Concat(output, "Operator: ", Operator, ", ");
-            output = string.Concat(output, "PrevReset: ", PrevReset, ", ");
-            output = string.Concat(output, "Size: ", Size, ", ");
-            output = string.Concat(output, "SortOrder: ", SortOrder, ", ");
-            output = string.Concat(output, "StartActive: ", StartActive, ", ");
-            output = string.Concat(output, "StartTime: ", StartTime, ", ");
-            output = string.Concat(output, "Title: ", Title, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AppleId: " + ApiClient.FormatValue(AppleId));
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("DisplayName: " + ApiClient.FormatValue(DisplayName));
+            members.Add("EdgeCount: " + ApiClient.FormatValue(EdgeCount));
+            members.Add("FacebookId: " + ApiClient.FormatValue(FacebookId));
+            members.Add("FacebookInstantGameId: " + ApiClient.FormatValue(FacebookInstantGameId));
+            members.Add("GamecenterId: " + ApiClient.FormatValue(GamecenterId));
+            members.Add("GoogleId: " + ApiClient.FormatValue(GoogleId));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("Location: " + ApiClient.FormatValue(Location));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("Online: " + ApiClient.FormatValue(Online));
+            members.Add("SteamId: " + ApiClient.FormatValue(SteamId));
+            members.Add("Timezone: " + ApiClient.FormatValue(Timezone));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
         }
     }
 
     /// <summary>
-    /// A list of tournaments.
+    /// A list of groups belonging to a user, along with the user's role in each group.
     /// </summary>
-    public interface IApiTournamentList
+    public interface IApiUserGroupList
     {
 
         /// <summary>
-        /// A pagination cursor (optional).
+        /// Cursor for the next page of results, if any.
         /// </summary>
         string Cursor { get; }
 
         /// <summary>
-        /// The list of tournaments returned.
+        /// Group-role pairs for a user.
         /// </summary>
-        IEnumerable<IApiTournament> Tournaments { get; }
+        IEnumerable<IUserGroupListUserGroup> UserGroups { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiUserGroupList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiUserGroupList : IApiUserGroupList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="cursor"), Preserve]
+        public string Cursor { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IUserGroupListUserGroup> UserGroups => _userGroups ?? new List<UserGroupListUserGroup>(0);
+        [DataMember(Name="user_groups"), Preserve]
+        public List<UserGroupListUserGroup> _userGroups { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "user_groups":
+                    _userGroups = reader.ReadList(reader.ReadObject<UserGroupListUserGroup>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_userGroups != null)
+            {
+                writer.WriteName("user_groups");
+                writer.WriteList(_userGroups, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiUserGroupList Clone() => (ApiUserGroupList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiUserGroupList) MemberwiseClone();
+            clone._userGroups = _userGroups?.ConvertAll(item => (UserGroupListUserGroup) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiUserGroupList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_userGroups, other._userGroups);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_userGroups);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("UserGroups: " + ApiClient.FormatList(_userGroups));
+        }
+    }
+
+    /// <summary>
+    /// A collection of zero or more users.
+    /// </summary>
+    public interface IApiUsers
+    {
+
+        /// <summary>
+        /// The User objects.
+        /// </summary>
+        IEnumerable<IApiUser> Users { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiUsers Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiTournamentList : IApiTournamentList
+    internal class ApiUsers : IApiUsers, IApiJsonObject
     {
 
-        /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiUser> Users => _users ?? new List<ApiUser>(0);
+        [DataMember(Name="users"), Preserve]
+        public List<ApiUser> _users { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "users":
+                    _users = reader.ReadList(reader.ReadObject<ApiUser>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_users != null)
+            {
+                writer.WriteName("users");
+                writer.WriteList(_users, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiUsers Clone() => (ApiUsers) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiUsers) MemberwiseClone();
+            clone._users = _users?.ConvertAll(item => (ApiUser) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiUsers) obj;
+            return ApiClient.ListEquals(_users, other._users);
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiTournament> Tournaments => _tournaments ?? new List<ApiTournament>(0);
-        [DataMember(Name="tournaments"), Preserve]
-        public List<ApiTournament> _tournaments { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_users);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "Tournaments: [", string.Join(", ", Tournaments), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Users: " + ApiClient.FormatList(_users));
         }
     }
 
     /// <summary>
-    /// A set of tournament records which may be part of a tournament records page or a batch of individual records.
+    /// Apple IAP Purchases validation request
     /// </summary>
-    public interface IApiTournamentRecordList
+    public interface IApiValidatePurchaseAppleRequest
     {
 
         /// <summary>
-        /// The cursor to send when retireving the next page (optional).
-        /// </summary>
-        string NextCursor { get; }
-
-        /// <summary>
-        /// A batched set of tournament records belonging to specified owners.
-        /// </summary>
-        IEnumerable<IApiLeaderboardRecord> OwnerRecords { get; }
-
-        /// <summary>
-        /// The cursor to send when retrieving the previous page (optional).
+        /// Persist the purchase
         /// </summary>
-        string PrevCursor { get; }
+        bool? Persist { get; }
 
         /// <summary>
-        /// The total number of ranks available.
+        /// Base64 encoded Apple receipt data payload.
         /// </summary>
-        string RankCount { get; }
+        string Receipt { get; }
 
         /// <summary>
-        /// A list of tournament records.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IEnumerable<IApiLeaderboardRecord> Records { get; }
+        IApiValidatePurchaseAppleRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiTournamentRecordList : IApiTournamentRecordList
+    internal class ApiValidatePurchaseAppleRequest : IApiValidatePurchaseAppleRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="next_cursor"), Preserve]
-        public string NextCursor { get; set; }
+        [DataMember(Name="persist"), Preserve]
+        public bool? Persist { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiLeaderboardRecord> OwnerRecords => _ownerRecords ?? new List<ApiLeaderboardRecord>(0);
-        [DataMember(Name="owner_records"), Preserve]
-        public List<ApiLeaderboardRecord> _ownerRecords { get; set; }
+        [DataMember(Name="receipt"), Preserve]
+        public string Receipt { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="prev_cursor"), Preserve]
-        public string PrevCursor { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "persist":
+                    Persist = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "receipt":
+                    Receipt = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="rank_count"), Preserve]
-        public string RankCount { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Persist != null)
+            {
+                writer.WriteName("persist");
+                writer.WriteBoolean(Persist.Value);
+            }
+            if (Receipt != null)
+            {
+                writer.WriteName("receipt");
+                writer.WriteString(Receipt);
+            }
+        }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiLeaderboardRecord> Records => _records ?? new List<ApiLeaderboardRecord>(0);
-        [DataMember(Name="records"), Preserve]
-        public List<ApiLeaderboardRecord> _records { get; set; }
+        public IApiValidatePurchaseAppleRequest Clone() => (ApiValidatePurchaseAppleRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidatePurchaseAppleRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiValidatePurchaseAppleRequest) obj;
+            return Nullable.Equals(Persist, other.Persist) &&
+                Receipt == other.Receipt;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Persist.GetHashCode();
+                hash = hash * 31 + (Receipt?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "OwnerRecords: [", string.Join(", ", OwnerRecords), "], ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            output = string.Concat(output, "RankCount: ", RankCount, ", ");
-            output = string.Concat(output, "Records: [", string.Join(", ", Records), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Persist: " + ApiClient.FormatValue(Persist));
+            members.Add("Receipt: " + ApiClient.FormatValue(Receipt));
         }
     }
 
     /// <summary>
-    /// Update a user's account details.
+    /// Facebook Instant IAP Purchase validation request
     /// </summary>
-    public interface IApiUpdateAccountRequest
+    public interface IApiValidatePurchaseFacebookInstantRequest
     {
 
         /// <summary>
-        /// A URL for an avatar image.
-        /// </summary>
-        string AvatarUrl { get; }
-
-        /// <summary>
-        /// The display name of the user.
-        /// </summary>
-        string DisplayName { get; }
-
-        /// <summary>
-        /// The language expected to be a tag which follows the BCP-47 spec.
-        /// </summary>
-        string LangTag { get; }
-
-        /// <summary>
-        /// The location set by the user.
+        /// Persist the purchase
         /// </summary>
-        string Location { get; }
+        bool? Persist { get; }
 
         /// <summary>
-        /// The timezone set by the user.
+        /// Base64 encoded Facebook Instant signedRequest receipt data payload.
         /// </summary>
-        string Timezone { get; }
+        string SignedRequest { get; }
 
         /// <summary>
-        /// The username of the user's account.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Username { get; }
+        IApiValidatePurchaseFacebookInstantRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiUpdateAccountRequest : IApiUpdateAccountRequest
+    internal class ApiValidatePurchaseFacebookInstantRequest : IApiValidatePurchaseFacebookInstantRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="avatar_url"), Preserve]
-        public string AvatarUrl { get; set; }
+        [DataMember(Name="persist"), Preserve]
+        public bool? Persist { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="display_name"), Preserve]
-        public string DisplayName { get; set; }
+        [DataMember(Name="signed_request"), Preserve]
+        public string SignedRequest { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="lang_tag"), Preserve]
-        public string LangTag { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "persist":
+                    Persist = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "signed_request":
+                    SignedRequest = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="location"), Preserve]
-        public string Location { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Persist != null)
+            {
+                writer.WriteName("persist");
+                writer.WriteBoolean(Persist.Value);
+            }
+            if (SignedRequest != null)
+            {
+                writer.WriteName("signed_request");
+                writer.WriteString(SignedRequest);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="timezone"), Preserve]
-        public string Timezone { get; set; }
+        public IApiValidatePurchaseFacebookInstantRequest Clone() => (ApiValidatePurchaseFacebookInstantRequest) CloneModel();
 
-        /// <inheritdoc />
-        [DataMember(Name="username"), Preserve]
-        public string Username { get; set; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidatePurchaseFacebookInstantRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiValidatePurchaseFacebookInstantRequest) obj;
+            return Nullable.Equals(Persist, other.Persist) &&
+                SignedRequest == other.SignedRequest;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Persist.GetHashCode();
+                hash = hash * 31 + (SignedRequest?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "DisplayName: ", DisplayName, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "Location: ", Location, ", ");
-            output = string.Concat(output, "Timezone: ", Timezone, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Persist: " + ApiClient.FormatValue(Persist));
+            members.Add("SignedRequest: " + ApiClient.FormatValue(SignedRequest));
         }
     }
 
     /// <summary>
-    /// A user in the server.
+    /// Google IAP Purchase validation request
     /// </summary>
-    public interface IApiUser
+    public interface IApiValidatePurchaseGoogleRequest
     {
 
         /// <summary>
-        /// The Apple Sign In ID in the user's account.
+        /// Persist the purchase
         /// </summary>
-        string AppleId { get; }
+        bool? Persist { get; }
 
         /// <summary>
-        /// A URL for an avatar image.
+        /// JSON encoded Google purchase payload.
         /// </summary>
-        string AvatarUrl { get; }
+        string Purchase { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user was created.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string CreateTime { get; }
+        IApiValidatePurchaseGoogleRequest Clone();
+    }
 
-        /// <summary>
-        /// The display name of the user.
-        /// </summary>
-        string DisplayName { get; }
+    /// <inheritdoc />
+    internal class ApiValidatePurchaseGoogleRequest : IApiValidatePurchaseGoogleRequest, IApiJsonObject
+    {
 
-        /// <summary>
-        /// Number of related edges to this user.
-        /// </summary>
-        int EdgeCount { get; }
+        /// <inheritdoc />
+        [DataMember(Name="persist"), Preserve]
+        public bool? Persist { get; set; }
 
-        /// <summary>
-        /// The Facebook id in the user's account.
-        /// </summary>
-        string FacebookId { get; }
+        /// <inheritdoc />
+        [DataMember(Name="purchase"), Preserve]
+        public string Purchase { get; set; }
 
-        /// <summary>
-        /// The Facebook Instant Game ID in the user's account.
-        /// </summary>
-        string FacebookInstantGameId { get; }
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "persist":
+                    Persist = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "purchase":
+                    Purchase = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
-        /// <summary>
-        /// The Apple Game Center in of the user's account.
-        /// </summary>
-        string GamecenterId { get; }
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Persist != null)
+            {
+                writer.WriteName("persist");
+                writer.WriteBoolean(Persist.Value);
+            }
+            if (Purchase != null)
+            {
+                writer.WriteName("purchase");
+                writer.WriteString(Purchase);
+            }
+        }
 
-        /// <summary>
-        /// The Google id in the user's account.
-        /// </summary>
-        string GoogleId { get; }
+        /// <inheritdoc />
+        public IApiValidatePurchaseGoogleRequest Clone() => (ApiValidatePurchaseGoogleRequest) CloneModel();
 
-        /// <summary>
-        /// The id of the user's account.
-        /// </summary>
-        string Id { get; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidatePurchaseGoogleRequest) MemberwiseClone();
+            return clone;
+        }
 
-        /// <summary>
-        /// The language expected to be a tag which follows the BCP-47 spec.
-        /// </summary>
-        string LangTag { get; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <summary>
-        /// The location set by the user.
-        /// </summary>
-        string Location { get; }
+            var other = (ApiValidatePurchaseGoogleRequest) obj;
+            return Nullable.Equals(Persist, other.Persist) &&
+                Purchase == other.Purchase;
+        }
 
-        /// <summary>
-        /// Additional information stored as a JSON object.
-        /// </summary>
-        string Metadata { get; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Persist.GetHashCode();
+                hash = hash * 31 + (Purchase?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
-        /// <summary>
-        /// Indicates whether the user is currently online.
-        /// </summary>
-        bool Online { get; }
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Persist: " + ApiClient.FormatValue(Persist));
+            members.Add("Purchase: " + ApiClient.FormatValue(Purchase));
+        }
+    }
+
+    /// <summary>
+    /// Huawei IAP Purchase validation request
+    /// </summary>
+    public interface IApiValidatePurchaseHuaweiRequest
+    {
 
         /// <summary>
-        /// The Steam id in the user's account.
+        /// Persist the purchase
         /// </summary>
-        string SteamId { get; }
+        bool? Persist { get; }
 
         /// <summary>
-        /// The timezone set by the user.
+        /// JSON encoded Huawei InAppPurchaseData.
         /// </summary>
-        string Timezone { get; }
+        string Purchase { get; }
 
         /// <summary>
-        /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user was last updated.
+        /// InAppPurchaseData signature.
         /// </summary>
-        string UpdateTime { get; }
+        string Signature { get; }
 
         /// <summary>
-        /// The username of the user's account.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Username { get; }
+        IApiValidatePurchaseHuaweiRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiUser : IApiUser
+    internal class ApiValidatePurchaseHuaweiRequest : IApiValidatePurchaseHuaweiRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="apple_id"), Preserve]
-        public string AppleId { get; set; }
+        [DataMember(Name="persist"), Preserve]
+        public bool? Persist { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="avatar_url"), Preserve]
-        public string AvatarUrl { get; set; }
+        [DataMember(Name="purchase"), Preserve]
+        public string Purchase { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        [DataMember(Name="signature"), Preserve]
+        public string Signature { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="display_name"), Preserve]
-        public string DisplayName { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "persist":
+                    Persist = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "purchase":
+                    Purchase = reader.ReadString();
+                    return true;
+                case "signature":
+                    Signature = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="edge_count"), Preserve]
-        public int EdgeCount { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Persist != null)
+            {
+                writer.WriteName("persist");
+                writer.WriteBoolean(Persist.Value);
+            }
+            if (Purchase != null)
+            {
+                writer.WriteName("purchase");
+                writer.WriteString(Purchase);
+            }
+            if (Signature != null)
+            {
+                writer.WriteName("signature");
+                writer.WriteString(Signature);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="facebook_id"), Preserve]
-        public string FacebookId { get; set; }
+        public IApiValidatePurchaseHuaweiRequest Clone() => (ApiValidatePurchaseHuaweiRequest) CloneModel();
 
-        /// <inheritdoc />
-        [DataMember(Name="facebook_instant_game_id"), Preserve]
-        public string FacebookInstantGameId { get; set; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidatePurchaseHuaweiRequest) MemberwiseClone();
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="gamecenter_id"), Preserve]
-        public string GamecenterId { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="google_id"), Preserve]
-        public string GoogleId { get; set; }
+            var other = (ApiValidatePurchaseHuaweiRequest) obj;
+            return Nullable.Equals(Persist, other.Persist) &&
+                Purchase == other.Purchase &&
+                Signature == other.Signature;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Persist.GetHashCode();
+                hash = hash * 31 + (Purchase?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Signature?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="lang_tag"), Preserve]
-        public string LangTag { get; set; }
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="location"), Preserve]
-        public string Location { get; set; }
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Persist: " + ApiClient.FormatValue(Persist));
+            members.Add("Purchase: " + ApiClient.FormatValue(Purchase));
+            members.Add("Signature: " + ApiClient.FormatValue(Signature));
+        }
+    }
 
-        /// <inheritdoc />
-        [DataMember(Name="metadata"), Preserve]
-        public string Metadata { get; set; }
+    /// <summary>
+    /// Validate IAP response.
+    /// </summary>
+    public interface IApiValidatePurchaseResponse
+    {
 
-        /// <inheritdoc />
-        [DataMember(Name="online"), Preserve]
-        public bool Online { get; set; }
+        /// <summary>
+        /// Newly seen validated purchases.
+        /// </summary>
+        IEnumerable<IApiValidatedPurchase> ValidatedPurchases { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiValidatePurchaseResponse Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiValidatePurchaseResponse : IApiValidatePurchaseResponse, IApiJsonObject
+    {
 
         /// <inheritdoc />
-        [DataMember(Name="steam_id"), Preserve]
-        public string SteamId { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IApiValidatedPurchase> ValidatedPurchases => _validatedPurchases ?? new List<ApiValidatedPurchase>(0);
+        [DataMember(Name="validated_purchases"), Preserve]
+        public List<ApiValidatedPurchase> _validatedPurchases { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="timezone"), Preserve]
-        public string Timezone { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "validated_purchases":
+                    _validatedPurchases = reader.ReadList(reader.ReadObject<ApiValidatedPurchase>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_validatedPurchases != null)
+            {
+                writer.WriteName("validated_purchases");
+                writer.WriteList(_validatedPurchases, writer.WriteObject);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="username"), Preserve]
-        public string Username { get; set; }
+        public IApiValidatePurchaseResponse Clone() => (ApiValidatePurchaseResponse) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidatePurchaseResponse) MemberwiseClone();
+            clone._validatedPurchases = _validatedPurchases?.ConvertAll(item => (ApiValidatedPurchase) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiValidatePurchaseResponse) obj;
+            return ApiClient.ListEquals(_validatedPurchases, other._validatedPurchases);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_validatedPurchases);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "AppleId: ", AppleId, ", ");
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "DisplayName: ", DisplayName, ", ");
-            output = string.Concat(output, "EdgeCount: ", EdgeCount, ", ");
-            output = string.Concat(output, "FacebookId: ", FacebookId, ", ");
-            output = string.Concat(output, "FacebookInstantGameId: ", FacebookInstantGameId, ", ");
-            output = string.Concat(output, "GamecenterId: ", GamecenterId, ", ");
-            output = string.Concat(output, "GoogleId: ", GoogleId, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "Location: ", Location, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "Online: ", Online, ", ");
-            output = string.Concat(output, "SteamId: ", SteamId, ", ");
-            output = string.Concat(output, "Timezone: ", Timezone, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ValidatedPurchases: " + ApiClient.FormatList(_validatedPurchases));
         }
     }
 
     /// <summary>
-    /// A list of groups belonging to a user, along with the user's role in each group.
+    /// Apple Subscription validation request
     /// </summary>
-    public interface IApiUserGroupList
+    public interface IApiValidateSubscriptionAppleRequest
     {
 
         /// <summary>
-        /// Cursor for the next page of results, if any.
+        /// Persist the subscription.
         /// </summary>
-        string Cursor { get; }
+        bool? Persist { get; }
 
         /// <summary>
-        /// Group-role pairs for a user.
+        /// Base64 encoded Apple receipt data payload.
         /// </summary>
-        IEnumerable<IUserGroupListUserGroup> UserGroups { get; }
+        string Receipt { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiValidateSubscriptionAppleRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiUserGroupList : IApiUserGroupList
+    internal class ApiValidateSubscriptionAppleRequest : IApiValidateSubscriptionAppleRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="cursor"), Preserve]
-        public string Cursor { get; set; }
+        [DataMember(Name="persist"), Preserve]
+        public bool? Persist { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IUserGroupListUserGroup> UserGroups => _userGroups ?? new List<UserGroupListUserGroup>(0);
-        [DataMember(Name="user_groups"), Preserve]
-        public List<UserGroupListUserGroup> _userGroups { get; set; }
+        [DataMember(Name="receipt"), Preserve]
+        public string Receipt { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "persist":
+                    Persist = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "receipt":
+                    Receipt = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Persist != null)
+            {
+                writer.WriteName("persist");
+                writer.WriteBoolean(Persist.Value);
+            }
+            if (Receipt != null)
+            {
+                writer.WriteName("receipt");
+                writer.WriteString(Receipt);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiValidateSubscriptionAppleRequest Clone() => (ApiValidateSubscriptionAppleRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidateSubscriptionAppleRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiValidateSubscriptionAppleRequest) obj;
+            return Nullable.Equals(Persist, other.Persist) &&
+                Receipt == other.Receipt;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Persist.GetHashCode();
+                hash = hash * 31 + (Receipt?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "UserGroups: [", string.Join(", ", UserGroups), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Persist: " + ApiClient.FormatValue(Persist));
+            members.Add("Receipt: " + ApiClient.FormatValue(Receipt));
         }
     }
 
     /// <summary>
-    /// A collection of zero or more users.
+    /// Google Subscription validation request
     /// </summary>
-    public interface IApiUsers
+    public interface IApiValidateSubscriptionGoogleRequest
     {
 
         /// <summary>
-        /// The User objects.
+        /// Persist the subscription.
         /// </summary>
-        IEnumerable<IApiUser> Users { get; }
+        bool? Persist { get; }
+
+        /// <summary>
+        /// JSON encoded Google purchase payload.
+        /// </summary>
+        string Receipt { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiValidateSubscriptionGoogleRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiUsers : IApiUsers
+    internal class ApiValidateSubscriptionGoogleRequest : IApiValidateSubscriptionGoogleRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiUser> Users => _users ?? new List<ApiUser>(0);
-        [DataMember(Name="users"), Preserve]
-        public List<ApiUser> _users { get; set; }
+        [DataMember(Name="persist"), Preserve]
+        public bool? Persist { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="receipt"), Preserve]
+        public string Receipt { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "persist":
+                    Persist = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "receipt":
+                    Receipt = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Persist != null)
+            {
+                writer.WriteName("persist");
+                writer.WriteBoolean(Persist.Value);
+            }
+            if (Receipt != null)
+            {
+                writer.WriteName("receipt");
+                writer.WriteString(Receipt);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiValidateSubscriptionGoogleRequest Clone() => (ApiValidateSubscriptionGoogleRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidateSubscriptionGoogleRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiValidateSubscriptionGoogleRequest) obj;
+            return Nullable.Equals(Persist, other.Persist) &&
+                Receipt == other.Receipt;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Persist.GetHashCode();
+                hash = hash * 31 + (Receipt?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-        public override string ToString()
+        internal virtual void FormatMembers(List<string> members)
         {
-            var output = "";
-            output = string.Concat(output, "Users: [", string.Join(", ", Users), "], ");
-            return output;
+            members.Add("Persist: " + ApiClient.FormatValue(Persist));
+            members.Add("Receipt: " + ApiClient.FormatValue(Receipt));
         }
     }
 
     /// <summary>
-    /// Apple IAP Purchases validation request
+    /// Validate Subscription response.
     /// </summary>
-    public interface IApiValidatePurchaseAppleRequest
+    public interface IApiValidateSubscriptionResponse
     {
 
         /// <summary>
-        /// Persist the purchase
+        /// 
         /// </summary>
-        bool Persist { get; }
+        IApiValidatedSubscription ValidatedSubscription { get; }
 
         /// <summary>
-        /// Base64 encoded Apple receipt data payload.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Receipt { get; }
+        IApiValidateSubscriptionResponse Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiValidatePurchaseAppleRequest : IApiValidatePurchaseAppleRequest
+    internal class ApiValidateSubscriptionResponse : IApiValidateSubscriptionResponse, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="persist"), Preserve]
-        public bool Persist { get; set; }
+        [IgnoreDataMember]
+        public IApiValidatedSubscription ValidatedSubscription => _validatedSubscription;
+        [DataMember(Name="validated_subscription"), Preserve]
+        public ApiValidatedSubscription _validatedSubscription { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="receipt"), Preserve]
-        public string Receipt { get; set; }
-
-        public override string ToString()
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Persist: ", Persist, ", ");
-            output = string.Concat(output, "Receipt: ", Receipt, ", ");
-            return output;
+            switch (name)
+            {
+                case "validated_subscription":
+                    _validatedSubscription = reader.ReadObject<ApiValidatedSubscription>();
+                    return true;
+                default:
+                    return false;
+            }
         }
-    }
 
-    /// <summary>
-    /// Facebook Instant IAP Purchase validation request
-    /// </summary>
-    public interface IApiValidatePurchaseFacebookInstantRequest
-    {
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_validatedSubscription != null)
+            {
+                writer.WriteName("validated_subscription");
+                writer.WriteObject(_validatedSubscription);
+            }
+        }
 
-        /// <summary>
-        /// Persist the purchase
-        /// </summary>
-        bool Persist { get; }
+        /// <inheritdoc />
+        public IApiValidateSubscriptionResponse Clone() => (ApiValidateSubscriptionResponse) CloneModel();
 
-        /// <summary>
-        /// Base64 encoded Facebook Instant signedRequest receipt data payload.
-        /// </summary>
-        string SignedRequest { get; }
-    }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidateSubscriptionResponse) MemberwiseClone();
+            clone._validatedSubscription = (ApiValidatedSubscription) _validatedSubscription?.CloneModel();
+            return clone;
+        }
 
-    /// <inheritdoc />
-    internal class ApiValidatePurchaseFacebookInstantRequest : IApiValidatePurchaseFacebookInstantRequest
-    {
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="persist"), Preserve]
-        public bool Persist { get; set; }
+            var other = (ApiValidateSubscriptionResponse) obj;
+            return Equals(_validatedSubscription, other._validatedSubscription);
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="signed_request"), Preserve]
-        public string SignedRequest { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_validatedSubscription?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Persist: ", Persist, ", ");
-            output = string.Concat(output, "SignedRequest: ", SignedRequest, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ValidatedSubscription: " + ApiClient.FormatValue(_validatedSubscription));
         }
     }
 
     /// <summary>
-    /// Google IAP Purchase validation request
+    /// Validated Purchase stored by Nakama.
     /// </summary>
-    public interface IApiValidatePurchaseGoogleRequest
+    public interface IApiValidatedPurchase
     {
 
         /// <summary>
-        /// Persist the purchase
+        /// Timestamp when the receipt validation was stored in DB.
         /// </summary>
-        bool Persist { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
-        /// JSON encoded Google purchase payload.
+        /// Whether the purchase was done in production or sandbox environment.
         /// </summary>
-        string Purchase { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiValidatePurchaseGoogleRequest : IApiValidatePurchaseGoogleRequest
-    {
-
-        /// <inheritdoc />
-        [DataMember(Name="persist"), Preserve]
-        public bool Persist { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="purchase"), Preserve]
-        public string Purchase { get; set; }
-
-        public override string ToString()
-        {
-            var output = "";
-            output = string.Concat(output, "Persist: ", Persist, ", ");
-            output = string.Concat(output, "Purchase: ", Purchase, ", ");
-            return output;
-        }
-    }
-
-    /// <summary>
-    /// Huawei IAP Purchase validation request
-    /// </summary>
-    public interface IApiValidatePurchaseHuaweiRequest
-    {
+        ApiStoreEnvironment Environment { get; }
 
         /// <summary>
-        /// Persist the purchase
+        /// Purchase Product ID.
         /// </summary>
-        bool Persist { get; }
+        string ProductId { get; }
 
         /// <summary>
-        /// JSON encoded Huawei InAppPurchaseData.
+        /// Raw provider validation response.
         /// </summary>
-        string Purchase { get; }
+        string ProviderResponse { get; }
 
         /// <summary>
-        /// InAppPurchaseData signature.
+        /// Timestamp when the purchase was done.
         /// </summary>
-        string Signature { get; }
-    }
+        DateTime PurchaseTime { get; }
 
-    /// <inheritdoc />
-    internal class ApiValidatePurchaseHuaweiRequest : IApiValidatePurchaseHuaweiRequest
-    {
+        /// <summary>
+        /// Timestamp when the purchase was refunded. Set to UNIX
+        /// </summary>
+        DateTime RefundTime { get; }
 
-        /// <inheritdoc />
-        [DataMember(Name="persist"), Preserve]
-        public bool Persist { get; set; }
+        /// <summary>
+        /// Whether the purchase had already been validated by Nakama before.
+        /// </summary>
+        bool SeenBefore { get; }
 
-        /// <inheritdoc />
-        [DataMember(Name="purchase"), Preserve]
-        public string Purchase { get; set; }
+        /// <summary>
+        /// Store identifier
+        /// </summary>
+        ApiStoreProvider Store { get; }
 
-        /// <inheritdoc />
-        [DataMember(Name="signature"), Preserve]
-        public string Signature { get; set; }
+        /// <summary>
+        /// Purchase Transaction ID.
+        /// </summary>
+        string TransactionId { get; }
 
-        public override string ToString()
-        {
-            var output = "";
-            output = string.Concat(output, "Persist: ", Persist, ", ");
-            output = string.Concat(output, "Purchase: ", Purchase, ", ");
-            output = string.Concat(output, "Signature: ", Signature, ", ");
-            return output;
-        }
-    }
+        /// <summary>
+        /// Timestamp when the receipt validation was updated in DB.
+        /// </summary>
+        DateTime UpdateTime { get; }
 
-    /// <summary>
-    /// Validate IAP response.
-    /// </summary>
-    public interface IApiValidatePurchaseResponse
-    {
+        /// <summary>
+        /// Purchase User ID.
+        /// </summary>
+        string UserId { get; }
 
         /// <summary>
-        /// Newly seen validated purchases.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IEnumerable<IApiValidatedPurchase> ValidatedPurchases { get; }
+        IApiValidatedPurchase Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiValidatePurchaseResponse : IApiValidatePurchaseResponse
+    internal class ApiValidatedPurchase : IApiValidatedPurchase, IApiJsonObject
     {
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IEnumerable<IApiValidatedPurchase> ValidatedPurchases => _validatedPurchases ?? new List<ApiValidatedPurchase>(0);
-        [DataMember(Name="validated_purchases"), Preserve]
-        public List<ApiValidatedPurchase> _validatedPurchases { get; set; }
-
-        public override string ToString()
+        public DateTime CreateTime
         {
-            var output = "";
-            output = string.Concat(output, "ValidatedPurchases: [", string.Join(", ", ValidatedPurchases), "], ");
-            return output;
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
         }
-    }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
 
-    /// <summary>
-    /// Apple Subscription validation request
-    /// </summary>
-    public interface IApiValidateSubscriptionAppleRequest
-    {
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public ApiStoreEnvironment Environment
+        {
+            get => ApiStoreEnvironmentConverter.Parse(_environment);
+            set => _environment = ApiStoreEnvironmentConverter.Format(value);
+        }
+        [DataMember(Name="environment"), Preserve]
+        public string _environment { get; set; }
 
-        /// <summary>
-        /// Persist the subscription.
-        /// </summary>
-        bool Persist { get; }
+        /// <inheritdoc />
+        [DataMember(Name="product_id"), Preserve]
+        public string ProductId { get; set; }
 
-        /// <summary>
-        /// Base64 encoded Apple receipt data payload.
-        /// </summary>
-        string Receipt { get; }
-    }
+        /// <inheritdoc />
+        [DataMember(Name="provider_response"), Preserve]
+        public string ProviderResponse { get; set; }
 
-    /// <inheritdoc />
-    internal class ApiValidateSubscriptionAppleRequest : IApiValidateSubscriptionAppleRequest
-    {
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime PurchaseTime
+        {
+            get => ApiClient.ParseDateTime(_purchaseTime);
+            set => _purchaseTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="purchase_time"), Preserve]
+        public string _purchaseTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="persist"), Preserve]
-        public bool Persist { get; set; }
+        [IgnoreDataMember]
+        public DateTime RefundTime
+        {
+            get => ApiClient.ParseDateTime(_refundTime);
+            set => _refundTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="refund_time"), Preserve]
+        public string _refundTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="receipt"), Preserve]
-        public string Receipt { get; set; }
+        [DataMember(Name="seen_before"), Preserve]
+        public bool SeenBefore { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public ApiStoreProvider Store
         {
-            var output = "";
-            output = string.Concat(output, "Persist: ", Persist, ", ");
-            output = string.Concat(output, "Receipt: ", Receipt, ", ");
-            return output;
+            get => ApiStoreProviderConverter.Parse(_store);
+            set => _store = ApiStoreProviderConverter.Format(value);
         }
-    }
+        [DataMember(Name="store"), Preserve]
+        public string _store { get; set; }
 
-    /// <summary>
-    /// Google Subscription validation request
-    /// </summary>
-    public interface IApiValidateSubscriptionGoogleRequest
-    {
+        /// <inheritdoc />
+        [DataMember(Name="transaction_id"), Preserve]
+        public string TransactionId { get; set; }
 
-        /// <summary>
-        /// Persist the subscription.
-        /// </summary>
-        bool Persist { get; }
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
 
-        /// <summary>
-        /// JSON encoded Google purchase payload.
-        /// </summary>
-        string Receipt { get; }
-    }
+        /// <inheritdoc />
+        [DataMember(Name="user_id"), Preserve]
+        public string UserId { get; set; }
 
-    /// <inheritdoc />
-    internal class ApiValidateSubscriptionGoogleRequest : IApiValidateSubscriptionGoogleRequest
-    {
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "environment":
+                    _environment = reader.ReadString();
+                    return true;
+                case "product_id":
+                    ProductId = reader.ReadString();
+                    return true;
+                case "provider_response":
+                    ProviderResponse = reader.ReadString();
+                    return true;
+                case "purchase_time":
+                    _purchaseTime = reader.ReadString();
+                    return true;
+                case "refund_time":
+                    _refundTime = reader.ReadString();
+                    return true;
+                case "seen_before":
+                    SeenBefore = reader.ReadBoolean();
+                    return true;
+                case "store":
+                    _store = reader.ReadString();
+                    return true;
+                case "transaction_id":
+                    TransactionId = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="persist"), Preserve]
-        public bool Persist { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (_environment != null)
+            {
+                writer.WriteName("environment");
+                writer.WriteString(_environment);
+            }
+            if (ProductId != null)
+            {
+                writer.WriteName("product_id");
+                writer.WriteString(ProductId);
+            }
+            if (ProviderResponse != null)
+            {
+                writer.WriteName("provider_response");
+                writer.WriteString(ProviderResponse);
+            }
+            if (_purchaseTime != null)
+            {
+                writer.WriteName("purchase_time");
+                writer.WriteString(_purchaseTime);
+            }
+            if (_refundTime != null)
+            {
+                writer.WriteName("refund_time");
+                writer.WriteString(_refundTime);
+            }
+            writer.WriteName("seen_before");
+            writer.WriteBoolean(SeenBefore);
+            if (_store != null)
+            {
+                writer.WriteName("store");
+                writer.WriteString(_store);
+            }
+            if (TransactionId != null)
+            {
+                writer.WriteName("transaction_id");
+                writer.WriteString(TransactionId);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="receipt"), Preserve]
-        public string Receipt { get; set; }
+        public IApiValidatedPurchase Clone() => (ApiValidatedPurchase) CloneModel();
 
-        public override string ToString()
+        internal virtual object CloneModel()
         {
-            var output = "";
-            output = string.Concat(output, "Persist: ", Persist, ", ");
-            output = string.Concat(output, "Receipt: ", Receipt, ", ");
-            return output;
+            var clone = (ApiValidatedPurchase) MemberwiseClone();
+            return clone;
         }
-    }
-
-    /// <summary>
-    /// Validate Subscription response.
-    /// </summary>
-    public interface IApiValidateSubscriptionResponse
-    {
 
-        /// <summary>
-        /// 
-        /// </summary>
-        IApiValidatedSubscription ValidatedSubscription { get; }
-    }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-    /// <inheritdoc />
-    internal class ApiValidateSubscriptionResponse : IApiValidateSubscriptionResponse
-    {
+            var other = (ApiValidatedPurchase) obj;
+            return _createTime == other._createTime &&
+                _environment == other._environment &&
+                ProductId == other.ProductId &&
+                ProviderResponse == other.ProviderResponse &&
+                _purchaseTime == other._purchaseTime &&
+                _refundTime == other._refundTime &&
+                SeenBefore == other.SeenBefore &&
+                _store == other._store &&
+                TransactionId == other.TransactionId &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IApiValidatedSubscription ValidatedSubscription => _validatedSubscription;
-        [DataMember(Name="validated_subscription"), Preserve]
-        public ApiValidatedSubscription _validatedSubscription { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_environment?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProductId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProviderResponse?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_purchaseTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_refundTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + SeenBefore.GetHashCode();
+                hash = hash * 31 + (_store?.GetHashCode() ?? 0);
+                hash = hash * 31 + (TransactionId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ValidatedSubscription: ", ValidatedSubscription, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Environment: " + ApiClient.FormatEncoded(_environment));
+            members.Add("ProductId: " + ApiClient.FormatValue(ProductId));
+            members.Add("ProviderResponse: " + ApiClient.FormatValue(ProviderResponse));
+            members.Add("PurchaseTime: " + ApiClient.FormatValue(PurchaseTime));
+            members.Add("RefundTime: " + ApiClient.FormatValue(RefundTime));
+            members.Add("SeenBefore: " + ApiClient.FormatValue(SeenBefore));
+            members.Add("Store: " + ApiClient.FormatEncoded(_store));
+            members.Add("TransactionId: " + ApiClient.FormatValue(TransactionId));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
         }
     }
 
     /// <summary>
-    /// Validated Purchase stored by Nakama.
+    /// 
     /// </summary>
-    public interface IApiValidatedPurchase
+    public interface IApiValidatedSubscription
     {
 
         /// <summary>
-        /// Timestamp when the receipt validation was stored in DB.
+        /// Whether the subscription is currently active or not.
+        /// </summary>
+        bool Active { get; }
+
+        /// <summary>
+        /// UNIX Timestamp when the receipt validation was stored in DB.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// Whether the purchase was done in production or sandbox environment.
         /// </summary>
         ApiStoreEnvironment Environment { get; }
 
+        /// <summary>
+        /// Subscription expiration time. The subscription can still be auto-renewed to extend the expiration time further.
+        /// </summary>
+        DateTime ExpiryTime { get; }
+
+        /// <summary>
+        /// Purchase Original transaction ID (we only keep track of the original subscription, not subsequent renewals).
+        /// </summary>
+        string OriginalTransactionId { get; }
+
         /// <summary>
         /// Purchase Product ID.
         /// </summary>
         string ProductId { get; }
 
         /// <summary>
-        /// Raw provider validation response.
+        /// Raw provider notification body.
         /// </summary>
-        string ProviderResponse { get; }
+        string ProviderNotification { get; }
 
         /// <summary>
-        /// Timestamp when the purchase was done.
+        /// Raw provider validation response body.
         /// </summary>
-        string PurchaseTime { get; }
+        string ProviderResponse { get; }
 
         /// <summary>
-        /// Timestamp when the purchase was refunded. Set to UNIX
+        /// UNIX Timestamp when the purchase was done.
         /// </summary>
-        string RefundTime { get; }
+        DateTime PurchaseTime { get; }
 
         /// <summary>
-        /// Whether the purchase had already been validated by Nakama before.
+        /// Subscription refund time. If this time is set, the subscription was refunded.
         /// </summary>
-        bool SeenBefore { get; }
+        DateTime RefundTime { get; }
 
         /// <summary>
         /// Store identifier
@@ -4118,444 +11891,1094 @@ namespace Nakama
         ApiStoreProvider Store { get; }
 
         /// <summary>
-        /// Purchase Transaction ID.
+        /// UNIX Timestamp when the receipt validation was updated in DB.
         /// </summary>
-        string TransactionId { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
-        /// Timestamp when the receipt validation was updated in DB.
+        /// Subscription User ID.
         /// </summary>
-        string UpdateTime { get; }
+        string UserId { get; }
 
         /// <summary>
-        /// Purchase User ID.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string UserId { get; }
+        IApiValidatedSubscription Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiValidatedPurchase : IApiValidatedPurchase
+    internal class ApiValidatedSubscription : IApiValidatedSubscription, IApiJsonObject
     {
 
         /// <inheritdoc />
+        [DataMember(Name="active"), Preserve]
+        public bool Active { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ApiStoreEnvironment Environment => _environment;
+        public ApiStoreEnvironment Environment
+        {
+            get => ApiStoreEnvironmentConverter.Parse(_environment);
+            set => _environment = ApiStoreEnvironmentConverter.Format(value);
+        }
         [DataMember(Name="environment"), Preserve]
-        public ApiStoreEnvironment _environment { get; set; }
+        public string _environment { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime ExpiryTime
+        {
+            get => ApiClient.ParseDateTime(_expiryTime);
+            set => _expiryTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="expiry_time"), Preserve]
+        public string _expiryTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="original_transaction_id"), Preserve]
+        public string OriginalTransactionId { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="product_id"), Preserve]
         public string ProductId { get; set; }
 
+        /// <inheritdoc />
+        [DataMember(Name="provider_notification"), Preserve]
+        public string ProviderNotification { get; set; }
+
         /// <inheritdoc />
         [DataMember(Name="provider_response"), Preserve]
         public string ProviderResponse { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime PurchaseTime
+        {
+            get => ApiClient.ParseDateTime(_purchaseTime);
+            set => _purchaseTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="purchase_time"), Preserve]
-        public string PurchaseTime { get; set; }
+        public string _purchaseTime { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime RefundTime
+        {
+            get => ApiClient.ParseDateTime(_refundTime);
+            set => _refundTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="refund_time"), Preserve]
-        public string RefundTime { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="seen_before"), Preserve]
-        public bool SeenBefore { get; set; }
+        public string _refundTime { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ApiStoreProvider Store => _store;
+        public ApiStoreProvider Store
+        {
+            get => ApiStoreProviderConverter.Parse(_store);
+            set => _store = ApiStoreProviderConverter.Format(value);
+        }
         [DataMember(Name="store"), Preserve]
-        public ApiStoreProvider _store { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="transaction_id"), Preserve]
-        public string TransactionId { get; set; }
+        public string _store { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="user_id"), Preserve]
         public string UserId { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "active":
+                    Active = reader.ReadBoolean();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "environment":
+                    _environment = reader.ReadString();
+                    return true;
+                case "expiry_time":
+                    _expiryTime = reader.ReadString();
+                    return true;
+                case "original_transaction_id":
+                    OriginalTransactionId = reader.ReadString();
+                    return true;
+                case "product_id":
+                    ProductId = reader.ReadString();
+                    return true;
+                case "provider_notification":
+                    ProviderNotification = reader.ReadString();
+                    return true;
+                case "provider_response":
+                    ProviderResponse = reader.ReadString();
+                    return true;
+                case "purchase_time":
+                    _purchaseTime = reader.ReadString();
+                    return true;
+                case "refund_time":
+                    _refundTime = reader.ReadString();
+                    return true;
+                case "store":
+                    _store = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("active");
+            writer.WriteBoolean(Active);
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (_environment != null)
+            {
+                writer.WriteName("environment");
+                writer.WriteString(_environment);
+            }
+            if (_expiryTime != null)
+            {
+                writer.WriteName("expiry_time");
+                writer.WriteString(_expiryTime);
+            }
+            if (OriginalTransactionId != null)
+            {
+                writer.WriteName("original_transaction_id");
+                writer.WriteString(OriginalTransactionId);
+            }
+            if (ProductId != null)
+            {
+                writer.WriteName("product_id");
+                writer.WriteString(ProductId);
+            }
+            if (ProviderNotification != null)
+            {
+                writer.WriteName("provider_notification");
+                writer.WriteString(ProviderNotification);
+            }
+            if (ProviderResponse != null)
+            {
+                writer.WriteName("provider_response");
+                writer.WriteString(ProviderResponse);
+            }
+            if (_purchaseTime != null)
+            {
+                writer.WriteName("purchase_time");
+                writer.WriteString(_purchaseTime);
+            }
+            if (_refundTime != null)
+            {
+                writer.WriteName("refund_time");
+                writer.WriteString(_refundTime);
+            }
+            if (_store != null)
+            {
+                writer.WriteName("store");
+                writer.WriteString(_store);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiValidatedSubscription Clone() => (ApiValidatedSubscription) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidatedSubscription) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiValidatedSubscription) obj;
+            return Active == other.Active &&
+                _createTime == other._createTime &&
+                _environment == other._environment &&
+                _expiryTime == other._expiryTime &&
+                OriginalTransactionId == other.OriginalTransactionId &&
+                ProductId == other.ProductId &&
+                ProviderNotification == other.ProviderNotification &&
+                ProviderResponse == other.ProviderResponse &&
+                _purchaseTime == other._purchaseTime &&
+                _refundTime == other._refundTime &&
+                _store == other._store &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Active.GetHashCode();
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_environment?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_expiryTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (OriginalTransactionId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProductId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProviderNotification?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProviderResponse?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_purchaseTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_refundTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_store?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Environment: ", Environment, ", ");
-            output = string.Concat(output, "ProductId: ", ProductId, ", ");
-            output = string.Concat(output, "ProviderResponse: ", ProviderResponse, ", ");
-            output = string.Concat(output, "PurchaseTime: ", PurchaseTime, ", ");
-            output = string.Concat(output, "RefundTime: ", RefundTime, ", ");
-            output = string.Concat(output, "SeenBefore: ", SeenBefore, ", ");
-            output = string.Concat(output, "Store: ", Store, ", ");
-            output = string.Concat(output, "TransactionId: ", TransactionId, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Active: " + ApiClient.FormatValue(Active));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Environment: " + ApiClient.FormatEncoded(_environment));
+            members.Add("ExpiryTime: " + ApiClient.FormatValue(ExpiryTime));
+            members.Add("OriginalTransactionId: " + ApiClient.FormatValue(OriginalTransactionId));
+            members.Add("ProductId: " + ApiClient.FormatValue(ProductId));
+            members.Add("ProviderNotification: " + ApiClient.FormatValue(ProviderNotification));
+            members.Add("ProviderResponse: " + ApiClient.FormatValue(ProviderResponse));
+            members.Add("PurchaseTime: " + ApiClient.FormatValue(PurchaseTime));
+            members.Add("RefundTime: " + ApiClient.FormatValue(RefundTime));
+            members.Add("Store: " + ApiClient.FormatEncoded(_store));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
         }
     }
 
     /// <summary>
-    /// 
+    /// The object to store.
     /// </summary>
-    public interface IApiValidatedSubscription
+    public interface IApiWriteStorageObject
     {
 
         /// <summary>
-        /// Whether the subscription is currently active or not.
+        /// The collection to store the object.
         /// </summary>
-        bool Active { get; }
+        string Collection { get; }
 
         /// <summary>
-        /// UNIX Timestamp when the receipt validation was stored in DB.
+        /// The key for the object within the collection.
         /// </summary>
-        string CreateTime { get; }
+        string Key { get; }
 
         /// <summary>
-        /// Whether the purchase was done in production or sandbox environment.
+        /// The read access permissions for the object.
         /// </summary>
-        ApiStoreEnvironment Environment { get; }
+        int? PermissionRead { get; }
 
         /// <summary>
-        /// Subscription expiration time. The subscription can still be auto-renewed to extend the expiration time further.
+        /// The write access permissions for the object.
         /// </summary>
-        string ExpiryTime { get; }
+        int? PermissionWrite { get; }
 
         /// <summary>
-        /// Purchase Original transaction ID (we only keep track of the original subscription, not subsequent renewals).
+        /// The value of the object.
         /// </summary>
-        string OriginalTransactionId { get; }
+        string Value { get; }
 
         /// <summary>
-        /// Purchase Product ID.
+        /// The version hash of the object to check. Possible values are: ["", "*", "#hash#"].  if-match and if-none-match
         /// </summary>
-        string ProductId { get; }
+        string Version { get; }
 
         /// <summary>
-        /// Raw provider notification body.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string ProviderNotification { get; }
+        IApiWriteStorageObject Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiWriteStorageObject : IApiWriteStorageObject, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="collection"), Preserve]
+        public string Collection { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="key"), Preserve]
+        public string Key { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="permission_read"), Preserve]
+        public int? PermissionRead { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="permission_write"), Preserve]
+        public int? PermissionWrite { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="value"), Preserve]
+        public string Value { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="version"), Preserve]
+        public string Version { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "collection":
+                    Collection = reader.ReadString();
+                    return true;
+                case "key":
+                    Key = reader.ReadString();
+                    return true;
+                case "permission_read":
+                    PermissionRead = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "permission_write":
+                    PermissionWrite = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                case "version":
+                    Version = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collection != null)
+            {
+                writer.WriteName("collection");
+                writer.WriteString(Collection);
+            }
+            if (Key != null)
+            {
+                writer.WriteName("key");
+                writer.WriteString(Key);
+            }
+            if (PermissionRead != null)
+            {
+                writer.WriteName("permission_read");
+                writer.WriteInt32(PermissionRead.Value);
+            }
+            if (PermissionWrite != null)
+            {
+                writer.WriteName("permission_write");
+                writer.WriteInt32(PermissionWrite.Value);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+            if (Version != null)
+            {
+                writer.WriteName("version");
+                writer.WriteString(Version);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiWriteStorageObject Clone() => (ApiWriteStorageObject) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiWriteStorageObject) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <summary>
-        /// Raw provider validation response body.
-        /// </summary>
-        string ProviderResponse { get; }
+            var other = (ApiWriteStorageObject) obj;
+            return Collection == other.Collection &&
+                Key == other.Key &&
+                Nullable.Equals(PermissionRead, other.PermissionRead) &&
+                Nullable.Equals(PermissionWrite, other.PermissionWrite) &&
+                Value == other.Value &&
+                Version == other.Version;
+        }
 
-        /// <summary>
-        /// UNIX Timestamp when the purchase was done.
-        /// </summary>
-        string PurchaseTime { get; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Collection?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Key?.GetHashCode() ?? 0);
+                hash = hash * 31 + PermissionRead.GetHashCode();
+                hash = hash * 31 + PermissionWrite.GetHashCode();
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Version?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
-        /// <summary>
-        /// Subscription refund time. If this time is set, the subscription was refunded.
-        /// </summary>
-        string RefundTime { get; }
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-        /// <summary>
-        /// Store identifier
-        /// </summary>
-        ApiStoreProvider Store { get; }
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collection: " + ApiClient.FormatValue(Collection));
+            members.Add("Key: " + ApiClient.FormatValue(Key));
+            members.Add("PermissionRead: " + ApiClient.FormatValue(PermissionRead));
+            members.Add("PermissionWrite: " + ApiClient.FormatValue(PermissionWrite));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
+            members.Add("Version: " + ApiClient.FormatValue(Version));
+        }
+    }
+
+    /// <summary>
+    /// Write objects to the storage engine.
+    /// </summary>
+    public interface IApiWriteStorageObjectsRequest
+    {
 
         /// <summary>
-        /// UNIX Timestamp when the receipt validation was updated in DB.
+        /// The objects to store on the server.
         /// </summary>
-        string UpdateTime { get; }
+        IEnumerable<IApiWriteStorageObject> Objects { get; }
 
         /// <summary>
-        /// Subscription User ID.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string UserId { get; }
+        IApiWriteStorageObjectsRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiValidatedSubscription : IApiValidatedSubscription
+    internal class ApiWriteStorageObjectsRequest : IApiWriteStorageObjectsRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="active"), Preserve]
-        public bool Active { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IApiWriteStorageObject> Objects => _objects ?? new List<ApiWriteStorageObject>(0);
+        [DataMember(Name="objects"), Preserve]
+        public List<ApiWriteStorageObject> _objects { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "objects":
+                    _objects = reader.ReadList(reader.ReadObject<ApiWriteStorageObject>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public ApiStoreEnvironment Environment => _environment;
-        [DataMember(Name="environment"), Preserve]
-        public ApiStoreEnvironment _environment { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_objects != null)
+            {
+                writer.WriteName("objects");
+                writer.WriteList(_objects, writer.WriteObject);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="expiry_time"), Preserve]
-        public string ExpiryTime { get; set; }
+        public IApiWriteStorageObjectsRequest Clone() => (ApiWriteStorageObjectsRequest) CloneModel();
 
-        /// <inheritdoc />
-        [DataMember(Name="original_transaction_id"), Preserve]
-        public string OriginalTransactionId { get; set; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiWriteStorageObjectsRequest) MemberwiseClone();
+            clone._objects = _objects?.ConvertAll(item => (ApiWriteStorageObject) item?.CloneModel());
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="product_id"), Preserve]
-        public string ProductId { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="provider_notification"), Preserve]
-        public string ProviderNotification { get; set; }
+            var other = (ApiWriteStorageObjectsRequest) obj;
+            return ApiClient.ListEquals(_objects, other._objects);
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="provider_response"), Preserve]
-        public string ProviderResponse { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_objects);
+                return hash;
+            }
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="purchase_time"), Preserve]
-        public string PurchaseTime { get; set; }
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Objects: " + ApiClient.FormatList(_objects));
+        }
+    }
+
+    /// <summary>
+    /// 
+    /// </summary>
+    public interface IProtobufAny
+    {
+
+        /// <summary>
+        /// 
+        /// </summary>
+        string @type { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IProtobufAny Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ProtobufAny : IProtobufAny, IApiJsonObject
+    {
 
         /// <inheritdoc />
-        [DataMember(Name="refund_time"), Preserve]
-        public string RefundTime { get; set; }
+        [DataMember(Name="@type"), Preserve]
+        public string @type { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public ApiStoreProvider Store => _store;
-        [DataMember(Name="store"), Preserve]
-        public ApiStoreProvider _store { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "@type":
+                    @type = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (@type != null)
+            {
+                writer.WriteName("@type");
+                writer.WriteString(@type);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="user_id"), Preserve]
-        public string UserId { get; set; }
+        public IProtobufAny Clone() => (ProtobufAny) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ProtobufAny) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ProtobufAny) obj;
+            return @type == other.@type;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (@type?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Active: ", Active, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Environment: ", Environment, ", ");
-            output = string.Concat(output, "ExpiryTime: ", ExpiryTime, ", ");
-            output = string.Concat(output, "OriginalTransactionId: ", OriginalTransactionId, ", ");
-            output = string.Concat(output, "ProductId: ", ProductId, ", ");
-            output = string.Concat(output, "ProviderNotification: ", ProviderNotification, ", ");
-            output = string.Concat(output, "ProviderResponse: ", ProviderResponse, ", ");
-            output = string.Concat(output, "PurchaseTime: ", PurchaseTime, ", ");
-            output = string.Concat(output, "RefundTime: ", RefundTime, ", ");
-            output = string.Concat(output, "Store: ", Store, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("@type: " + ApiClient.FormatValue(@type));
         }
     }
 
     /// <summary>
-    /// The object to store.
+    /// 
     /// </summary>
-    public interface IApiWriteStorageObject
+    public interface IRpcStatus
     {
 
         /// <summary>
-        /// The collection to store the object.
-        /// </summary>
-        string Collection { get; }
-
-        /// <summary>
-        /// The key for the object within the collection.
-        /// </summary>
-        string Key { get; }
-
-        /// <summary>
-        /// The read access permissions for the object.
+        /// 
         /// </summary>
-        int PermissionRead { get; }
+        int Code { get; }
 
         /// <summary>
-        /// The write access permissions for the object.
+        /// 
         /// </summary>
-        int PermissionWrite { get; }
+        string Message { get; }
 
         /// <summary>
-        /// The value of the object.
+        /// 
         /// </summary>
-        string Value { get; }
+        IEnumerable<IProtobufAny> Details { get; }
 
         /// <summary>
-        /// The version hash of the object to check. Possible values are: ["", "*", "#hash#"].  if-match and if-none-match
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Version { get; }
+        IRpcStatus Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiWriteStorageObject : IApiWriteStorageObject
+    internal class RpcStatus : IRpcStatus, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="collection"), Preserve]
-        public string Collection { get; set; }
+        [DataMember(Name="code"), Preserve]
+        public int Code { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="key"), Preserve]
-        public string Key { get; set; }
+        [DataMember(Name="message"), Preserve]
+        public string Message { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="permission_read"), Preserve]
-        public int PermissionRead { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IProtobufAny> Details => _details ?? new List<ProtobufAny>(0);
+        [DataMember(Name="details"), Preserve]
+        public List<ProtobufAny> _details { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="permission_write"), Preserve]
-        public int PermissionWrite { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "code":
+                    Code = reader.ReadInt32();
+                    return true;
+                case "message":
+                    Message = reader.ReadString();
+                    return true;
+                case "details":
+                    _details = reader.ReadList(reader.ReadObject<ProtobufAny>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="value"), Preserve]
-        public string Value { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("code");
+            writer.WriteInt32(Code);
+            if (Message != null)
+            {
+                writer.WriteName("message");
+                writer.WriteString(Message);
+            }
+            if (_details != null)
+            {
+                writer.WriteName("details");
+                writer.WriteList(_details, writer.WriteObject);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="version"), Preserve]
-        public string Version { get; set; }
+        public IRpcStatus Clone() => (RpcStatus) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (RpcStatus) MemberwiseClone();
+            clone._details = _details?.ConvertAll(item => (ProtobufAny) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (RpcStatus) obj;
+            return Code == other.Code &&
+                Message == other.Message &&
+                ApiClient.ListEquals(_details, other._details);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Message?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_details);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Collection: ", Collection, ", ");
-            output = string.Concat(output, "Key: ", Key, ", ");
-            output = string.Concat(output, "PermissionRead: ", PermissionRead, ", ");
-            output = string.Concat(output, "PermissionWrite: ", PermissionWrite, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            output = string.Concat(output, "Version: ", Version, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Message: " + ApiClient.FormatValue(Message));
+            members.Add("Details: " + ApiClient.FormatList(_details));
         }
     }
 
     /// <summary>
-    /// Write objects to the storage engine.
+    /// The low level client for the Nakama API.
     /// </summary>
-    public interface IApiWriteStorageObjectsRequest
+    internal class ApiClient
     {
+        public readonly IHttpAdapter HttpAdapter;
+        public int Timeout { get; set; }
 
-        /// <summary>
-        /// The objects to store on the server.
-        /// </summary>
-        IEnumerable<IApiWriteStorageObject> Objects { get; }
-    }
+        private readonly Uri _baseUri;
 
-    /// <inheritdoc />
-    internal class ApiWriteStorageObjectsRequest : IApiWriteStorageObjectsRequest
-    {
+        public ApiClient(Uri baseUri, IHttpAdapter httpAdapter, int timeout = 10)
+        {
+            _baseUri = baseUri;
+            HttpAdapter = httpAdapter;
+            Timeout = timeout;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiWriteStorageObject> Objects => _objects ?? new List<ApiWriteStorageObject>(0);
-        [DataMember(Name="objects"), Preserve]
-        public List<ApiWriteStorageObject> _objects { get; set; }
+        private async Task<string> SendAsync(string method, Uri uri, IDictionary<string, string> headers, byte[] body,
+            CancellationToken? cancellationToken, RequestOptions options)
+        {
+            if (options?.Headers != null)
+            {
+                foreach (var header in options.Headers)
+                {
+                    headers[header.Key] = header.Value;
+                }
+            }
+            var timeout = options?.Timeout ?? Timeout;
+            cancellationToken = cancellationToken ?? options?.CancellationToken;
+            try
+            {
+                return await HttpAdapter.SendAsync(method, uri, headers, body, timeout, cancellationToken);
+            }
+            catch (ApiResponseException e) when (e.GetType() == typeof(ApiResponseException))
+            {
+                var exception = ApiStatusException.FromResponse(e);
+                if (exception == null)
+                {
+                    throw;
+                }
+                throw exception;
+            }
+        }
+
+        private static T ParseResponse<T>(string contents, Func<ApiJsonReader, T> read) =>
+            string.IsNullOrEmpty(contents) ? default(T) : read(new ApiJsonReader(contents));
+
+        internal static long ParseInt64(string value)
+        {
+            long.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
+
+        internal static string FormatInt64(long value) => value.ToString(CultureInfo.InvariantCulture);
 
-        public override string ToString()
+        internal static ulong ParseUInt64(string value)
         {
-            var output = "";
-            output = string.Concat(output, "Objects: [", string.Join(", ", Objects), "], ");
-            return output;
+            ulong.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
         }
-    }
 
-    /// <summary>
-    /// 
-    /// </summary>
-    public interface IProtobufAny
-    {
+        internal static string FormatUInt64(ulong value) => value.ToString(CultureInfo.InvariantCulture);
 
-        /// <summary>
-        /// 
-        /// </summary>
-        string @type { get; }
-    }
+        internal static DateTime ParseDateTime(string value)
+        {
+            DateTime.TryParse(value, CultureInfo.InvariantCulture,
+                DateTimeStyles.AdjustToUniversal | DateTimeStyles.AssumeUniversal, out var result);
+            return result;
+        }
 
-    /// <inheritdoc />
-    internal class ProtobufAny : IProtobufAny
-    {
+        internal static string FormatDateTime(DateTime value) =>
+            value.ToUniversalTime().ToString("yyyy-MM-dd'T'HH:mm:ss.FFFFFFF'Z'", CultureInfo.InvariantCulture);
 
-        /// <inheritdoc />
-        [DataMember(Name="@type"), Preserve]
-        public string @type { get; set; }
+        internal static byte[] ParseBytes(string value) => value == null ? null : Convert.FromBase64String(value);
 
-        public override string ToString()
+        internal static string FormatBytes(byte[] value) => value == null ? null : Convert.ToBase64String(value);
+
+        private static byte[] EncodeForm(List<KeyValuePair<string, object>> form)
         {
-            var output = "";
-            output = string.Concat(output, "@type: ", @type, ", ");
-            return output;
+            var fields = new List<string>(form.Count);
+            foreach (var field in form)
+            {
+                fields.Add(string.Concat(Uri.EscapeDataString(field.Key), "=", Uri.EscapeDataString((string) field.Value)));
+            }
+            return Encoding.UTF8.GetBytes(string.Join("&", fields));
         }
-    }
 
-    /// <summary>
-    /// 
-    /// </summary>
-    public interface IRpcStatus
-    {
+        private static byte[] EncodeMultipart(List<KeyValuePair<string, object>> form, string boundary)
+        {
+            using (var stream = new MemoryStream())
+            {
+                foreach (var field in form)
+                {
+                    var file = field.Value as byte[];
+                    var header = file == null
+                        ? $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"\r\n\r\n"
+                        : $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"; filename=\"{field.Key}\"\r\nContent-Type: application/octet-stream\r\n\r\n";
+                    var part = Encoding.UTF8.GetBytes(header);
+                    stream.Write(part, 0, part.Length);
+                    part = file ?? Encoding.UTF8.GetBytes((string) field.Value);
+                    stream.Write(part, 0, part.Length);
+                    part = Encoding.UTF8.GetBytes("\r\n");
+                    stream.Write(part, 0, part.Length);
+                }
+                var end = Encoding.UTF8.GetBytes($"--{boundary}--\r\n");
+                stream.Write(end, 0, end.Length);
+                return stream.ToArray();
+            }
+        }
 
-        /// <summary>
-        /// 
-        /// </summary>
-        int Code { get; }
+        internal static Dictionary<string, TOutput> ConvertMap<TInput, TOutput>(Dictionary<string, TInput> map,
+            Converter<TInput, TOutput> converter)
+        {
+            if (map == null)
+            {
+                return null;
+            }
 
-        /// <summary>
-        /// 
-        /// </summary>
-        IEnumerable<IProtobufAny> Details { get; }
+            var result = new Dictionary<string, TOutput>(map.Count);
+            foreach (var kvp in map)
+            {
+                result.Add(kvp.Key, converter(kvp.Value));
+            }
+            return result;
+        }
 
-        /// <summary>
-        /// 
-        /// </summary>
-        string Message { get; }
-    }
+        // Models compare a missing list or map as equal to an empty one, as the server may leave either out.
+        internal static bool ListEquals<T>(List<T> a, List<T> b)
+        {
+            var count = a?.Count ?? 0;
+            if (count != (b?.Count ?? 0))
+            {
+                return false;
+            }
 
-    /// <inheritdoc />
-    internal class RpcStatus : IRpcStatus
-    {
+            var comparer = EqualityComparer<T>.Default;
+            for (var i = 0; i < count; i++)
+            {
+                if (!comparer.Equals(a[i], b[i]))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="code"), Preserve]
-        public int Code { get; set; }
+        internal static bool MapEquals<T>(Dictionary<string, T> a, Dictionary<string, T> b)
+        {
+            if ((a?.Count ?? 0) != (b?.Count ?? 0))
+            {
+                return false;
+            }
+            if (a == null || b == null)
+            {
+                return true;
+            }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IProtobufAny> Details => _details ?? new List<ProtobufAny>(0);
-        [DataMember(Name="details"), Preserve]
-        public List<ProtobufAny> _details { get; set; }
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in a)
+            {
+                if (!b.TryGetValue(kvp.Key, out var value) || !comparer.Equals(kvp.Value, value))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="message"), Preserve]
-        public string Message { get; set; }
+        internal static int ListHashCode<T>(List<T> list)
+        {
+            var hash = 0;
+            if (list == null)
+            {
+                return hash;
+            }
 
-        public override string ToString()
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var item in list)
+            {
+                hash = unchecked(hash * 31 + comparer.GetHashCode(item));
+            }
+            return hash;
+        }
+
+        // Entries are hashed apart and summed, so that maps hash alike whatever order they were filled in.
+        internal static int MapHashCode<T>(Dictionary<string, T> map)
         {
-            var output = "";
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Details: [", string.Join(", ", Details), "], ");
-            output = string.Concat(output, "Message: ", Message, ", ");
-            return output;
+            var hash = 0;
+            if (map == null)
+            {
+                return hash;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in map)
+            {
+                hash = unchecked(hash + (kvp.Key.GetHashCode() ^ comparer.GetHashCode(kvp.Value)));
+            }
+            return hash;
         }
-    }
 
-    /// <summary>
-    /// The low level client for the Nakama API.
-    /// </summary>
-    internal class ApiClient
-    {
-        public readonly IHttpAdapter HttpAdapter;
-        public int Timeout { get; set; }
+        internal static string FormatModel(string name, List<string> members) =>
+            members.Count == 0 ? string.Concat(name, " {}") : string.Concat(name, " { ", string.Join(", ", members), " }");
 
-        private readonly Uri _baseUri;
+        internal static string FormatValue(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    return "null";
+                case string text:
+                    return string.Concat("\"", text.Replace("\\", "\\\\").Replace("\"", "\\\""), "\"");
+                case bool flag:
+                    return flag ? "true" : "false";
+                case DateTime time:
+                    return FormatDateTime(time);
+                case IFormattable formattable:
+                    return formattable.ToString(null, CultureInfo.InvariantCulture);
+                default:
+                    return value.ToString();
+            }
+        }
 
-        public ApiClient(Uri baseUri, IHttpAdapter httpAdapter, int timeout = 10)
+        internal static string FormatEncoded(string value) => value ?? "null";
+
+        internal static string FormatList<T>(List<T> list, Func<T, string> format = null)
         {
-            _baseUri = baseUri;
-            HttpAdapter = httpAdapter;
-            Timeout = timeout;
+            if (list == null || list.Count == 0)
+            {
+                return "[]";
+            }
+
+            var items = new List<string>(list.Count);
+            foreach (var item in list)
+            {
+                items.Add(format == null ? FormatValue(item) : format(item));
+            }
+            return string.Concat("[", string.Join(", ", items), "]");
+        }
+
+        internal static string FormatMap<T>(IDictionary<string, T> map, Func<T, string> format = null)
+        {
+            if (map == null || map.Count == 0)
+            {
+                return "{}";
+            }
+
+            var entries = new List<string>(map.Count);
+            foreach (var kvp in map)
+            {
+                entries.Add(string.Concat(FormatValue(kvp.Key), ": ", format == null ? FormatValue(kvp.Value) : format(kvp.Value)));
+            }
+            return string.Concat("{ ", string.Join(", ", entries), " }");
         }
 
         /// <summary>
@@ -4563,7 +12986,8 @@ namespace Nakama
         /// </summary>
         public async Task HealthcheckAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/healthcheck";
@@ -4578,13 +13002,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -4592,7 +13019,8 @@ namespace Nakama
         /// </summary>
         public async Task DeleteAccountAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/account";
@@ -4607,13 +13035,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "DELETE";
+            var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -4621,7 +13052,8 @@ namespace Nakama
         /// </summary>
         public async Task<IApiAccount> GetAccountAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/account";
@@ -4636,14 +13068,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiAccount>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiAccount>());
         }
 
         /// <summary>
@@ -4652,7 +13087,8 @@ namespace Nakama
         public async Task UpdateAccountAsync(
             string bearerToken,
             ApiUpdateAccountRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -4671,15 +13107,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "PUT";
+            var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -4689,9 +13129,10 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountApple account,
-            bool? create,
-            string username,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -4702,7 +13143,7 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
@@ -4716,7 +13157,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -4726,10 +13167,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -4739,9 +13181,10 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountCustom account,
-            bool? create,
-            string username,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -4752,7 +13195,7 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
@@ -4766,7 +13209,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -4776,10 +13219,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -4789,9 +13233,10 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountDevice account,
-            bool? create,
-            string username,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -4802,7 +13247,7 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
@@ -4816,7 +13261,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -4826,10 +13271,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -4839,9 +13285,10 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountEmail account,
-            bool? create,
-            string username,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -4852,7 +13299,7 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
@@ -4866,7 +13313,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -4876,10 +13323,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -4889,10 +13337,11 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountFacebook account,
-            bool? create,
-            string username,
-            bool? sync,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            bool? sync = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -4903,13 +13352,13 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
             }
             if (sync != null) {
-                queryParams = string.Concat(queryParams, "sync=", sync.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "sync=", Uri.EscapeDataString(sync.Value ? "true" : "false"), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -4920,7 +13369,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -4930,10 +13379,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -4943,9 +13393,10 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountFacebookInstantGame account,
-            bool? create,
-            string username,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -4956,7 +13407,7 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
@@ -4970,7 +13421,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -4980,10 +13431,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -4993,9 +13445,10 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountGameCenter account,
-            bool? create,
-            string username,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -5006,7 +13459,7 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
@@ -5020,7 +13473,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -5030,10 +13483,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -5043,9 +13497,10 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountGoogle account,
-            bool? create,
-            string username,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -5056,7 +13511,7 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
@@ -5070,7 +13525,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -5080,10 +13535,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -5093,10 +13549,11 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAccountSteam account,
-            bool? create,
-            string username,
-            bool? sync,
-            CancellationToken? cancellationToken)
+            bool? create = null,
+            string username = null,
+            bool? sync = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -5107,13 +13564,13 @@ namespace Nakama
 
             var queryParams = "";
             if (create != null) {
-                queryParams = string.Concat(queryParams, "create=", create.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "create=", Uri.EscapeDataString(create.Value ? "true" : "false"), "&");
             }
             if (username != null) {
                 queryParams = string.Concat(queryParams, "username=", Uri.EscapeDataString(username), "&");
             }
             if (sync != null) {
-                queryParams = string.Concat(queryParams, "sync=", sync.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "sync=", Uri.EscapeDataString(sync.Value ? "true" : "false"), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -5124,7 +13581,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -5134,10 +13591,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -5146,7 +13604,8 @@ namespace Nakama
         public async Task LinkAppleAsync(
             string bearerToken,
             ApiAccountApple body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5165,15 +13624,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5182,7 +13645,8 @@ namespace Nakama
         public async Task LinkCustomAsync(
             string bearerToken,
             ApiAccountCustom body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5201,15 +13665,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5218,7 +13686,8 @@ namespace Nakama
         public async Task LinkDeviceAsync(
             string bearerToken,
             ApiAccountDevice body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5237,15 +13706,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5254,7 +13727,8 @@ namespace Nakama
         public async Task LinkEmailAsync(
             string bearerToken,
             ApiAccountEmail body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5273,15 +13747,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5290,8 +13768,9 @@ namespace Nakama
         public async Task LinkFacebookAsync(
             string bearerToken,
             ApiAccountFacebook account,
-            bool? sync,
-            CancellationToken? cancellationToken)
+            bool? sync = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -5302,7 +13781,7 @@ namespace Nakama
 
             var queryParams = "";
             if (sync != null) {
-                queryParams = string.Concat(queryParams, "sync=", sync.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "sync=", Uri.EscapeDataString(sync.Value ? "true" : "false"), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -5313,15 +13792,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5330,7 +13813,8 @@ namespace Nakama
         public async Task LinkFacebookInstantGameAsync(
             string bearerToken,
             ApiAccountFacebookInstantGame body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5349,15 +13833,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5366,7 +13854,8 @@ namespace Nakama
         public async Task LinkGameCenterAsync(
             string bearerToken,
             ApiAccountGameCenter body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5385,15 +13874,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5402,7 +13895,8 @@ namespace Nakama
         public async Task LinkGoogleAsync(
             string bearerToken,
             ApiAccountGoogle body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5421,15 +13915,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5438,7 +13936,8 @@ namespace Nakama
         public async Task LinkSteamAsync(
             string bearerToken,
             ApiLinkSteamRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5457,15 +13956,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5475,7 +13978,8 @@ namespace Nakama
             string basicAuthUsername,
             string basicAuthPassword,
             ApiSessionRefreshRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5494,7 +13998,7 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(basicAuthUsername))
             {
@@ -5504,10 +14008,11 @@ namespace Nakama
             }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -5516,7 +14021,8 @@ namespace Nakama
         public async Task UnlinkAppleAsync(
             string bearerToken,
             ApiAccountApple body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5535,15 +14041,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5552,7 +14062,8 @@ namespace Nakama
         public async Task UnlinkCustomAsync(
             string bearerToken,
             ApiAccountCustom body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5571,15 +14082,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5588,7 +14103,8 @@ namespace Nakama
         public async Task UnlinkDeviceAsync(
             string bearerToken,
             ApiAccountDevice body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5607,15 +14123,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5624,7 +14144,8 @@ namespace Nakama
         public async Task UnlinkEmailAsync(
             string bearerToken,
             ApiAccountEmail body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5643,15 +14164,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5660,7 +14185,8 @@ namespace Nakama
         public async Task UnlinkFacebookAsync(
             string bearerToken,
             ApiAccountFacebook body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5679,15 +14205,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5696,7 +14226,8 @@ namespace Nakama
         public async Task UnlinkFacebookInstantGameAsync(
             string bearerToken,
             ApiAccountFacebookInstantGame body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5715,15 +14246,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5732,7 +14267,8 @@ namespace Nakama
         public async Task UnlinkGameCenterAsync(
             string bearerToken,
             ApiAccountGameCenter body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5751,15 +14287,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5768,7 +14308,8 @@ namespace Nakama
         public async Task UnlinkGoogleAsync(
             string bearerToken,
             ApiAccountGoogle body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5787,15 +14328,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5804,7 +14349,8 @@ namespace Nakama
         public async Task UnlinkSteamAsync(
             string bearerToken,
             ApiAccountSteam body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5823,15 +14369,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5840,25 +14390,26 @@ namespace Nakama
         public async Task<IApiChannelMessageList> ListChannelMessagesAsync(
             string bearerToken,
             string channelId,
-            int? limit,
-            bool? forward,
-            string cursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            bool? forward = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (channelId == null)
             {
                 throw new ArgumentException("'channelId' is required but was null.");
             }
 
-            var urlpath = "/v2/channel/{channelId}";
-            urlpath = urlpath.Replace("{channelId}", Uri.EscapeDataString(channelId));
+            var urlpath = "/v2/channel/{channel_id}";
+            urlpath = urlpath.Replace("{channel_id}", Uri.EscapeDataString(channelId));
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (forward != null) {
-                queryParams = string.Concat(queryParams, "forward=", forward.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "forward=", Uri.EscapeDataString(forward.Value ? "true" : "false"), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -5872,14 +14423,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiChannelMessageList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiChannelMessageList>());
         }
 
         /// <summary>
@@ -5888,7 +14442,8 @@ namespace Nakama
         public async Task EventAsync(
             string bearerToken,
             ApiEvent body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5907,15 +14462,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5923,9 +14482,10 @@ namespace Nakama
         /// </summary>
         public async Task DeleteFriendsAsync(
             string bearerToken,
-            IEnumerable<string> ids,
-            IEnumerable<string> usernames,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> ids = null,
+            IEnumerable<string> usernames = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/friend";
@@ -5948,13 +14508,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "DELETE";
+            var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5962,20 +14525,21 @@ namespace Nakama
         /// </summary>
         public async Task<IApiFriendList> ListFriendsAsync(
             string bearerToken,
-            int? limit,
-            int? state,
-            string cursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            int? state = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/friend";
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (state != null) {
-                queryParams = string.Concat(queryParams, "state=", state, "&");
+                queryParams = string.Concat(queryParams, "state=", Uri.EscapeDataString(state.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -5989,14 +14553,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiFriendList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiFriendList>());
         }
 
         /// <summary>
@@ -6004,10 +14571,11 @@ namespace Nakama
         /// </summary>
         public async Task AddFriendsAsync(
             string bearerToken,
-            IEnumerable<string> ids,
-            IEnumerable<string> usernames,
-            string metadata,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> ids = null,
+            IEnumerable<string> usernames = null,
+            string metadata = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/friend";
@@ -6033,13 +14601,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6047,9 +14618,10 @@ namespace Nakama
         /// </summary>
         public async Task BlockFriendsAsync(
             string bearerToken,
-            IEnumerable<string> ids,
-            IEnumerable<string> usernames,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> ids = null,
+            IEnumerable<string> usernames = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/friend/block";
@@ -6072,13 +14644,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6087,8 +14662,9 @@ namespace Nakama
         public async Task ImportFacebookFriendsAsync(
             string bearerToken,
             ApiAccountFacebook account,
-            bool? reset,
-            CancellationToken? cancellationToken)
+            bool? reset = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -6099,7 +14675,7 @@ namespace Nakama
 
             var queryParams = "";
             if (reset != null) {
-                queryParams = string.Concat(queryParams, "reset=", reset.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "reset=", Uri.EscapeDataString(reset.Value ? "true" : "false"), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -6110,15 +14686,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6126,16 +14706,17 @@ namespace Nakama
         /// </summary>
         public async Task<IApiFriendsOfFriendsList> ListFriendsOfFriendsAsync(
             string bearerToken,
-            int? limit,
-            string cursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/friend/friends";
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -6149,14 +14730,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiFriendsOfFriendsList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiFriendsOfFriendsList>());
         }
 
         /// <summary>
@@ -6165,8 +14749,9 @@ namespace Nakama
         public async Task ImportSteamFriendsAsync(
             string bearerToken,
             ApiAccountSteam account,
-            bool? reset,
-            CancellationToken? cancellationToken)
+            bool? reset = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (account == null)
             {
@@ -6177,7 +14762,7 @@ namespace Nakama
 
             var queryParams = "";
             if (reset != null) {
-                queryParams = string.Concat(queryParams, "reset=", reset.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "reset=", Uri.EscapeDataString(reset.Value ? "true" : "false"), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -6188,15 +14773,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = account.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(account);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6204,13 +14793,14 @@ namespace Nakama
         /// </summary>
         public async Task<IApiGroupList> ListGroupsAsync(
             string bearerToken,
-            string name,
-            string cursor,
-            int? limit,
-            string langTag,
-            int? members,
-            bool? open,
-            CancellationToken? cancellationToken)
+            string name = null,
+            string cursor = null,
+            int? limit = null,
+            string langTag = null,
+            int? members = null,
+            bool? open = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/group";
@@ -6223,16 +14813,16 @@ namespace Nakama
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (langTag != null) {
                 queryParams = string.Concat(queryParams, "lang_tag=", Uri.EscapeDataString(langTag), "&");
             }
             if (members != null) {
-                queryParams = string.Concat(queryParams, "members=", members, "&");
+                queryParams = string.Concat(queryParams, "members=", Uri.EscapeDataString(members.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (open != null) {
-                queryParams = string.Concat(queryParams, "open=", open.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "open=", Uri.EscapeDataString(open.Value ? "true" : "false"), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -6243,14 +14833,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiGroupList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiGroupList>());
         }
 
         /// <summary>
@@ -6259,7 +14852,8 @@ namespace Nakama
         public async Task<IApiGroup> CreateGroupAsync(
             string bearerToken,
             ApiCreateGroupRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -6278,16 +14872,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiGroup>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiGroup>());
         }
 
         /// <summary>
@@ -6296,15 +14894,16 @@ namespace Nakama
         public async Task DeleteGroupAsync(
             string bearerToken,
             string groupId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
 
@@ -6316,13 +14915,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "DELETE";
+            var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6332,7 +14934,8 @@ namespace Nakama
             string bearerToken,
             string groupId,
             ApiUpdateGroupRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
@@ -6343,8 +14946,8 @@ namespace Nakama
                 throw new ArgumentException("'body' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
 
@@ -6356,15 +14959,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "PUT";
+            var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6373,16 +14980,17 @@ namespace Nakama
         public async Task AddGroupUsersAsync(
             string bearerToken,
             string groupId,
-            IEnumerable<string> userIds,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> userIds = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}/add";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}/add";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
             foreach (var elem in userIds ?? new string[0])
@@ -6398,13 +15006,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6413,16 +15024,17 @@ namespace Nakama
         public async Task BanGroupUsersAsync(
             string bearerToken,
             string groupId,
-            IEnumerable<string> userIds,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> userIds = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}/ban";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}/ban";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
             foreach (var elem in userIds ?? new string[0])
@@ -6438,13 +15050,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6453,16 +15068,17 @@ namespace Nakama
         public async Task DemoteGroupUsersAsync(
             string bearerToken,
             string groupId,
-            IEnumerable<string> userIds,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> userIds = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}/demote";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}/demote";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
             foreach (var elem in userIds ?? new string[0])
@@ -6478,13 +15094,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6493,15 +15112,16 @@ namespace Nakama
         public async Task JoinGroupAsync(
             string bearerToken,
             string groupId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}/join";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}/join";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
 
@@ -6513,13 +15133,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6528,16 +15151,17 @@ namespace Nakama
         public async Task KickGroupUsersAsync(
             string bearerToken,
             string groupId,
-            IEnumerable<string> userIds,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> userIds = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}/kick";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}/kick";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
             foreach (var elem in userIds ?? new string[0])
@@ -6553,13 +15177,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6568,15 +15195,16 @@ namespace Nakama
         public async Task LeaveGroupAsync(
             string bearerToken,
             string groupId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}/leave";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}/leave";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
 
@@ -6588,13 +15216,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6603,16 +15234,17 @@ namespace Nakama
         public async Task PromoteGroupUsersAsync(
             string bearerToken,
             string groupId,
-            IEnumerable<string> userIds,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> userIds = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}/promote";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}/promote";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
             foreach (var elem in userIds ?? new string[0])
@@ -6628,13 +15260,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6643,25 +15278,26 @@ namespace Nakama
         public async Task<IApiGroupUserList> ListGroupUsersAsync(
             string bearerToken,
             string groupId,
-            int? limit,
-            int? state,
-            string cursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            int? state = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
                 throw new ArgumentException("'groupId' is required but was null.");
             }
 
-            var urlpath = "/v2/group/{groupId}/user";
-            urlpath = urlpath.Replace("{groupId}", Uri.EscapeDataString(groupId));
+            var urlpath = "/v2/group/{group_id}/user";
+            urlpath = urlpath.Replace("{group_id}", Uri.EscapeDataString(groupId));
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (state != null) {
-                queryParams = string.Concat(queryParams, "state=", state, "&");
+                queryParams = string.Concat(queryParams, "state=", Uri.EscapeDataString(state.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -6675,14 +15311,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiGroupUserList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiGroupUserList>());
         }
 
         /// <summary>
@@ -6691,7 +15330,8 @@ namespace Nakama
         public async Task<IApiValidatePurchaseResponse> ValidatePurchaseAppleAsync(
             string bearerToken,
             ApiValidatePurchaseAppleRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -6710,16 +15350,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidatePurchaseResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidatePurchaseResponse>());
         }
 
         /// <summary>
@@ -6728,7 +15372,8 @@ namespace Nakama
         public async Task<IApiValidatePurchaseResponse> ValidatePurchaseFacebookInstantAsync(
             string bearerToken,
             ApiValidatePurchaseFacebookInstantRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -6747,16 +15392,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidatePurchaseResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidatePurchaseResponse>());
         }
 
         /// <summary>
@@ -6765,7 +15414,8 @@ namespace Nakama
         public async Task<IApiValidatePurchaseResponse> ValidatePurchaseGoogleAsync(
             string bearerToken,
             ApiValidatePurchaseGoogleRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -6784,16 +15434,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidatePurchaseResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidatePurchaseResponse>());
         }
 
         /// <summary>
@@ -6802,7 +15456,8 @@ namespace Nakama
         public async Task<IApiValidatePurchaseResponse> ValidatePurchaseHuaweiAsync(
             string bearerToken,
             ApiValidatePurchaseHuaweiRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -6821,16 +15476,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidatePurchaseResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidatePurchaseResponse>());
         }
 
         /// <summary>
@@ -6839,7 +15498,8 @@ namespace Nakama
         public async Task<IApiSubscriptionList> ListSubscriptionsAsync(
             string bearerToken,
             ApiListSubscriptionsRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -6858,16 +15518,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSubscriptionList>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSubscriptionList>());
         }
 
         /// <summary>
@@ -6876,7 +15540,8 @@ namespace Nakama
         public async Task<IApiValidateSubscriptionResponse> ValidateSubscriptionAppleAsync(
             string bearerToken,
             ApiValidateSubscriptionAppleRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -6895,16 +15560,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidateSubscriptionResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidateSubscriptionResponse>());
         }
 
         /// <summary>
@@ -6913,7 +15582,8 @@ namespace Nakama
         public async Task<IApiValidateSubscriptionResponse> ValidateSubscriptionGoogleAsync(
             string bearerToken,
             ApiValidateSubscriptionGoogleRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -6932,16 +15602,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidateSubscriptionResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidateSubscriptionResponse>());
         }
 
         /// <summary>
@@ -6950,15 +15624,16 @@ namespace Nakama
         public async Task<IApiValidatedSubscription> GetSubscriptionAsync(
             string bearerToken,
             string productId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (productId == null)
             {
                 throw new ArgumentException("'productId' is required but was null.");
             }
 
-            var urlpath = "/v2/iap/subscription/{productId}";
-            urlpath = urlpath.Replace("{productId}", Uri.EscapeDataString(productId));
+            var urlpath = "/v2/iap/subscription/{product_id}";
+            urlpath = urlpath.Replace("{product_id}", Uri.EscapeDataString(productId));
 
             var queryParams = "";
 
@@ -6970,14 +15645,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidatedSubscription>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidatedSubscription>());
         }
 
         /// <summary>
@@ -6986,15 +15664,16 @@ namespace Nakama
         public async Task DeleteLeaderboardRecordAsync(
             string bearerToken,
             string leaderboardId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (leaderboardId == null)
             {
                 throw new ArgumentException("'leaderboardId' is required but was null.");
             }
 
-            var urlpath = "/v2/leaderboard/{leaderboardId}";
-            urlpath = urlpath.Replace("{leaderboardId}", Uri.EscapeDataString(leaderboardId));
+            var urlpath = "/v2/leaderboard/{leaderboard_id}";
+            urlpath = urlpath.Replace("{leaderboard_id}", Uri.EscapeDataString(leaderboardId));
 
             var queryParams = "";
 
@@ -7006,13 +15685,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "DELETE";
+            var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7021,19 +15703,20 @@ namespace Nakama
         public async Task<IApiLeaderboardRecordList> ListLeaderboardRecordsAsync(
             string bearerToken,
             string leaderboardId,
-            IEnumerable<string> ownerIds,
-            int? limit,
-            string cursor,
-            string expiry,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> ownerIds = null,
+            int? limit = null,
+            string cursor = null,
+            long? expiry = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (leaderboardId == null)
             {
                 throw new ArgumentException("'leaderboardId' is required but was null.");
             }
 
-            var urlpath = "/v2/leaderboard/{leaderboardId}";
-            urlpath = urlpath.Replace("{leaderboardId}", Uri.EscapeDataString(leaderboardId));
+            var urlpath = "/v2/leaderboard/{leaderboard_id}";
+            urlpath = urlpath.Replace("{leaderboard_id}", Uri.EscapeDataString(leaderboardId));
 
             var queryParams = "";
             foreach (var elem in ownerIds ?? new string[0])
@@ -7041,13 +15724,13 @@ namespace Nakama
                 queryParams = string.Concat(queryParams, "owner_ids=", Uri.EscapeDataString(elem), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
             }
             if (expiry != null) {
-                queryParams = string.Concat(queryParams, "expiry=", Uri.EscapeDataString(expiry), "&");
+                queryParams = string.Concat(queryParams, "expiry=", Uri.EscapeDataString(expiry.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -7058,14 +15741,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiLeaderboardRecordList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiLeaderboardRecordList>());
         }
 
         /// <summary>
@@ -7075,7 +15761,8 @@ namespace Nakama
             string bearerToken,
             string leaderboardId,
             WriteLeaderboardRecordRequestLeaderboardRecordWrite record,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (leaderboardId == null)
             {
@@ -7086,8 +15773,8 @@ namespace Nakama
                 throw new ArgumentException("'record' is required but was null.");
             }
 
-            var urlpath = "/v2/leaderboard/{leaderboardId}";
-            urlpath = urlpath.Replace("{leaderboardId}", Uri.EscapeDataString(leaderboardId));
+            var urlpath = "/v2/leaderboard/{leaderboard_id}";
+            urlpath = urlpath.Replace("{leaderboard_id}", Uri.EscapeDataString(leaderboardId));
 
             var queryParams = "";
 
@@ -7099,16 +15786,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = record.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiLeaderboardRecord>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(record);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiLeaderboardRecord>());
         }
 
         /// <summary>
@@ -7118,10 +15809,11 @@ namespace Nakama
             string bearerToken,
             string leaderboardId,
             string ownerId,
-            int? limit,
-            string expiry,
-            string cursor,
-            CancellationToken? cancellationToken)
+            long? limit = null,
+            long? expiry = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (leaderboardId == null)
             {
@@ -7132,16 +15824,16 @@ namespace Nakama
                 throw new ArgumentException("'ownerId' is required but was null.");
             }
 
-            var urlpath = "/v2/leaderboard/{leaderboardId}/owner/{ownerId}";
-            urlpath = urlpath.Replace("{leaderboardId}", Uri.EscapeDataString(leaderboardId));
-            urlpath = urlpath.Replace("{ownerId}", Uri.EscapeDataString(ownerId));
+            var urlpath = "/v2/leaderboard/{leaderboard_id}/owner/{owner_id}";
+            urlpath = urlpath.Replace("{leaderboard_id}", Uri.EscapeDataString(leaderboardId));
+            urlpath = urlpath.Replace("{owner_id}", Uri.EscapeDataString(ownerId));
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (expiry != null) {
-                queryParams = string.Concat(queryParams, "expiry=", Uri.EscapeDataString(expiry), "&");
+                queryParams = string.Concat(queryParams, "expiry=", Uri.EscapeDataString(expiry.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -7155,14 +15847,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiLeaderboardRecordList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiLeaderboardRecordList>());
         }
 
         /// <summary>
@@ -7170,32 +15865,33 @@ namespace Nakama
         /// </summary>
         public async Task<IApiMatchList> ListMatchesAsync(
             string bearerToken,
-            int? limit,
-            bool? authoritative,
-            string label,
-            int? minSize,
-            int? maxSize,
-            string query,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            bool? authoritative = null,
+            string label = null,
+            int? minSize = null,
+            int? maxSize = null,
+            string query = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/match";
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (authoritative != null) {
-                queryParams = string.Concat(queryParams, "authoritative=", authoritative.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "authoritative=", Uri.EscapeDataString(authoritative.Value ? "true" : "false"), "&");
             }
             if (label != null) {
                 queryParams = string.Concat(queryParams, "label=", Uri.EscapeDataString(label), "&");
             }
             if (minSize != null) {
-                queryParams = string.Concat(queryParams, "min_size=", minSize, "&");
+                queryParams = string.Concat(queryParams, "min_size=", Uri.EscapeDataString(minSize.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (maxSize != null) {
-                queryParams = string.Concat(queryParams, "max_size=", maxSize, "&");
+                queryParams = string.Concat(queryParams, "max_size=", Uri.EscapeDataString(maxSize.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (query != null) {
                 queryParams = string.Concat(queryParams, "query=", Uri.EscapeDataString(query), "&");
@@ -7209,14 +15905,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiMatchList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiMatchList>());
         }
 
         /// <summary>
@@ -7224,7 +15923,8 @@ namespace Nakama
         /// </summary>
         public async Task<IApiMatchmakerStats> GetMatchmakerStatsAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/matchmaker/stats";
@@ -7239,14 +15939,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiMatchmakerStats>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiMatchmakerStats>());
         }
 
         /// <summary>
@@ -7254,8 +15957,9 @@ namespace Nakama
         /// </summary>
         public async Task DeleteNotificationsAsync(
             string bearerToken,
-            IEnumerable<string> ids,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> ids = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/notification";
@@ -7274,13 +15978,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "DELETE";
+            var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7288,16 +15995,17 @@ namespace Nakama
         /// </summary>
         public async Task<IApiNotificationList> ListNotificationsAsync(
             string bearerToken,
-            int? limit,
-            string cacheableCursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            string cacheableCursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/notification";
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cacheableCursor != null) {
                 queryParams = string.Concat(queryParams, "cacheable_cursor=", Uri.EscapeDataString(cacheableCursor), "&");
@@ -7311,14 +16019,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiNotificationList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiNotificationList>());
         }
 
         /// <summary>
@@ -7326,21 +16037,22 @@ namespace Nakama
         /// </summary>
         public async Task<IApiPartyList> ListPartiesAsync(
             string bearerToken,
-            int? limit,
-            bool? open,
-            string query,
-            string cursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            bool? open = null,
+            string query = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/party";
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (open != null) {
-                queryParams = string.Concat(queryParams, "open=", open.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "open=", Uri.EscapeDataString(open.Value ? "true" : "false"), "&");
             }
             if (query != null) {
                 queryParams = string.Concat(queryParams, "query=", Uri.EscapeDataString(query), "&");
@@ -7357,14 +16069,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiPartyList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiPartyList>());
         }
 
         /// <summary>
@@ -7372,12 +16087,12 @@ namespace Nakama
         /// </summary>
         public async Task<IApiRpc> RpcFunc2Async(
             string bearerToken,
-            string basicAuthUsername,
-            string basicAuthPassword,
+            string httpKeyAuth,
             string id,
-            string payload,
-            string httpKey,
-            CancellationToken? cancellationToken)
+            string payload = null,
+            string httpKey = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -7403,23 +16118,21 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(bearerToken))
             {
                 var header = string.Concat("Bearer ", bearerToken);
                 headers.Add("Authorization", header);
             }
-            if (!string.IsNullOrEmpty(basicAuthUsername))
+            if (!string.IsNullOrEmpty(httpKeyAuth))
             {
-                var credentials = Encoding.UTF8.GetBytes(basicAuthUsername + ":" + basicAuthPassword);
-                var header = string.Concat("Basic ", Convert.ToBase64String(credentials));
-                headers.Add("Authorization", header);
+                headers.Add("http_key", httpKeyAuth);
             }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiRpc>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiRpc>());
         }
 
         /// <summary>
@@ -7427,12 +16140,12 @@ namespace Nakama
         /// </summary>
         public async Task<IApiRpc> RpcFuncAsync(
             string bearerToken,
-            string basicAuthUsername,
-            string basicAuthPassword,
+            string httpKeyAuth,
             string id,
             string payload,
-            string httpKey,
-            CancellationToken? cancellationToken)
+            string httpKey = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -7459,25 +16172,24 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(bearerToken))
             {
                 var header = string.Concat("Bearer ", bearerToken);
                 headers.Add("Authorization", header);
             }
-            if (!string.IsNullOrEmpty(basicAuthUsername))
+            if (!string.IsNullOrEmpty(httpKeyAuth))
             {
-                var credentials = Encoding.UTF8.GetBytes(basicAuthUsername + ":" + basicAuthPassword);
-                var header = string.Concat("Basic ", Convert.ToBase64String(credentials));
-                headers.Add("Authorization", header);
+                headers.Add("http_key", httpKeyAuth);
             }
 
             byte[] content = null;
-            var jsonBody = payload.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiRpc>();
+            var writer = new ApiJsonWriter();
+            writer.WriteString(payload);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiRpc>());
         }
 
         /// <summary>
@@ -7486,7 +16198,8 @@ namespace Nakama
         public async Task SessionLogoutAsync(
             string bearerToken,
             ApiSessionLogoutRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -7505,15 +16218,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7522,7 +16239,8 @@ namespace Nakama
         public async Task<IApiStorageObjects> ReadStorageObjectsAsync(
             string bearerToken,
             ApiReadStorageObjectsRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -7541,16 +16259,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiStorageObjects>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiStorageObjects>());
         }
 
         /// <summary>
@@ -7559,7 +16281,8 @@ namespace Nakama
         public async Task<IApiStorageObjectAcks> WriteStorageObjectsAsync(
             string bearerToken,
             ApiWriteStorageObjectsRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -7578,16 +16301,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "PUT";
+            var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiStorageObjectAcks>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiStorageObjectAcks>());
         }
 
         /// <summary>
@@ -7596,7 +16323,8 @@ namespace Nakama
         public async Task DeleteStorageObjectsAsync(
             string bearerToken,
             ApiDeleteStorageObjectsRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -7615,15 +16343,19 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "PUT";
+            var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7632,10 +16364,11 @@ namespace Nakama
         public async Task<IApiStorageObjectList> ListStorageObjectsAsync(
             string bearerToken,
             string collection,
-            string userId,
-            int? limit,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string userId = null,
+            int? limit = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (collection == null)
             {
@@ -7650,7 +16383,7 @@ namespace Nakama
                 queryParams = string.Concat(queryParams, "user_id=", Uri.EscapeDataString(userId), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -7664,14 +16397,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiStorageObjectList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiStorageObjectList>());
         }
 
         /// <summary>
@@ -7681,9 +16417,10 @@ namespace Nakama
             string bearerToken,
             string collection,
             string userId,
-            int? limit,
-            string cursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (collection == null)
             {
@@ -7694,13 +16431,13 @@ namespace Nakama
                 throw new ArgumentException("'userId' is required but was null.");
             }
 
-            var urlpath = "/v2/storage/{collection}/{userId}";
+            var urlpath = "/v2/storage/{collection}/{user_id}";
             urlpath = urlpath.Replace("{collection}", Uri.EscapeDataString(collection));
-            urlpath = urlpath.Replace("{userId}", Uri.EscapeDataString(userId));
+            urlpath = urlpath.Replace("{user_id}", Uri.EscapeDataString(userId));
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -7714,14 +16451,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiStorageObjectList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiStorageObjectList>());
         }
 
         /// <summary>
@@ -7729,32 +16469,33 @@ namespace Nakama
         /// </summary>
         public async Task<IApiTournamentList> ListTournamentsAsync(
             string bearerToken,
-            int? categoryStart,
-            int? categoryEnd,
-            int? startTime,
-            int? endTime,
-            int? limit,
-            string cursor,
-            CancellationToken? cancellationToken)
+            long? categoryStart = null,
+            long? categoryEnd = null,
+            long? startTime = null,
+            long? endTime = null,
+            int? limit = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/tournament";
 
             var queryParams = "";
             if (categoryStart != null) {
-                queryParams = string.Concat(queryParams, "category_start=", categoryStart, "&");
+                queryParams = string.Concat(queryParams, "category_start=", Uri.EscapeDataString(categoryStart.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (categoryEnd != null) {
-                queryParams = string.Concat(queryParams, "category_end=", categoryEnd, "&");
+                queryParams = string.Concat(queryParams, "category_end=", Uri.EscapeDataString(categoryEnd.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (startTime != null) {
-                queryParams = string.Concat(queryParams, "start_time=", startTime, "&");
+                queryParams = string.Concat(queryParams, "start_time=", Uri.EscapeDataString(startTime.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (endTime != null) {
-                queryParams = string.Concat(queryParams, "end_time=", endTime, "&");
+                queryParams = string.Concat(queryParams, "end_time=", Uri.EscapeDataString(endTime.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -7768,14 +16509,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiTournamentList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiTournamentList>());
         }
 
         /// <summary>
@@ -7784,15 +16528,16 @@ namespace Nakama
         public async Task DeleteTournamentRecordAsync(
             string bearerToken,
             string tournamentId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (tournamentId == null)
             {
                 throw new ArgumentException("'tournamentId' is required but was null.");
             }
 
-            var urlpath = "/v2/tournament/{tournamentId}";
-            urlpath = urlpath.Replace("{tournamentId}", Uri.EscapeDataString(tournamentId));
+            var urlpath = "/v2/tournament/{tournament_id}";
+            urlpath = urlpath.Replace("{tournament_id}", Uri.EscapeDataString(tournamentId));
 
             var queryParams = "";
 
@@ -7804,13 +16549,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "DELETE";
+            var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7819,19 +16567,20 @@ namespace Nakama
         public async Task<IApiTournamentRecordList> ListTournamentRecordsAsync(
             string bearerToken,
             string tournamentId,
-            IEnumerable<string> ownerIds,
-            int? limit,
-            string cursor,
-            string expiry,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> ownerIds = null,
+            int? limit = null,
+            string cursor = null,
+            long? expiry = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (tournamentId == null)
             {
                 throw new ArgumentException("'tournamentId' is required but was null.");
             }
 
-            var urlpath = "/v2/tournament/{tournamentId}";
-            urlpath = urlpath.Replace("{tournamentId}", Uri.EscapeDataString(tournamentId));
+            var urlpath = "/v2/tournament/{tournament_id}";
+            urlpath = urlpath.Replace("{tournament_id}", Uri.EscapeDataString(tournamentId));
 
             var queryParams = "";
             foreach (var elem in ownerIds ?? new string[0])
@@ -7839,13 +16588,13 @@ namespace Nakama
                 queryParams = string.Concat(queryParams, "owner_ids=", Uri.EscapeDataString(elem), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
             }
             if (expiry != null) {
-                queryParams = string.Concat(queryParams, "expiry=", Uri.EscapeDataString(expiry), "&");
+                queryParams = string.Concat(queryParams, "expiry=", Uri.EscapeDataString(expiry.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -7856,14 +16605,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiTournamentRecordList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiTournamentRecordList>());
         }
 
         /// <summary>
@@ -7873,7 +16625,8 @@ namespace Nakama
             string bearerToken,
             string tournamentId,
             WriteTournamentRecordRequestTournamentRecordWrite record,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (tournamentId == null)
             {
@@ -7884,8 +16637,8 @@ namespace Nakama
                 throw new ArgumentException("'record' is required but was null.");
             }
 
-            var urlpath = "/v2/tournament/{tournamentId}";
-            urlpath = urlpath.Replace("{tournamentId}", Uri.EscapeDataString(tournamentId));
+            var urlpath = "/v2/tournament/{tournament_id}";
+            urlpath = urlpath.Replace("{tournament_id}", Uri.EscapeDataString(tournamentId));
 
             var queryParams = "";
 
@@ -7897,16 +16650,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = record.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiLeaderboardRecord>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(record);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiLeaderboardRecord>());
         }
 
         /// <summary>
@@ -7916,7 +16673,8 @@ namespace Nakama
             string bearerToken,
             string tournamentId,
             WriteTournamentRecordRequestTournamentRecordWrite record,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (tournamentId == null)
             {
@@ -7927,8 +16685,8 @@ namespace Nakama
                 throw new ArgumentException("'record' is required but was null.");
             }
 
-            var urlpath = "/v2/tournament/{tournamentId}";
-            urlpath = urlpath.Replace("{tournamentId}", Uri.EscapeDataString(tournamentId));
+            var urlpath = "/v2/tournament/{tournament_id}";
+            urlpath = urlpath.Replace("{tournament_id}", Uri.EscapeDataString(tournamentId));
 
             var queryParams = "";
 
@@ -7940,16 +16698,20 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "PUT";
+            var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = record.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiLeaderboardRecord>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(record);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiLeaderboardRecord>());
         }
 
         /// <summary>
@@ -7958,15 +16720,16 @@ namespace Nakama
         public async Task JoinTournamentAsync(
             string bearerToken,
             string tournamentId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (tournamentId == null)
             {
                 throw new ArgumentException("'tournamentId' is required but was null.");
             }
 
-            var urlpath = "/v2/tournament/{tournamentId}/join";
-            urlpath = urlpath.Replace("{tournamentId}", Uri.EscapeDataString(tournamentId));
+            var urlpath = "/v2/tournament/{tournament_id}/join";
+            urlpath = urlpath.Replace("{tournament_id}", Uri.EscapeDataString(tournamentId));
 
             var queryParams = "";
 
@@ -7978,13 +16741,16 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "POST";
+            var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7994,10 +16760,11 @@ namespace Nakama
             string bearerToken,
             string tournamentId,
             string ownerId,
-            int? limit,
-            string expiry,
-            string cursor,
-            CancellationToken? cancellationToken)
+            long? limit = null,
+            long? expiry = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (tournamentId == null)
             {
@@ -8008,16 +16775,16 @@ namespace Nakama
                 throw new ArgumentException("'ownerId' is required but was null.");
             }
 
-            var urlpath = "/v2/tournament/{tournamentId}/owner/{ownerId}";
-            urlpath = urlpath.Replace("{tournamentId}", Uri.EscapeDataString(tournamentId));
-            urlpath = urlpath.Replace("{ownerId}", Uri.EscapeDataString(ownerId));
+            var urlpath = "/v2/tournament/{tournament_id}/owner/{owner_id}";
+            urlpath = urlpath.Replace("{tournament_id}", Uri.EscapeDataString(tournamentId));
+            urlpath = urlpath.Replace("{owner_id}", Uri.EscapeDataString(ownerId));
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (expiry != null) {
-                queryParams = string.Concat(queryParams, "expiry=", Uri.EscapeDataString(expiry), "&");
+                queryParams = string.Concat(queryParams, "expiry=", Uri.EscapeDataString(expiry.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -8031,14 +16798,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiTournamentRecordList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiTournamentRecordList>());
         }
 
         /// <summary>
@@ -8046,10 +16816,11 @@ namespace Nakama
         /// </summary>
         public async Task<IApiUsers> GetUsersAsync(
             string bearerToken,
-            IEnumerable<string> ids,
-            IEnumerable<string> usernames,
-            IEnumerable<string> facebookIds,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> ids = null,
+            IEnumerable<string> usernames = null,
+            IEnumerable<string> facebookIds = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/user";
@@ -8076,14 +16847,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiUsers>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiUsers>());
         }
 
         /// <summary>
@@ -8092,25 +16866,26 @@ namespace Nakama
         public async Task<IApiUserGroupList> ListUserGroupsAsync(
             string bearerToken,
             string userId,
-            int? limit,
-            int? state,
-            string cursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            int? state = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (userId == null)
             {
                 throw new ArgumentException("'userId' is required but was null.");
             }
 
-            var urlpath = "/v2/user/{userId}/group";
-            urlpath = urlpath.Replace("{userId}", Uri.EscapeDataString(userId));
+            var urlpath = "/v2/user/{user_id}/group";
+            urlpath = urlpath.Replace("{user_id}", Uri.EscapeDataString(userId));
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (state != null) {
-                queryParams = string.Concat(queryParams, "state=", state, "&");
+                queryParams = string.Concat(queryParams, "state=", Uri.EscapeDataString(state.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -8124,14 +16899,17 @@ namespace Nakama
                 Query = queryParams
             }.Uri;
 
-            var method = "GET";
+            var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(method, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiUserGroupList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiUserGroupList>());
         }
     }
 }
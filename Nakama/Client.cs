@@ -250,7 +250,7 @@ namespace Nakama
                         PublicKeyUrl = publicKeyUrl,
                         Salt = salt,
                         Signature = signature,
-                        TimestampSeconds = timestamp,
+                        _timestampSeconds = timestamp,
                         _vars = vars
                     }, create, username, canceller),
                 new RetryHistory(bundleId, retryConfiguration ?? GlobalRetryConfiguration, canceller));
@@ -717,7 +717,7 @@ namespace Nakama
                     PublicKeyUrl = publicKeyUrl,
                     Salt = salt,
                     Signature = signature,
-                    TimestampSeconds = timestamp
+                    _timestampSeconds = timestamp
                 }, canceller), new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
         }
 
@@ -852,7 +852,7 @@ namespace Nakama
 
             var response = await _retryInvoker.InvokeWithRetry(() => _apiClient.ListLeaderboardRecordsAsync(
                     session.AuthToken, leaderboardId, ownerIds, limit,
-                    cursor, expiry?.ToString(), canceller),
+                    cursor, expiry, canceller),
                 new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
 
             foreach (var record in response.Records)
@@ -891,7 +891,7 @@ namespace Nakama
 
             var response = await _retryInvoker.InvokeWithRetry(() => _apiClient.ListLeaderboardRecordsAroundOwnerAsync(
                     session.AuthToken, leaderboardId, ownerId,
-                    limit, expiry?.ToString(), cursor, canceller),
+                    limit, expiry, cursor, canceller),
                 new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
 
             foreach (var record in response.Records)
@@ -1030,7 +1030,7 @@ namespace Nakama
 
             var response = await _retryInvoker.InvokeWithRetry(() => _apiClient.ListTournamentRecordsAroundOwnerAsync(
                     session.AuthToken, tournamentId, ownerId,
-                    limit, expiry?.ToString(), cursor, canceller),
+                    limit, expiry, cursor, canceller),
                 new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
 
             foreach (var record in response.Records)
@@ -1069,7 +1069,7 @@ namespace Nakama
 
             var response = await _retryInvoker.InvokeWithRetry(() => _apiClient.ListTournamentRecordsAsync(
                     session.AuthToken, tournamentId, ownerIds, limit, cursor,
-                    expiry?.ToString(), canceller),
+                    expiry, canceller),
                 new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
 
             foreach (var record in response.Records)
@@ -1201,7 +1201,7 @@ namespace Nakama
             }
 
             return await _retryInvoker.InvokeWithRetry(
-                () => _apiClient.RpcFuncAsync(session.AuthToken, string.Empty, string.Empty, id, payload, null, canceller),
+                () => _apiClient.RpcFuncAsync(session.AuthToken, null, id, payload, null, canceller),
                 new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
         }
 
@@ -1216,20 +1216,20 @@ namespace Nakama
             }
 
             return await _retryInvoker.InvokeWithRetry(
-                () => _apiClient.RpcFunc2Async(session.AuthToken, string.Empty, string.Empty, id, null, null, canceller),
+                () => _apiClient.RpcFunc2Async(session.AuthToken, null, id, null, null, canceller),
                 new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
         }
 
         /// <inheritdoc cref="RpcAsync(string,string,string,RetryConfiguration,CancellationToken)"/>
         public Task<IApiRpc> RpcAsync(string httpkey, string id, string payload,
             RetryConfiguration retryConfiguration = null, CancellationToken canceller = default) =>
-            _retryInvoker.InvokeWithRetry(() => _apiClient.RpcFuncAsync(null, string.Empty, string.Empty, id, payload, httpkey, canceller),
+            _retryInvoker.InvokeWithRetry(() => _apiClient.RpcFuncAsync(null, null, id, payload, httpkey, canceller),
                 new RetryHistory(id, retryConfiguration ?? GlobalRetryConfiguration, canceller));
 
         /// <inheritdoc cref="RpcAsync(string,string,RetryConfiguration,CancellationToken)"/>
         public Task<IApiRpc> RpcAsync(string httpkey, string id, RetryConfiguration retryConfiguration = null,
             CancellationToken canceller = default) =>
-            _retryInvoker.InvokeWithRetry(() => _apiClient.RpcFunc2Async(null, string.Empty, string.Empty, id, null, httpkey, canceller),
+            _retryInvoker.InvokeWithRetry(() => _apiClient.RpcFunc2Async(null, null, id, null, httpkey, canceller),
                 new RetryHistory(id, retryConfiguration ?? GlobalRetryConfiguration, canceller));
 
         /// <inheritdoc cref="SessionLogoutAsync(Nakama.ISession,RetryConfiguration,CancellationToken)"/>
@@ -1377,7 +1377,7 @@ namespace Nakama
                     PublicKeyUrl = publicKeyUrl,
                     Salt = salt,
                     Signature = signature,
-                    TimestampSeconds = timestamp
+                    _timestampSeconds = timestamp
                 }, canceller), new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
         }
 
@@ -1592,9 +1592,9 @@ namespace Nakama
                 new WriteLeaderboardRecordRequestLeaderboardRecordWrite
                 {
                     Metadata = metadata,
-                    Score = score.ToString(),
-                    Subscore = subScore.ToString(),
-                    _operator = apiOperator
+                    Score = score,
+                    Subscore = subScore,
+                    Operator = apiOperator
                 }, canceller), new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
         }
 
@@ -1644,9 +1644,9 @@ namespace Nakama
                 new WriteTournamentRecordRequestTournamentRecordWrite
                 {
                     Metadata = metadata,
-                    Score = score.ToString(),
-                    Subscore = subScore.ToString(),
-                    _operator = apiOperator
+                    Score = score,
+                    Subscore = subScore,
+                    Operator = apiOperator
                 }, canceller), new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, canceller));
         }
     }
@@ -3,16 +3,17 @@ namespace Nakama.Console
 {
     using System;
     using System.Collections.Generic;
+    using System.Globalization;
+    using System.IO;
     using System.Runtime.Serialization;
     using System.Text;
     using System.Threading;
     using System.Threading.Tasks;
-    using TinyJson;
 
     /// <summary>
     /// An exception generated for <c>HttpResponse</c> objects don't return a success status.
     /// </summary>
-    public sealed class ApiResponseException : Exception
+    public class ApiResponseException : Exception
     {
         public long StatusCode { get; }
 
@@ -34,9 +35,776 @@ namespace Nakama.Console
         {
         }
 
+        protected ApiResponseException(ApiResponseException e) : base(e.Message, e)
+        {
+            StatusCode = e.StatusCode;
+            GrpcStatusCode = e.GrpcStatusCode;
+            foreach (var key in e.Data.Keys)
+            {
+                Data[key] = e.Data[key];
+            }
+        }
+
         public override string ToString()
         {
-            return $"ApiResponseException(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
+            return $"{GetType().Name}(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
+        }
+    }
+
+    /// <summary>
+    /// A failure the server reported with a gRPC status code.
+    /// </summary>
+    public class ApiStatusException : ApiResponseException
+    {
+        /// <summary>
+        /// The error response, with the details the <see cref="IHttpAdapter"/> passed on in the exception data.
+        /// </summary>
+        public IRpcStatus Status { get; }
+
+        public ApiStatusException(ApiResponseException e) : base(e)
+        {
+            var status = new Dictionary<string, object>
+            {
+                {"code", e.GrpcStatusCode},
+                {"message", e.Message}
+            };
+            if (e.Data.Contains("details"))
+            {
+                status["details"] = e.Data["details"];
+            }
+            var writer = new ApiJsonWriter();
+            writer.WriteAny(status);
+            Status = new ApiJsonReader(writer.ToString()).ReadObject<RpcStatus>();
+        }
+
+        /// <summary>
+        /// The exception for the gRPC status code of a failure, or null when the code is not a known one.
+        /// </summary>
+        internal static ApiStatusException FromResponse(ApiResponseException e)
+        {
+            switch (e.GrpcStatusCode)
+            {
+                case 1:
+                    return new CancelledException(e);
+                case 2:
+                    return new UnknownException(e);
+                case 3:
+                    return new InvalidArgumentException(e);
+                case 4:
+                    return new DeadlineExceededException(e);
+                case 5:
+                    return new NotFoundException(e);
+                case 6:
+                    return new AlreadyExistsException(e);
+                case 7:
+                    return new PermissionDeniedException(e);
+                case 8:
+                    return new ResourceExhaustedException(e);
+                case 9:
+                    return new FailedPreconditionException(e);
+                case 10:
+                    return new AbortedException(e);
+                case 11:
+                    return new OutOfRangeException(e);
+                case 12:
+                    return new UnimplementedException(e);
+                case 13:
+                    return new InternalException(e);
+                case 14:
+                    return new UnavailableException(e);
+                case 15:
+                    return new DataLossException(e);
+                case 16:
+                    return new UnauthenticatedException(e);
+                default:
+                    return null;
+            }
+        }
+    }
+
+    /// <summary>
+    /// The operation was cancelled, typically by the caller.
+    /// </summary>
+    public class CancelledException : ApiStatusException
+    {
+        public CancelledException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// An unknown error.
+    /// </summary>
+    public class UnknownException : ApiStatusException
+    {
+        public UnknownException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The client specified an invalid argument.
+    /// </summary>
+    public class InvalidArgumentException : ApiStatusException
+    {
+        public InvalidArgumentException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The deadline expired before the operation could complete.
+    /// </summary>
+    public class DeadlineExceededException : ApiStatusException
+    {
+        public DeadlineExceededException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// Some requested entity was not found.
+    /// </summary>
+    public class NotFoundException : ApiStatusException
+    {
+        public NotFoundException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The entity a client attempted to create already exists.
+    /// </summary>
+    public class AlreadyExistsException : ApiStatusException
+    {
+        public AlreadyExistsException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The caller does not have permission to execute the operation.
+    /// </summary>
+    public class PermissionDeniedException : ApiStatusException
+    {
+        public PermissionDeniedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// Some resource has been exhausted, such as a per-user quota.
+    /// </summary>
+    public class ResourceExhaustedException : ApiStatusException
+    {
+        public ResourceExhaustedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The system is not in a state required for the operation's execution.
+    /// </summary>
+    public class FailedPreconditionException : ApiStatusException
+    {
+        public FailedPreconditionException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The operation was aborted, typically due to a concurrency issue.
+    /// </summary>
+    public class AbortedException : ApiStatusException
+    {
+        public AbortedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The operation was attempted past the valid range.
+    /// </summary>
+    public class OutOfRangeException : ApiStatusException
+    {
+        public OutOfRangeException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The operation is not implemented or not supported.
+    /// </summary>
+    public class UnimplementedException : ApiStatusException
+    {
+        public UnimplementedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// An internal error of the server.
+    /// </summary>
+    public class InternalException : ApiStatusException
+    {
+        public InternalException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The service is currently unavailable.
+    /// </summary>
+    public class UnavailableException : ApiStatusException
+    {
+        public UnavailableException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// Unrecoverable data loss or corruption.
+    /// </summary>
+    public class DataLossException : ApiStatusException
+    {
+        public DataLossException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+    /// <summary>
+    /// The request does not have valid authentication credentials.
+    /// </summary>
+    public class UnauthenticatedException : ApiStatusException
+    {
+        public UnauthenticatedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
+
+
+    /// <summary>
+    /// Options of a single request, which override those of the client.
+    /// </summary>
+    public class RequestOptions
+    {
+        /// <summary>
+        /// The timeout of the request in seconds, in place of the timeout of the client.
+        /// </summary>
+        public int? Timeout { get; set; }
+
+        /// <summary>
+        /// Headers sent with the request, which replace those of the same name set by the method.
+        /// </summary>
+        public IDictionary<string, string> Headers { get; set; }
+
+        /// <summary>
+        /// The token which cancels the request, unless one is passed to the method.
+        /// </summary>
+        public CancellationToken? CancellationToken { get; set; }
+
+        /// <summary>
+        /// The retry configuration of the request, used by clients which retry it.
+        /// </summary>
+        public RetryConfiguration RetryConfiguration { get; set; }
+    }
+
+    /// <summary>
+    /// A model which reads and writes its own members as JSON, without reflection.
+    /// </summary>
+    internal interface IApiJsonObject
+    {
+        /// <summary>
+        /// Reads the value of a member, and returns false when the model has no member of that name.
+        /// </summary>
+        bool ReadMember(string name, ApiJsonReader reader);
+
+        /// <summary>
+        /// Writes the members which are set.
+        /// </summary>
+        void WriteMembers(ApiJsonWriter writer);
+    }
+
+    /// <summary>
+    /// A forward-only reader of JSON text, which values are read from in the order they appear.
+    /// </summary>
+    internal sealed class ApiJsonReader
+    {
+        private readonly string _json;
+        private int _position;
+
+        public ApiJsonReader(string json)
+        {
+            _json = json;
+        }
+
+        /// <summary>
+        /// Reads an object into a new model, or returns null for a JSON null. Members the model doesn't know are skipped.
+        /// </summary>
+        public T ReadObject<T>() where T : class, IApiJsonObject, new()
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var value = new T();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var name = ReadName();
+                    if (!value.ReadMember(name, this))
+                    {
+                        Skip();
+                    }
+                } while (Consume(','));
+                Expect('}');
+            }
+            return value;
+        }
+
+        public List<T> ReadList<T>(Func<T> readItem)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var list = new List<T>();
+            Expect('[');
+            if (!Consume(']'))
+            {
+                do
+                {
+                    list.Add(readItem());
+                } while (Consume(','));
+                Expect(']');
+            }
+            return list;
+        }
+
+        public Dictionary<string, T> ReadMap<T>(Func<T> readValue)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var map = new Dictionary<string, T>();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var key = ReadName();
+                    map[key] = readValue();
+                } while (Consume(','));
+                Expect('}');
+            }
+            return map;
+        }
+
+        public T? ReadNullable<T>(Func<T> read) where T : struct => ReadNull() ? (T?) null : read();
+
+        /// <summary>
+        /// Reads a string, or the text of a number or literal in its place.
+        /// </summary>
+        public string ReadString()
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+            if (Peek() != '"')
+            {
+                return ReadLiteral();
+            }
+
+            _position++;
+            StringBuilder builder = null;
+            var start = _position;
+            while (_position < _json.Length)
+            {
+                var c = _json[_position];
+                if (c == '"')
+                {
+                    var value = builder == null
+                        ? _json.Substring(start, _position - start)
+                        : builder.Append(_json, start, _position - start).ToString();
+                    _position++;
+                    return value;
+                }
+                if (c != '\\')
+                {
+                    _position++;
+                    continue;
+                }
+
+                if (builder == null)
+                {
+                    builder = new StringBuilder();
+                }
+                builder.Append(_json, start, _position - start);
+                if (++_position >= _json.Length)
+                {
+                    break;
+                }
+                switch (_json[_position])
+                {
+                    case 'b':
+                        builder.Append('\b');
+                        break;
+                    case 'f':
+                        builder.Append('\f');
+                        break;
+                    case 'n':
+                        builder.Append('\n');
+                        break;
+                    case 'r':
+                        builder.Append('\r');
+                        break;
+                    case 't':
+                        builder.Append('\t');
+                        break;
+                    case 'u':
+                        if (_position + 4 >= _json.Length ||
+                            !int.TryParse(_json.Substring(_position + 1, 4), NumberStyles.AllowHexSpecifier,
+                                CultureInfo.InvariantCulture, out var code))
+                        {
+                            throw Error("invalid unicode escape");
+                        }
+                        builder.Append((char) code);
+                        _position += 4;
+                        break;
+                    default:
+                        builder.Append(_json[_position]);
+                        break;
+                }
+                start = ++_position;
+            }
+            throw Error("unterminated string");
+        }
+
+        public int ReadInt32() => int.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public long ReadInt64() => long.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public ulong ReadUInt64() => ulong.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public double ReadDouble() => double.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public float ReadSingle() => float.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public bool ReadBoolean() => ReadString() == "true";
+
+        /// <summary>
+        /// Skips the next value, whatever its type.
+        /// </summary>
+        public void Skip()
+        {
+            switch (Peek())
+            {
+                case '{':
+                    ReadMap(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                case '[':
+                    ReadList(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                default:
+                    ReadString();
+                    break;
+            }
+        }
+
+        private string ReadName()
+        {
+            if (Peek() != '"')
+            {
+                throw Error("expected a member name");
+            }
+            var name = ReadString();
+            Expect(':');
+            return name;
+        }
+
+        // Numbers which don't fit a double are sent as strings, and a null is read as the default value.
+        private string ReadNumber() => ReadString() ?? "0";
+
+        private string ReadLiteral()
+        {
+            var start = _position;
+            while (_position < _json.Length && ",:]} \t\r\n".IndexOf(_json[_position]) < 0)
+            {
+                _position++;
+            }
+            if (_position == start)
+            {
+                throw Error("expected a value");
+            }
+            return _json.Substring(start, _position - start);
+        }
+
+        private bool ReadNull()
+        {
+            if (Peek() != 'n' || string.CompareOrdinal(_json, _position, "null", 0, 4) != 0)
+            {
+                return false;
+            }
+            _position += 4;
+            return true;
+        }
+
+        private bool Consume(char c)
+        {
+            if (Peek() != c)
+            {
+                return false;
+            }
+            _position++;
+            return true;
+        }
+
+        private void Expect(char c)
+        {
+            if (!Consume(c))
+            {
+                throw Error(string.Concat("expected '", c.ToString(), "'"));
+            }
+        }
+
+        private char Peek()
+        {
+            while (_position < _json.Length && char.IsWhiteSpace(_json[_position]))
+            {
+                _position++;
+            }
+            return _position < _json.Length ? _json[_position] : '\0';
+        }
+
+        private FormatException Error(string message) =>
+            new FormatException(string.Concat("Invalid JSON at position ",
+                _position.ToString(CultureInfo.InvariantCulture), ": ", message));
+    }
+
+    /// <summary>
+    /// A writer of JSON text, which values are written to in order.
+    /// </summary>
+    internal sealed class ApiJsonWriter
+    {
+        private readonly StringBuilder _builder = new StringBuilder();
+        private bool _separate;
+
+        public void WriteName(string name)
+        {
+            WriteString(name);
+            _builder.Append(':');
+            _separate = false;
+        }
+
+        public void WriteObject(IApiJsonObject value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            value.WriteMembers(this);
+            _builder.Append('}');
+            _separate = true;
+        }
+
+        public void WriteList<T>(IEnumerable<T> values, Action<T> writeItem)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('[');
+            _separate = false;
+            foreach (var value in values)
+            {
+                writeItem(value);
+            }
+            _builder.Append(']');
+            _separate = true;
+        }
+
+        public void WriteMap<T>(IDictionary<string, T> values, Action<T> writeValue)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            foreach (var kvp in values)
+            {
+                WriteName(kvp.Key);
+                writeValue(kvp.Value);
+            }
+            _builder.Append('}');
+            _separate = true;
+        }
+
+        public void WriteString(string value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('"');
+            foreach (var c in value)
+            {
+                switch (c)
+                {
+                    case '"':
+                        _builder.Append("\\\"");
+                        break;
+                    case '\\':
+                        _builder.Append("\\\\");
+                        break;
+                    case '\n':
+                        _builder.Append("\\n");
+                        break;
+                    case '\r':
+                        _builder.Append("\\r");
+                        break;
+                    case '\t':
+                        _builder.Append("\\t");
+                        break;
+                    default:
+                        if (c < ' ')
+                        {
+                            _builder.Append("\\u").Append(((int) c).ToString("x4", CultureInfo.InvariantCulture));
+                        }
+                        else
+                        {
+                            _builder.Append(c);
+                        }
+                        break;
+                }
+            }
+            _builder.Append('"');
+            _separate = true;
+        }
+
+        public void WriteInt32(int value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteInt64(long value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteUInt64(ulong value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteDouble(double value)
+        {
+            // JSON has no literal for NaN or infinities, which are sent as strings.
+            if (double.IsNaN(value) || double.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
+
+        public void WriteSingle(float value)
+        {
+            // Formatted as a float, so that it isn't widened to a double with digits it never had, e.g. 0.1f.
+            if (float.IsNaN(value) || float.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
+
+        public void WriteBoolean(bool value) => WriteLiteral(value ? "true" : "false");
+
+        public void WriteNull() => WriteLiteral("null");
+
+        /// <summary>
+        /// Writes a value of unknown type, as parsed into dictionaries, lists and primitives.
+        /// </summary>
+        public void WriteAny(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    WriteNull();
+                    break;
+                case string s:
+                    WriteString(s);
+                    break;
+                case bool b:
+                    WriteBoolean(b);
+                    break;
+                case int i:
+                    WriteInt32(i);
+                    break;
+                case long l:
+                    WriteInt64(l);
+                    break;
+                case double d:
+                    WriteDouble(d);
+                    break;
+                case float f:
+                    WriteSingle(f);
+                    break;
+                case IApiJsonObject o:
+                    WriteObject(o);
+                    break;
+                case IDictionary<string, object> map:
+                    WriteMap(map, WriteAny);
+                    break;
+                case System.Collections.IEnumerable list:
+                    Separate();
+                    _builder.Append('[');
+                    _separate = false;
+                    foreach (var item in list)
+                    {
+                        WriteAny(item);
+                    }
+                    _builder.Append(']');
+                    _separate = true;
+                    break;
+                default:
+                    WriteString(Convert.ToString(value, CultureInfo.InvariantCulture));
+                    break;
+            }
+        }
+
+        public override string ToString() => _builder.ToString();
+
+        private void WriteLiteral(string value)
+        {
+            Separate();
+            _builder.Append(value);
+            _separate = true;
+        }
+
+        private void Separate()
+        {
+            if (_separate)
+            {
+                _builder.Append(',');
+            }
         }
     }
 
@@ -55,10 +823,15 @@ namespace Nakama.Console
         /// Whether it is a join request.
         /// </summary>
         bool JoinRequest { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_AddGroupUsersRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiConsole_AddGroupUsersRequest : IApiConsole_AddGroupUsersRequest
+    internal class ApiConsole_AddGroupUsersRequest : IApiConsole_AddGroupUsersRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -69,12 +842,81 @@ namespace Nakama.Console
         [DataMember(Name="join_request"), Preserve]
         public bool JoinRequest { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "ids":
+                    Ids = reader.ReadString();
+                    return true;
+                case "join_request":
+                    JoinRequest = reader.ReadBoolean();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Ids != null)
+            {
+                writer.WriteName("ids");
+                writer.WriteString(Ids);
+            }
+            writer.WriteName("join_request");
+            writer.WriteBoolean(JoinRequest);
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_AddGroupUsersRequest Clone() => (ApiConsole_AddGroupUsersRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiConsole_AddGroupUsersRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiConsole_AddGroupUsersRequest) obj;
+            return Ids == other.Ids &&
+                JoinRequest == other.JoinRequest;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Ids?.GetHashCode() ?? 0);
+                hash = hash * 31 + JoinRequest.GetHashCode();
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Ids: ", Ids, ", ");
-            output = string.Concat(output, "JoinRequest: ", JoinRequest, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Ids: " + ApiClient.FormatValue(Ids));
+            members.Add("JoinRequest: " + ApiClient.FormatValue(JoinRequest));
         }
     }
 
@@ -98,10 +940,15 @@ namespace Nakama.Console
         /// 
         /// </summary>
         string UserId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_CallApiEndpointRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiConsole_CallApiEndpointRequest : IApiConsole_CallApiEndpointRequest
+    internal class ApiConsole_CallApiEndpointRequest : IApiConsole_CallApiEndpointRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -118,19 +965,96 @@ namespace Nakama.Console
         [DataMember(Name="user_id"), Preserve]
         public string UserId { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "body":
+                    Body = reader.ReadString();
+                    return true;
+                case "session_vars":
+                    _sessionVars = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Body != null)
+            {
+                writer.WriteName("body");
+                writer.WriteString(Body);
+            }
+            if (_sessionVars != null)
+            {
+                writer.WriteName("session_vars");
+                writer.WriteMap(_sessionVars, writer.WriteString);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_CallApiEndpointRequest Clone() => (ApiConsole_CallApiEndpointRequest) CloneModel();
+
+        internal virtual object CloneModel()
         {
-            var output = "";
-            output = string.Concat(output, "Body: ", Body, ", ");
+            var clone = (ApiConsole_CallApiEndpointRequest) MemberwiseClone();
+            clone._sessionVars = ApiClient.ConvertMap<string, string>(_sessionVars, item => item);
+            return clone;
+        }
 
-            var session_varsString = "";
-            foreach (var kvp in SessionVars)
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
             {
-                session_varsString = string.Concat(session_varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                return true;
             }
-            output = string.Concat(output, "SessionVars: [" + session_varsString + "]");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiConsole_CallApiEndpointRequest) obj;
+            return Body == other.Body &&
+                ApiClient.MapEquals(_sessionVars, other._sessionVars) &&
+                UserId == other.UserId;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Body?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_sessionVars);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Body: " + ApiClient.FormatValue(Body));
+            members.Add("SessionVars: " + ApiClient.FormatMap(_sessionVars));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
         }
     }
 
@@ -154,10 +1078,15 @@ namespace Nakama.Console
         /// 
         /// </summary>
         string UserId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_CallRpcEndpointRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiConsole_CallRpcEndpointRequest : IApiConsole_CallRpcEndpointRequest
+    internal class ApiConsole_CallRpcEndpointRequest : IApiConsole_CallRpcEndpointRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -171,22 +1100,99 @@ namespace Nakama.Console
         public Dictionary<string, string> _sessionVars { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="user_id"), Preserve]
-        public string UserId { get; set; }
+        [DataMember(Name="user_id"), Preserve]
+        public string UserId { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "body":
+                    Body = reader.ReadString();
+                    return true;
+                case "session_vars":
+                    _sessionVars = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Body != null)
+            {
+                writer.WriteName("body");
+                writer.WriteString(Body);
+            }
+            if (_sessionVars != null)
+            {
+                writer.WriteName("session_vars");
+                writer.WriteMap(_sessionVars, writer.WriteString);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_CallRpcEndpointRequest Clone() => (ApiConsole_CallRpcEndpointRequest) CloneModel();
 
-        public override string ToString()
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiConsole_CallRpcEndpointRequest) MemberwiseClone();
+            clone._sessionVars = ApiClient.ConvertMap<string, string>(_sessionVars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
         {
-            var output = "";
-            output = string.Concat(output, "Body: ", Body, ", ");
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiConsole_CallRpcEndpointRequest) obj;
+            return Body == other.Body &&
+                ApiClient.MapEquals(_sessionVars, other._sessionVars) &&
+                UserId == other.UserId;
+        }
 
-            var session_varsString = "";
-            foreach (var kvp in SessionVars)
+        public override int GetHashCode()
+        {
+            unchecked
             {
-                session_varsString = string.Concat(session_varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                var hash = 17;
+                hash = hash * 31 + (Body?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_sessionVars);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
             }
-            output = string.Concat(output, "SessionVars: [" + session_varsString + "]");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Body: " + ApiClient.FormatValue(Body));
+            members.Add("SessionVars: " + ApiClient.FormatMap(_sessionVars));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
         }
     }
 
@@ -200,21 +1206,85 @@ namespace Nakama.Console
         /// Required.
         /// </summary>
         bool Required { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_RequireUserMfaRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiConsole_RequireUserMfaRequest : IApiConsole_RequireUserMfaRequest
+    internal class ApiConsole_RequireUserMfaRequest : IApiConsole_RequireUserMfaRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="required"), Preserve]
         public bool Required { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "required":
+                    Required = reader.ReadBoolean();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("required");
+            writer.WriteBoolean(Required);
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_RequireUserMfaRequest Clone() => (ApiConsole_RequireUserMfaRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiConsole_RequireUserMfaRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiConsole_RequireUserMfaRequest) obj;
+            return Required == other.Required;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Required.GetHashCode();
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Required: ", Required, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Required: " + ApiClient.FormatValue(Required));
         }
     }
 
@@ -228,21 +1298,88 @@ namespace Nakama.Console
         /// Device ID to unlink.
         /// </summary>
         string DeviceId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_UnlinkDeviceRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiConsole_UnlinkDeviceRequest : IApiConsole_UnlinkDeviceRequest
+    internal class ApiConsole_UnlinkDeviceRequest : IApiConsole_UnlinkDeviceRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="device_id"), Preserve]
         public string DeviceId { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "device_id":
+                    DeviceId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (DeviceId != null)
+            {
+                writer.WriteName("device_id");
+                writer.WriteString(DeviceId);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_UnlinkDeviceRequest Clone() => (ApiConsole_UnlinkDeviceRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiConsole_UnlinkDeviceRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiConsole_UnlinkDeviceRequest) obj;
+            return DeviceId == other.DeviceId;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (DeviceId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "DeviceId: ", DeviceId, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("DeviceId: " + ApiClient.FormatValue(DeviceId));
         }
     }
 
@@ -311,10 +1448,15 @@ namespace Nakama.Console
         /// Wallet.
         /// </summary>
         string Wallet { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_UpdateAccountRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiConsole_UpdateAccountRequest : IApiConsole_UpdateAccountRequest
+    internal class ApiConsole_UpdateAccountRequest : IApiConsole_UpdateAccountRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -367,28 +1509,195 @@ namespace Nakama.Console
         [DataMember(Name="wallet"), Preserve]
         public string Wallet { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "custom_id":
+                    CustomId = reader.ReadString();
+                    return true;
+                case "device_ids":
+                    _deviceIds = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "display_name":
+                    DisplayName = reader.ReadString();
+                    return true;
+                case "email":
+                    Email = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "location":
+                    Location = reader.ReadString();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "password":
+                    Password = reader.ReadString();
+                    return true;
+                case "timezone":
+                    Timezone = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                case "wallet":
+                    Wallet = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (CustomId != null)
+            {
+                writer.WriteName("custom_id");
+                writer.WriteString(CustomId);
+            }
+            if (_deviceIds != null)
+            {
+                writer.WriteName("device_ids");
+                writer.WriteMap(_deviceIds, writer.WriteString);
+            }
+            if (DisplayName != null)
+            {
+                writer.WriteName("display_name");
+                writer.WriteString(DisplayName);
+            }
+            if (Email != null)
+            {
+                writer.WriteName("email");
+                writer.WriteString(Email);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            if (Location != null)
+            {
+                writer.WriteName("location");
+                writer.WriteString(Location);
+            }
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (Password != null)
+            {
+                writer.WriteName("password");
+                writer.WriteString(Password);
+            }
+            if (Timezone != null)
+            {
+                writer.WriteName("timezone");
+                writer.WriteString(Timezone);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+            if (Wallet != null)
+            {
+                writer.WriteName("wallet");
+                writer.WriteString(Wallet);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_UpdateAccountRequest Clone() => (ApiConsole_UpdateAccountRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiConsole_UpdateAccountRequest) MemberwiseClone();
+            clone._deviceIds = ApiClient.ConvertMap<string, string>(_deviceIds, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
         {
-            var output = "";
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "CustomId: ", CustomId, ", ");
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-            var device_idsString = "";
-            foreach (var kvp in DeviceIds)
+            var other = (ApiConsole_UpdateAccountRequest) obj;
+            return AvatarUrl == other.AvatarUrl &&
+                CustomId == other.CustomId &&
+                ApiClient.MapEquals(_deviceIds, other._deviceIds) &&
+                DisplayName == other.DisplayName &&
+                Email == other.Email &&
+                LangTag == other.LangTag &&
+                Location == other.Location &&
+                Metadata == other.Metadata &&
+                Password == other.Password &&
+                Timezone == other.Timezone &&
+                Username == other.Username &&
+                Wallet == other.Wallet;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
             {
-                device_idsString = string.Concat(device_idsString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                var hash = 17;
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (CustomId?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_deviceIds);
+                hash = hash * 31 + (DisplayName?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Email?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Location?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Password?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Timezone?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Wallet?.GetHashCode() ?? 0);
+                return hash;
             }
-            output = string.Concat(output, "DeviceIds: [" + device_idsString + "]");
-            output = string.Concat(output, "DisplayName: ", DisplayName, ", ");
-            output = string.Concat(output, "Email: ", Email, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "Location: ", Location, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "Password: ", Password, ", ");
-            output = string.Concat(output, "Timezone: ", Timezone, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            output = string.Concat(output, "Wallet: ", Wallet, ", ");
-            return output;
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("CustomId: " + ApiClient.FormatValue(CustomId));
+            members.Add("DeviceIds: " + ApiClient.FormatMap(_deviceIds));
+            members.Add("DisplayName: " + ApiClient.FormatValue(DisplayName));
+            members.Add("Email: " + ApiClient.FormatValue(Email));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("Location: " + ApiClient.FormatValue(Location));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("Password: " + ApiClient.FormatValue(Password));
+            members.Add("Timezone: " + ApiClient.FormatValue(Timezone));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
+            members.Add("Wallet: " + ApiClient.FormatValue(Wallet));
         }
     }
 
@@ -416,7 +1725,7 @@ namespace Nakama.Console
         /// <summary>
         /// The maximum number of members allowed.
         /// </summary>
-        int MaxCount { get; }
+        int? MaxCount { get; }
 
         /// <summary>
         /// Metadata.
@@ -431,11 +1740,16 @@ namespace Nakama.Console
         /// <summary>
         /// Anyone can join open groups, otherwise only admins can accept members.
         /// </summary>
-        bool Open { get; }
+        bool? Open { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_UpdateGroupRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiConsole_UpdateGroupRequest : IApiConsole_UpdateGroupRequest
+    internal class ApiConsole_UpdateGroupRequest : IApiConsole_UpdateGroupRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -452,7 +1766,7 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="max_count"), Preserve]
-        public int MaxCount { get; set; }
+        public int? MaxCount { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="metadata"), Preserve]
@@ -464,47 +1778,236 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="open"), Preserve]
-        public bool Open { get; set; }
+        public bool? Open { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "description":
+                    Description = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "max_count":
+                    MaxCount = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "open":
+                    Open = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (Description != null)
+            {
+                writer.WriteName("description");
+                writer.WriteString(Description);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            if (MaxCount != null)
+            {
+                writer.WriteName("max_count");
+                writer.WriteInt32(MaxCount.Value);
+            }
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (Open != null)
+            {
+                writer.WriteName("open");
+                writer.WriteBoolean(Open.Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_UpdateGroupRequest Clone() => (ApiConsole_UpdateGroupRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiConsole_UpdateGroupRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiConsole_UpdateGroupRequest) obj;
+            return AvatarUrl == other.AvatarUrl &&
+                Description == other.Description &&
+                LangTag == other.LangTag &&
+                Nullable.Equals(MaxCount, other.MaxCount) &&
+                Metadata == other.Metadata &&
+                Name == other.Name &&
+                Nullable.Equals(Open, other.Open);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Description?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + MaxCount.GetHashCode();
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + Open.GetHashCode();
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("Description: " + ApiClient.FormatValue(Description));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("MaxCount: " + ApiClient.FormatValue(MaxCount));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Open: " + ApiClient.FormatValue(Open));
+        }
+    }
+
+    /// <summary>
+    /// Request to update an existing setting.
+    /// </summary>
+    public interface IApiConsole_UpdateSettingRequest
+    {
+
+        /// <summary>
+        /// Setting value.
+        /// </summary>
+        string Value { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_UpdateSettingRequest Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiConsole_UpdateSettingRequest : IApiConsole_UpdateSettingRequest, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="value"), Preserve]
+        public string Value { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_UpdateSettingRequest Clone() => (ApiConsole_UpdateSettingRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiConsole_UpdateSettingRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiConsole_UpdateSettingRequest) obj;
+            return Value == other.Value;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "Description: ", Description, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "MaxCount: ", MaxCount, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "Open: ", Open, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
         }
-    }
-
-    /// <summary>
-    /// Request to update an existing setting.
-    /// </summary>
-    public interface IApiConsole_UpdateSettingRequest
-    {
-
-        /// <summary>
-        /// Setting value.
-        /// </summary>
-        string Value { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiConsole_UpdateSettingRequest : IApiConsole_UpdateSettingRequest
-    {
-
-        /// <inheritdoc />
-        [DataMember(Name="value"), Preserve]
-        public string Value { get; set; }
 
-        public override string ToString()
+        internal virtual void FormatMembers(List<string> members)
         {
-            var output = "";
-            output = string.Concat(output, "Value: ", Value, ", ");
-            return output;
+            members.Add("Value: " + ApiClient.FormatValue(Value));
         }
     }
 
@@ -517,12 +2020,12 @@ namespace Nakama.Console
         /// <summary>
         /// Read permission value.
         /// </summary>
-        int PermissionRead { get; }
+        int? PermissionRead { get; }
 
         /// <summary>
         /// Write permission value.
         /// </summary>
-        int PermissionWrite { get; }
+        int? PermissionWrite { get; }
 
         /// <summary>
         /// Value.
@@ -533,19 +2036,24 @@ namespace Nakama.Console
         /// Version for OCC.
         /// </summary>
         string Version { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiConsole_WriteStorageObjectRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiConsole_WriteStorageObjectRequest : IApiConsole_WriteStorageObjectRequest
+    internal class ApiConsole_WriteStorageObjectRequest : IApiConsole_WriteStorageObjectRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="permission_read"), Preserve]
-        public int PermissionRead { get; set; }
+        public int? PermissionRead { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="permission_write"), Preserve]
-        public int PermissionWrite { get; set; }
+        public int? PermissionWrite { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="value"), Preserve]
@@ -555,14 +2063,106 @@ namespace Nakama.Console
         [DataMember(Name="version"), Preserve]
         public string Version { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "permission_read":
+                    PermissionRead = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "permission_write":
+                    PermissionWrite = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                case "version":
+                    Version = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (PermissionRead != null)
+            {
+                writer.WriteName("permission_read");
+                writer.WriteInt32(PermissionRead.Value);
+            }
+            if (PermissionWrite != null)
+            {
+                writer.WriteName("permission_write");
+                writer.WriteInt32(PermissionWrite.Value);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+            if (Version != null)
+            {
+                writer.WriteName("version");
+                writer.WriteString(Version);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiConsole_WriteStorageObjectRequest Clone() => (ApiConsole_WriteStorageObjectRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiConsole_WriteStorageObjectRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiConsole_WriteStorageObjectRequest) obj;
+            return Nullable.Equals(PermissionRead, other.PermissionRead) &&
+                Nullable.Equals(PermissionWrite, other.PermissionWrite) &&
+                Value == other.Value &&
+                Version == other.Version;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + PermissionRead.GetHashCode();
+                hash = hash * 31 + PermissionWrite.GetHashCode();
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Version?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "PermissionRead: ", PermissionRead, ", ");
-            output = string.Concat(output, "PermissionWrite: ", PermissionWrite, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            output = string.Concat(output, "Version: ", Version, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("PermissionRead: " + ApiClient.FormatValue(PermissionRead));
+            members.Add("PermissionWrite: " + ApiClient.FormatValue(PermissionWrite));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
+            members.Add("Version: " + ApiClient.FormatValue(Version));
         }
     }
 
@@ -581,10 +2181,15 @@ namespace Nakama.Console
         /// Warning message text.
         /// </summary>
         string Message { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConfigWarning Clone();
     }
 
     /// <inheritdoc />
-    internal class ConfigWarning : IConfigWarning
+    internal class ConfigWarning : IConfigWarning, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -595,12 +2200,84 @@ namespace Nakama.Console
         [DataMember(Name="message"), Preserve]
         public string Message { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "field":
+                    Field = reader.ReadString();
+                    return true;
+                case "message":
+                    Message = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Field != null)
+            {
+                writer.WriteName("field");
+                writer.WriteString(Field);
+            }
+            if (Message != null)
+            {
+                writer.WriteName("message");
+                writer.WriteString(Message);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConfigWarning Clone() => (ConfigWarning) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConfigWarning) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConfigWarning) obj;
+            return Field == other.Field &&
+                Message == other.Message;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Field?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Message?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Field: ", Field, ", ");
-            output = string.Concat(output, "Message: ", Message, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Field: " + ApiClient.FormatValue(Field));
+            members.Add("Message: " + ApiClient.FormatValue(Message));
         }
     }
 
@@ -613,21 +2290,26 @@ namespace Nakama.Console
         /// <summary>
         /// Their relationship to the group.
         /// </summary>
-        int State { get; }
+        int? State { get; }
 
         /// <summary>
         /// User.
         /// </summary>
         INakamaapiUser User { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IGroupUserListGroupUser Clone();
     }
 
     /// <inheritdoc />
-    internal class GroupUserListGroupUser : IGroupUserListGroupUser
+    internal class GroupUserListGroupUser : IGroupUserListGroupUser, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="state"), Preserve]
-        public int State { get; set; }
+        public int? State { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
@@ -635,12 +2317,85 @@ namespace Nakama.Console
         [DataMember(Name="user"), Preserve]
         public NakamaapiUser _user { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "state":
+                    State = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "user":
+                    _user = reader.ReadObject<NakamaapiUser>();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (State != null)
+            {
+                writer.WriteName("state");
+                writer.WriteInt32(State.Value);
+            }
+            if (_user != null)
+            {
+                writer.WriteName("user");
+                writer.WriteObject(_user);
+            }
+        }
+
+        /// <inheritdoc />
+        public IGroupUserListGroupUser Clone() => (GroupUserListGroupUser) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (GroupUserListGroupUser) MemberwiseClone();
+            clone._user = (NakamaapiUser) _user?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (GroupUserListGroupUser) obj;
+            return Nullable.Equals(State, other.State) &&
+                Equals(_user, other._user);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + State.GetHashCode();
+                hash = hash * 31 + (_user?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "State: ", State, ", ");
-            output = string.Concat(output, "User: ", User, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("State: " + ApiClient.FormatValue(State));
+            members.Add("User: " + ApiClient.FormatValue(_user));
         }
     }
 
@@ -653,32 +2408,115 @@ namespace Nakama.Console
         /// <summary>
         /// Module last modified date
         /// </summary>
-        string ModTime { get; }
+        DateTime ModTime { get; }
 
         /// <summary>
         /// Module path
         /// </summary>
         string Path { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IRuntimeInfoModuleInfo Clone();
     }
 
     /// <inheritdoc />
-    internal class RuntimeInfoModuleInfo : IRuntimeInfoModuleInfo
+    internal class RuntimeInfoModuleInfo : IRuntimeInfoModuleInfo, IApiJsonObject
     {
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime ModTime
+        {
+            get => ApiClient.ParseDateTime(_modTime);
+            set => _modTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="mod_time"), Preserve]
-        public string ModTime { get; set; }
+        public string _modTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="path"), Preserve]
         public string Path { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "mod_time":
+                    _modTime = reader.ReadString();
+                    return true;
+                case "path":
+                    Path = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_modTime != null)
+            {
+                writer.WriteName("mod_time");
+                writer.WriteString(_modTime);
+            }
+            if (Path != null)
+            {
+                writer.WriteName("path");
+                writer.WriteString(Path);
+            }
+        }
+
+        /// <inheritdoc />
+        public IRuntimeInfoModuleInfo Clone() => (RuntimeInfoModuleInfo) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (RuntimeInfoModuleInfo) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (RuntimeInfoModuleInfo) obj;
+            return _modTime == other._modTime &&
+                Path == other.Path;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_modTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Path?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ModTime: ", ModTime, ", ");
-            output = string.Concat(output, "Path: ", Path, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ModTime: " + ApiClient.FormatValue(ModTime));
+            members.Add("Path: " + ApiClient.FormatValue(Path));
         }
     }
 
@@ -696,11 +2534,16 @@ namespace Nakama.Console
         /// <summary>
         /// The user's relationship to the group.
         /// </summary>
-        int State { get; }
+        int? State { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IUserGroupListUserGroup Clone();
     }
 
     /// <inheritdoc />
-    internal class UserGroupListUserGroup : IUserGroupListUserGroup
+    internal class UserGroupListUserGroup : IUserGroupListUserGroup, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -711,14 +2554,87 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="state"), Preserve]
-        public int State { get; set; }
+        public int? State { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "group":
+                    _group = reader.ReadObject<ApiGroup>();
+                    return true;
+                case "state":
+                    State = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_group != null)
+            {
+                writer.WriteName("group");
+                writer.WriteObject(_group);
+            }
+            if (State != null)
+            {
+                writer.WriteName("state");
+                writer.WriteInt32(State.Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IUserGroupListUserGroup Clone() => (UserGroupListUserGroup) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (UserGroupListUserGroup) MemberwiseClone();
+            clone._group = (ApiGroup) _group?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (UserGroupListUserGroup) obj;
+            return Equals(_group, other._group) &&
+                Nullable.Equals(State, other.State);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_group?.GetHashCode() ?? 0);
+                hash = hash * 31 + State.GetHashCode();
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Group: ", Group, ", ");
-            output = string.Concat(output, "State: ", State, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Group: " + ApiClient.FormatValue(_group));
+            members.Add("State: " + ApiClient.FormatValue(State));
         }
     }
 
@@ -737,10 +2653,15 @@ namespace Nakama.Console
         /// Extra information that will be bundled in the session token.
         /// </summary>
         IDictionary<string, string> Vars { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAccountDevice Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiAccountDevice : IApiAccountDevice
+    internal class ApiAccountDevice : IApiAccountDevice, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -753,18 +2674,85 @@ namespace Nakama.Console
         [DataMember(Name="vars"), Preserve]
         public Dictionary<string, string> _vars { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "vars":
+                    _vars = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (_vars != null)
+            {
+                writer.WriteName("vars");
+                writer.WriteMap(_vars, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiAccountDevice Clone() => (ApiAccountDevice) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAccountDevice) MemberwiseClone();
+            clone._vars = ApiClient.ConvertMap<string, string>(_vars, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAccountDevice) obj;
+            return Id == other.Id &&
+                ApiClient.MapEquals(_vars, other._vars);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_vars);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Id: ", Id, ", ");
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-            var varsString = "";
-            foreach (var kvp in Vars)
-            {
-                varsString = string.Concat(varsString, "{" + kvp.Key + "=" + kvp.Value + "}");
-            }
-            output = string.Concat(output, "Vars: [" + varsString + "]");
-            return output;
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Vars: " + ApiClient.FormatMap(_vars));
         }
     }
 
@@ -782,7 +2770,7 @@ namespace Nakama.Console
         /// <summary>
         /// The code representing a message type or category.
         /// </summary>
-        int Code { get; }
+        int? Code { get; }
 
         /// <summary>
         /// The content payload.
@@ -792,7 +2780,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the message was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The ID of the group, or an empty string if this message was not sent through a group channel.
@@ -807,7 +2795,7 @@ namespace Nakama.Console
         /// <summary>
         /// True if the message was persisted to the channel's history, false otherwise.
         /// </summary>
-        bool Persistent { get; }
+        bool? Persistent { get; }
 
         /// <summary>
         /// The name of the chat room, or an empty string if this message was not sent through a chat room.
@@ -822,7 +2810,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the message was last updated.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// The ID of the first DM user, or an empty string if this message was not sent through a DM chat.
@@ -838,10 +2826,15 @@ namespace Nakama.Console
         /// The username of the message sender, if any.
         /// </summary>
         string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiChannelMessage Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiChannelMessage : IApiChannelMessage
+    internal class ApiChannelMessage : IApiChannelMessage, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -850,15 +2843,21 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="code"), Preserve]
-        public int Code { get; set; }
+        public int? Code { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="content"), Preserve]
         public string Content { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="group_id"), Preserve]
@@ -870,7 +2869,7 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="persistent"), Preserve]
-        public bool Persistent { get; set; }
+        public bool? Persistent { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="room_name"), Preserve]
@@ -881,8 +2880,14 @@ namespace Nakama.Console
         public string SenderId { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="user_id_one"), Preserve]
@@ -896,23 +2901,205 @@ namespace Nakama.Console
         [DataMember(Name="username"), Preserve]
         public string Username { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "channel_id":
+                    ChannelId = reader.ReadString();
+                    return true;
+                case "code":
+                    Code = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "content":
+                    Content = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "group_id":
+                    GroupId = reader.ReadString();
+                    return true;
+                case "message_id":
+                    MessageId = reader.ReadString();
+                    return true;
+                case "persistent":
+                    Persistent = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "room_name":
+                    RoomName = reader.ReadString();
+                    return true;
+                case "sender_id":
+                    SenderId = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id_one":
+                    UserIdOne = reader.ReadString();
+                    return true;
+                case "user_id_two":
+                    UserIdTwo = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (ChannelId != null)
+            {
+                writer.WriteName("channel_id");
+                writer.WriteString(ChannelId);
+            }
+            if (Code != null)
+            {
+                writer.WriteName("code");
+                writer.WriteInt32(Code.Value);
+            }
+            if (Content != null)
+            {
+                writer.WriteName("content");
+                writer.WriteString(Content);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (GroupId != null)
+            {
+                writer.WriteName("group_id");
+                writer.WriteString(GroupId);
+            }
+            if (MessageId != null)
+            {
+                writer.WriteName("message_id");
+                writer.WriteString(MessageId);
+            }
+            if (Persistent != null)
+            {
+                writer.WriteName("persistent");
+                writer.WriteBoolean(Persistent.Value);
+            }
+            if (RoomName != null)
+            {
+                writer.WriteName("room_name");
+                writer.WriteString(RoomName);
+            }
+            if (SenderId != null)
+            {
+                writer.WriteName("sender_id");
+                writer.WriteString(SenderId);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserIdOne != null)
+            {
+                writer.WriteName("user_id_one");
+                writer.WriteString(UserIdOne);
+            }
+            if (UserIdTwo != null)
+            {
+                writer.WriteName("user_id_two");
+                writer.WriteString(UserIdTwo);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiChannelMessage Clone() => (ApiChannelMessage) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiChannelMessage) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiChannelMessage) obj;
+            return ChannelId == other.ChannelId &&
+                Nullable.Equals(Code, other.Code) &&
+                Content == other.Content &&
+                _createTime == other._createTime &&
+                GroupId == other.GroupId &&
+                MessageId == other.MessageId &&
+                Nullable.Equals(Persistent, other.Persistent) &&
+                RoomName == other.RoomName &&
+                SenderId == other.SenderId &&
+                _updateTime == other._updateTime &&
+                UserIdOne == other.UserIdOne &&
+                UserIdTwo == other.UserIdTwo &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (ChannelId?.GetHashCode() ?? 0);
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Content?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (GroupId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (MessageId?.GetHashCode() ?? 0);
+                hash = hash * 31 + Persistent.GetHashCode();
+                hash = hash * 31 + (RoomName?.GetHashCode() ?? 0);
+                hash = hash * 31 + (SenderId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserIdOne?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserIdTwo?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ChannelId: ", ChannelId, ", ");
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Content: ", Content, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "GroupId: ", GroupId, ", ");
-            output = string.Concat(output, "MessageId: ", MessageId, ", ");
-            output = string.Concat(output, "Persistent: ", Persistent, ", ");
-            output = string.Concat(output, "RoomName: ", RoomName, ", ");
-            output = string.Concat(output, "SenderId: ", SenderId, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserIdOne: ", UserIdOne, ", ");
-            output = string.Concat(output, "UserIdTwo: ", UserIdTwo, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ChannelId: " + ApiClient.FormatValue(ChannelId));
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Content: " + ApiClient.FormatValue(Content));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("GroupId: " + ApiClient.FormatValue(GroupId));
+            members.Add("MessageId: " + ApiClient.FormatValue(MessageId));
+            members.Add("Persistent: " + ApiClient.FormatValue(Persistent));
+            members.Add("RoomName: " + ApiClient.FormatValue(RoomName));
+            members.Add("SenderId: " + ApiClient.FormatValue(SenderId));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserIdOne: " + ApiClient.FormatValue(UserIdOne));
+            members.Add("UserIdTwo: " + ApiClient.FormatValue(UserIdTwo));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
         }
     }
 
@@ -941,10 +3128,15 @@ namespace Nakama.Console
         /// The cursor to send when retrieving the previous page, if any.
         /// </summary>
         string PrevCursor { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiChannelMessageList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiChannelMessageList : IApiChannelMessageList
+    internal class ApiChannelMessageList : IApiChannelMessageList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -965,14 +3157,107 @@ namespace Nakama.Console
         [DataMember(Name="prev_cursor"), Preserve]
         public string PrevCursor { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cacheable_cursor":
+                    CacheableCursor = reader.ReadString();
+                    return true;
+                case "messages":
+                    _messages = reader.ReadList(reader.ReadObject<ApiChannelMessage>);
+                    return true;
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (CacheableCursor != null)
+            {
+                writer.WriteName("cacheable_cursor");
+                writer.WriteString(CacheableCursor);
+            }
+            if (_messages != null)
+            {
+                writer.WriteName("messages");
+                writer.WriteList(_messages, writer.WriteObject);
+            }
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiChannelMessageList Clone() => (ApiChannelMessageList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiChannelMessageList) MemberwiseClone();
+            clone._messages = _messages?.ConvertAll(item => (ApiChannelMessage) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiChannelMessageList) obj;
+            return CacheableCursor == other.CacheableCursor &&
+                ApiClient.ListEquals(_messages, other._messages) &&
+                NextCursor == other.NextCursor &&
+                PrevCursor == other.PrevCursor;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (CacheableCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_messages);
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CacheableCursor: ", CacheableCursor, ", ");
-            output = string.Concat(output, "Messages: [", string.Join(", ", Messages), "], ");
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CacheableCursor: " + ApiClient.FormatValue(CacheableCursor));
+            members.Add("Messages: " + ApiClient.FormatList(_messages));
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
         }
     }
 
@@ -990,21 +3275,26 @@ namespace Nakama.Console
         /// <summary>
         /// The friend status.  one of "Friend.State".
         /// </summary>
-        int State { get; }
+        int? State { get; }
 
         /// <summary>
         /// Time of the latest relationship update.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// The user object.
         /// </summary>
         INakamaapiUser User { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFriend Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiFriend : IApiFriend
+    internal class ApiFriend : IApiFriend, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1013,11 +3303,17 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="state"), Preserve]
-        public int State { get; set; }
+        public int? State { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
@@ -1025,14 +3321,107 @@ namespace Nakama.Console
         [DataMember(Name="user"), Preserve]
         public NakamaapiUser _user { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "state":
+                    State = reader.ReadNullable(reader.ReadInt32);
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user":
+                    _user = reader.ReadObject<NakamaapiUser>();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (State != null)
+            {
+                writer.WriteName("state");
+                writer.WriteInt32(State.Value);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (_user != null)
+            {
+                writer.WriteName("user");
+                writer.WriteObject(_user);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFriend Clone() => (ApiFriend) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFriend) MemberwiseClone();
+            clone._user = (NakamaapiUser) _user?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFriend) obj;
+            return Metadata == other.Metadata &&
+                Nullable.Equals(State, other.State) &&
+                _updateTime == other._updateTime &&
+                Equals(_user, other._user);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + State.GetHashCode();
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_user?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "State: ", State, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "User: ", User, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("State: " + ApiClient.FormatValue(State));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("User: " + ApiClient.FormatValue(_user));
         }
     }
 
@@ -1051,10 +3440,15 @@ namespace Nakama.Console
         /// The Friend objects.
         /// </summary>
         IEnumerable<IApiFriend> Friends { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFriendList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiFriendList : IApiFriendList
+    internal class ApiFriendList : IApiFriendList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1067,12 +3461,85 @@ namespace Nakama.Console
         [DataMember(Name="friends"), Preserve]
         public List<ApiFriend> _friends { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "friends":
+                    _friends = reader.ReadList(reader.ReadObject<ApiFriend>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_friends != null)
+            {
+                writer.WriteName("friends");
+                writer.WriteList(_friends, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFriendList Clone() => (ApiFriendList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFriendList) MemberwiseClone();
+            clone._friends = _friends?.ConvertAll(item => (ApiFriend) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFriendList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_friends, other._friends);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_friends);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "Friends: [", string.Join(", ", Friends), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("Friends: " + ApiClient.FormatList(_friends));
         }
     }
 
@@ -1090,7 +3557,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the group was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The id of the user who created the group.
@@ -1135,16 +3602,21 @@ namespace Nakama.Console
         /// <summary>
         /// Anyone can join open groups, otherwise only admins can accept members.
         /// </summary>
-        bool Open { get; }
+        bool? Open { get; }
 
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the group was last updated.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiGroup Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiGroup : IApiGroup
+    internal class ApiGroup : IApiGroup, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1152,8 +3624,14 @@ namespace Nakama.Console
         public string AvatarUrl { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="creator_id"), Preserve]
@@ -1189,28 +3667,200 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="open"), Preserve]
-        public bool Open { get; set; }
+        public bool? Open { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "creator_id":
+                    CreatorId = reader.ReadString();
+                    return true;
+                case "description":
+                    Description = reader.ReadString();
+                    return true;
+                case "edge_count":
+                    EdgeCount = reader.ReadInt32();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "max_count":
+                    MaxCount = reader.ReadInt32();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "open":
+                    Open = reader.ReadNullable(reader.ReadBoolean);
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (CreatorId != null)
+            {
+                writer.WriteName("creator_id");
+                writer.WriteString(CreatorId);
+            }
+            if (Description != null)
+            {
+                writer.WriteName("description");
+                writer.WriteString(Description);
+            }
+            writer.WriteName("edge_count");
+            writer.WriteInt32(EdgeCount);
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            writer.WriteName("max_count");
+            writer.WriteInt32(MaxCount);
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (Open != null)
+            {
+                writer.WriteName("open");
+                writer.WriteBoolean(Open.Value);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiGroup Clone() => (ApiGroup) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiGroup) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiGroup) obj;
+            return AvatarUrl == other.AvatarUrl &&
+                _createTime == other._createTime &&
+                CreatorId == other.CreatorId &&
+                Description == other.Description &&
+                EdgeCount == other.EdgeCount &&
+                Id == other.Id &&
+                LangTag == other.LangTag &&
+                MaxCount == other.MaxCount &&
+                Metadata == other.Metadata &&
+                Name == other.Name &&
+                Nullable.Equals(Open, other.Open) &&
+                _updateTime == other._updateTime;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (CreatorId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Description?.GetHashCode() ?? 0);
+                hash = hash * 31 + EdgeCount.GetHashCode();
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + MaxCount.GetHashCode();
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + Open.GetHashCode();
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "CreatorId: ", CreatorId, ", ");
-            output = string.Concat(output, "Description: ", Description, ", ");
-            output = string.Concat(output, "EdgeCount: ", EdgeCount, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "MaxCount: ", MaxCount, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "Open: ", Open, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("CreatorId: " + ApiClient.FormatValue(CreatorId));
+            members.Add("Description: " + ApiClient.FormatValue(Description));
+            members.Add("EdgeCount: " + ApiClient.FormatValue(EdgeCount));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("MaxCount: " + ApiClient.FormatValue(MaxCount));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Open: " + ApiClient.FormatValue(Open));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
         }
     }
 
@@ -1229,10 +3879,15 @@ namespace Nakama.Console
         /// User-role pairs for a group.
         /// </summary>
         IEnumerable<IGroupUserListGroupUser> GroupUsers { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiGroupUserList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiGroupUserList : IApiGroupUserList
+    internal class ApiGroupUserList : IApiGroupUserList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1245,12 +3900,85 @@ namespace Nakama.Console
         [DataMember(Name="group_users"), Preserve]
         public List<GroupUserListGroupUser> _groupUsers { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "group_users":
+                    _groupUsers = reader.ReadList(reader.ReadObject<GroupUserListGroupUser>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_groupUsers != null)
+            {
+                writer.WriteName("group_users");
+                writer.WriteList(_groupUsers, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiGroupUserList Clone() => (ApiGroupUserList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiGroupUserList) MemberwiseClone();
+            clone._groupUsers = _groupUsers?.ConvertAll(item => (GroupUserListGroupUser) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiGroupUserList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_groupUsers, other._groupUsers);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_groupUsers);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "GroupUsers: [", string.Join(", ", GroupUsers), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("GroupUsers: " + ApiClient.FormatList(_groupUsers));
         }
     }
 
@@ -1263,12 +3991,12 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record expires.
         /// </summary>
-        string ExpiryTime { get; }
+        DateTime ExpiryTime { get; }
 
         /// <summary>
         /// The ID of the leaderboard this score belongs to.
@@ -1278,7 +4006,7 @@ namespace Nakama.Console
         /// <summary>
         /// The maximum number of score updates allowed by the owner.
         /// </summary>
-        int MaxNumScore { get; }
+        long MaxNumScore { get; }
 
         /// <summary>
         /// Metadata.
@@ -1298,40 +4026,57 @@ namespace Nakama.Console
         /// <summary>
         /// The rank of this record.
         /// </summary>
-        string Rank { get; }
+        long Rank { get; }
 
         /// <summary>
         /// The score value.
         /// </summary>
-        string Score { get; }
+        long Score { get; }
 
         /// <summary>
         /// An optional subscore value.
         /// </summary>
-        string Subscore { get; }
+        long Subscore { get; }
 
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the leaderboard record was updated.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// The username of the score owner, if the owner is a user.
         /// </summary>
         string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiLeaderboardRecord Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiLeaderboardRecord : IApiLeaderboardRecord
+    internal class ApiLeaderboardRecord : IApiLeaderboardRecord, IApiJsonObject
     {
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime ExpiryTime
+        {
+            get => ApiClient.ParseDateTime(_expiryTime);
+            set => _expiryTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="expiry_time"), Preserve]
-        public string ExpiryTime { get; set; }
+        public string _expiryTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="leaderboard_id"), Preserve]
@@ -1339,7 +4084,7 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="max_num_score"), Preserve]
-        public int MaxNumScore { get; set; }
+        public long MaxNumScore { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="metadata"), Preserve]
@@ -1354,41 +4099,231 @@ namespace Nakama.Console
         public string OwnerId { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Rank
+        {
+            get => ApiClient.ParseInt64(_rank);
+            set => _rank = ApiClient.FormatInt64(value);
+        }
         [DataMember(Name="rank"), Preserve]
-        public string Rank { get; set; }
+        public string _rank { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Score
+        {
+            get => ApiClient.ParseInt64(_score);
+            set => _score = ApiClient.FormatInt64(value);
+        }
         [DataMember(Name="score"), Preserve]
-        public string Score { get; set; }
+        public string _score { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Subscore
+        {
+            get => ApiClient.ParseInt64(_subscore);
+            set => _subscore = ApiClient.FormatInt64(value);
+        }
         [DataMember(Name="subscore"), Preserve]
-        public string Subscore { get; set; }
+        public string _subscore { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="username"), Preserve]
         public string Username { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "expiry_time":
+                    _expiryTime = reader.ReadString();
+                    return true;
+                case "leaderboard_id":
+                    LeaderboardId = reader.ReadString();
+                    return true;
+                case "max_num_score":
+                    MaxNumScore = reader.ReadInt64();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "num_score":
+                    NumScore = reader.ReadInt32();
+                    return true;
+                case "owner_id":
+                    OwnerId = reader.ReadString();
+                    return true;
+                case "rank":
+                    _rank = reader.ReadString();
+                    return true;
+                case "score":
+                    _score = reader.ReadString();
+                    return true;
+                case "subscore":
+                    _subscore = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (_expiryTime != null)
+            {
+                writer.WriteName("expiry_time");
+                writer.WriteString(_expiryTime);
+            }
+            if (LeaderboardId != null)
+            {
+                writer.WriteName("leaderboard_id");
+                writer.WriteString(LeaderboardId);
+            }
+            writer.WriteName("max_num_score");
+            writer.WriteInt64(MaxNumScore);
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            writer.WriteName("num_score");
+            writer.WriteInt32(NumScore);
+            if (OwnerId != null)
+            {
+                writer.WriteName("owner_id");
+                writer.WriteString(OwnerId);
+            }
+            if (_rank != null)
+            {
+                writer.WriteName("rank");
+                writer.WriteString(_rank);
+            }
+            if (_score != null)
+            {
+                writer.WriteName("score");
+                writer.WriteString(_score);
+            }
+            if (_subscore != null)
+            {
+                writer.WriteName("subscore");
+                writer.WriteString(_subscore);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiLeaderboardRecord Clone() => (ApiLeaderboardRecord) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiLeaderboardRecord) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiLeaderboardRecord) obj;
+            return _createTime == other._createTime &&
+                _expiryTime == other._expiryTime &&
+                LeaderboardId == other.LeaderboardId &&
+                MaxNumScore == other.MaxNumScore &&
+                Metadata == other.Metadata &&
+                NumScore == other.NumScore &&
+                OwnerId == other.OwnerId &&
+                _rank == other._rank &&
+                _score == other._score &&
+                _subscore == other._subscore &&
+                _updateTime == other._updateTime &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_expiryTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LeaderboardId?.GetHashCode() ?? 0);
+                hash = hash * 31 + MaxNumScore.GetHashCode();
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + NumScore.GetHashCode();
+                hash = hash * 31 + (OwnerId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_rank?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_score?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_subscore?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "ExpiryTime: ", ExpiryTime, ", ");
-            output = string.Concat(output, "LeaderboardId: ", LeaderboardId, ", ");
-            output = string.Concat(output, "MaxNumScore: ", MaxNumScore, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "NumScore: ", NumScore, ", ");
-            output = string.Concat(output, "OwnerId: ", OwnerId, ", ");
-            output = string.Concat(output, "Rank: ", Rank, ", ");
-            output = string.Concat(output, "Score: ", Score, ", ");
-            output = string.Concat(output, "Subscore: ", Subscore, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("ExpiryTime: " + ApiClient.FormatValue(ExpiryTime));
+            members.Add("LeaderboardId: " + ApiClient.FormatValue(LeaderboardId));
+            members.Add("MaxNumScore: " + ApiClient.FormatValue(MaxNumScore));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("NumScore: " + ApiClient.FormatValue(NumScore));
+            members.Add("OwnerId: " + ApiClient.FormatValue(OwnerId));
+            members.Add("Rank: " + ApiClient.FormatValue(Rank));
+            members.Add("Score: " + ApiClient.FormatValue(Score));
+            members.Add("Subscore: " + ApiClient.FormatValue(Subscore));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
         }
     }
 
@@ -1416,16 +4351,21 @@ namespace Nakama.Console
         /// <summary>
         /// The total number of ranks available.
         /// </summary>
-        string RankCount { get; }
+        long RankCount { get; }
 
         /// <summary>
         /// A list of leaderboard records.
         /// </summary>
         IEnumerable<IApiLeaderboardRecord> Records { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiLeaderboardRecordList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiLeaderboardRecordList : IApiLeaderboardRecordList
+    internal class ApiLeaderboardRecordList : IApiLeaderboardRecordList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1443,8 +4383,14 @@ namespace Nakama.Console
         public string PrevCursor { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public long RankCount
+        {
+            get => ApiClient.ParseInt64(_rankCount);
+            set => _rankCount = ApiClient.FormatInt64(value);
+        }
         [DataMember(Name="rank_count"), Preserve]
-        public string RankCount { get; set; }
+        public string _rankCount { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
@@ -1452,15 +4398,119 @@ namespace Nakama.Console
         [DataMember(Name="records"), Preserve]
         public List<ApiLeaderboardRecord> _records { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "owner_records":
+                    _ownerRecords = reader.ReadList(reader.ReadObject<ApiLeaderboardRecord>);
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                case "rank_count":
+                    _rankCount = reader.ReadString();
+                    return true;
+                case "records":
+                    _records = reader.ReadList(reader.ReadObject<ApiLeaderboardRecord>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (_ownerRecords != null)
+            {
+                writer.WriteName("owner_records");
+                writer.WriteList(_ownerRecords, writer.WriteObject);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+            if (_rankCount != null)
+            {
+                writer.WriteName("rank_count");
+                writer.WriteString(_rankCount);
+            }
+            if (_records != null)
+            {
+                writer.WriteName("records");
+                writer.WriteList(_records, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiLeaderboardRecordList Clone() => (ApiLeaderboardRecordList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiLeaderboardRecordList) MemberwiseClone();
+            clone._ownerRecords = _ownerRecords?.ConvertAll(item => (ApiLeaderboardRecord) item?.CloneModel());
+            clone._records = _records?.ConvertAll(item => (ApiLeaderboardRecord) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiLeaderboardRecordList) obj;
+            return NextCursor == other.NextCursor &&
+                ApiClient.ListEquals(_ownerRecords, other._ownerRecords) &&
+                PrevCursor == other.PrevCursor &&
+                _rankCount == other._rankCount &&
+                ApiClient.ListEquals(_records, other._records);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_ownerRecords);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_rankCount?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_records);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "OwnerRecords: [", string.Join(", ", OwnerRecords), "], ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            output = string.Concat(output, "RankCount: ", RankCount, ", ");
-            output = string.Concat(output, "Records: [", string.Join(", ", Records), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("OwnerRecords: " + ApiClient.FormatList(_ownerRecords));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
+            members.Add("RankCount: " + ApiClient.FormatValue(RankCount));
+            members.Add("Records: " + ApiClient.FormatList(_records));
         }
     }
 
@@ -1484,10 +4534,15 @@ namespace Nakama.Console
         /// Stored validated purchases.
         /// </summary>
         IEnumerable<IApiValidatedPurchase> ValidatedPurchases { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiPurchaseList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiPurchaseList : IApiPurchaseList
+    internal class ApiPurchaseList : IApiPurchaseList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1504,13 +4559,96 @@ namespace Nakama.Console
         [DataMember(Name="validated_purchases"), Preserve]
         public List<ApiValidatedPurchase> _validatedPurchases { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                case "validated_purchases":
+                    _validatedPurchases = reader.ReadList(reader.ReadObject<ApiValidatedPurchase>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+            if (_validatedPurchases != null)
+            {
+                writer.WriteName("validated_purchases");
+                writer.WriteList(_validatedPurchases, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiPurchaseList Clone() => (ApiPurchaseList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiPurchaseList) MemberwiseClone();
+            clone._validatedPurchases = _validatedPurchases?.ConvertAll(item => (ApiValidatedPurchase) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiPurchaseList) obj;
+            return Cursor == other.Cursor &&
+                PrevCursor == other.PrevCursor &&
+                ApiClient.ListEquals(_validatedPurchases, other._validatedPurchases);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_validatedPurchases);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            output = string.Concat(output, "ValidatedPurchases: [", string.Join(", ", ValidatedPurchases), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
+            members.Add("ValidatedPurchases: " + ApiClient.FormatList(_validatedPurchases));
         }
     }
 
@@ -1528,7 +4666,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The key of the object within the collection.
@@ -1548,7 +4686,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was last updated.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// The user owner of the object.
@@ -1564,10 +4702,15 @@ namespace Nakama.Console
         /// The version hash of the object.
         /// </summary>
         string Version { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiStorageObject Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiStorageObject : IApiStorageObject
+    internal class ApiStorageObject : IApiStorageObject, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1575,8 +4718,14 @@ namespace Nakama.Console
         public string Collection { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="key"), Preserve]
@@ -1591,8 +4740,14 @@ namespace Nakama.Console
         public int PermissionWrite { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="user_id"), Preserve]
@@ -1602,23 +4757,159 @@ namespace Nakama.Console
         [DataMember(Name="value"), Preserve]
         public string Value { get; set; }
 
-        /// <inheritdoc />
-        [DataMember(Name="version"), Preserve]
-        public string Version { get; set; }
+        /// <inheritdoc />
+        [DataMember(Name="version"), Preserve]
+        public string Version { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "collection":
+                    Collection = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "key":
+                    Key = reader.ReadString();
+                    return true;
+                case "permission_read":
+                    PermissionRead = reader.ReadInt32();
+                    return true;
+                case "permission_write":
+                    PermissionWrite = reader.ReadInt32();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                case "version":
+                    Version = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collection != null)
+            {
+                writer.WriteName("collection");
+                writer.WriteString(Collection);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Key != null)
+            {
+                writer.WriteName("key");
+                writer.WriteString(Key);
+            }
+            writer.WriteName("permission_read");
+            writer.WriteInt32(PermissionRead);
+            writer.WriteName("permission_write");
+            writer.WriteInt32(PermissionWrite);
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+            if (Version != null)
+            {
+                writer.WriteName("version");
+                writer.WriteString(Version);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiStorageObject Clone() => (ApiStorageObject) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiStorageObject) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiStorageObject) obj;
+            return Collection == other.Collection &&
+                _createTime == other._createTime &&
+                Key == other.Key &&
+                PermissionRead == other.PermissionRead &&
+                PermissionWrite == other.PermissionWrite &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId &&
+                Value == other.Value &&
+                Version == other.Version;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Collection?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Key?.GetHashCode() ?? 0);
+                hash = hash * 31 + PermissionRead.GetHashCode();
+                hash = hash * 31 + PermissionWrite.GetHashCode();
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Version?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Collection: ", Collection, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Key: ", Key, ", ");
-            output = string.Concat(output, "PermissionRead: ", PermissionRead, ", ");
-            output = string.Concat(output, "PermissionWrite: ", PermissionWrite, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            output = string.Concat(output, "Version: ", Version, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collection: " + ApiClient.FormatValue(Collection));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Key: " + ApiClient.FormatValue(Key));
+            members.Add("PermissionRead: " + ApiClient.FormatValue(PermissionRead));
+            members.Add("PermissionWrite: " + ApiClient.FormatValue(PermissionWrite));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
+            members.Add("Version: " + ApiClient.FormatValue(Version));
         }
     }
 
@@ -1636,7 +4927,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The key of the object within the collection.
@@ -1646,7 +4937,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was last updated.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// The owner of the object.
@@ -1657,10 +4948,15 @@ namespace Nakama.Console
         /// The version hash of the object.
         /// </summary>
         string Version { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiStorageObjectAck Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiStorageObjectAck : IApiStorageObjectAck
+    internal class ApiStorageObjectAck : IApiStorageObjectAck, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1668,16 +4964,28 @@ namespace Nakama.Console
         public string Collection { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="key"), Preserve]
         public string Key { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="user_id"), Preserve]
@@ -1687,16 +4995,128 @@ namespace Nakama.Console
         [DataMember(Name="version"), Preserve]
         public string Version { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "collection":
+                    Collection = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "key":
+                    Key = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                case "version":
+                    Version = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collection != null)
+            {
+                writer.WriteName("collection");
+                writer.WriteString(Collection);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Key != null)
+            {
+                writer.WriteName("key");
+                writer.WriteString(Key);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+            if (Version != null)
+            {
+                writer.WriteName("version");
+                writer.WriteString(Version);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiStorageObjectAck Clone() => (ApiStorageObjectAck) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiStorageObjectAck) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiStorageObjectAck) obj;
+            return Collection == other.Collection &&
+                _createTime == other._createTime &&
+                Key == other.Key &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId &&
+                Version == other.Version;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Collection?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Key?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Version?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Collection: ", Collection, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Key: ", Key, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            output = string.Concat(output, "Version: ", Version, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collection: " + ApiClient.FormatValue(Collection));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Key: " + ApiClient.FormatValue(Key));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
+            members.Add("Version: " + ApiClient.FormatValue(Version));
         }
     }
 
@@ -1706,17 +5126,63 @@ namespace Nakama.Console
     public enum ApiStoreEnvironment
     {
         /// <summary>
-        /// - UNKNOWN: Unknown environment.
+        /// Unknown environment.
         /// </summary>
         UNKNOWN = 0,
         /// <summary>
-        ///  - SANDBOX: Sandbox/test environment.
+        /// Sandbox/test environment.
         /// </summary>
         SANDBOX = 1,
         /// <summary>
-        ///  - PRODUCTION: Production environment.
+        /// Production environment.
         /// </summary>
         PRODUCTION = 2,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
+        /// </summary>
+        Unrecognized = -1,
+    }
+
+    /// <summary>
+    /// Converts <see cref="ApiStoreEnvironment"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ApiStoreEnvironmentConverter
+    {
+        public static ApiStoreEnvironment Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ApiStoreEnvironment);
+                case "UNKNOWN":
+                case "0":
+                    return ApiStoreEnvironment.UNKNOWN;
+                case "SANDBOX":
+                case "1":
+                    return ApiStoreEnvironment.SANDBOX;
+                case "PRODUCTION":
+                case "2":
+                    return ApiStoreEnvironment.PRODUCTION;
+                default:
+                    return ApiStoreEnvironment.Unrecognized;
+            }
+        }
+
+        public static string Format(ApiStoreEnvironment value)
+        {
+            switch (value)
+            {
+                case ApiStoreEnvironment.UNKNOWN:
+                    return "UNKNOWN";
+                case ApiStoreEnvironment.SANDBOX:
+                    return "SANDBOX";
+                case ApiStoreEnvironment.PRODUCTION:
+                    return "PRODUCTION";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
+        }
     }
 
     /// <summary>
@@ -1725,21 +5191,72 @@ namespace Nakama.Console
     public enum ApiStoreProvider
     {
         /// <summary>
-        /// - APPLE_APP_STORE: Apple App Store
+        /// Apple App Store
         /// </summary>
         APPLE_APP_STORE = 0,
         /// <summary>
-        ///  - GOOGLE_PLAY_STORE: Google Play Store
+        /// Google Play Store
         /// </summary>
         GOOGLE_PLAY_STORE = 1,
         /// <summary>
-        ///  - HUAWEI_APP_GALLERY: Huawei App Gallery
+        /// Huawei App Gallery
         /// </summary>
         HUAWEI_APP_GALLERY = 2,
         /// <summary>
-        ///  - FACEBOOK_INSTANT_STORE: Facebook Instant Store
+        /// Facebook Instant Store
         /// </summary>
         FACEBOOK_INSTANT_STORE = 3,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
+        /// </summary>
+        Unknown = -1,
+    }
+
+    /// <summary>
+    /// Converts <see cref="ApiStoreProvider"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ApiStoreProviderConverter
+    {
+        public static ApiStoreProvider Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ApiStoreProvider);
+                case "APPLE_APP_STORE":
+                case "0":
+                    return ApiStoreProvider.APPLE_APP_STORE;
+                case "GOOGLE_PLAY_STORE":
+                case "1":
+                    return ApiStoreProvider.GOOGLE_PLAY_STORE;
+                case "HUAWEI_APP_GALLERY":
+                case "2":
+                    return ApiStoreProvider.HUAWEI_APP_GALLERY;
+                case "FACEBOOK_INSTANT_STORE":
+                case "3":
+                    return ApiStoreProvider.FACEBOOK_INSTANT_STORE;
+                default:
+                    return ApiStoreProvider.Unknown;
+            }
+        }
+
+        public static string Format(ApiStoreProvider value)
+        {
+            switch (value)
+            {
+                case ApiStoreProvider.APPLE_APP_STORE:
+                    return "APPLE_APP_STORE";
+                case ApiStoreProvider.GOOGLE_PLAY_STORE:
+                    return "GOOGLE_PLAY_STORE";
+                case ApiStoreProvider.HUAWEI_APP_GALLERY:
+                    return "HUAWEI_APP_GALLERY";
+                case ApiStoreProvider.FACEBOOK_INSTANT_STORE:
+                    return "FACEBOOK_INSTANT_STORE";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
+        }
     }
 
     /// <summary>
@@ -1762,10 +5279,15 @@ namespace Nakama.Console
         /// Stored validated subscriptions.
         /// </summary>
         IEnumerable<IApiValidatedSubscription> ValidatedSubscriptions { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiSubscriptionList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiSubscriptionList : IApiSubscriptionList
+    internal class ApiSubscriptionList : IApiSubscriptionList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1782,13 +5304,96 @@ namespace Nakama.Console
         [DataMember(Name="validated_subscriptions"), Preserve]
         public List<ApiValidatedSubscription> _validatedSubscriptions { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                case "validated_subscriptions":
+                    _validatedSubscriptions = reader.ReadList(reader.ReadObject<ApiValidatedSubscription>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+            if (_validatedSubscriptions != null)
+            {
+                writer.WriteName("validated_subscriptions");
+                writer.WriteList(_validatedSubscriptions, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiSubscriptionList Clone() => (ApiSubscriptionList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiSubscriptionList) MemberwiseClone();
+            clone._validatedSubscriptions = _validatedSubscriptions?.ConvertAll(item => (ApiValidatedSubscription) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiSubscriptionList) obj;
+            return Cursor == other.Cursor &&
+                PrevCursor == other.PrevCursor &&
+                ApiClient.ListEquals(_validatedSubscriptions, other._validatedSubscriptions);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_validatedSubscriptions);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            output = string.Concat(output, "ValidatedSubscriptions: [", string.Join(", ", ValidatedSubscriptions), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
+            members.Add("ValidatedSubscriptions: " + ApiClient.FormatList(_validatedSubscriptions));
         }
     }
 
@@ -1807,10 +5412,15 @@ namespace Nakama.Console
         /// Group-role pairs for a user.
         /// </summary>
         IEnumerable<IUserGroupListUserGroup> UserGroups { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiUserGroupList Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiUserGroupList : IApiUserGroupList
+    internal class ApiUserGroupList : IApiUserGroupList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -1823,12 +5433,85 @@ namespace Nakama.Console
         [DataMember(Name="user_groups"), Preserve]
         public List<UserGroupListUserGroup> _userGroups { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "user_groups":
+                    _userGroups = reader.ReadList(reader.ReadObject<UserGroupListUserGroup>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_userGroups != null)
+            {
+                writer.WriteName("user_groups");
+                writer.WriteList(_userGroups, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiUserGroupList Clone() => (ApiUserGroupList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiUserGroupList) MemberwiseClone();
+            clone._userGroups = _userGroups?.ConvertAll(item => (UserGroupListUserGroup) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiUserGroupList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_userGroups, other._userGroups);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_userGroups);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "UserGroups: [", string.Join(", ", UserGroups), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("UserGroups: " + ApiClient.FormatList(_userGroups));
         }
     }
 
@@ -1841,7 +5524,7 @@ namespace Nakama.Console
         /// <summary>
         /// Timestamp when the receipt validation was stored in DB.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// Whether the purchase was done in production or sandbox environment.
@@ -1861,12 +5544,12 @@ namespace Nakama.Console
         /// <summary>
         /// Timestamp when the purchase was done.
         /// </summary>
-        string PurchaseTime { get; }
+        DateTime PurchaseTime { get; }
 
         /// <summary>
         /// Timestamp when the purchase was refunded. Set to UNIX
         /// </summary>
-        string RefundTime { get; }
+        DateTime RefundTime { get; }
 
         /// <summary>
         /// Whether the purchase had already been validated by Nakama before.
@@ -1886,27 +5569,42 @@ namespace Nakama.Console
         /// <summary>
         /// Timestamp when the receipt validation was updated in DB.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// Purchase User ID.
         /// </summary>
         string UserId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiValidatedPurchase Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiValidatedPurchase : IApiValidatedPurchase
+    internal class ApiValidatedPurchase : IApiValidatedPurchase, IApiJsonObject
     {
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ApiStoreEnvironment Environment => _environment;
+        public ApiStoreEnvironment Environment
+        {
+            get => ApiStoreEnvironmentConverter.Parse(_environment);
+            set => _environment = ApiStoreEnvironmentConverter.Format(value);
+        }
         [DataMember(Name="environment"), Preserve]
-        public ApiStoreEnvironment _environment { get; set; }
+        public string _environment { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="product_id"), Preserve]
@@ -1917,12 +5615,24 @@ namespace Nakama.Console
         public string ProviderResponse { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime PurchaseTime
+        {
+            get => ApiClient.ParseDateTime(_purchaseTime);
+            set => _purchaseTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="purchase_time"), Preserve]
-        public string PurchaseTime { get; set; }
+        public string _purchaseTime { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime RefundTime
+        {
+            get => ApiClient.ParseDateTime(_refundTime);
+            set => _refundTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="refund_time"), Preserve]
-        public string RefundTime { get; set; }
+        public string _refundTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="seen_before"), Preserve]
@@ -1930,37 +5640,206 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ApiStoreProvider Store => _store;
+        public ApiStoreProvider Store
+        {
+            get => ApiStoreProviderConverter.Parse(_store);
+            set => _store = ApiStoreProviderConverter.Format(value);
+        }
         [DataMember(Name="store"), Preserve]
-        public ApiStoreProvider _store { get; set; }
+        public string _store { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="transaction_id"), Preserve]
         public string TransactionId { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="user_id"), Preserve]
         public string UserId { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "environment":
+                    _environment = reader.ReadString();
+                    return true;
+                case "product_id":
+                    ProductId = reader.ReadString();
+                    return true;
+                case "provider_response":
+                    ProviderResponse = reader.ReadString();
+                    return true;
+                case "purchase_time":
+                    _purchaseTime = reader.ReadString();
+                    return true;
+                case "refund_time":
+                    _refundTime = reader.ReadString();
+                    return true;
+                case "seen_before":
+                    SeenBefore = reader.ReadBoolean();
+                    return true;
+                case "store":
+                    _store = reader.ReadString();
+                    return true;
+                case "transaction_id":
+                    TransactionId = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (_environment != null)
+            {
+                writer.WriteName("environment");
+                writer.WriteString(_environment);
+            }
+            if (ProductId != null)
+            {
+                writer.WriteName("product_id");
+                writer.WriteString(ProductId);
+            }
+            if (ProviderResponse != null)
+            {
+                writer.WriteName("provider_response");
+                writer.WriteString(ProviderResponse);
+            }
+            if (_purchaseTime != null)
+            {
+                writer.WriteName("purchase_time");
+                writer.WriteString(_purchaseTime);
+            }
+            if (_refundTime != null)
+            {
+                writer.WriteName("refund_time");
+                writer.WriteString(_refundTime);
+            }
+            writer.WriteName("seen_before");
+            writer.WriteBoolean(SeenBefore);
+            if (_store != null)
+            {
+                writer.WriteName("store");
+                writer.WriteString(_store);
+            }
+            if (TransactionId != null)
+            {
+                writer.WriteName("transaction_id");
+                writer.WriteString(TransactionId);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiValidatedPurchase Clone() => (ApiValidatedPurchase) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidatedPurchase) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiValidatedPurchase) obj;
+            return _createTime == other._createTime &&
+                _environment == other._environment &&
+                ProductId == other.ProductId &&
+                ProviderResponse == other.ProviderResponse &&
+                _purchaseTime == other._purchaseTime &&
+                _refundTime == other._refundTime &&
+                SeenBefore == other.SeenBefore &&
+                _store == other._store &&
+                TransactionId == other.TransactionId &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_environment?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProductId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProviderResponse?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_purchaseTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_refundTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + SeenBefore.GetHashCode();
+                hash = hash * 31 + (_store?.GetHashCode() ?? 0);
+                hash = hash * 31 + (TransactionId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Environment: ", Environment, ", ");
-            output = string.Concat(output, "ProductId: ", ProductId, ", ");
-            output = string.Concat(output, "ProviderResponse: ", ProviderResponse, ", ");
-            output = string.Concat(output, "PurchaseTime: ", PurchaseTime, ", ");
-            output = string.Concat(output, "RefundTime: ", RefundTime, ", ");
-            output = string.Concat(output, "SeenBefore: ", SeenBefore, ", ");
-            output = string.Concat(output, "Store: ", Store, ", ");
-            output = string.Concat(output, "TransactionId: ", TransactionId, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Environment: " + ApiClient.FormatEncoded(_environment));
+            members.Add("ProductId: " + ApiClient.FormatValue(ProductId));
+            members.Add("ProviderResponse: " + ApiClient.FormatValue(ProviderResponse));
+            members.Add("PurchaseTime: " + ApiClient.FormatValue(PurchaseTime));
+            members.Add("RefundTime: " + ApiClient.FormatValue(RefundTime));
+            members.Add("SeenBefore: " + ApiClient.FormatValue(SeenBefore));
+            members.Add("Store: " + ApiClient.FormatEncoded(_store));
+            members.Add("TransactionId: " + ApiClient.FormatValue(TransactionId));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
         }
     }
 
@@ -1978,7 +5857,7 @@ namespace Nakama.Console
         /// <summary>
         /// UNIX Timestamp when the receipt validation was stored in DB.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// Whether the purchase was done in production or sandbox environment.
@@ -1988,7 +5867,7 @@ namespace Nakama.Console
         /// <summary>
         /// Subscription expiration time. The subscription can still be auto-renewed to extend the expiration time further.
         /// </summary>
-        string ExpiryTime { get; }
+        DateTime ExpiryTime { get; }
 
         /// <summary>
         /// Purchase Original transaction ID (we only keep track of the original subscription, not subsequent renewals).
@@ -2013,12 +5892,12 @@ namespace Nakama.Console
         /// <summary>
         /// UNIX Timestamp when the purchase was done.
         /// </summary>
-        string PurchaseTime { get; }
+        DateTime PurchaseTime { get; }
 
         /// <summary>
         /// Subscription refund time. If this time is set, the subscription was refunded.
         /// </summary>
-        string RefundTime { get; }
+        DateTime RefundTime { get; }
 
         /// <summary>
         /// Store identifier
@@ -2028,16 +5907,21 @@ namespace Nakama.Console
         /// <summary>
         /// UNIX Timestamp when the receipt validation was updated in DB.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// Subscription User ID.
         /// </summary>
         string UserId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiValidatedSubscription Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiValidatedSubscription : IApiValidatedSubscription
+    internal class ApiValidatedSubscription : IApiValidatedSubscription, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2045,18 +5929,34 @@ namespace Nakama.Console
         public bool Active { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ApiStoreEnvironment Environment => _environment;
+        public ApiStoreEnvironment Environment
+        {
+            get => ApiStoreEnvironmentConverter.Parse(_environment);
+            set => _environment = ApiStoreEnvironmentConverter.Format(value);
+        }
         [DataMember(Name="environment"), Preserve]
-        public ApiStoreEnvironment _environment { get; set; }
+        public string _environment { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime ExpiryTime
+        {
+            get => ApiClient.ParseDateTime(_expiryTime);
+            set => _expiryTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="expiry_time"), Preserve]
-        public string ExpiryTime { get; set; }
+        public string _expiryTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="original_transaction_id"), Preserve]
@@ -2075,44 +5975,245 @@ namespace Nakama.Console
         public string ProviderResponse { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime PurchaseTime
+        {
+            get => ApiClient.ParseDateTime(_purchaseTime);
+            set => _purchaseTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="purchase_time"), Preserve]
-        public string PurchaseTime { get; set; }
+        public string _purchaseTime { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime RefundTime
+        {
+            get => ApiClient.ParseDateTime(_refundTime);
+            set => _refundTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="refund_time"), Preserve]
-        public string RefundTime { get; set; }
+        public string _refundTime { get; set; }
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ApiStoreProvider Store => _store;
+        public ApiStoreProvider Store
+        {
+            get => ApiStoreProviderConverter.Parse(_store);
+            set => _store = ApiStoreProviderConverter.Format(value);
+        }
         [DataMember(Name="store"), Preserve]
-        public ApiStoreProvider _store { get; set; }
+        public string _store { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="user_id"), Preserve]
         public string UserId { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "active":
+                    Active = reader.ReadBoolean();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "environment":
+                    _environment = reader.ReadString();
+                    return true;
+                case "expiry_time":
+                    _expiryTime = reader.ReadString();
+                    return true;
+                case "original_transaction_id":
+                    OriginalTransactionId = reader.ReadString();
+                    return true;
+                case "product_id":
+                    ProductId = reader.ReadString();
+                    return true;
+                case "provider_notification":
+                    ProviderNotification = reader.ReadString();
+                    return true;
+                case "provider_response":
+                    ProviderResponse = reader.ReadString();
+                    return true;
+                case "purchase_time":
+                    _purchaseTime = reader.ReadString();
+                    return true;
+                case "refund_time":
+                    _refundTime = reader.ReadString();
+                    return true;
+                case "store":
+                    _store = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("active");
+            writer.WriteBoolean(Active);
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (_environment != null)
+            {
+                writer.WriteName("environment");
+                writer.WriteString(_environment);
+            }
+            if (_expiryTime != null)
+            {
+                writer.WriteName("expiry_time");
+                writer.WriteString(_expiryTime);
+            }
+            if (OriginalTransactionId != null)
+            {
+                writer.WriteName("original_transaction_id");
+                writer.WriteString(OriginalTransactionId);
+            }
+            if (ProductId != null)
+            {
+                writer.WriteName("product_id");
+                writer.WriteString(ProductId);
+            }
+            if (ProviderNotification != null)
+            {
+                writer.WriteName("provider_notification");
+                writer.WriteString(ProviderNotification);
+            }
+            if (ProviderResponse != null)
+            {
+                writer.WriteName("provider_response");
+                writer.WriteString(ProviderResponse);
+            }
+            if (_purchaseTime != null)
+            {
+                writer.WriteName("purchase_time");
+                writer.WriteString(_purchaseTime);
+            }
+            if (_refundTime != null)
+            {
+                writer.WriteName("refund_time");
+                writer.WriteString(_refundTime);
+            }
+            if (_store != null)
+            {
+                writer.WriteName("store");
+                writer.WriteString(_store);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiValidatedSubscription Clone() => (ApiValidatedSubscription) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiValidatedSubscription) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiValidatedSubscription) obj;
+            return Active == other.Active &&
+                _createTime == other._createTime &&
+                _environment == other._environment &&
+                _expiryTime == other._expiryTime &&
+                OriginalTransactionId == other.OriginalTransactionId &&
+                ProductId == other.ProductId &&
+                ProviderNotification == other.ProviderNotification &&
+                ProviderResponse == other.ProviderResponse &&
+                _purchaseTime == other._purchaseTime &&
+                _refundTime == other._refundTime &&
+                _store == other._store &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Active.GetHashCode();
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_environment?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_expiryTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (OriginalTransactionId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProductId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProviderNotification?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ProviderResponse?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_purchaseTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_refundTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_store?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Active: ", Active, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Environment: ", Environment, ", ");
-            output = string.Concat(output, "ExpiryTime: ", ExpiryTime, ", ");
-            output = string.Concat(output, "OriginalTransactionId: ", OriginalTransactionId, ", ");
-            output = string.Concat(output, "ProductId: ", ProductId, ", ");
-            output = string.Concat(output, "ProviderNotification: ", ProviderNotification, ", ");
-            output = string.Concat(output, "ProviderResponse: ", ProviderResponse, ", ");
-            output = string.Concat(output, "PurchaseTime: ", PurchaseTime, ", ");
-            output = string.Concat(output, "RefundTime: ", RefundTime, ", ");
-            output = string.Concat(output, "Store: ", Store, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Active: " + ApiClient.FormatValue(Active));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Environment: " + ApiClient.FormatEncoded(_environment));
+            members.Add("ExpiryTime: " + ApiClient.FormatValue(ExpiryTime));
+            members.Add("OriginalTransactionId: " + ApiClient.FormatValue(OriginalTransactionId));
+            members.Add("ProductId: " + ApiClient.FormatValue(ProductId));
+            members.Add("ProviderNotification: " + ApiClient.FormatValue(ProviderNotification));
+            members.Add("ProviderResponse: " + ApiClient.FormatValue(ProviderResponse));
+            members.Add("PurchaseTime: " + ApiClient.FormatValue(PurchaseTime));
+            members.Add("RefundTime: " + ApiClient.FormatValue(RefundTime));
+            members.Add("Store: " + ApiClient.FormatEncoded(_store));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
         }
     }
 
@@ -2161,10 +6262,15 @@ namespace Nakama.Console
         /// The user's wallet ledger items.
         /// </summary>
         IEnumerable<IConsoleWalletLedger> WalletLedgers { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleAccountExport Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleAccountExport : IConsoleAccountExport
+    internal class ConsoleAccountExport : IConsoleAccountExport, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2215,18 +6321,158 @@ namespace Nakama.Console
         [DataMember(Name="wallet_ledgers"), Preserve]
         public List<ConsoleWalletLedger> _walletLedgers { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "account":
+                    _account = reader.ReadObject<NakamaapiAccount>();
+                    return true;
+                case "friends":
+                    _friends = reader.ReadList(reader.ReadObject<ApiFriend>);
+                    return true;
+                case "groups":
+                    _groups = reader.ReadList(reader.ReadObject<ApiGroup>);
+                    return true;
+                case "leaderboard_records":
+                    _leaderboardRecords = reader.ReadList(reader.ReadObject<ApiLeaderboardRecord>);
+                    return true;
+                case "messages":
+                    _messages = reader.ReadList(reader.ReadObject<ApiChannelMessage>);
+                    return true;
+                case "notifications":
+                    _notifications = reader.ReadList(reader.ReadObject<NakamaapiNotification>);
+                    return true;
+                case "objects":
+                    _objects = reader.ReadList(reader.ReadObject<ApiStorageObject>);
+                    return true;
+                case "wallet_ledgers":
+                    _walletLedgers = reader.ReadList(reader.ReadObject<ConsoleWalletLedger>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_account != null)
+            {
+                writer.WriteName("account");
+                writer.WriteObject(_account);
+            }
+            if (_friends != null)
+            {
+                writer.WriteName("friends");
+                writer.WriteList(_friends, writer.WriteObject);
+            }
+            if (_groups != null)
+            {
+                writer.WriteName("groups");
+                writer.WriteList(_groups, writer.WriteObject);
+            }
+            if (_leaderboardRecords != null)
+            {
+                writer.WriteName("leaderboard_records");
+                writer.WriteList(_leaderboardRecords, writer.WriteObject);
+            }
+            if (_messages != null)
+            {
+                writer.WriteName("messages");
+                writer.WriteList(_messages, writer.WriteObject);
+            }
+            if (_notifications != null)
+            {
+                writer.WriteName("notifications");
+                writer.WriteList(_notifications, writer.WriteObject);
+            }
+            if (_objects != null)
+            {
+                writer.WriteName("objects");
+                writer.WriteList(_objects, writer.WriteObject);
+            }
+            if (_walletLedgers != null)
+            {
+                writer.WriteName("wallet_ledgers");
+                writer.WriteList(_walletLedgers, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleAccountExport Clone() => (ConsoleAccountExport) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleAccountExport) MemberwiseClone();
+            clone._account = (NakamaapiAccount) _account?.CloneModel();
+            clone._friends = _friends?.ConvertAll(item => (ApiFriend) item?.CloneModel());
+            clone._groups = _groups?.ConvertAll(item => (ApiGroup) item?.CloneModel());
+            clone._leaderboardRecords = _leaderboardRecords?.ConvertAll(item => (ApiLeaderboardRecord) item?.CloneModel());
+            clone._messages = _messages?.ConvertAll(item => (ApiChannelMessage) item?.CloneModel());
+            clone._notifications = _notifications?.ConvertAll(item => (NakamaapiNotification) item?.CloneModel());
+            clone._objects = _objects?.ConvertAll(item => (ApiStorageObject) item?.CloneModel());
+            clone._walletLedgers = _walletLedgers?.ConvertAll(item => (ConsoleWalletLedger) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleAccountExport) obj;
+            return Equals(_account, other._account) &&
+                ApiClient.ListEquals(_friends, other._friends) &&
+                ApiClient.ListEquals(_groups, other._groups) &&
+                ApiClient.ListEquals(_leaderboardRecords, other._leaderboardRecords) &&
+                ApiClient.ListEquals(_messages, other._messages) &&
+                ApiClient.ListEquals(_notifications, other._notifications) &&
+                ApiClient.ListEquals(_objects, other._objects) &&
+                ApiClient.ListEquals(_walletLedgers, other._walletLedgers);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_account?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_friends);
+                hash = hash * 31 + ApiClient.ListHashCode(_groups);
+                hash = hash * 31 + ApiClient.ListHashCode(_leaderboardRecords);
+                hash = hash * 31 + ApiClient.ListHashCode(_messages);
+                hash = hash * 31 + ApiClient.ListHashCode(_notifications);
+                hash = hash * 31 + ApiClient.ListHashCode(_objects);
+                hash = hash * 31 + ApiClient.ListHashCode(_walletLedgers);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Account: ", Account, ", ");
-            output = string.Concat(output, "Friends: [", string.Join(", ", Friends), "], ");
-            output = string.Concat(output, "Groups: [", string.Join(", ", Groups), "], ");
-            output = string.Concat(output, "LeaderboardRecords: [", string.Join(", ", LeaderboardRecords), "], ");
-            output = string.Concat(output, "Messages: [", string.Join(", ", Messages), "], ");
-            output = string.Concat(output, "Notifications: [", string.Join(", ", Notifications), "], ");
-            output = string.Concat(output, "Objects: [", string.Join(", ", Objects), "], ");
-            output = string.Concat(output, "WalletLedgers: [", string.Join(", ", WalletLedgers), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Account: " + ApiClient.FormatValue(_account));
+            members.Add("Friends: " + ApiClient.FormatList(_friends));
+            members.Add("Groups: " + ApiClient.FormatList(_groups));
+            members.Add("LeaderboardRecords: " + ApiClient.FormatList(_leaderboardRecords));
+            members.Add("Messages: " + ApiClient.FormatList(_messages));
+            members.Add("Notifications: " + ApiClient.FormatList(_notifications));
+            members.Add("Objects: " + ApiClient.FormatList(_objects));
+            members.Add("WalletLedgers: " + ApiClient.FormatList(_walletLedgers));
         }
     }
 
@@ -2250,10 +6496,15 @@ namespace Nakama.Console
         /// A list of users.
         /// </summary>
         IEnumerable<INakamaapiUser> Users { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleAccountList Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleAccountList : IConsoleAccountList
+    internal class ConsoleAccountList : IConsoleAccountList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2270,13 +6521,93 @@ namespace Nakama.Console
         [DataMember(Name="users"), Preserve]
         public List<NakamaapiUser> _users { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "total_count":
+                    TotalCount = reader.ReadInt32();
+                    return true;
+                case "users":
+                    _users = reader.ReadList(reader.ReadObject<NakamaapiUser>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            writer.WriteName("total_count");
+            writer.WriteInt32(TotalCount);
+            if (_users != null)
+            {
+                writer.WriteName("users");
+                writer.WriteList(_users, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleAccountList Clone() => (ConsoleAccountList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleAccountList) MemberwiseClone();
+            clone._users = _users?.ConvertAll(item => (NakamaapiUser) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleAccountList) obj;
+            return NextCursor == other.NextCursor &&
+                TotalCount == other.TotalCount &&
+                ApiClient.ListEquals(_users, other._users);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + TotalCount.GetHashCode();
+                hash = hash * 31 + ApiClient.ListHashCode(_users);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "TotalCount: ", TotalCount, ", ");
-            output = string.Concat(output, "Users: [", string.Join(", ", Users), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("TotalCount: " + ApiClient.FormatValue(TotalCount));
+            members.Add("Users: " + ApiClient.FormatList(_users));
         }
     }
 
@@ -2315,10 +6646,15 @@ namespace Nakama.Console
         /// The username of the user.
         /// </summary>
         string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleAddUserRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleAddUserRequest : IConsoleAddUserRequest
+    internal class ConsoleAddUserRequest : IConsoleAddUserRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2339,24 +6675,134 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ConsoleUserRole Role => _role;
+        public ConsoleUserRole Role
+        {
+            get => ConsoleUserRoleConverter.Parse(_role);
+            set => _role = ConsoleUserRoleConverter.Format(value);
+        }
         [DataMember(Name="role"), Preserve]
-        public ConsoleUserRole _role { get; set; }
+        public string _role { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="username"), Preserve]
         public string Username { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "email":
+                    Email = reader.ReadString();
+                    return true;
+                case "mfa_required":
+                    MfaRequired = reader.ReadBoolean();
+                    return true;
+                case "newsletter_subscription":
+                    NewsletterSubscription = reader.ReadBoolean();
+                    return true;
+                case "password":
+                    Password = reader.ReadString();
+                    return true;
+                case "role":
+                    _role = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Email != null)
+            {
+                writer.WriteName("email");
+                writer.WriteString(Email);
+            }
+            writer.WriteName("mfa_required");
+            writer.WriteBoolean(MfaRequired);
+            writer.WriteName("newsletter_subscription");
+            writer.WriteBoolean(NewsletterSubscription);
+            if (Password != null)
+            {
+                writer.WriteName("password");
+                writer.WriteString(Password);
+            }
+            if (_role != null)
+            {
+                writer.WriteName("role");
+                writer.WriteString(_role);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleAddUserRequest Clone() => (ConsoleAddUserRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleAddUserRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleAddUserRequest) obj;
+            return Email == other.Email &&
+                MfaRequired == other.MfaRequired &&
+                NewsletterSubscription == other.NewsletterSubscription &&
+                Password == other.Password &&
+                _role == other._role &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Email?.GetHashCode() ?? 0);
+                hash = hash * 31 + MfaRequired.GetHashCode();
+                hash = hash * 31 + NewsletterSubscription.GetHashCode();
+                hash = hash * 31 + (Password?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_role?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Email: ", Email, ", ");
-            output = string.Concat(output, "MfaRequired: ", MfaRequired, ", ");
-            output = string.Concat(output, "NewsletterSubscription: ", NewsletterSubscription, ", ");
-            output = string.Concat(output, "Password: ", Password, ", ");
-            output = string.Concat(output, "Role: ", Role, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Email: " + ApiClient.FormatValue(Email));
+            members.Add("MfaRequired: " + ApiClient.FormatValue(MfaRequired));
+            members.Add("NewsletterSubscription: " + ApiClient.FormatValue(NewsletterSubscription));
+            members.Add("Password: " + ApiClient.FormatValue(Password));
+            members.Add("Role: " + ApiClient.FormatEncoded(_role));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
         }
     }
 
@@ -2375,10 +6821,15 @@ namespace Nakama.Console
         /// 
         /// </summary>
         string Method { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleApiEndpointDescriptor Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleApiEndpointDescriptor : IConsoleApiEndpointDescriptor
+    internal class ConsoleApiEndpointDescriptor : IConsoleApiEndpointDescriptor, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2389,12 +6840,84 @@ namespace Nakama.Console
         [DataMember(Name="method"), Preserve]
         public string Method { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "body_template":
+                    BodyTemplate = reader.ReadString();
+                    return true;
+                case "method":
+                    Method = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (BodyTemplate != null)
+            {
+                writer.WriteName("body_template");
+                writer.WriteString(BodyTemplate);
+            }
+            if (Method != null)
+            {
+                writer.WriteName("method");
+                writer.WriteString(Method);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleApiEndpointDescriptor Clone() => (ConsoleApiEndpointDescriptor) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleApiEndpointDescriptor) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleApiEndpointDescriptor) obj;
+            return BodyTemplate == other.BodyTemplate &&
+                Method == other.Method;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (BodyTemplate?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Method?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "BodyTemplate: ", BodyTemplate, ", ");
-            output = string.Concat(output, "Method: ", Method, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("BodyTemplate: " + ApiClient.FormatValue(BodyTemplate));
+            members.Add("Method: " + ApiClient.FormatValue(Method));
         }
     }
 
@@ -2413,10 +6936,15 @@ namespace Nakama.Console
         /// 
         /// </summary>
         IEnumerable<IConsoleApiEndpointDescriptor> RpcEndpoints { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleApiEndpointList Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleApiEndpointList : IConsoleApiEndpointList
+    internal class ConsoleApiEndpointList : IConsoleApiEndpointList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2426,17 +6954,91 @@ namespace Nakama.Console
         public List<ConsoleApiEndpointDescriptor> _endpoints { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IConsoleApiEndpointDescriptor> RpcEndpoints => _rpcEndpoints ?? new List<ConsoleApiEndpointDescriptor>(0);
-        [DataMember(Name="rpc_endpoints"), Preserve]
-        public List<ConsoleApiEndpointDescriptor> _rpcEndpoints { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IConsoleApiEndpointDescriptor> RpcEndpoints => _rpcEndpoints ?? new List<ConsoleApiEndpointDescriptor>(0);
+        [DataMember(Name="rpc_endpoints"), Preserve]
+        public List<ConsoleApiEndpointDescriptor> _rpcEndpoints { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "endpoints":
+                    _endpoints = reader.ReadList(reader.ReadObject<ConsoleApiEndpointDescriptor>);
+                    return true;
+                case "rpc_endpoints":
+                    _rpcEndpoints = reader.ReadList(reader.ReadObject<ConsoleApiEndpointDescriptor>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_endpoints != null)
+            {
+                writer.WriteName("endpoints");
+                writer.WriteList(_endpoints, writer.WriteObject);
+            }
+            if (_rpcEndpoints != null)
+            {
+                writer.WriteName("rpc_endpoints");
+                writer.WriteList(_rpcEndpoints, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleApiEndpointList Clone() => (ConsoleApiEndpointList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleApiEndpointList) MemberwiseClone();
+            clone._endpoints = _endpoints?.ConvertAll(item => (ConsoleApiEndpointDescriptor) item?.CloneModel());
+            clone._rpcEndpoints = _rpcEndpoints?.ConvertAll(item => (ConsoleApiEndpointDescriptor) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleApiEndpointList) obj;
+            return ApiClient.ListEquals(_endpoints, other._endpoints) &&
+                ApiClient.ListEquals(_rpcEndpoints, other._rpcEndpoints);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_endpoints);
+                hash = hash * 31 + ApiClient.ListHashCode(_rpcEndpoints);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Endpoints: [", string.Join(", ", Endpoints), "], ");
-            output = string.Concat(output, "RpcEndpoints: [", string.Join(", ", RpcEndpoints), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Endpoints: " + ApiClient.FormatList(_endpoints));
+            members.Add("RpcEndpoints: " + ApiClient.FormatList(_rpcEndpoints));
         }
     }
 
@@ -2450,21 +7052,88 @@ namespace Nakama.Console
         /// Session token to log out.
         /// </summary>
         string Token { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleAuthenticateLogoutRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleAuthenticateLogoutRequest : IConsoleAuthenticateLogoutRequest
+    internal class ConsoleAuthenticateLogoutRequest : IConsoleAuthenticateLogoutRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="token"), Preserve]
         public string Token { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleAuthenticateLogoutRequest Clone() => (ConsoleAuthenticateLogoutRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleAuthenticateLogoutRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleAuthenticateLogoutRequest) obj;
+            return Token == other.Token;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Token: ", Token, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Token: " + ApiClient.FormatValue(Token));
         }
     }
 
@@ -2483,10 +7152,15 @@ namespace Nakama.Console
         /// MFA token.
         /// </summary>
         string Mfa { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleAuthenticateMFASetupRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleAuthenticateMFASetupRequest : IConsoleAuthenticateMFASetupRequest
+    internal class ConsoleAuthenticateMFASetupRequest : IConsoleAuthenticateMFASetupRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2497,12 +7171,84 @@ namespace Nakama.Console
         [DataMember(Name="mfa"), Preserve]
         public string Mfa { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "code":
+                    Code = reader.ReadString();
+                    return true;
+                case "mfa":
+                    Mfa = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Code != null)
+            {
+                writer.WriteName("code");
+                writer.WriteString(Code);
+            }
+            if (Mfa != null)
+            {
+                writer.WriteName("mfa");
+                writer.WriteString(Mfa);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleAuthenticateMFASetupRequest Clone() => (ConsoleAuthenticateMFASetupRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleAuthenticateMFASetupRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleAuthenticateMFASetupRequest) obj;
+            return Code == other.Code &&
+                Mfa == other.Mfa;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Code?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Mfa?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Mfa: ", Mfa, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Mfa: " + ApiClient.FormatValue(Mfa));
         }
     }
 
@@ -2516,21 +7262,89 @@ namespace Nakama.Console
         /// An one-time code to configure the MFA mechanism
         /// </summary>
         List<string> RecoveryCodes { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleAuthenticateMFASetupResponse Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleAuthenticateMFASetupResponse : IConsoleAuthenticateMFASetupResponse
+    internal class ConsoleAuthenticateMFASetupResponse : IConsoleAuthenticateMFASetupResponse, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="recovery_codes"), Preserve]
         public List<string> RecoveryCodes { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "recovery_codes":
+                    RecoveryCodes = reader.ReadList(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (RecoveryCodes != null)
+            {
+                writer.WriteName("recovery_codes");
+                writer.WriteList(RecoveryCodes, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleAuthenticateMFASetupResponse Clone() => (ConsoleAuthenticateMFASetupResponse) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleAuthenticateMFASetupResponse) MemberwiseClone();
+            clone.RecoveryCodes = RecoveryCodes?.ConvertAll(item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleAuthenticateMFASetupResponse) obj;
+            return ApiClient.ListEquals(RecoveryCodes, other.RecoveryCodes);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(RecoveryCodes);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "RecoveryCodes: [", string.Join(", ", RecoveryCodes), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("RecoveryCodes: " + ApiClient.FormatList(RecoveryCodes));
         }
     }
 
@@ -2554,10 +7368,15 @@ namespace Nakama.Console
         /// The username of the user.
         /// </summary>
         string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleAuthenticateRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleAuthenticateRequest : IConsoleAuthenticateRequest
+    internal class ConsoleAuthenticateRequest : IConsoleAuthenticateRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2572,13 +7391,95 @@ namespace Nakama.Console
         [DataMember(Name="username"), Preserve]
         public string Username { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "mfa":
+                    Mfa = reader.ReadString();
+                    return true;
+                case "password":
+                    Password = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Mfa != null)
+            {
+                writer.WriteName("mfa");
+                writer.WriteString(Mfa);
+            }
+            if (Password != null)
+            {
+                writer.WriteName("password");
+                writer.WriteString(Password);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleAuthenticateRequest Clone() => (ConsoleAuthenticateRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleAuthenticateRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleAuthenticateRequest) obj;
+            return Mfa == other.Mfa &&
+                Password == other.Password &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Mfa?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Password?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Mfa: ", Mfa, ", ");
-            output = string.Concat(output, "Password: ", Password, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Mfa: " + ApiClient.FormatValue(Mfa));
+            members.Add("Password: " + ApiClient.FormatValue(Password));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
         }
     }
 
@@ -2597,10 +7498,15 @@ namespace Nakama.Console
         /// 
         /// </summary>
         string ErrorMessage { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleCallApiEndpointResponse Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleCallApiEndpointResponse : IConsoleCallApiEndpointResponse
+    internal class ConsoleCallApiEndpointResponse : IConsoleCallApiEndpointResponse, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2611,12 +7517,84 @@ namespace Nakama.Console
         [DataMember(Name="error_message"), Preserve]
         public string ErrorMessage { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "body":
+                    Body = reader.ReadString();
+                    return true;
+                case "error_message":
+                    ErrorMessage = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Body != null)
+            {
+                writer.WriteName("body");
+                writer.WriteString(Body);
+            }
+            if (ErrorMessage != null)
+            {
+                writer.WriteName("error_message");
+                writer.WriteString(ErrorMessage);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleCallApiEndpointResponse Clone() => (ConsoleCallApiEndpointResponse) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleCallApiEndpointResponse) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleCallApiEndpointResponse) obj;
+            return Body == other.Body &&
+                ErrorMessage == other.ErrorMessage;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Body?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ErrorMessage?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Body: ", Body, ", ");
-            output = string.Concat(output, "ErrorMessage: ", ErrorMessage, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Body: " + ApiClient.FormatValue(Body));
+            members.Add("ErrorMessage: " + ApiClient.FormatValue(ErrorMessage));
         }
     }
 
@@ -2640,10 +7618,15 @@ namespace Nakama.Console
         /// Any warnings about the current config.
         /// </summary>
         IEnumerable<IConfigWarning> Warnings { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleConfig Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleConfig : IConsoleConfig
+    internal class ConsoleConfig : IConsoleConfig, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2660,13 +7643,96 @@ namespace Nakama.Console
         [DataMember(Name="warnings"), Preserve]
         public List<ConfigWarning> _warnings { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "config":
+                    Config = reader.ReadString();
+                    return true;
+                case "server_version":
+                    ServerVersion = reader.ReadString();
+                    return true;
+                case "warnings":
+                    _warnings = reader.ReadList(reader.ReadObject<ConfigWarning>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Config != null)
+            {
+                writer.WriteName("config");
+                writer.WriteString(Config);
+            }
+            if (ServerVersion != null)
+            {
+                writer.WriteName("server_version");
+                writer.WriteString(ServerVersion);
+            }
+            if (_warnings != null)
+            {
+                writer.WriteName("warnings");
+                writer.WriteList(_warnings, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleConfig Clone() => (ConsoleConfig) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleConfig) MemberwiseClone();
+            clone._warnings = _warnings?.ConvertAll(item => (ConfigWarning) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleConfig) obj;
+            return Config == other.Config &&
+                ServerVersion == other.ServerVersion &&
+                ApiClient.ListEquals(_warnings, other._warnings);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Config?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ServerVersion?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_warnings);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Config: ", Config, ", ");
-            output = string.Concat(output, "ServerVersion: ", ServerVersion, ", ");
-            output = string.Concat(output, "Warnings: [", string.Join(", ", Warnings), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Config: " + ApiClient.FormatValue(Config));
+            members.Add("ServerVersion: " + ApiClient.FormatValue(ServerVersion));
+            members.Add("Warnings: " + ApiClient.FormatList(_warnings));
         }
     }
 
@@ -2682,13 +7748,18 @@ namespace Nakama.Console
         string MfaCode { get; }
 
         /// <summary>
-        /// A session token (JWT) for the console user.
+        /// A session token (JWT) for the console user.
+        /// </summary>
+        string Token { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Token { get; }
+        IConsoleConsoleSession Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleConsoleSession : IConsoleConsoleSession
+    internal class ConsoleConsoleSession : IConsoleConsoleSession, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2699,12 +7770,84 @@ namespace Nakama.Console
         [DataMember(Name="token"), Preserve]
         public string Token { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "mfa_code":
+                    MfaCode = reader.ReadString();
+                    return true;
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (MfaCode != null)
+            {
+                writer.WriteName("mfa_code");
+                writer.WriteString(MfaCode);
+            }
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleConsoleSession Clone() => (ConsoleConsoleSession) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleConsoleSession) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleConsoleSession) obj;
+            return MfaCode == other.MfaCode &&
+                Token == other.Token;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (MfaCode?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "MfaCode: ", MfaCode, ", ");
-            output = string.Concat(output, "Token: ", Token, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("MfaCode: " + ApiClient.FormatValue(MfaCode));
+            members.Add("Token: " + ApiClient.FormatValue(Token));
         }
     }
 
@@ -2717,22 +7860,95 @@ namespace Nakama.Console
         /// <summary>
         /// Total number of messages deleted.
         /// </summary>
-        string Total { get; }
+        long Total { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleDeleteChannelMessagesResponse Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleDeleteChannelMessagesResponse : IConsoleDeleteChannelMessagesResponse
+    internal class ConsoleDeleteChannelMessagesResponse : IConsoleDeleteChannelMessagesResponse, IApiJsonObject
     {
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Total
+        {
+            get => ApiClient.ParseInt64(_total);
+            set => _total = ApiClient.FormatInt64(value);
+        }
         [DataMember(Name="total"), Preserve]
-        public string Total { get; set; }
+        public string _total { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "total":
+                    _total = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_total != null)
+            {
+                writer.WriteName("total");
+                writer.WriteString(_total);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleDeleteChannelMessagesResponse Clone() => (ConsoleDeleteChannelMessagesResponse) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleDeleteChannelMessagesResponse) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleDeleteChannelMessagesResponse) obj;
+            return _total == other._total;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_total?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Total: ", Total, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Total: " + ApiClient.FormatValue(Total));
         }
     }
 
@@ -2751,10 +7967,15 @@ namespace Nakama.Console
         /// The group's list of members.
         /// </summary>
         IEnumerable<IGroupUserListGroupUser> Members { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleGroupExport Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleGroupExport : IConsoleGroupExport
+    internal class ConsoleGroupExport : IConsoleGroupExport, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2769,36 +7990,87 @@ namespace Nakama.Console
         [DataMember(Name="members"), Preserve]
         public List<GroupUserListGroupUser> _members { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "group":
+                    _group = reader.ReadObject<ApiGroup>();
+                    return true;
+                case "members":
+                    _members = reader.ReadList(reader.ReadObject<GroupUserListGroupUser>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_group != null)
+            {
+                writer.WriteName("group");
+                writer.WriteObject(_group);
+            }
+            if (_members != null)
+            {
+                writer.WriteName("members");
+                writer.WriteList(_members, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleGroupExport Clone() => (ConsoleGroupExport) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleGroupExport) MemberwiseClone();
+            clone._group = (ApiGroup) _group?.CloneModel();
+            clone._members = _members?.ConvertAll(item => (GroupUserListGroupUser) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleGroupExport) obj;
+            return Equals(_group, other._group) &&
+                ApiClient.ListEquals(_members, other._members);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_group?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_members);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Group: ", Group, ", ");
-            output = string.Concat(output, "Members: [", string.Join(", ", Members), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
         }
-    }
 
-    /// <summary>
-    /// 
-    /// </summary>
-    public enum ConsoleListChannelMessagesRequestType
-    {
-        /// <summary>
-        /// 
-        /// </summary>
-        UNKNOWN = 0,
-        /// <summary>
-        /// 
-        /// </summary>
-        ROOM = 1,
-        /// <summary>
-        /// 
-        /// </summary>
-        GROUP = 2,
-        /// <summary>
-        /// 
-        /// </summary>
-        DIRECT = 3,
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Group: " + ApiClient.FormatValue(_group));
+            members.Add("Members: " + ApiClient.FormatList(_members));
+        }
     }
 
     /// <summary>
@@ -2816,10 +8088,15 @@ namespace Nakama.Console
         /// The node name
         /// </summary>
         string Node { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleMatchListMatch Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleMatchListMatch : IConsoleMatchListMatch
+    internal class ConsoleMatchListMatch : IConsoleMatchListMatch, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2832,12 +8109,85 @@ namespace Nakama.Console
         [DataMember(Name="node"), Preserve]
         public string Node { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "api_match":
+                    _apiMatch = reader.ReadObject<NakamaapiMatch>();
+                    return true;
+                case "node":
+                    Node = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_apiMatch != null)
+            {
+                writer.WriteName("api_match");
+                writer.WriteObject(_apiMatch);
+            }
+            if (Node != null)
+            {
+                writer.WriteName("node");
+                writer.WriteString(Node);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleMatchListMatch Clone() => (ConsoleMatchListMatch) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleMatchListMatch) MemberwiseClone();
+            clone._apiMatch = (NakamaapiMatch) _apiMatch?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleMatchListMatch) obj;
+            return Equals(_apiMatch, other._apiMatch) &&
+                Node == other.Node;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_apiMatch?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Node?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ApiMatch: ", ApiMatch, ", ");
-            output = string.Concat(output, "Node: ", Node, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ApiMatch: " + ApiClient.FormatValue(_apiMatch));
+            members.Add("Node: " + ApiClient.FormatValue(Node));
         }
     }
 
@@ -2860,11 +8210,16 @@ namespace Nakama.Console
         /// <summary>
         /// Current tick number.
         /// </summary>
-        string Tick { get; }
+        long Tick { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleMatchState Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleMatchState : IConsoleMatchState
+    internal class ConsoleMatchState : IConsoleMatchState, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2878,16 +8233,105 @@ namespace Nakama.Console
         public string State { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public long Tick
+        {
+            get => ApiClient.ParseInt64(_tick);
+            set => _tick = ApiClient.FormatInt64(value);
+        }
         [DataMember(Name="tick"), Preserve]
-        public string Tick { get; set; }
+        public string _tick { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "presences":
+                    _presences = reader.ReadList(reader.ReadObject<RealtimeUserPresence>);
+                    return true;
+                case "state":
+                    State = reader.ReadString();
+                    return true;
+                case "tick":
+                    _tick = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_presences != null)
+            {
+                writer.WriteName("presences");
+                writer.WriteList(_presences, writer.WriteObject);
+            }
+            if (State != null)
+            {
+                writer.WriteName("state");
+                writer.WriteString(State);
+            }
+            if (_tick != null)
+            {
+                writer.WriteName("tick");
+                writer.WriteString(_tick);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleMatchState Clone() => (ConsoleMatchState) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleMatchState) MemberwiseClone();
+            clone._presences = _presences?.ConvertAll(item => (RealtimeUserPresence) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleMatchState) obj;
+            return ApiClient.ListEquals(_presences, other._presences) &&
+                State == other.State &&
+                _tick == other._tick;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_presences);
+                hash = hash * 31 + (State?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_tick?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Presences: [", string.Join(", ", Presences), "], ");
-            output = string.Concat(output, "State: ", State, ", ");
-            output = string.Concat(output, "Tick: ", Tick, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Presences: " + ApiClient.FormatList(_presences));
+            members.Add("State: " + ApiClient.FormatValue(State));
+            members.Add("Tick: " + ApiClient.FormatValue(Tick));
         }
     }
 
@@ -2926,10 +8370,15 @@ namespace Nakama.Console
         /// Lua registered RPC functions
         /// </summary>
         List<string> LuaRpcFunctions { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleRuntimeInfo Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleRuntimeInfo : IConsoleRuntimeInfo
+    internal class ConsoleRuntimeInfo : IConsoleRuntimeInfo, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -2948,30 +8397,148 @@ namespace Nakama.Console
         [DataMember(Name="js_modules"), Preserve]
         public List<RuntimeInfoModuleInfo> _jsModules { get; set; }
 
-        /// <inheritdoc />
-        [DataMember(Name="js_rpc_functions"), Preserve]
-        public List<string> JsRpcFunctions { get; set; }
+        /// <inheritdoc />
+        [DataMember(Name="js_rpc_functions"), Preserve]
+        public List<string> JsRpcFunctions { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IRuntimeInfoModuleInfo> LuaModules => _luaModules ?? new List<RuntimeInfoModuleInfo>(0);
+        [DataMember(Name="lua_modules"), Preserve]
+        public List<RuntimeInfoModuleInfo> _luaModules { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="lua_rpc_functions"), Preserve]
+        public List<string> LuaRpcFunctions { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "go_modules":
+                    _goModules = reader.ReadList(reader.ReadObject<RuntimeInfoModuleInfo>);
+                    return true;
+                case "go_rpc_functions":
+                    GoRpcFunctions = reader.ReadList(reader.ReadString);
+                    return true;
+                case "js_modules":
+                    _jsModules = reader.ReadList(reader.ReadObject<RuntimeInfoModuleInfo>);
+                    return true;
+                case "js_rpc_functions":
+                    JsRpcFunctions = reader.ReadList(reader.ReadString);
+                    return true;
+                case "lua_modules":
+                    _luaModules = reader.ReadList(reader.ReadObject<RuntimeInfoModuleInfo>);
+                    return true;
+                case "lua_rpc_functions":
+                    LuaRpcFunctions = reader.ReadList(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_goModules != null)
+            {
+                writer.WriteName("go_modules");
+                writer.WriteList(_goModules, writer.WriteObject);
+            }
+            if (GoRpcFunctions != null)
+            {
+                writer.WriteName("go_rpc_functions");
+                writer.WriteList(GoRpcFunctions, writer.WriteString);
+            }
+            if (_jsModules != null)
+            {
+                writer.WriteName("js_modules");
+                writer.WriteList(_jsModules, writer.WriteObject);
+            }
+            if (JsRpcFunctions != null)
+            {
+                writer.WriteName("js_rpc_functions");
+                writer.WriteList(JsRpcFunctions, writer.WriteString);
+            }
+            if (_luaModules != null)
+            {
+                writer.WriteName("lua_modules");
+                writer.WriteList(_luaModules, writer.WriteObject);
+            }
+            if (LuaRpcFunctions != null)
+            {
+                writer.WriteName("lua_rpc_functions");
+                writer.WriteList(LuaRpcFunctions, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleRuntimeInfo Clone() => (ConsoleRuntimeInfo) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleRuntimeInfo) MemberwiseClone();
+            clone._goModules = _goModules?.ConvertAll(item => (RuntimeInfoModuleInfo) item?.CloneModel());
+            clone.GoRpcFunctions = GoRpcFunctions?.ConvertAll(item => item);
+            clone._jsModules = _jsModules?.ConvertAll(item => (RuntimeInfoModuleInfo) item?.CloneModel());
+            clone.JsRpcFunctions = JsRpcFunctions?.ConvertAll(item => item);
+            clone._luaModules = _luaModules?.ConvertAll(item => (RuntimeInfoModuleInfo) item?.CloneModel());
+            clone.LuaRpcFunctions = LuaRpcFunctions?.ConvertAll(item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IRuntimeInfoModuleInfo> LuaModules => _luaModules ?? new List<RuntimeInfoModuleInfo>(0);
-        [DataMember(Name="lua_modules"), Preserve]
-        public List<RuntimeInfoModuleInfo> _luaModules { get; set; }
+            var other = (ConsoleRuntimeInfo) obj;
+            return ApiClient.ListEquals(_goModules, other._goModules) &&
+                ApiClient.ListEquals(GoRpcFunctions, other.GoRpcFunctions) &&
+                ApiClient.ListEquals(_jsModules, other._jsModules) &&
+                ApiClient.ListEquals(JsRpcFunctions, other.JsRpcFunctions) &&
+                ApiClient.ListEquals(_luaModules, other._luaModules) &&
+                ApiClient.ListEquals(LuaRpcFunctions, other.LuaRpcFunctions);
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="lua_rpc_functions"), Preserve]
-        public List<string> LuaRpcFunctions { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_goModules);
+                hash = hash * 31 + ApiClient.ListHashCode(GoRpcFunctions);
+                hash = hash * 31 + ApiClient.ListHashCode(_jsModules);
+                hash = hash * 31 + ApiClient.ListHashCode(JsRpcFunctions);
+                hash = hash * 31 + ApiClient.ListHashCode(_luaModules);
+                hash = hash * 31 + ApiClient.ListHashCode(LuaRpcFunctions);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "GoModules: [", string.Join(", ", GoModules), "], ");
-            output = string.Concat(output, "GoRpcFunctions: [", string.Join(", ", GoRpcFunctions), "], ");
-            output = string.Concat(output, "JsModules: [", string.Join(", ", JsModules), "], ");
-            output = string.Concat(output, "JsRpcFunctions: [", string.Join(", ", JsRpcFunctions), "], ");
-            output = string.Concat(output, "LuaModules: [", string.Join(", ", LuaModules), "], ");
-            output = string.Concat(output, "LuaRpcFunctions: [", string.Join(", ", LuaRpcFunctions), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("GoModules: " + ApiClient.FormatList(_goModules));
+            members.Add("GoRpcFunctions: " + ApiClient.FormatList(GoRpcFunctions));
+            members.Add("JsModules: " + ApiClient.FormatList(_jsModules));
+            members.Add("JsRpcFunctions: " + ApiClient.FormatList(JsRpcFunctions));
+            members.Add("LuaModules: " + ApiClient.FormatList(_luaModules));
+            members.Add("LuaRpcFunctions: " + ApiClient.FormatList(LuaRpcFunctions));
         }
     }
 
@@ -2989,16 +8556,21 @@ namespace Nakama.Console
         /// <summary>
         /// Update time.
         /// </summary>
-        string UpdateTimeSec { get; }
+        long UpdateTimeSec { get; }
 
         /// <summary>
         /// Setting value.
         /// </summary>
         string Value { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleSetting Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleSetting : IConsoleSetting
+    internal class ConsoleSetting : IConsoleSetting, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3006,20 +8578,108 @@ namespace Nakama.Console
         public string Name { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public long UpdateTimeSec
+        {
+            get => ApiClient.ParseInt64(_updateTimeSec);
+            set => _updateTimeSec = ApiClient.FormatInt64(value);
+        }
         [DataMember(Name="update_time_sec"), Preserve]
-        public string UpdateTimeSec { get; set; }
+        public string _updateTimeSec { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="value"), Preserve]
         public string Value { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "update_time_sec":
+                    _updateTimeSec = reader.ReadString();
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (_updateTimeSec != null)
+            {
+                writer.WriteName("update_time_sec");
+                writer.WriteString(_updateTimeSec);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleSetting Clone() => (ConsoleSetting) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleSetting) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleSetting) obj;
+            return Name == other.Name &&
+                _updateTimeSec == other._updateTimeSec &&
+                Value == other.Value;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTimeSec?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "UpdateTimeSec: ", UpdateTimeSec, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("UpdateTimeSec: " + ApiClient.FormatValue(UpdateTimeSec));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
         }
     }
 
@@ -3033,10 +8693,15 @@ namespace Nakama.Console
         /// A list of settings.
         /// </summary>
         IEnumerable<IConsoleSetting> Settings { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleSettingList Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleSettingList : IConsoleSettingList
+    internal class ConsoleSettingList : IConsoleSettingList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3045,11 +8710,74 @@ namespace Nakama.Console
         [DataMember(Name="settings"), Preserve]
         public List<ConsoleSetting> _settings { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "settings":
+                    _settings = reader.ReadList(reader.ReadObject<ConsoleSetting>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_settings != null)
+            {
+                writer.WriteName("settings");
+                writer.WriteList(_settings, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleSettingList Clone() => (ConsoleSettingList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleSettingList) MemberwiseClone();
+            clone._settings = _settings?.ConvertAll(item => (ConsoleSetting) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleSettingList) obj;
+            return ApiClient.ListEquals(_settings, other._settings);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_settings);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Settings: [", string.Join(", ", Settings), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Settings: " + ApiClient.FormatList(_settings));
         }
     }
 
@@ -3074,6 +8802,57 @@ namespace Nakama.Console
         /// 
         /// </summary>
         STATUS_HEALTH_DISCONNECTING = 3,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
+        /// </summary>
+        Unknown = -1,
+    }
+
+    /// <summary>
+    /// Converts <see cref="ConsoleStatusHealth"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ConsoleStatusHealthConverter
+    {
+        public static ConsoleStatusHealth Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ConsoleStatusHealth);
+                case "STATUS_HEALTH_OK":
+                case "0":
+                    return ConsoleStatusHealth.STATUS_HEALTH_OK;
+                case "STATUS_HEALTH_ERROR":
+                case "1":
+                    return ConsoleStatusHealth.STATUS_HEALTH_ERROR;
+                case "STATUS_HEALTH_CONNECTING":
+                case "2":
+                    return ConsoleStatusHealth.STATUS_HEALTH_CONNECTING;
+                case "STATUS_HEALTH_DISCONNECTING":
+                case "3":
+                    return ConsoleStatusHealth.STATUS_HEALTH_DISCONNECTING;
+                default:
+                    return ConsoleStatusHealth.Unknown;
+            }
+        }
+
+        public static string Format(ConsoleStatusHealth value)
+        {
+            switch (value)
+            {
+                case ConsoleStatusHealth.STATUS_HEALTH_OK:
+                    return "STATUS_HEALTH_OK";
+                case ConsoleStatusHealth.STATUS_HEALTH_ERROR:
+                    return "STATUS_HEALTH_ERROR";
+                case ConsoleStatusHealth.STATUS_HEALTH_CONNECTING:
+                    return "STATUS_HEALTH_CONNECTING";
+                case ConsoleStatusHealth.STATUS_HEALTH_DISCONNECTING:
+                    return "STATUS_HEALTH_DISCONNECTING";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
+        }
     }
 
     /// <summary>
@@ -3090,11 +8869,16 @@ namespace Nakama.Console
         /// <summary>
         /// Timestamp
         /// </summary>
-        string Timestamp { get; }
+        DateTime Timestamp { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleStatusList Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleStatusList : IConsoleStatusList
+    internal class ConsoleStatusList : IConsoleStatusList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3104,15 +8888,94 @@ namespace Nakama.Console
         public List<ConsoleStatusListStatus> _nodes { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime Timestamp
+        {
+            get => ApiClient.ParseDateTime(_timestamp);
+            set => _timestamp = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="timestamp"), Preserve]
-        public string Timestamp { get; set; }
+        public string _timestamp { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "nodes":
+                    _nodes = reader.ReadList(reader.ReadObject<ConsoleStatusListStatus>);
+                    return true;
+                case "timestamp":
+                    _timestamp = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_nodes != null)
+            {
+                writer.WriteName("nodes");
+                writer.WriteList(_nodes, writer.WriteObject);
+            }
+            if (_timestamp != null)
+            {
+                writer.WriteName("timestamp");
+                writer.WriteString(_timestamp);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleStatusList Clone() => (ConsoleStatusList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleStatusList) MemberwiseClone();
+            clone._nodes = _nodes?.ConvertAll(item => (ConsoleStatusListStatus) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleStatusList) obj;
+            return ApiClient.ListEquals(_nodes, other._nodes) &&
+                _timestamp == other._timestamp;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_nodes);
+                hash = hash * 31 + (_timestamp?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Nodes: [", string.Join(", ", Nodes), "], ");
-            output = string.Concat(output, "Timestamp: ", Timestamp, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Nodes: " + ApiClient.FormatList(_nodes));
+            members.Add("Timestamp: " + ApiClient.FormatValue(Timestamp));
         }
     }
 
@@ -3171,10 +9034,15 @@ namespace Nakama.Console
         /// Currently connected sessions.
         /// </summary>
         int SessionCount { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleStatusListStatus Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleStatusListStatus : IConsoleStatusListStatus
+    internal class ConsoleStatusListStatus : IConsoleStatusListStatus, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3199,9 +9067,13 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ConsoleStatusHealth Health => _health;
+        public ConsoleStatusHealth Health
+        {
+            get => ConsoleStatusHealthConverter.Parse(_health);
+            set => _health = ConsoleStatusHealthConverter.Format(value);
+        }
         [DataMember(Name="health"), Preserve]
-        public ConsoleStatusHealth _health { get; set; }
+        public string _health { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="match_count"), Preserve]
@@ -3219,20 +9091,148 @@ namespace Nakama.Console
         [DataMember(Name="session_count"), Preserve]
         public int SessionCount { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "avg_input_kbs":
+                    AvgInputKbs = reader.ReadDouble();
+                    return true;
+                case "avg_latency_ms":
+                    AvgLatencyMs = reader.ReadDouble();
+                    return true;
+                case "avg_output_kbs":
+                    AvgOutputKbs = reader.ReadDouble();
+                    return true;
+                case "avg_rate_sec":
+                    AvgRateSec = reader.ReadDouble();
+                    return true;
+                case "goroutine_count":
+                    GoroutineCount = reader.ReadInt32();
+                    return true;
+                case "health":
+                    _health = reader.ReadString();
+                    return true;
+                case "match_count":
+                    MatchCount = reader.ReadInt32();
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "presence_count":
+                    PresenceCount = reader.ReadInt32();
+                    return true;
+                case "session_count":
+                    SessionCount = reader.ReadInt32();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("avg_input_kbs");
+            writer.WriteDouble(AvgInputKbs);
+            writer.WriteName("avg_latency_ms");
+            writer.WriteDouble(AvgLatencyMs);
+            writer.WriteName("avg_output_kbs");
+            writer.WriteDouble(AvgOutputKbs);
+            writer.WriteName("avg_rate_sec");
+            writer.WriteDouble(AvgRateSec);
+            writer.WriteName("goroutine_count");
+            writer.WriteInt32(GoroutineCount);
+            if (_health != null)
+            {
+                writer.WriteName("health");
+                writer.WriteString(_health);
+            }
+            writer.WriteName("match_count");
+            writer.WriteInt32(MatchCount);
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            writer.WriteName("presence_count");
+            writer.WriteInt32(PresenceCount);
+            writer.WriteName("session_count");
+            writer.WriteInt32(SessionCount);
+        }
+
+        /// <inheritdoc />
+        public IConsoleStatusListStatus Clone() => (ConsoleStatusListStatus) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleStatusListStatus) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleStatusListStatus) obj;
+            return AvgInputKbs.Equals(other.AvgInputKbs) &&
+                AvgLatencyMs.Equals(other.AvgLatencyMs) &&
+                AvgOutputKbs.Equals(other.AvgOutputKbs) &&
+                AvgRateSec.Equals(other.AvgRateSec) &&
+                GoroutineCount == other.GoroutineCount &&
+                _health == other._health &&
+                MatchCount == other.MatchCount &&
+                Name == other.Name &&
+                PresenceCount == other.PresenceCount &&
+                SessionCount == other.SessionCount;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + AvgInputKbs.GetHashCode();
+                hash = hash * 31 + AvgLatencyMs.GetHashCode();
+                hash = hash * 31 + AvgOutputKbs.GetHashCode();
+                hash = hash * 31 + AvgRateSec.GetHashCode();
+                hash = hash * 31 + GoroutineCount.GetHashCode();
+                hash = hash * 31 + (_health?.GetHashCode() ?? 0);
+                hash = hash * 31 + MatchCount.GetHashCode();
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + PresenceCount.GetHashCode();
+                hash = hash * 31 + SessionCount.GetHashCode();
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "AvgInputKbs: ", AvgInputKbs, ", ");
-            output = string.Concat(output, "AvgLatencyMs: ", AvgLatencyMs, ", ");
-            output = string.Concat(output, "AvgOutputKbs: ", AvgOutputKbs, ", ");
-            output = string.Concat(output, "AvgRateSec: ", AvgRateSec, ", ");
-            output = string.Concat(output, "GoroutineCount: ", GoroutineCount, ", ");
-            output = string.Concat(output, "Health: ", Health, ", ");
-            output = string.Concat(output, "MatchCount: ", MatchCount, ", ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "PresenceCount: ", PresenceCount, ", ");
-            output = string.Concat(output, "SessionCount: ", SessionCount, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AvgInputKbs: " + ApiClient.FormatValue(AvgInputKbs));
+            members.Add("AvgLatencyMs: " + ApiClient.FormatValue(AvgLatencyMs));
+            members.Add("AvgOutputKbs: " + ApiClient.FormatValue(AvgOutputKbs));
+            members.Add("AvgRateSec: " + ApiClient.FormatValue(AvgRateSec));
+            members.Add("GoroutineCount: " + ApiClient.FormatValue(GoroutineCount));
+            members.Add("Health: " + ApiClient.FormatEncoded(_health));
+            members.Add("MatchCount: " + ApiClient.FormatValue(MatchCount));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("PresenceCount: " + ApiClient.FormatValue(PresenceCount));
+            members.Add("SessionCount: " + ApiClient.FormatValue(SessionCount));
         }
     }
 
@@ -3246,21 +9246,89 @@ namespace Nakama.Console
         /// Available collection names in the whole of the storage
         /// </summary>
         List<string> Collections { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleStorageCollectionsList Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleStorageCollectionsList : IConsoleStorageCollectionsList
+    internal class ConsoleStorageCollectionsList : IConsoleStorageCollectionsList, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="collections"), Preserve]
         public List<string> Collections { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "collections":
+                    Collections = reader.ReadList(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collections != null)
+            {
+                writer.WriteName("collections");
+                writer.WriteList(Collections, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleStorageCollectionsList Clone() => (ConsoleStorageCollectionsList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleStorageCollectionsList) MemberwiseClone();
+            clone.Collections = Collections?.ConvertAll(item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleStorageCollectionsList) obj;
+            return ApiClient.ListEquals(Collections, other.Collections);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(Collections);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Collections: [", string.Join(", ", Collections), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collections: " + ApiClient.FormatList(Collections));
         }
     }
 
@@ -3284,10 +9352,15 @@ namespace Nakama.Console
         /// Approximate total number of storage objects.
         /// </summary>
         int TotalCount { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleStorageList Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleStorageList : IConsoleStorageList
+    internal class ConsoleStorageList : IConsoleStorageList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3301,16 +9374,96 @@ namespace Nakama.Console
         public List<ConsoleStorageListObject> _objects { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="total_count"), Preserve]
-        public int TotalCount { get; set; }
+        [DataMember(Name="total_count"), Preserve]
+        public int TotalCount { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "objects":
+                    _objects = reader.ReadList(reader.ReadObject<ConsoleStorageListObject>);
+                    return true;
+                case "total_count":
+                    TotalCount = reader.ReadInt32();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (_objects != null)
+            {
+                writer.WriteName("objects");
+                writer.WriteList(_objects, writer.WriteObject);
+            }
+            writer.WriteName("total_count");
+            writer.WriteInt32(TotalCount);
+        }
+
+        /// <inheritdoc />
+        public IConsoleStorageList Clone() => (ConsoleStorageList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleStorageList) MemberwiseClone();
+            clone._objects = _objects?.ConvertAll(item => (ConsoleStorageListObject) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleStorageList) obj;
+            return NextCursor == other.NextCursor &&
+                ApiClient.ListEquals(_objects, other._objects) &&
+                TotalCount == other.TotalCount;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_objects);
+                hash = hash * 31 + TotalCount.GetHashCode();
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "Objects: [", string.Join(", ", Objects), "], ");
-            output = string.Concat(output, "TotalCount: ", TotalCount, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("Objects: " + ApiClient.FormatList(_objects));
+            members.Add("TotalCount: " + ApiClient.FormatValue(TotalCount));
         }
     }
 
@@ -3328,7 +9481,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The key of the object within the collection.
@@ -3348,7 +9501,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the object was last updated.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// The user owner of the object.
@@ -3359,10 +9512,15 @@ namespace Nakama.Console
         /// The version hash of the object.
         /// </summary>
         string Version { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleStorageListObject Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleStorageListObject : IConsoleStorageListObject
+    internal class ConsoleStorageListObject : IConsoleStorageListObject, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3370,8 +9528,14 @@ namespace Nakama.Console
         public string Collection { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="key"), Preserve]
@@ -3386,8 +9550,14 @@ namespace Nakama.Console
         public int PermissionWrite { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="user_id"), Preserve]
@@ -3397,18 +9567,144 @@ namespace Nakama.Console
         [DataMember(Name="version"), Preserve]
         public string Version { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "collection":
+                    Collection = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "key":
+                    Key = reader.ReadString();
+                    return true;
+                case "permission_read":
+                    PermissionRead = reader.ReadInt32();
+                    return true;
+                case "permission_write":
+                    PermissionWrite = reader.ReadInt32();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                case "version":
+                    Version = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Collection != null)
+            {
+                writer.WriteName("collection");
+                writer.WriteString(Collection);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Key != null)
+            {
+                writer.WriteName("key");
+                writer.WriteString(Key);
+            }
+            writer.WriteName("permission_read");
+            writer.WriteInt32(PermissionRead);
+            writer.WriteName("permission_write");
+            writer.WriteInt32(PermissionWrite);
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+            if (Version != null)
+            {
+                writer.WriteName("version");
+                writer.WriteString(Version);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleStorageListObject Clone() => (ConsoleStorageListObject) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleStorageListObject) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleStorageListObject) obj;
+            return Collection == other.Collection &&
+                _createTime == other._createTime &&
+                Key == other.Key &&
+                PermissionRead == other.PermissionRead &&
+                PermissionWrite == other.PermissionWrite &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId &&
+                Version == other.Version;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Collection?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Key?.GetHashCode() ?? 0);
+                hash = hash * 31 + PermissionRead.GetHashCode();
+                hash = hash * 31 + PermissionWrite.GetHashCode();
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Version?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Collection: ", Collection, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Key: ", Key, ", ");
-            output = string.Concat(output, "PermissionRead: ", PermissionRead, ", ");
-            output = string.Concat(output, "PermissionWrite: ", PermissionWrite, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            output = string.Concat(output, "Version: ", Version, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Collection: " + ApiClient.FormatValue(Collection));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Key: " + ApiClient.FormatValue(Key));
+            members.Add("PermissionRead: " + ApiClient.FormatValue(PermissionRead));
+            members.Add("PermissionWrite: " + ApiClient.FormatValue(PermissionWrite));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
+            members.Add("Version: " + ApiClient.FormatValue(Version));
         }
     }
 
@@ -3422,10 +9718,15 @@ namespace Nakama.Console
         /// A list of users.
         /// </summary>
         IEnumerable<IConsoleUserListUser> Users { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleUserList Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleUserList : IConsoleUserList
+    internal class ConsoleUserList : IConsoleUserList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3434,11 +9735,74 @@ namespace Nakama.Console
         [DataMember(Name="users"), Preserve]
         public List<ConsoleUserListUser> _users { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "users":
+                    _users = reader.ReadList(reader.ReadObject<ConsoleUserListUser>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_users != null)
+            {
+                writer.WriteName("users");
+                writer.WriteList(_users, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleUserList Clone() => (ConsoleUserList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleUserList) MemberwiseClone();
+            clone._users = _users?.ConvertAll(item => (ConsoleUserListUser) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleUserList) obj;
+            return ApiClient.ListEquals(_users, other._users);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_users);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Users: [", string.Join(", ", Users), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Users: " + ApiClient.FormatList(_users));
         }
     }
 
@@ -3472,10 +9836,15 @@ namespace Nakama.Console
         /// Username of the user
         /// </summary>
         string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleUserListUser Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleUserListUser : IConsoleUserListUser
+    internal class ConsoleUserListUser : IConsoleUserListUser, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3492,31 +9861,131 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public ConsoleUserRole Role => _role;
+        public ConsoleUserRole Role
+        {
+            get => ConsoleUserRoleConverter.Parse(_role);
+            set => _role = ConsoleUserRoleConverter.Format(value);
+        }
         [DataMember(Name="role"), Preserve]
-        public ConsoleUserRole _role { get; set; }
+        public string _role { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="username"), Preserve]
         public string Username { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "email":
+                    Email = reader.ReadString();
+                    return true;
+                case "mfa_enabled":
+                    MfaEnabled = reader.ReadBoolean();
+                    return true;
+                case "mfa_required":
+                    MfaRequired = reader.ReadBoolean();
+                    return true;
+                case "role":
+                    _role = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Email != null)
+            {
+                writer.WriteName("email");
+                writer.WriteString(Email);
+            }
+            writer.WriteName("mfa_enabled");
+            writer.WriteBoolean(MfaEnabled);
+            writer.WriteName("mfa_required");
+            writer.WriteBoolean(MfaRequired);
+            if (_role != null)
+            {
+                writer.WriteName("role");
+                writer.WriteString(_role);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleUserListUser Clone() => (ConsoleUserListUser) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleUserListUser) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleUserListUser) obj;
+            return Email == other.Email &&
+                MfaEnabled == other.MfaEnabled &&
+                MfaRequired == other.MfaRequired &&
+                _role == other._role &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Email?.GetHashCode() ?? 0);
+                hash = hash * 31 + MfaEnabled.GetHashCode();
+                hash = hash * 31 + MfaRequired.GetHashCode();
+                hash = hash * 31 + (_role?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Email: ", Email, ", ");
-            output = string.Concat(output, "MfaEnabled: ", MfaEnabled, ", ");
-            output = string.Concat(output, "MfaRequired: ", MfaRequired, ", ");
-            output = string.Concat(output, "Role: ", Role, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Email: " + ApiClient.FormatValue(Email));
+            members.Add("MfaEnabled: " + ApiClient.FormatValue(MfaEnabled));
+            members.Add("MfaRequired: " + ApiClient.FormatValue(MfaRequired));
+            members.Add("Role: " + ApiClient.FormatEncoded(_role));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
         }
     }
 
     /// <summary>
     /// - USER_ROLE_ADMIN: All access
-    ///  - USER_ROLE_DEVELOPER: Best for developers, also enables APIs and API explorer
-    ///  - USER_ROLE_MAINTAINER: Best for users who regularly update player information.
-    ///  - USER_ROLE_READONLY: Read-only role for those only need to view data
+    /// - USER_ROLE_DEVELOPER: Best for developers, also enables APIs and API explorer
+    /// - USER_ROLE_MAINTAINER: Best for users who regularly update player information.
+    /// - USER_ROLE_READONLY: Read-only role for those only need to view data
     /// </summary>
     public enum ConsoleUserRole
     {
@@ -3540,6 +10009,62 @@ namespace Nakama.Console
         /// 
         /// </summary>
         USER_ROLE_READONLY = 4,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
+        /// </summary>
+        Unknown = -1,
+    }
+
+    /// <summary>
+    /// Converts <see cref="ConsoleUserRole"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ConsoleUserRoleConverter
+    {
+        public static ConsoleUserRole Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ConsoleUserRole);
+                case "USER_ROLE_UNKNOWN":
+                case "0":
+                    return ConsoleUserRole.USER_ROLE_UNKNOWN;
+                case "USER_ROLE_ADMIN":
+                case "1":
+                    return ConsoleUserRole.USER_ROLE_ADMIN;
+                case "USER_ROLE_DEVELOPER":
+                case "2":
+                    return ConsoleUserRole.USER_ROLE_DEVELOPER;
+                case "USER_ROLE_MAINTAINER":
+                case "3":
+                    return ConsoleUserRole.USER_ROLE_MAINTAINER;
+                case "USER_ROLE_READONLY":
+                case "4":
+                    return ConsoleUserRole.USER_ROLE_READONLY;
+                default:
+                    return ConsoleUserRole.Unknown;
+            }
+        }
+
+        public static string Format(ConsoleUserRole value)
+        {
+            switch (value)
+            {
+                case ConsoleUserRole.USER_ROLE_UNKNOWN:
+                    return "USER_ROLE_UNKNOWN";
+                case ConsoleUserRole.USER_ROLE_ADMIN:
+                    return "USER_ROLE_ADMIN";
+                case ConsoleUserRole.USER_ROLE_DEVELOPER:
+                    return "USER_ROLE_DEVELOPER";
+                case ConsoleUserRole.USER_ROLE_MAINTAINER:
+                    return "USER_ROLE_MAINTAINER";
+                case ConsoleUserRole.USER_ROLE_READONLY:
+                    return "USER_ROLE_READONLY";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
+        }
     }
 
     /// <summary>
@@ -3556,7 +10081,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time when the wallet ledger item was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The identifier of this wallet change.
@@ -3571,16 +10096,21 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time when the wallet ledger item was updated.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// The user ID this wallet ledger item belongs to.
         /// </summary>
         string UserId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IConsoleWalletLedger Clone();
     }
 
     /// <inheritdoc />
-    internal class ConsoleWalletLedger : IConsoleWalletLedger
+    internal class ConsoleWalletLedger : IConsoleWalletLedger, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3588,8 +10118,14 @@ namespace Nakama.Console
         public string Changeset { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="id"), Preserve]
@@ -3600,123 +10136,279 @@ namespace Nakama.Console
         public string Metadata { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public string _updateTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="user_id"), Preserve]
         public string UserId { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
         {
-            var output = "";
-            output = string.Concat(output, "Changeset: ", Changeset, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+            switch (name)
+            {
+                case "changeset":
+                    Changeset = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
         }
-    }
-
-    /// <summary>
-    /// List of wallet ledger items for a particular user.
-    /// </summary>
-    public interface IConsoleWalletLedgerList
-    {
-
-        /// <summary>
-        /// A list of wallet ledger items.
-        /// </summary>
-        IEnumerable<IConsoleWalletLedger> Items { get; }
 
-        /// <summary>
-        /// The cursor to send when retrieving the next older page, if any.
-        /// </summary>
-        string NextCursor { get; }
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Changeset != null)
+            {
+                writer.WriteName("changeset");
+                writer.WriteString(Changeset);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
 
-        /// <summary>
-        /// The cursor to send when retrieving the previous page newer, if any.
-        /// </summary>
-        string PrevCursor { get; }
-    }
+        /// <inheritdoc />
+        public IConsoleWalletLedger Clone() => (ConsoleWalletLedger) CloneModel();
 
-    /// <inheritdoc />
-    internal class ConsoleWalletLedgerList : IConsoleWalletLedgerList
-    {
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleWalletLedger) MemberwiseClone();
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IConsoleWalletLedger> Items => _items ?? new List<ConsoleWalletLedger>(0);
-        [DataMember(Name="items"), Preserve]
-        public List<ConsoleWalletLedger> _items { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="next_cursor"), Preserve]
-        public string NextCursor { get; set; }
+            var other = (ConsoleWalletLedger) obj;
+            return Changeset == other.Changeset &&
+                _createTime == other._createTime &&
+                Id == other.Id &&
+                Metadata == other.Metadata &&
+                _updateTime == other._updateTime &&
+                UserId == other.UserId;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="prev_cursor"), Preserve]
-        public string PrevCursor { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Changeset?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Items: [", string.Join(", ", Items), "], ");
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Changeset: " + ApiClient.FormatValue(Changeset));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
         }
     }
 
     /// <summary>
-    /// 
+    /// List of wallet ledger items for a particular user.
     /// </summary>
-    public interface IGooglerpcStatus
+    public interface IConsoleWalletLedgerList
     {
 
         /// <summary>
-        /// 
+        /// A list of wallet ledger items.
+        /// </summary>
+        IEnumerable<IConsoleWalletLedger> Items { get; }
+
+        /// <summary>
+        /// The cursor to send when retrieving the next older page, if any.
         /// </summary>
-        int Code { get; }
+        string NextCursor { get; }
 
         /// <summary>
-        /// 
+        /// The cursor to send when retrieving the previous page newer, if any.
         /// </summary>
-        IEnumerable<IProtobufAny> Details { get; }
+        string PrevCursor { get; }
 
         /// <summary>
-        /// 
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Message { get; }
+        IConsoleWalletLedgerList Clone();
     }
 
     /// <inheritdoc />
-    internal class GooglerpcStatus : IGooglerpcStatus
+    internal class ConsoleWalletLedgerList : IConsoleWalletLedgerList, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="code"), Preserve]
-        public int Code { get; set; }
+        [IgnoreDataMember]
+        public IEnumerable<IConsoleWalletLedger> Items => _items ?? new List<ConsoleWalletLedger>(0);
+        [DataMember(Name="items"), Preserve]
+        public List<ConsoleWalletLedger> _items { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IProtobufAny> Details => _details ?? new List<ProtobufAny>(0);
-        [DataMember(Name="details"), Preserve]
-        public List<ProtobufAny> _details { get; set; }
+        [DataMember(Name="next_cursor"), Preserve]
+        public string NextCursor { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="message"), Preserve]
-        public string Message { get; set; }
+        [DataMember(Name="prev_cursor"), Preserve]
+        public string PrevCursor { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "items":
+                    _items = reader.ReadList(reader.ReadObject<ConsoleWalletLedger>);
+                    return true;
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_items != null)
+            {
+                writer.WriteName("items");
+                writer.WriteList(_items, writer.WriteObject);
+            }
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+        }
+
+        /// <inheritdoc />
+        public IConsoleWalletLedgerList Clone() => (ConsoleWalletLedgerList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ConsoleWalletLedgerList) MemberwiseClone();
+            clone._items = _items?.ConvertAll(item => (ConsoleWalletLedger) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ConsoleWalletLedgerList) obj;
+            return ApiClient.ListEquals(_items, other._items) &&
+                NextCursor == other.NextCursor &&
+                PrevCursor == other.PrevCursor;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_items);
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Details: [", string.Join(", ", Details), "], ");
-            output = string.Concat(output, "Message: ", Message, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Items: " + ApiClient.FormatList(_items));
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
         }
     }
 
@@ -3739,7 +10431,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user's account was disabled/banned.
         /// </summary>
-        string DisableTime { get; }
+        DateTime DisableTime { get; }
 
         /// <summary>
         /// The email address of the user.
@@ -3754,16 +10446,21 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user's email was verified.
         /// </summary>
-        string VerifyTime { get; }
+        DateTime VerifyTime { get; }
 
         /// <summary>
         /// The user's wallet data.
         /// </summary>
         string Wallet { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaapiAccount Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaapiAccount : INakamaapiAccount
+    internal class NakamaapiAccount : INakamaapiAccount, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3777,8 +10474,14 @@ namespace Nakama.Console
         public List<ApiAccountDevice> _devices { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime DisableTime
+        {
+            get => ApiClient.ParseDateTime(_disableTime);
+            set => _disableTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="disable_time"), Preserve]
-        public string DisableTime { get; set; }
+        public string _disableTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="email"), Preserve]
@@ -3791,24 +10494,154 @@ namespace Nakama.Console
         public NakamaapiUser _user { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime VerifyTime
+        {
+            get => ApiClient.ParseDateTime(_verifyTime);
+            set => _verifyTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="verify_time"), Preserve]
-        public string VerifyTime { get; set; }
+        public string _verifyTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="wallet"), Preserve]
         public string Wallet { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "custom_id":
+                    CustomId = reader.ReadString();
+                    return true;
+                case "devices":
+                    _devices = reader.ReadList(reader.ReadObject<ApiAccountDevice>);
+                    return true;
+                case "disable_time":
+                    _disableTime = reader.ReadString();
+                    return true;
+                case "email":
+                    Email = reader.ReadString();
+                    return true;
+                case "user":
+                    _user = reader.ReadObject<NakamaapiUser>();
+                    return true;
+                case "verify_time":
+                    _verifyTime = reader.ReadString();
+                    return true;
+                case "wallet":
+                    Wallet = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (CustomId != null)
+            {
+                writer.WriteName("custom_id");
+                writer.WriteString(CustomId);
+            }
+            if (_devices != null)
+            {
+                writer.WriteName("devices");
+                writer.WriteList(_devices, writer.WriteObject);
+            }
+            if (_disableTime != null)
+            {
+                writer.WriteName("disable_time");
+                writer.WriteString(_disableTime);
+            }
+            if (Email != null)
+            {
+                writer.WriteName("email");
+                writer.WriteString(Email);
+            }
+            if (_user != null)
+            {
+                writer.WriteName("user");
+                writer.WriteObject(_user);
+            }
+            if (_verifyTime != null)
+            {
+                writer.WriteName("verify_time");
+                writer.WriteString(_verifyTime);
+            }
+            if (Wallet != null)
+            {
+                writer.WriteName("wallet");
+                writer.WriteString(Wallet);
+            }
+        }
+
+        /// <inheritdoc />
+        public INakamaapiAccount Clone() => (NakamaapiAccount) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaapiAccount) MemberwiseClone();
+            clone._devices = _devices?.ConvertAll(item => (ApiAccountDevice) item?.CloneModel());
+            clone._user = (NakamaapiUser) _user?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaapiAccount) obj;
+            return CustomId == other.CustomId &&
+                ApiClient.ListEquals(_devices, other._devices) &&
+                _disableTime == other._disableTime &&
+                Email == other.Email &&
+                Equals(_user, other._user) &&
+                _verifyTime == other._verifyTime &&
+                Wallet == other.Wallet;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (CustomId?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_devices);
+                hash = hash * 31 + (_disableTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Email?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_user?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_verifyTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Wallet?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CustomId: ", CustomId, ", ");
-            output = string.Concat(output, "Devices: [", string.Join(", ", Devices), "], ");
-            output = string.Concat(output, "DisableTime: ", DisableTime, ", ");
-            output = string.Concat(output, "Email: ", Email, ", ");
-            output = string.Concat(output, "User: ", User, ", ");
-            output = string.Concat(output, "VerifyTime: ", VerifyTime, ", ");
-            output = string.Concat(output, "Wallet: ", Wallet, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CustomId: " + ApiClient.FormatValue(CustomId));
+            members.Add("Devices: " + ApiClient.FormatList(_devices));
+            members.Add("DisableTime: " + ApiClient.FormatValue(DisableTime));
+            members.Add("Email: " + ApiClient.FormatValue(Email));
+            members.Add("User: " + ApiClient.FormatValue(_user));
+            members.Add("VerifyTime: " + ApiClient.FormatValue(VerifyTime));
+            members.Add("Wallet: " + ApiClient.FormatValue(Wallet));
         }
     }
 
@@ -3847,10 +10680,15 @@ namespace Nakama.Console
         /// Tick Rate
         /// </summary>
         int TickRate { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaapiMatch Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaapiMatch : INakamaapiMatch
+    internal class NakamaapiMatch : INakamaapiMatch, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3877,16 +10715,119 @@ namespace Nakama.Console
         [DataMember(Name="tick_rate"), Preserve]
         public int TickRate { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "authoritative":
+                    Authoritative = reader.ReadBoolean();
+                    return true;
+                case "handler_name":
+                    HandlerName = reader.ReadString();
+                    return true;
+                case "label":
+                    Label = reader.ReadString();
+                    return true;
+                case "match_id":
+                    MatchId = reader.ReadString();
+                    return true;
+                case "size":
+                    Size = reader.ReadInt32();
+                    return true;
+                case "tick_rate":
+                    TickRate = reader.ReadInt32();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("authoritative");
+            writer.WriteBoolean(Authoritative);
+            if (HandlerName != null)
+            {
+                writer.WriteName("handler_name");
+                writer.WriteString(HandlerName);
+            }
+            if (Label != null)
+            {
+                writer.WriteName("label");
+                writer.WriteString(Label);
+            }
+            if (MatchId != null)
+            {
+                writer.WriteName("match_id");
+                writer.WriteString(MatchId);
+            }
+            writer.WriteName("size");
+            writer.WriteInt32(Size);
+            writer.WriteName("tick_rate");
+            writer.WriteInt32(TickRate);
+        }
+
+        /// <inheritdoc />
+        public INakamaapiMatch Clone() => (NakamaapiMatch) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaapiMatch) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaapiMatch) obj;
+            return Authoritative == other.Authoritative &&
+                HandlerName == other.HandlerName &&
+                Label == other.Label &&
+                MatchId == other.MatchId &&
+                Size == other.Size &&
+                TickRate == other.TickRate;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Authoritative.GetHashCode();
+                hash = hash * 31 + (HandlerName?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Label?.GetHashCode() ?? 0);
+                hash = hash * 31 + (MatchId?.GetHashCode() ?? 0);
+                hash = hash * 31 + Size.GetHashCode();
+                hash = hash * 31 + TickRate.GetHashCode();
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Authoritative: ", Authoritative, ", ");
-            output = string.Concat(output, "HandlerName: ", HandlerName, ", ");
-            output = string.Concat(output, "Label: ", Label, ", ");
-            output = string.Concat(output, "MatchId: ", MatchId, ", ");
-            output = string.Concat(output, "Size: ", Size, ", ");
-            output = string.Concat(output, "TickRate: ", TickRate, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Authoritative: " + ApiClient.FormatValue(Authoritative));
+            members.Add("HandlerName: " + ApiClient.FormatValue(HandlerName));
+            members.Add("Label: " + ApiClient.FormatValue(Label));
+            members.Add("MatchId: " + ApiClient.FormatValue(MatchId));
+            members.Add("Size: " + ApiClient.FormatValue(Size));
+            members.Add("TickRate: " + ApiClient.FormatValue(TickRate));
         }
     }
 
@@ -3909,7 +10850,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the notification was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// ID of the Notification.
@@ -3930,10 +10871,15 @@ namespace Nakama.Console
         /// Subject of the notification.
         /// </summary>
         string Subject { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaapiNotification Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaapiNotification : INakamaapiNotification
+    internal class NakamaapiNotification : INakamaapiNotification, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -3945,8 +10891,14 @@ namespace Nakama.Console
         public string Content { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="id"), Preserve]
@@ -3964,17 +10916,133 @@ namespace Nakama.Console
         [DataMember(Name="subject"), Preserve]
         public string Subject { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "code":
+                    Code = reader.ReadInt32();
+                    return true;
+                case "content":
+                    Content = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "persistent":
+                    Persistent = reader.ReadBoolean();
+                    return true;
+                case "sender_id":
+                    SenderId = reader.ReadString();
+                    return true;
+                case "subject":
+                    Subject = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("code");
+            writer.WriteInt32(Code);
+            if (Content != null)
+            {
+                writer.WriteName("content");
+                writer.WriteString(Content);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            writer.WriteName("persistent");
+            writer.WriteBoolean(Persistent);
+            if (SenderId != null)
+            {
+                writer.WriteName("sender_id");
+                writer.WriteString(SenderId);
+            }
+            if (Subject != null)
+            {
+                writer.WriteName("subject");
+                writer.WriteString(Subject);
+            }
+        }
+
+        /// <inheritdoc />
+        public INakamaapiNotification Clone() => (NakamaapiNotification) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaapiNotification) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaapiNotification) obj;
+            return Code == other.Code &&
+                Content == other.Content &&
+                _createTime == other._createTime &&
+                Id == other.Id &&
+                Persistent == other.Persistent &&
+                SenderId == other.SenderId &&
+                Subject == other.Subject;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Content?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + Persistent.GetHashCode();
+                hash = hash * 31 + (SenderId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Subject?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Content: ", Content, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "Persistent: ", Persistent, ", ");
-            output = string.Concat(output, "SenderId: ", SenderId, ", ");
-            output = string.Concat(output, "Subject: ", Subject, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Content: " + ApiClient.FormatValue(Content));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Persistent: " + ApiClient.FormatValue(Persistent));
+            members.Add("SenderId: " + ApiClient.FormatValue(SenderId));
+            members.Add("Subject: " + ApiClient.FormatValue(Subject));
         }
     }
 
@@ -3997,7 +11065,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The display name of the user.
@@ -4067,16 +11135,21 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the user was last updated.
         /// </summary>
-        string UpdateTime { get; }
+        DateTime UpdateTime { get; }
 
         /// <summary>
         /// The username of the user's account.
         /// </summary>
         string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaapiUser Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaapiUser : INakamaapiUser
+    internal class NakamaapiUser : INakamaapiUser, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -4088,8 +11161,14 @@ namespace Nakama.Console
         public string AvatarUrl { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="display_name"), Preserve]
@@ -4132,47 +11211,279 @@ namespace Nakama.Console
         public string Metadata { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="online"), Preserve]
-        public bool Online { get; set; }
+        [DataMember(Name="online"), Preserve]
+        public bool Online { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="steam_id"), Preserve]
+        public string SteamId { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="timezone"), Preserve]
+        public string Timezone { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime UpdateTime
+        {
+            get => ApiClient.ParseDateTime(_updateTime);
+            set => _updateTime = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="username"), Preserve]
+        public string Username { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "apple_id":
+                    AppleId = reader.ReadString();
+                    return true;
+                case "avatar_url":
+                    AvatarUrl = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "display_name":
+                    DisplayName = reader.ReadString();
+                    return true;
+                case "edge_count":
+                    EdgeCount = reader.ReadInt32();
+                    return true;
+                case "facebook_id":
+                    FacebookId = reader.ReadString();
+                    return true;
+                case "facebook_instant_game_id":
+                    FacebookInstantGameId = reader.ReadString();
+                    return true;
+                case "gamecenter_id":
+                    GamecenterId = reader.ReadString();
+                    return true;
+                case "google_id":
+                    GoogleId = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "lang_tag":
+                    LangTag = reader.ReadString();
+                    return true;
+                case "location":
+                    Location = reader.ReadString();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "online":
+                    Online = reader.ReadBoolean();
+                    return true;
+                case "steam_id":
+                    SteamId = reader.ReadString();
+                    return true;
+                case "timezone":
+                    Timezone = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (AppleId != null)
+            {
+                writer.WriteName("apple_id");
+                writer.WriteString(AppleId);
+            }
+            if (AvatarUrl != null)
+            {
+                writer.WriteName("avatar_url");
+                writer.WriteString(AvatarUrl);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (DisplayName != null)
+            {
+                writer.WriteName("display_name");
+                writer.WriteString(DisplayName);
+            }
+            writer.WriteName("edge_count");
+            writer.WriteInt32(EdgeCount);
+            if (FacebookId != null)
+            {
+                writer.WriteName("facebook_id");
+                writer.WriteString(FacebookId);
+            }
+            if (FacebookInstantGameId != null)
+            {
+                writer.WriteName("facebook_instant_game_id");
+                writer.WriteString(FacebookInstantGameId);
+            }
+            if (GamecenterId != null)
+            {
+                writer.WriteName("gamecenter_id");
+                writer.WriteString(GamecenterId);
+            }
+            if (GoogleId != null)
+            {
+                writer.WriteName("google_id");
+                writer.WriteString(GoogleId);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (LangTag != null)
+            {
+                writer.WriteName("lang_tag");
+                writer.WriteString(LangTag);
+            }
+            if (Location != null)
+            {
+                writer.WriteName("location");
+                writer.WriteString(Location);
+            }
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            writer.WriteName("online");
+            writer.WriteBoolean(Online);
+            if (SteamId != null)
+            {
+                writer.WriteName("steam_id");
+                writer.WriteString(SteamId);
+            }
+            if (Timezone != null)
+            {
+                writer.WriteName("timezone");
+                writer.WriteString(Timezone);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public INakamaapiUser Clone() => (NakamaapiUser) CloneModel();
 
-        /// <inheritdoc />
-        [DataMember(Name="steam_id"), Preserve]
-        public string SteamId { get; set; }
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaapiUser) MemberwiseClone();
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="timezone"), Preserve]
-        public string Timezone { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+            var other = (NakamaapiUser) obj;
+            return AppleId == other.AppleId &&
+                AvatarUrl == other.AvatarUrl &&
+                _createTime == other._createTime &&
+                DisplayName == other.DisplayName &&
+                EdgeCount == other.EdgeCount &&
+                FacebookId == other.FacebookId &&
+                FacebookInstantGameId == other.FacebookInstantGameId &&
+                GamecenterId == other.GamecenterId &&
+                GoogleId == other.GoogleId &&
+                Id == other.Id &&
+                LangTag == other.LangTag &&
+                Location == other.Location &&
+                Metadata == other.Metadata &&
+                Online == other.Online &&
+                SteamId == other.SteamId &&
+                Timezone == other.Timezone &&
+                _updateTime == other._updateTime &&
+                Username == other.Username;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="username"), Preserve]
-        public string Username { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (AppleId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (AvatarUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (DisplayName?.GetHashCode() ?? 0);
+                hash = hash * 31 + EdgeCount.GetHashCode();
+                hash = hash * 31 + (FacebookId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (FacebookInstantGameId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (GamecenterId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (GoogleId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + (LangTag?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Location?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + Online.GetHashCode();
+                hash = hash * 31 + (SteamId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Timezone?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "AppleId: ", AppleId, ", ");
-            output = string.Concat(output, "AvatarUrl: ", AvatarUrl, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "DisplayName: ", DisplayName, ", ");
-            output = string.Concat(output, "EdgeCount: ", EdgeCount, ", ");
-            output = string.Concat(output, "FacebookId: ", FacebookId, ", ");
-            output = string.Concat(output, "FacebookInstantGameId: ", FacebookInstantGameId, ", ");
-            output = string.Concat(output, "GamecenterId: ", GamecenterId, ", ");
-            output = string.Concat(output, "GoogleId: ", GoogleId, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "LangTag: ", LangTag, ", ");
-            output = string.Concat(output, "Location: ", Location, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "Online: ", Online, ", ");
-            output = string.Concat(output, "SteamId: ", SteamId, ", ");
-            output = string.Concat(output, "Timezone: ", Timezone, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("AppleId: " + ApiClient.FormatValue(AppleId));
+            members.Add("AvatarUrl: " + ApiClient.FormatValue(AvatarUrl));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("DisplayName: " + ApiClient.FormatValue(DisplayName));
+            members.Add("EdgeCount: " + ApiClient.FormatValue(EdgeCount));
+            members.Add("FacebookId: " + ApiClient.FormatValue(FacebookId));
+            members.Add("FacebookInstantGameId: " + ApiClient.FormatValue(FacebookInstantGameId));
+            members.Add("GamecenterId: " + ApiClient.FormatValue(GamecenterId));
+            members.Add("GoogleId: " + ApiClient.FormatValue(GoogleId));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("LangTag: " + ApiClient.FormatValue(LangTag));
+            members.Add("Location: " + ApiClient.FormatValue(Location));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("Online: " + ApiClient.FormatValue(Online));
+            members.Add("SteamId: " + ApiClient.FormatValue(SteamId));
+            members.Add("Timezone: " + ApiClient.FormatValue(Timezone));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
         }
     }
 
@@ -4190,11 +11501,16 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time when the account was disabled.
         /// </summary>
-        string DisableTime { get; }
+        DateTime DisableTime { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaconsoleAccount Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaconsoleAccount : INakamaconsoleAccount
+    internal class NakamaconsoleAccount : INakamaconsoleAccount, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -4204,15 +11520,94 @@ namespace Nakama.Console
         public NakamaapiAccount _account { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime DisableTime
+        {
+            get => ApiClient.ParseDateTime(_disableTime);
+            set => _disableTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="disable_time"), Preserve]
-        public string DisableTime { get; set; }
+        public string _disableTime { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "account":
+                    _account = reader.ReadObject<NakamaapiAccount>();
+                    return true;
+                case "disable_time":
+                    _disableTime = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_account != null)
+            {
+                writer.WriteName("account");
+                writer.WriteObject(_account);
+            }
+            if (_disableTime != null)
+            {
+                writer.WriteName("disable_time");
+                writer.WriteString(_disableTime);
+            }
+        }
+
+        /// <inheritdoc />
+        public INakamaconsoleAccount Clone() => (NakamaconsoleAccount) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaconsoleAccount) MemberwiseClone();
+            clone._account = (NakamaapiAccount) _account?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaconsoleAccount) obj;
+            return Equals(_account, other._account) &&
+                _disableTime == other._disableTime;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_account?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_disableTime?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Account: ", Account, ", ");
-            output = string.Concat(output, "DisableTime: ", DisableTime, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Account: " + ApiClient.FormatValue(_account));
+            members.Add("DisableTime: " + ApiClient.FormatValue(DisableTime));
         }
     }
 
@@ -4236,10 +11631,15 @@ namespace Nakama.Console
         /// Approximate total number of groups.
         /// </summary>
         int TotalCount { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaconsoleGroupList Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaconsoleGroupList : INakamaconsoleGroupList
+    internal class NakamaconsoleGroupList : INakamaconsoleGroupList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -4256,13 +11656,93 @@ namespace Nakama.Console
         [DataMember(Name="total_count"), Preserve]
         public int TotalCount { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "groups":
+                    _groups = reader.ReadList(reader.ReadObject<ApiGroup>);
+                    return true;
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "total_count":
+                    TotalCount = reader.ReadInt32();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_groups != null)
+            {
+                writer.WriteName("groups");
+                writer.WriteList(_groups, writer.WriteObject);
+            }
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            writer.WriteName("total_count");
+            writer.WriteInt32(TotalCount);
+        }
+
+        /// <inheritdoc />
+        public INakamaconsoleGroupList Clone() => (NakamaconsoleGroupList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaconsoleGroupList) MemberwiseClone();
+            clone._groups = _groups?.ConvertAll(item => (ApiGroup) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaconsoleGroupList) obj;
+            return ApiClient.ListEquals(_groups, other._groups) &&
+                NextCursor == other.NextCursor &&
+                TotalCount == other.TotalCount;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_groups);
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + TotalCount.GetHashCode();
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Groups: [", string.Join(", ", Groups), "], ");
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "TotalCount: ", TotalCount, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Groups: " + ApiClient.FormatList(_groups));
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("TotalCount: " + ApiClient.FormatValue(TotalCount));
         }
     }
 
@@ -4280,12 +11760,12 @@ namespace Nakama.Console
         /// <summary>
         /// The category of the leaderboard. e.g. "vip" could be category 1.
         /// </summary>
-        int Category { get; }
+        long Category { get; }
 
         /// <summary>
         /// The UNIX time when the leaderboard was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// The description of the leaderboard. May be blank.
@@ -4295,17 +11775,17 @@ namespace Nakama.Console
         /// <summary>
         /// Duration of the tournament in seconds.
         /// </summary>
-        int Duration { get; }
+        long Duration { get; }
 
         /// <summary>
         /// The UNIX time when the leaderboard stops being active until next reset. A computed value.
         /// </summary>
-        int EndActive { get; }
+        long EndActive { get; }
 
         /// <summary>
         /// The UNIX time when the leaderboard will be stopped.
         /// </summary>
-        string EndTime { get; }
+        DateTime EndTime { get; }
 
         /// <summary>
         /// The ID of the leaderboard.
@@ -4320,12 +11800,12 @@ namespace Nakama.Console
         /// <summary>
         /// The maximum score updates allowed per player for the current leaderboard.
         /// </summary>
-        int MaxNumScore { get; }
+        long MaxNumScore { get; }
 
         /// <summary>
         /// The maximum number of players for the leaderboard.
         /// </summary>
-        int MaxSize { get; }
+        long MaxSize { get; }
 
         /// <summary>
         /// Additional information stored as a JSON object.
@@ -4335,7 +11815,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time when the tournament is next playable. A computed value.
         /// </summary>
-        int NextReset { get; }
+        long NextReset { get; }
 
         /// <summary>
         /// The operator of the leaderboard
@@ -4345,7 +11825,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time when the tournament was last reset. A computed value.
         /// </summary>
-        int PrevReset { get; }
+        long PrevReset { get; }
 
         /// <summary>
         /// Reset cron expression.
@@ -4355,22 +11835,22 @@ namespace Nakama.Console
         /// <summary>
         /// The current number of players in the leaderboard.
         /// </summary>
-        int Size { get; }
+        long Size { get; }
 
         /// <summary>
         /// ASC or DESC sort mode of scores in the leaderboard.
         /// </summary>
-        int SortOrder { get; }
+        long SortOrder { get; }
 
         /// <summary>
         /// The UNIX time when the leaderboard start being active. A computed value.
         /// </summary>
-        int StartActive { get; }
+        long StartActive { get; }
 
         /// <summary>
         /// The UNIX time when the leaderboard will start.
         /// </summary>
-        string StartTime { get; }
+        DateTime StartTime { get; }
 
         /// <summary>
         /// The title for the leaderboard.
@@ -4381,10 +11861,15 @@ namespace Nakama.Console
         /// Tournament.
         /// </summary>
         bool Tournament { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaconsoleLeaderboard Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaconsoleLeaderboard : INakamaconsoleLeaderboard
+    internal class NakamaconsoleLeaderboard : INakamaconsoleLeaderboard, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -4393,11 +11878,17 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="category"), Preserve]
-        public int Category { get; set; }
+        public long Category { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="description"), Preserve]
@@ -4405,15 +11896,21 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="duration"), Preserve]
-        public int Duration { get; set; }
+        public long Duration { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="end_active"), Preserve]
-        public int EndActive { get; set; }
+        public long EndActive { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime EndTime
+        {
+            get => ApiClient.ParseDateTime(_endTime);
+            set => _endTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="end_time"), Preserve]
-        public string EndTime { get; set; }
+        public string _endTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="id"), Preserve]
@@ -4425,11 +11922,11 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="max_num_score"), Preserve]
-        public int MaxNumScore { get; set; }
+        public long MaxNumScore { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="max_size"), Preserve]
-        public int MaxSize { get; set; }
+        public long MaxSize { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="metadata"), Preserve]
@@ -4437,7 +11934,7 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="next_reset"), Preserve]
-        public int NextReset { get; set; }
+        public long NextReset { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="operator"), Preserve]
@@ -4445,7 +11942,7 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="prev_reset"), Preserve]
-        public int PrevReset { get; set; }
+        public long PrevReset { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="reset_schedule"), Preserve]
@@ -4453,19 +11950,25 @@ namespace Nakama.Console
 
         /// <inheritdoc />
         [DataMember(Name="size"), Preserve]
-        public int Size { get; set; }
+        public long Size { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="sort_order"), Preserve]
-        public int SortOrder { get; set; }
+        public long SortOrder { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="start_active"), Preserve]
-        public int StartActive { get; set; }
+        public long StartActive { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime StartTime
+        {
+            get => ApiClient.ParseDateTime(_startTime);
+            set => _startTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="start_time"), Preserve]
-        public string StartTime { get; set; }
+        public string _startTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="title"), Preserve]
@@ -4475,32 +11978,262 @@ namespace Nakama.Console
         [DataMember(Name="tournament"), Preserve]
         public bool Tournament { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "authoritative":
+                    Authoritative = reader.ReadBoolean();
+                    return true;
+                case "category":
+                    Category = reader.ReadInt64();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "description":
+                    Description = reader.ReadString();
+                    return true;
+                case "duration":
+                    Duration = reader.ReadInt64();
+                    return true;
+                case "end_active":
+                    EndActive = reader.ReadInt64();
+                    return true;
+                case "end_time":
+                    _endTime = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "join_required":
+                    JoinRequired = reader.ReadBoolean();
+                    return true;
+                case "max_num_score":
+                    MaxNumScore = reader.ReadInt64();
+                    return true;
+                case "max_size":
+                    MaxSize = reader.ReadInt64();
+                    return true;
+                case "metadata":
+                    Metadata = reader.ReadString();
+                    return true;
+                case "next_reset":
+                    NextReset = reader.ReadInt64();
+                    return true;
+                case "operator":
+                    Operator = reader.ReadInt32();
+                    return true;
+                case "prev_reset":
+                    PrevReset = reader.ReadInt64();
+                    return true;
+                case "reset_schedule":
+                    ResetSchedule = reader.ReadString();
+                    return true;
+                case "size":
+                    Size = reader.ReadInt64();
+                    return true;
+                case "sort_order":
+                    SortOrder = reader.ReadInt64();
+                    return true;
+                case "start_active":
+                    StartActive = reader.ReadInt64();
+                    return true;
+                case "start_time":
+                    _startTime = reader.ReadString();
+                    return true;
+                case "title":
+                    Title = reader.ReadString();
+                    return true;
+                case "tournament":
+                    Tournament = reader.ReadBoolean();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("authoritative");
+            writer.WriteBoolean(Authoritative);
+            writer.WriteName("category");
+            writer.WriteInt64(Category);
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Description != null)
+            {
+                writer.WriteName("description");
+                writer.WriteString(Description);
+            }
+            writer.WriteName("duration");
+            writer.WriteInt64(Duration);
+            writer.WriteName("end_active");
+            writer.WriteInt64(EndActive);
+            if (_endTime != null)
+            {
+                writer.WriteName("end_time");
+                writer.WriteString(_endTime);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            writer.WriteName("join_required");
+            writer.WriteBoolean(JoinRequired);
+            writer.WriteName("max_num_score");
+            writer.WriteInt64(MaxNumScore);
+            writer.WriteName("max_size");
+            writer.WriteInt64(MaxSize);
+            if (Metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteString(Metadata);
+            }
+            writer.WriteName("next_reset");
+            writer.WriteInt64(NextReset);
+            writer.WriteName("operator");
+            writer.WriteInt32(Operator);
+            writer.WriteName("prev_reset");
+            writer.WriteInt64(PrevReset);
+            if (ResetSchedule != null)
+            {
+                writer.WriteName("reset_schedule");
+                writer.WriteString(ResetSchedule);
+            }
+            writer.WriteName("size");
+            writer.WriteInt64(Size);
+            writer.WriteName("sort_order");
+            writer.WriteInt64(SortOrder);
+            writer.WriteName("start_active");
+            writer.WriteInt64(StartActive);
+            if (_startTime != null)
+            {
+                writer.WriteName("start_time");
+                writer.WriteString(_startTime);
+            }
+            if (Title != null)
+            {
+                writer.WriteName("title");
+                writer.WriteString(Title);
+            }
+            writer.WriteName("tournament");
+            writer.WriteBoolean(Tournament);
+        }
+
+        /// <inheritdoc />
+        public INakamaconsoleLeaderboard Clone() => (NakamaconsoleLeaderboard) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaconsoleLeaderboard) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaconsoleLeaderboard) obj;
+            return Authoritative == other.Authoritative &&
+                Category == other.Category &&
+                _createTime == other._createTime &&
+                Description == other.Description &&
+                Duration == other.Duration &&
+                EndActive == other.EndActive &&
+                _endTime == other._endTime &&
+                Id == other.Id &&
+                JoinRequired == other.JoinRequired &&
+                MaxNumScore == other.MaxNumScore &&
+                MaxSize == other.MaxSize &&
+                Metadata == other.Metadata &&
+                NextReset == other.NextReset &&
+                Operator == other.Operator &&
+                PrevReset == other.PrevReset &&
+                ResetSchedule == other.ResetSchedule &&
+                Size == other.Size &&
+                SortOrder == other.SortOrder &&
+                StartActive == other.StartActive &&
+                _startTime == other._startTime &&
+                Title == other.Title &&
+                Tournament == other.Tournament;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Authoritative.GetHashCode();
+                hash = hash * 31 + Category.GetHashCode();
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Description?.GetHashCode() ?? 0);
+                hash = hash * 31 + Duration.GetHashCode();
+                hash = hash * 31 + EndActive.GetHashCode();
+                hash = hash * 31 + (_endTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + JoinRequired.GetHashCode();
+                hash = hash * 31 + MaxNumScore.GetHashCode();
+                hash = hash * 31 + MaxSize.GetHashCode();
+                hash = hash * 31 + (Metadata?.GetHashCode() ?? 0);
+                hash = hash * 31 + NextReset.GetHashCode();
+                hash = hash * 31 + Operator.GetHashCode();
+                hash = hash * 31 + PrevReset.GetHashCode();
+                hash = hash * 31 + (ResetSchedule?.GetHashCode() ?? 0);
+                hash = hash * 31 + Size.GetHashCode();
+                hash = hash * 31 + SortOrder.GetHashCode();
+                hash = hash * 31 + StartActive.GetHashCode();
+                hash = hash * 31 + (_startTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Title?.GetHashCode() ?? 0);
+                hash = hash * 31 + Tournament.GetHashCode();
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Authoritative: ", Authoritative, ", ");
-            output = string.Concat(output, "Category: ", Category, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Description: ", Description, ", ");
-            output = string.Concat(output, "Duration: ", Duration, ", ");
-            output = string.Concat(output, "EndActive: ", EndActive, ", ");
-            output = string.Concat(output, "EndTime: ", EndTime, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "JoinRequired: ", JoinRequired, ", ");
-            output = string.Concat(output, "MaxNumScore: ", MaxNumScore, ", ");
-            output = string.Concat(output, "MaxSize: ", MaxSize, ", ");
-            output = string.Concat(output, "Metadata: ", Metadata, ", ");
-            output = string.Concat(output, "NextReset: ", NextReset, ", ");
-            output = string.Concat(output, "Operator: ", Operator, ", ");
-            output = string.Concat(output, "PrevReset: ", PrevReset, ", ");
-            output = string.Concat(output, "ResetSchedule: ", ResetSchedule, ", ");
-            output = string.Concat(output, "Size: ", Size, ", ");
-            output = string.Concat(output, "SortOrder: ", SortOrder, ", ");
-            output = string.Concat(output, "StartActive: ", StartActive, ", ");
-            output = string.Concat(output, "StartTime: ", StartTime, ", ");
-            output = string.Concat(output, "Title: ", Title, ", ");
-            output = string.Concat(output, "Tournament: ", Tournament, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Authoritative: " + ApiClient.FormatValue(Authoritative));
+            members.Add("Category: " + ApiClient.FormatValue(Category));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Description: " + ApiClient.FormatValue(Description));
+            members.Add("Duration: " + ApiClient.FormatValue(Duration));
+            members.Add("EndActive: " + ApiClient.FormatValue(EndActive));
+            members.Add("EndTime: " + ApiClient.FormatValue(EndTime));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("JoinRequired: " + ApiClient.FormatValue(JoinRequired));
+            members.Add("MaxNumScore: " + ApiClient.FormatValue(MaxNumScore));
+            members.Add("MaxSize: " + ApiClient.FormatValue(MaxSize));
+            members.Add("Metadata: " + ApiClient.FormatValue(Metadata));
+            members.Add("NextReset: " + ApiClient.FormatValue(NextReset));
+            members.Add("Operator: " + ApiClient.FormatValue(Operator));
+            members.Add("PrevReset: " + ApiClient.FormatValue(PrevReset));
+            members.Add("ResetSchedule: " + ApiClient.FormatValue(ResetSchedule));
+            members.Add("Size: " + ApiClient.FormatValue(Size));
+            members.Add("SortOrder: " + ApiClient.FormatValue(SortOrder));
+            members.Add("StartActive: " + ApiClient.FormatValue(StartActive));
+            members.Add("StartTime: " + ApiClient.FormatValue(StartTime));
+            members.Add("Title: " + ApiClient.FormatValue(Title));
+            members.Add("Tournament: " + ApiClient.FormatValue(Tournament));
         }
     }
 
@@ -4524,10 +12257,15 @@ namespace Nakama.Console
         /// Total count of leaderboards and tournaments.
         /// </summary>
         int Total { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaconsoleLeaderboardList Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaconsoleLeaderboardList : INakamaconsoleLeaderboardList
+    internal class NakamaconsoleLeaderboardList : INakamaconsoleLeaderboardList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -4544,13 +12282,93 @@ namespace Nakama.Console
         [DataMember(Name="total"), Preserve]
         public int Total { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cursor":
+                    Cursor = reader.ReadString();
+                    return true;
+                case "leaderboards":
+                    _leaderboards = reader.ReadList(reader.ReadObject<NakamaconsoleLeaderboard>);
+                    return true;
+                case "total":
+                    Total = reader.ReadInt32();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Cursor != null)
+            {
+                writer.WriteName("cursor");
+                writer.WriteString(Cursor);
+            }
+            if (_leaderboards != null)
+            {
+                writer.WriteName("leaderboards");
+                writer.WriteList(_leaderboards, writer.WriteObject);
+            }
+            writer.WriteName("total");
+            writer.WriteInt32(Total);
+        }
+
+        /// <inheritdoc />
+        public INakamaconsoleLeaderboardList Clone() => (NakamaconsoleLeaderboardList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaconsoleLeaderboardList) MemberwiseClone();
+            clone._leaderboards = _leaderboards?.ConvertAll(item => (NakamaconsoleLeaderboard) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaconsoleLeaderboardList) obj;
+            return Cursor == other.Cursor &&
+                ApiClient.ListEquals(_leaderboards, other._leaderboards) &&
+                Total == other.Total;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Cursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_leaderboards);
+                hash = hash * 31 + Total.GetHashCode();
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Cursor: ", Cursor, ", ");
-            output = string.Concat(output, "Leaderboards: [", string.Join(", ", Leaderboards), "], ");
-            output = string.Concat(output, "Total: ", Total, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Cursor: " + ApiClient.FormatValue(Cursor));
+            members.Add("Leaderboards: " + ApiClient.FormatList(_leaderboards));
+            members.Add("Total: " + ApiClient.FormatValue(Total));
         }
     }
 
@@ -4564,23 +12382,91 @@ namespace Nakama.Console
         /// 
         /// </summary>
         IEnumerable<IConsoleMatchListMatch> Matches { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaconsoleMatchList Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaconsoleMatchList : INakamaconsoleMatchList
+    internal class NakamaconsoleMatchList : INakamaconsoleMatchList, IApiJsonObject
     {
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IConsoleMatchListMatch> Matches => _matches ?? new List<ConsoleMatchListMatch>(0);
-        [DataMember(Name="matches"), Preserve]
-        public List<ConsoleMatchListMatch> _matches { get; set; }
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IConsoleMatchListMatch> Matches => _matches ?? new List<ConsoleMatchListMatch>(0);
+        [DataMember(Name="matches"), Preserve]
+        public List<ConsoleMatchListMatch> _matches { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "matches":
+                    _matches = reader.ReadList(reader.ReadObject<ConsoleMatchListMatch>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_matches != null)
+            {
+                writer.WriteName("matches");
+                writer.WriteList(_matches, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public INakamaconsoleMatchList Clone() => (NakamaconsoleMatchList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaconsoleMatchList) MemberwiseClone();
+            clone._matches = _matches?.ConvertAll(item => (ConsoleMatchListMatch) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaconsoleMatchList) obj;
+            return ApiClient.ListEquals(_matches, other._matches);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_matches);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Matches: [", string.Join(", ", Matches), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Matches: " + ApiClient.FormatList(_matches));
         }
     }
 
@@ -4603,7 +12489,7 @@ namespace Nakama.Console
         /// <summary>
         /// The UNIX time (for gRPC clients) or ISO string (for REST clients) when the notification was created.
         /// </summary>
-        string CreateTime { get; }
+        DateTime CreateTime { get; }
 
         /// <summary>
         /// ID of the Notification.
@@ -4629,10 +12515,15 @@ namespace Nakama.Console
         /// User id.
         /// </summary>
         string UserId { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaconsoleNotification Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaconsoleNotification : INakamaconsoleNotification
+    internal class NakamaconsoleNotification : INakamaconsoleNotification, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -4644,8 +12535,14 @@ namespace Nakama.Console
         public string Content { get; set; }
 
         /// <inheritdoc />
+        [IgnoreDataMember]
+        public DateTime CreateTime
+        {
+            get => ApiClient.ParseDateTime(_createTime);
+            set => _createTime = ApiClient.FormatDateTime(value);
+        }
         [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        public string _createTime { get; set; }
 
         /// <inheritdoc />
         [DataMember(Name="id"), Preserve]
@@ -4667,18 +12564,144 @@ namespace Nakama.Console
         [DataMember(Name="user_id"), Preserve]
         public string UserId { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "code":
+                    Code = reader.ReadInt32();
+                    return true;
+                case "content":
+                    Content = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "persistent":
+                    Persistent = reader.ReadBoolean();
+                    return true;
+                case "sender_id":
+                    SenderId = reader.ReadString();
+                    return true;
+                case "subject":
+                    Subject = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("code");
+            writer.WriteInt32(Code);
+            if (Content != null)
+            {
+                writer.WriteName("content");
+                writer.WriteString(Content);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            writer.WriteName("persistent");
+            writer.WriteBoolean(Persistent);
+            if (SenderId != null)
+            {
+                writer.WriteName("sender_id");
+                writer.WriteString(SenderId);
+            }
+            if (Subject != null)
+            {
+                writer.WriteName("subject");
+                writer.WriteString(Subject);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+        }
+
+        /// <inheritdoc />
+        public INakamaconsoleNotification Clone() => (NakamaconsoleNotification) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaconsoleNotification) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaconsoleNotification) obj;
+            return Code == other.Code &&
+                Content == other.Content &&
+                _createTime == other._createTime &&
+                Id == other.Id &&
+                Persistent == other.Persistent &&
+                SenderId == other.SenderId &&
+                Subject == other.Subject &&
+                UserId == other.UserId;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Content?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + Persistent.GetHashCode();
+                hash = hash * 31 + (SenderId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Subject?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Content: ", Content, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "Persistent: ", Persistent, ", ");
-            output = string.Concat(output, "SenderId: ", SenderId, ", ");
-            output = string.Concat(output, "Subject: ", Subject, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Content: " + ApiClient.FormatValue(Content));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Persistent: " + ApiClient.FormatValue(Persistent));
+            members.Add("SenderId: " + ApiClient.FormatValue(SenderId));
+            members.Add("Subject: " + ApiClient.FormatValue(Subject));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
         }
     }
 
@@ -4702,10 +12725,15 @@ namespace Nakama.Console
         /// Previous page cursor if any.
         /// </summary>
         string PrevCursor { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        INakamaconsoleNotificationList Clone();
     }
 
     /// <inheritdoc />
-    internal class NakamaconsoleNotificationList : INakamaconsoleNotificationList
+    internal class NakamaconsoleNotificationList : INakamaconsoleNotificationList, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -4722,13 +12750,96 @@ namespace Nakama.Console
         [DataMember(Name="prev_cursor"), Preserve]
         public string PrevCursor { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "notifications":
+                    _notifications = reader.ReadList(reader.ReadObject<NakamaconsoleNotification>);
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (_notifications != null)
+            {
+                writer.WriteName("notifications");
+                writer.WriteList(_notifications, writer.WriteObject);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+        }
+
+        /// <inheritdoc />
+        public INakamaconsoleNotificationList Clone() => (NakamaconsoleNotificationList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (NakamaconsoleNotificationList) MemberwiseClone();
+            clone._notifications = _notifications?.ConvertAll(item => (NakamaconsoleNotification) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (NakamaconsoleNotificationList) obj;
+            return NextCursor == other.NextCursor &&
+                ApiClient.ListEquals(_notifications, other._notifications) &&
+                PrevCursor == other.PrevCursor;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_notifications);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "Notifications: [", string.Join(", ", Notifications), "], ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("Notifications: " + ApiClient.FormatList(_notifications));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
         }
     }
 
@@ -4742,21 +12853,88 @@ namespace Nakama.Console
         /// 
         /// </summary>
         string @type { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IProtobufAny Clone();
     }
 
     /// <inheritdoc />
-    internal class ProtobufAny : IProtobufAny
+    internal class ProtobufAny : IProtobufAny, IApiJsonObject
     {
 
         /// <inheritdoc />
         [DataMember(Name="@type"), Preserve]
         public string @type { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "@type":
+                    @type = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (@type != null)
+            {
+                writer.WriteName("@type");
+                writer.WriteString(@type);
+            }
+        }
+
+        /// <inheritdoc />
+        public IProtobufAny Clone() => (ProtobufAny) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ProtobufAny) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ProtobufAny) obj;
+            return @type == other.@type;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (@type?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "@type: ", @type, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("@type: " + ApiClient.FormatValue(@type));
         }
     }
 
@@ -4790,10 +12968,15 @@ namespace Nakama.Console
         /// The username for display purposes.
         /// </summary>
         string Username { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IRealtimeUserPresence Clone();
     }
 
     /// <inheritdoc />
-    internal class RealtimeUserPresence : IRealtimeUserPresence
+    internal class RealtimeUserPresence : IRealtimeUserPresence, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -4816,15 +12999,249 @@ namespace Nakama.Console
         [DataMember(Name="username"), Preserve]
         public string Username { get; set; }
 
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "persistence":
+                    Persistence = reader.ReadBoolean();
+                    return true;
+                case "session_id":
+                    SessionId = reader.ReadString();
+                    return true;
+                case "status":
+                    Status = reader.ReadString();
+                    return true;
+                case "user_id":
+                    UserId = reader.ReadString();
+                    return true;
+                case "username":
+                    Username = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("persistence");
+            writer.WriteBoolean(Persistence);
+            if (SessionId != null)
+            {
+                writer.WriteName("session_id");
+                writer.WriteString(SessionId);
+            }
+            if (Status != null)
+            {
+                writer.WriteName("status");
+                writer.WriteString(Status);
+            }
+            if (UserId != null)
+            {
+                writer.WriteName("user_id");
+                writer.WriteString(UserId);
+            }
+            if (Username != null)
+            {
+                writer.WriteName("username");
+                writer.WriteString(Username);
+            }
+        }
+
+        /// <inheritdoc />
+        public IRealtimeUserPresence Clone() => (RealtimeUserPresence) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (RealtimeUserPresence) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (RealtimeUserPresence) obj;
+            return Persistence == other.Persistence &&
+                SessionId == other.SessionId &&
+                Status == other.Status &&
+                UserId == other.UserId &&
+                Username == other.Username;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Persistence.GetHashCode();
+                hash = hash * 31 + (SessionId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Status?.GetHashCode() ?? 0);
+                hash = hash * 31 + (UserId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Username?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Persistence: " + ApiClient.FormatValue(Persistence));
+            members.Add("SessionId: " + ApiClient.FormatValue(SessionId));
+            members.Add("Status: " + ApiClient.FormatValue(Status));
+            members.Add("UserId: " + ApiClient.FormatValue(UserId));
+            members.Add("Username: " + ApiClient.FormatValue(Username));
+        }
+    }
+
+    /// <summary>
+    /// 
+    /// </summary>
+    public interface IRpcStatus
+    {
+
+        /// <summary>
+        /// 
+        /// </summary>
+        int Code { get; }
+
+        /// <summary>
+        /// 
+        /// </summary>
+        string Message { get; }
+
+        /// <summary>
+        /// 
+        /// </summary>
+        IEnumerable<IProtobufAny> Details { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IRpcStatus Clone();
+    }
+
+    /// <inheritdoc />
+    internal class RpcStatus : IRpcStatus, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="code"), Preserve]
+        public int Code { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="message"), Preserve]
+        public string Message { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IProtobufAny> Details => _details ?? new List<ProtobufAny>(0);
+        [DataMember(Name="details"), Preserve]
+        public List<ProtobufAny> _details { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "code":
+                    Code = reader.ReadInt32();
+                    return true;
+                case "message":
+                    Message = reader.ReadString();
+                    return true;
+                case "details":
+                    _details = reader.ReadList(reader.ReadObject<ProtobufAny>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("code");
+            writer.WriteInt32(Code);
+            if (Message != null)
+            {
+                writer.WriteName("message");
+                writer.WriteString(Message);
+            }
+            if (_details != null)
+            {
+                writer.WriteName("details");
+                writer.WriteList(_details, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IRpcStatus Clone() => (RpcStatus) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (RpcStatus) MemberwiseClone();
+            clone._details = _details?.ConvertAll(item => (ProtobufAny) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (RpcStatus) obj;
+            return Code == other.Code &&
+                Message == other.Message &&
+                ApiClient.ListEquals(_details, other._details);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Message?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_details);
+                return hash;
+            }
+        }
+
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Persistence: ", Persistence, ", ");
-            output = string.Concat(output, "SessionId: ", SessionId, ", ");
-            output = string.Concat(output, "Status: ", Status, ", ");
-            output = string.Concat(output, "UserId: ", UserId, ", ");
-            output = string.Concat(output, "Username: ", Username, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Message: " + ApiClient.FormatValue(Message));
+            members.Add("Details: " + ApiClient.FormatList(_details));
         }
     }
 
@@ -4845,12 +13262,251 @@ namespace Nakama.Console
             Timeout = timeout;
         }
 
+        private async Task<string> SendAsync(string method, Uri uri, IDictionary<string, string> headers, byte[] body,
+            CancellationToken? cancellationToken, RequestOptions options)
+        {
+            if (options?.Headers != null)
+            {
+                foreach (var header in options.Headers)
+                {
+                    headers[header.Key] = header.Value;
+                }
+            }
+            var timeout = options?.Timeout ?? Timeout;
+            cancellationToken = cancellationToken ?? options?.CancellationToken;
+            try
+            {
+                return await HttpAdapter.SendAsync(method, uri, headers, body, timeout, cancellationToken);
+            }
+            catch (ApiResponseException e) when (e.GetType() == typeof(ApiResponseException))
+            {
+                var exception = ApiStatusException.FromResponse(e);
+                if (exception == null)
+                {
+                    throw;
+                }
+                throw exception;
+            }
+        }
+
+        private static T ParseResponse<T>(string contents, Func<ApiJsonReader, T> read) =>
+            string.IsNullOrEmpty(contents) ? default(T) : read(new ApiJsonReader(contents));
+
+        internal static long ParseInt64(string value)
+        {
+            long.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
+
+        internal static string FormatInt64(long value) => value.ToString(CultureInfo.InvariantCulture);
+
+        internal static ulong ParseUInt64(string value)
+        {
+            ulong.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
+
+        internal static string FormatUInt64(ulong value) => value.ToString(CultureInfo.InvariantCulture);
+
+        internal static DateTime ParseDateTime(string value)
+        {
+            DateTime.TryParse(value, CultureInfo.InvariantCulture,
+                DateTimeStyles.AdjustToUniversal | DateTimeStyles.AssumeUniversal, out var result);
+            return result;
+        }
+
+        internal static string FormatDateTime(DateTime value) =>
+            value.ToUniversalTime().ToString("yyyy-MM-dd'T'HH:mm:ss.FFFFFFF'Z'", CultureInfo.InvariantCulture);
+
+        internal static byte[] ParseBytes(string value) => value == null ? null : Convert.FromBase64String(value);
+
+        internal static string FormatBytes(byte[] value) => value == null ? null : Convert.ToBase64String(value);
+
+        private static byte[] EncodeForm(List<KeyValuePair<string, object>> form)
+        {
+            var fields = new List<string>(form.Count);
+            foreach (var field in form)
+            {
+                fields.Add(string.Concat(Uri.EscapeDataString(field.Key), "=", Uri.EscapeDataString((string) field.Value)));
+            }
+            return Encoding.UTF8.GetBytes(string.Join("&", fields));
+        }
+
+        private static byte[] EncodeMultipart(List<KeyValuePair<string, object>> form, string boundary)
+        {
+            using (var stream = new MemoryStream())
+            {
+                foreach (var field in form)
+                {
+                    var file = field.Value as byte[];
+                    var header = file == null
+                        ? $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"\r\n\r\n"
+                        : $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"; filename=\"{field.Key}\"\r\nContent-Type: application/octet-stream\r\n\r\n";
+                    var part = Encoding.UTF8.GetBytes(header);
+                    stream.Write(part, 0, part.Length);
+                    part = file ?? Encoding.UTF8.GetBytes((string) field.Value);
+                    stream.Write(part, 0, part.Length);
+                    part = Encoding.UTF8.GetBytes("\r\n");
+                    stream.Write(part, 0, part.Length);
+                }
+                var end = Encoding.UTF8.GetBytes($"--{boundary}--\r\n");
+                stream.Write(end, 0, end.Length);
+                return stream.ToArray();
+            }
+        }
+
+        internal static Dictionary<string, TOutput> ConvertMap<TInput, TOutput>(Dictionary<string, TInput> map,
+            Converter<TInput, TOutput> converter)
+        {
+            if (map == null)
+            {
+                return null;
+            }
+
+            var result = new Dictionary<string, TOutput>(map.Count);
+            foreach (var kvp in map)
+            {
+                result.Add(kvp.Key, converter(kvp.Value));
+            }
+            return result;
+        }
+
+        // Models compare a missing list or map as equal to an empty one, as the server may leave either out.
+        internal static bool ListEquals<T>(List<T> a, List<T> b)
+        {
+            var count = a?.Count ?? 0;
+            if (count != (b?.Count ?? 0))
+            {
+                return false;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            for (var i = 0; i < count; i++)
+            {
+                if (!comparer.Equals(a[i], b[i]))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+
+        internal static bool MapEquals<T>(Dictionary<string, T> a, Dictionary<string, T> b)
+        {
+            if ((a?.Count ?? 0) != (b?.Count ?? 0))
+            {
+                return false;
+            }
+            if (a == null || b == null)
+            {
+                return true;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in a)
+            {
+                if (!b.TryGetValue(kvp.Key, out var value) || !comparer.Equals(kvp.Value, value))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+
+        internal static int ListHashCode<T>(List<T> list)
+        {
+            var hash = 0;
+            if (list == null)
+            {
+                return hash;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var item in list)
+            {
+                hash = unchecked(hash * 31 + comparer.GetHashCode(item));
+            }
+            return hash;
+        }
+
+        // Entries are hashed apart and summed, so that maps hash alike whatever order they were filled in.
+        internal static int MapHashCode<T>(Dictionary<string, T> map)
+        {
+            var hash = 0;
+            if (map == null)
+            {
+                return hash;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in map)
+            {
+                hash = unchecked(hash + (kvp.Key.GetHashCode() ^ comparer.GetHashCode(kvp.Value)));
+            }
+            return hash;
+        }
+
+        internal static string FormatModel(string name, List<string> members) =>
+            members.Count == 0 ? string.Concat(name, " {}") : string.Concat(name, " { ", string.Join(", ", members), " }");
+
+        internal static string FormatValue(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    return "null";
+                case string text:
+                    return string.Concat("\"", text.Replace("\\", "\\\\").Replace("\"", "\\\""), "\"");
+                case bool flag:
+                    return flag ? "true" : "false";
+                case DateTime time:
+                    return FormatDateTime(time);
+                case IFormattable formattable:
+                    return formattable.ToString(null, CultureInfo.InvariantCulture);
+                default:
+                    return value.ToString();
+            }
+        }
+
+        internal static string FormatEncoded(string value) => value ?? "null";
+
+        internal static string FormatList<T>(List<T> list, Func<T, string> format = null)
+        {
+            if (list == null || list.Count == 0)
+            {
+                return "[]";
+            }
+
+            var items = new List<string>(list.Count);
+            foreach (var item in list)
+            {
+                items.Add(format == null ? FormatValue(item) : format(item));
+            }
+            return string.Concat("[", string.Join(", ", items), "]");
+        }
+
+        internal static string FormatMap<T>(IDictionary<string, T> map, Func<T, string> format = null)
+        {
+            if (map == null || map.Count == 0)
+            {
+                return "{}";
+            }
+
+            var entries = new List<string>(map.Count);
+            foreach (var kvp in map)
+            {
+                entries.Add(string.Concat(FormatValue(kvp.Key), ": ", format == null ? FormatValue(kvp.Value) : format(kvp.Value)));
+            }
+            return string.Concat("{ ", string.Join(", ", entries), " }");
+        }
+
         /// <summary>
         /// Delete (non-recorded) all user accounts.
         /// </summary>
         public async Task ConsoleDeleteAccountsAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/account";
@@ -4867,11 +13523,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -4879,10 +13538,11 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleAccountList> ConsoleListAccountsAsync(
             string bearerToken,
-            string filter,
-            bool? tombstones,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string filter = null,
+            bool? tombstones = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/account";
@@ -4892,7 +13552,7 @@ namespace Nakama.Console
                 queryParams = string.Concat(queryParams, "filter=", Uri.EscapeDataString(filter), "&");
             }
             if (tombstones != null) {
-                queryParams = string.Concat(queryParams, "tombstones=", tombstones.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "tombstones=", Uri.EscapeDataString(tombstones.Value ? "true" : "false"), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -4908,12 +13568,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleAccountList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleAccountList>());
         }
 
         /// <summary>
@@ -4922,9 +13585,10 @@ namespace Nakama.Console
         public async Task<IConsoleWalletLedgerList> ConsoleGetWalletLedgerAsync(
             string bearerToken,
             string accountId,
-            int? limit,
-            string cursor,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (accountId == null)
             {
@@ -4936,7 +13600,7 @@ namespace Nakama.Console
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -4952,12 +13616,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleWalletLedgerList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleWalletLedgerList>());
         }
 
         /// <summary>
@@ -4966,8 +13633,9 @@ namespace Nakama.Console
         public async Task ConsoleDeleteAccountAsync(
             string bearerToken,
             string id,
-            bool? record_deletion,
-            CancellationToken? cancellationToken)
+            bool? recordDeletion = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -4978,8 +13646,8 @@ namespace Nakama.Console
             urlpath = urlpath.Replace("{id}", Uri.EscapeDataString(id));
 
             var queryParams = "";
-            if (record_deletion != null) {
-                queryParams = string.Concat(queryParams, "record_deletion=", record_deletion.ToString().ToLower(), "&");
+            if (recordDeletion != null) {
+                queryParams = string.Concat(queryParams, "record_deletion=", Uri.EscapeDataString(recordDeletion.Value ? "true" : "false"), "&");
             }
 
             string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
@@ -4992,11 +13660,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5005,7 +13676,8 @@ namespace Nakama.Console
         public async Task<INakamaconsoleAccount> ConsoleGetAccountAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5027,12 +13699,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<NakamaconsoleAccount>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<NakamaconsoleAccount>());
         }
 
         /// <summary>
@@ -5042,7 +13717,8 @@ namespace Nakama.Console
             string bearerToken,
             string id,
             ApiConsole_UpdateAccountRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5068,13 +13744,17 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5083,7 +13763,8 @@ namespace Nakama.Console
         public async Task ConsoleBanAccountAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5105,11 +13786,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5118,7 +13802,8 @@ namespace Nakama.Console
         public async Task<IConsoleAccountExport> ConsoleExportAccountAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5140,12 +13825,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleAccountExport>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleAccountExport>());
         }
 
         /// <summary>
@@ -5154,7 +13842,8 @@ namespace Nakama.Console
         public async Task<IApiFriendList> ConsoleGetFriendsAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5176,12 +13865,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiFriendList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiFriendList>());
         }
 
         /// <summary>
@@ -5191,7 +13883,8 @@ namespace Nakama.Console
             string bearerToken,
             string id,
             string friendId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5218,11 +13911,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5231,7 +13927,8 @@ namespace Nakama.Console
         public async Task<IApiUserGroupList> ConsoleGetGroupsAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5253,12 +13950,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiUserGroupList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiUserGroupList>());
         }
 
         /// <summary>
@@ -5268,7 +13968,8 @@ namespace Nakama.Console
             string bearerToken,
             string id,
             string groupId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5295,11 +13996,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5308,7 +14012,8 @@ namespace Nakama.Console
         public async Task ConsoleUnbanAccountAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5330,11 +14035,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5343,7 +14051,8 @@ namespace Nakama.Console
         public async Task ConsoleUnlinkAppleAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5365,11 +14074,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5378,7 +14090,8 @@ namespace Nakama.Console
         public async Task ConsoleUnlinkCustomAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5400,11 +14113,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5414,7 +14130,8 @@ namespace Nakama.Console
             string bearerToken,
             string id,
             ApiConsole_UnlinkDeviceRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5440,13 +14157,17 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5455,7 +14176,8 @@ namespace Nakama.Console
         public async Task ConsoleUnlinkEmailAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5477,11 +14199,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5490,7 +14215,8 @@ namespace Nakama.Console
         public async Task ConsoleUnlinkFacebookAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5512,11 +14238,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5525,7 +14254,8 @@ namespace Nakama.Console
         public async Task ConsoleUnlinkFacebookInstantGameAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5547,11 +14277,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5560,7 +14293,8 @@ namespace Nakama.Console
         public async Task ConsoleUnlinkGameCenterAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5582,11 +14316,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5595,7 +14332,8 @@ namespace Nakama.Console
         public async Task ConsoleUnlinkGoogleAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5617,11 +14355,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5630,7 +14371,8 @@ namespace Nakama.Console
         public async Task ConsoleUnlinkSteamAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5652,11 +14394,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5666,7 +14411,8 @@ namespace Nakama.Console
             string bearerToken,
             string id,
             string walletId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -5693,11 +14439,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5705,7 +14454,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task ConsoleDeleteAllDataAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/all";
@@ -5722,11 +14472,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5734,7 +14487,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleApiEndpointList> ConsoleListApiEndpointsAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/api/endpoints";
@@ -5751,12 +14505,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleApiEndpointList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleApiEndpointList>());
         }
 
         /// <summary>
@@ -5766,7 +14523,8 @@ namespace Nakama.Console
             string bearerToken,
             string method,
             ApiConsole_CallRpcEndpointRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (method == null)
             {
@@ -5792,14 +14550,18 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleCallApiEndpointResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleCallApiEndpointResponse>());
         }
 
         /// <summary>
@@ -5809,7 +14571,8 @@ namespace Nakama.Console
             string bearerToken,
             string method,
             ApiConsole_CallApiEndpointRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (method == null)
             {
@@ -5835,14 +14598,18 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleCallApiEndpointResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleCallApiEndpointResponse>());
         }
 
         /// <summary>
@@ -5850,7 +14617,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleConsoleSession> ConsoleAuthenticateAsync(
             ConsoleAuthenticateRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5873,10 +14641,11 @@ namespace Nakama.Console
             var headers = new Dictionary<string, string>();
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleConsoleSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleConsoleSession>());
         }
 
         /// <summary>
@@ -5885,7 +14654,8 @@ namespace Nakama.Console
         public async Task ConsoleAuthenticateLogoutAsync(
             string bearerToken,
             ConsoleAuthenticateLogoutRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5906,13 +14676,17 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -5921,7 +14695,8 @@ namespace Nakama.Console
         public async Task<IConsoleAuthenticateMFASetupResponse> ConsoleAuthenticateMFASetupAsync(
             string bearerToken,
             ConsoleAuthenticateMFASetupRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -5942,14 +14717,18 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleAuthenticateMFASetupResponse>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleAuthenticateMFASetupResponse>());
         }
 
         /// <summary>
@@ -5957,13 +14736,14 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IApiChannelMessageList> ConsoleListChannelMessagesAsync(
             string bearerToken,
-            string type,
-            string label,
-            string groupId,
-            string userIdOne,
-            string userIdTwo,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string type = null,
+            string label = null,
+            string groupId = null,
+            string userIdOne = null,
+            string userIdTwo = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/channel";
@@ -5998,12 +14778,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiChannelMessageList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiChannelMessageList>());
         }
 
         /// <summary>
@@ -6011,7 +14794,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleConfig> ConsoleGetConfigAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/config";
@@ -6028,12 +14812,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleConfig>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleConfig>());
         }
 
         /// <summary>
@@ -6041,9 +14828,10 @@ namespace Nakama.Console
         /// </summary>
         public async Task<INakamaconsoleGroupList> ConsoleListGroupsAsync(
             string bearerToken,
-            string filter,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string filter = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/group";
@@ -6066,12 +14854,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<NakamaconsoleGroupList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<NakamaconsoleGroupList>());
         }
 
         /// <summary>
@@ -6081,7 +14872,8 @@ namespace Nakama.Console
             string bearerToken,
             string groupId,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
@@ -6108,11 +14900,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6122,7 +14917,8 @@ namespace Nakama.Console
             string bearerToken,
             string groupId,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
@@ -6149,11 +14945,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6163,7 +14962,8 @@ namespace Nakama.Console
             string bearerToken,
             string groupId,
             ApiConsole_AddGroupUsersRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (groupId == null)
             {
@@ -6189,13 +14989,17 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6204,7 +15008,8 @@ namespace Nakama.Console
         public async Task ConsoleDeleteGroupAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6226,11 +15031,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6239,7 +15047,8 @@ namespace Nakama.Console
         public async Task<IApiGroup> ConsoleGetGroupAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6261,12 +15070,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiGroup>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiGroup>());
         }
 
         /// <summary>
@@ -6276,7 +15088,8 @@ namespace Nakama.Console
             string bearerToken,
             string id,
             ApiConsole_UpdateGroupRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6302,13 +15115,17 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6317,7 +15134,8 @@ namespace Nakama.Console
         public async Task<IConsoleGroupExport> ConsoleExportGroupAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6339,12 +15157,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleGroupExport>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleGroupExport>());
         }
 
         /// <summary>
@@ -6353,7 +15174,8 @@ namespace Nakama.Console
         public async Task<IApiGroupUserList> ConsoleGetMembersAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6375,12 +15197,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiGroupUserList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiGroupUserList>());
         }
 
         /// <summary>
@@ -6389,7 +15214,8 @@ namespace Nakama.Console
         public async Task<IApiValidatedPurchase> ConsoleGetPurchaseAsync(
             string bearerToken,
             string transactionId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (transactionId == null)
             {
@@ -6411,12 +15237,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidatedPurchase>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidatedPurchase>());
         }
 
         /// <summary>
@@ -6425,7 +15254,8 @@ namespace Nakama.Console
         public async Task<IApiValidatedSubscription> ConsoleGetSubscriptionAsync(
             string bearerToken,
             string originalTransactionId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (originalTransactionId == null)
             {
@@ -6447,12 +15277,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiValidatedSubscription>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiValidatedSubscription>());
         }
 
         /// <summary>
@@ -6460,8 +15293,9 @@ namespace Nakama.Console
         /// </summary>
         public async Task<INakamaconsoleLeaderboardList> ConsoleListLeaderboardsAsync(
             string bearerToken,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/leaderboard";
@@ -6481,12 +15315,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<NakamaconsoleLeaderboardList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<NakamaconsoleLeaderboardList>());
         }
 
         /// <summary>
@@ -6495,7 +15332,8 @@ namespace Nakama.Console
         public async Task ConsoleDeleteLeaderboardAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6517,11 +15355,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6530,7 +15371,8 @@ namespace Nakama.Console
         public async Task<INakamaconsoleLeaderboard> ConsoleGetLeaderboardAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6552,12 +15394,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<NakamaconsoleLeaderboard>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<NakamaconsoleLeaderboard>());
         }
 
         /// <summary>
@@ -6567,7 +15412,8 @@ namespace Nakama.Console
             string bearerToken,
             string id,
             string ownerId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6594,11 +15440,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6607,11 +15456,12 @@ namespace Nakama.Console
         public async Task<IApiLeaderboardRecordList> ConsoleListLeaderboardRecordsAsync(
             string bearerToken,
             string leaderboardId,
-            IEnumerable<string> ownerIds,
-            int? limit,
-            string cursor,
-            string expiry,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> ownerIds = null,
+            int? limit = null,
+            string cursor = null,
+            string expiry = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (leaderboardId == null)
             {
@@ -6627,7 +15477,7 @@ namespace Nakama.Console
                 queryParams = string.Concat(queryParams, "owner_ids=", Uri.EscapeDataString(elem), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -6646,12 +15496,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiLeaderboardRecordList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiLeaderboardRecordList>());
         }
 
         /// <summary>
@@ -6659,34 +15512,35 @@ namespace Nakama.Console
         /// </summary>
         public async Task<INakamaconsoleMatchList> ConsoleListMatchesAsync(
             string bearerToken,
-            int? limit,
-            bool? authoritative,
-            string label,
-            int? min_size,
-            int? max_size,
-            string matchId,
-            string query,
-            string node,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            bool? authoritative = null,
+            string label = null,
+            int? minSize = null,
+            int? maxSize = null,
+            string matchId = null,
+            string query = null,
+            string node = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/match";
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (authoritative != null) {
-                queryParams = string.Concat(queryParams, "authoritative=", authoritative.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "authoritative=", Uri.EscapeDataString(authoritative.Value ? "true" : "false"), "&");
             }
             if (label != null) {
                 queryParams = string.Concat(queryParams, "label=", Uri.EscapeDataString(label), "&");
             }
-            if (min_size != null) {
-                queryParams = string.Concat(queryParams, "min_size=", min_size, "&");
+            if (minSize != null) {
+                queryParams = string.Concat(queryParams, "min_size=", Uri.EscapeDataString(minSize.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
-            if (max_size != null) {
-                queryParams = string.Concat(queryParams, "max_size=", max_size, "&");
+            if (maxSize != null) {
+                queryParams = string.Concat(queryParams, "max_size=", Uri.EscapeDataString(maxSize.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (matchId != null) {
                 queryParams = string.Concat(queryParams, "match_id=", Uri.EscapeDataString(matchId), "&");
@@ -6708,12 +15562,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<NakamaconsoleMatchList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<NakamaconsoleMatchList>());
         }
 
         /// <summary>
@@ -6722,7 +15579,8 @@ namespace Nakama.Console
         public async Task<IConsoleMatchState> ConsoleGetMatchStateAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6744,12 +15602,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleMatchState>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleMatchState>());
         }
 
         /// <summary>
@@ -6757,9 +15618,10 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleDeleteChannelMessagesResponse> ConsoleDeleteChannelMessagesAsync(
             string bearerToken,
-            string before,
-            IEnumerable<string> ids,
-            CancellationToken? cancellationToken)
+            string before = null,
+            IEnumerable<string> ids = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/message";
@@ -6783,12 +15645,15 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleDeleteChannelMessagesResponse>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleDeleteChannelMessagesResponse>());
         }
 
         /// <summary>
@@ -6796,10 +15661,11 @@ namespace Nakama.Console
         /// </summary>
         public async Task<INakamaconsoleNotificationList> ConsoleListNotificationsAsync(
             string bearerToken,
-            string userId,
-            int? limit,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string userId = null,
+            int? limit = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/notification";
@@ -6809,7 +15675,7 @@ namespace Nakama.Console
                 queryParams = string.Concat(queryParams, "user_id=", Uri.EscapeDataString(userId), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -6825,12 +15691,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<NakamaconsoleNotificationList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<NakamaconsoleNotificationList>());
         }
 
         /// <summary>
@@ -6839,7 +15708,8 @@ namespace Nakama.Console
         public async Task ConsoleDeleteNotificationAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6861,11 +15731,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -6874,7 +15747,8 @@ namespace Nakama.Console
         public async Task<INakamaconsoleNotification> ConsoleGetNotificationAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -6896,12 +15770,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<NakamaconsoleNotification>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<NakamaconsoleNotification>());
         }
 
         /// <summary>
@@ -6909,10 +15786,11 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IApiPurchaseList> ConsoleListPurchasesAsync(
             string bearerToken,
-            string userId,
-            int? limit,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string userId = null,
+            int? limit = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/purchase";
@@ -6922,7 +15800,7 @@ namespace Nakama.Console
                 queryParams = string.Concat(queryParams, "user_id=", Uri.EscapeDataString(userId), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -6938,12 +15816,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiPurchaseList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiPurchaseList>());
         }
 
         /// <summary>
@@ -6951,7 +15832,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleRuntimeInfo> ConsoleGetRuntimeAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/runtime";
@@ -6968,12 +15850,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleRuntimeInfo>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleRuntimeInfo>());
         }
 
         /// <summary>
@@ -6981,8 +15866,9 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleSettingList> ConsoleListSettingsAsync(
             string bearerToken,
-            IEnumerable<string> names,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> names = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/setting";
@@ -7003,12 +15889,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleSettingList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleSettingList>());
         }
 
         /// <summary>
@@ -7017,7 +15906,8 @@ namespace Nakama.Console
         public async Task<IConsoleSetting> ConsoleGetSettingAsync(
             string bearerToken,
             string name,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (name == null)
             {
@@ -7039,12 +15929,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleSetting>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleSetting>());
         }
 
         /// <summary>
@@ -7054,7 +15947,8 @@ namespace Nakama.Console
             string bearerToken,
             string name,
             ApiConsole_UpdateSettingRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (name == null)
             {
@@ -7080,14 +15974,18 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleSetting>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleSetting>());
         }
 
         /// <summary>
@@ -7095,7 +15993,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleStatusList> ConsoleGetStatusAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/status";
@@ -7112,12 +16011,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleStatusList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleStatusList>());
         }
 
         /// <summary>
@@ -7125,7 +16027,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task ConsoleDeleteStorageAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/storage";
@@ -7142,11 +16045,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7154,11 +16060,12 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleStorageList> ConsoleListStorageAsync(
             string bearerToken,
-            string userId,
-            string key,
-            string collection,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string userId = null,
+            string key = null,
+            string collection = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/storage";
@@ -7187,12 +16094,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleStorageList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleStorageList>());
         }
 
         /// <summary>
@@ -7200,7 +16110,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleStorageCollectionsList> ConsoleListStorageCollectionsAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/storage/collections";
@@ -7217,12 +16128,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleStorageCollectionsList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleStorageCollectionsList>());
         }
 
         /// <summary>
@@ -7233,8 +16147,9 @@ namespace Nakama.Console
             string collection,
             string key,
             string userId,
-            string version,
-            CancellationToken? cancellationToken)
+            string version = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (collection == null)
             {
@@ -7269,11 +16184,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7284,7 +16202,8 @@ namespace Nakama.Console
             string collection,
             string key,
             string userId,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (collection == null)
             {
@@ -7316,12 +16235,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiStorageObject>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiStorageObject>());
         }
 
         /// <summary>
@@ -7333,7 +16255,8 @@ namespace Nakama.Console
             string key,
             string userId,
             ApiConsole_WriteStorageObjectRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (collection == null)
             {
@@ -7369,14 +16292,18 @@ namespace Nakama.Console
 
             var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiStorageObjectAck>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiStorageObjectAck>());
         }
 
         /// <summary>
@@ -7388,7 +16315,8 @@ namespace Nakama.Console
             string key,
             string userId,
             string version,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (collection == null)
             {
@@ -7425,11 +16353,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7437,10 +16368,11 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IApiSubscriptionList> ConsoleListSubscriptionsAsync(
             string bearerToken,
-            string userId,
-            int? limit,
-            string cursor,
-            CancellationToken? cancellationToken)
+            string userId = null,
+            int? limit = null,
+            string cursor = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/subscription";
@@ -7450,7 +16382,7 @@ namespace Nakama.Console
                 queryParams = string.Concat(queryParams, "user_id=", Uri.EscapeDataString(userId), "&");
             }
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -7466,12 +16398,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSubscriptionList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSubscriptionList>());
         }
 
         /// <summary>
@@ -7479,8 +16414,9 @@ namespace Nakama.Console
         /// </summary>
         public async Task ConsoleDeleteUserAsync(
             string bearerToken,
-            string username,
-            CancellationToken? cancellationToken)
+            string username = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/user";
@@ -7500,11 +16436,14 @@ namespace Nakama.Console
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7512,7 +16451,8 @@ namespace Nakama.Console
         /// </summary>
         public async Task<IConsoleUserList> ConsoleListUsersAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v2/console/user";
@@ -7529,12 +16469,15 @@ namespace Nakama.Console
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ConsoleUserList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ConsoleUserList>());
         }
 
         /// <summary>
@@ -7543,7 +16486,8 @@ namespace Nakama.Console
         public async Task ConsoleAddUserAsync(
             string bearerToken,
             ConsoleAddUserRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -7564,13 +16508,17 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7580,7 +16528,8 @@ namespace Nakama.Console
             string bearerToken,
             string username,
             ApiConsole_RequireUserMfaRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (username == null)
             {
@@ -7606,13 +16555,17 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -7621,7 +16574,8 @@ namespace Nakama.Console
         public async Task ConsoleResetUserMfaAsync(
             string bearerToken,
             string username,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (username == null)
             {
@@ -7643,11 +16597,14 @@ namespace Nakama.Console
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
     }
 }
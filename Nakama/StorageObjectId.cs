@@ -34,6 +34,10 @@ namespace Nakama
         /// <inheritdoc />
         public string UserId { get; set; }
 
+        IApiReadStorageObjectId IApiReadStorageObjectId.Clone() => (StorageObjectId) MemberwiseClone();
+
+        IApiDeleteStorageObjectId IApiDeleteStorageObjectId.Clone() => (StorageObjectId) MemberwiseClone();
+
         public override string ToString()
         {
             return $"StorageObjectId(Collection='{Collection}', Key='{Key}', Version='{Version}', UserId='{UserId}')";
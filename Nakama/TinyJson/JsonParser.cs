@@ -214,6 +214,12 @@ namespace Nakama.TinyJson
                 return parseStringBuilder.ToString();
             }
 
+            var underlyingType = Nullable.GetUnderlyingType(type);
+            if (underlyingType != null)
+            {
+                return json == "null" ? null : ParseValue(underlyingType, json);
+            }
+
             if (type.IsPrimitive)
             {
                 var result = Convert.ChangeType(json, type, System.Globalization.CultureInfo.InvariantCulture);
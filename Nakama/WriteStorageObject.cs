@@ -36,5 +36,11 @@ namespace Nakama
 
         /// <inheritdoc cref="Version"/>
         public string Version { get; set; }
+
+        int? IApiWriteStorageObject.PermissionRead => PermissionRead;
+
+        int? IApiWriteStorageObject.PermissionWrite => PermissionWrite;
+
+        IApiWriteStorageObject IApiWriteStorageObject.Clone() => (WriteStorageObject) MemberwiseClone();
     }
 }
@@ -3,16 +3,17 @@ namespace Satori
 {
     using System;
     using System.Collections.Generic;
+    using System.Globalization;
+    using System.IO;
     using System.Runtime.Serialization;
     using System.Text;
     using System.Threading;
     using System.Threading.Tasks;
-    using TinyJson;
 
     /// <summary>
     /// An exception generated for <c>HttpResponse</c> objects don't return a success status.
     /// </summary>
-    public sealed class ApiResponseException : Exception
+    public class ApiResponseException : Exception
     {
         public long StatusCode { get; }
 
@@ -34,696 +35,1044 @@ namespace Satori
         {
         }
 
+        protected ApiResponseException(ApiResponseException e) : base(e.Message, e)
+        {
+            StatusCode = e.StatusCode;
+            GrpcStatusCode = e.GrpcStatusCode;
+            foreach (var key in e.Data.Keys)
+            {
+                Data[key] = e.Data[key];
+            }
+        }
+
         public override string ToString()
         {
-            return $"ApiResponseException(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
+            return $"{GetType().Name}(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
         }
     }
 
     /// <summary>
-    /// The request to update the status of a message.
+    /// A failure the server reported with a gRPC status code.
     /// </summary>
-    public interface IApiUpdateMessageRequest
+    public class ApiStatusException : ApiResponseException
     {
-
         /// <summary>
-        /// The time the message was consumed by the identity.
+        /// The error response, with the details the <see cref="IHttpAdapter"/> passed on in the exception data.
         /// </summary>
-        string ConsumeTime { get; }
+        public IRpcStatus Status { get; }
+
+        public ApiStatusException(ApiResponseException e) : base(e)
+        {
+            var status = new Dictionary<string, object>
+            {
+                {"code", e.GrpcStatusCode},
+                {"message", e.Message}
+            };
+            if (e.Data.Contains("details"))
+            {
+                status["details"] = e.Data["details"];
+            }
+            var writer = new ApiJsonWriter();
+            writer.WriteAny(status);
+            Status = new ApiJsonReader(writer.ToString()).ReadObject<RpcStatus>();
+        }
 
         /// <summary>
-        /// The time the message was read at the client.
+        /// The exception for the gRPC status code of a failure, or null when the code is not a known one.
         /// </summary>
-        string ReadTime { get; }
+        internal static ApiStatusException FromResponse(ApiResponseException e)
+        {
+            switch (e.GrpcStatusCode)
+            {
+                case 1:
+                    return new CancelledException(e);
+                case 2:
+                    return new UnknownException(e);
+                case 3:
+                    return new InvalidArgumentException(e);
+                case 4:
+                    return new DeadlineExceededException(e);
+                case 5:
+                    return new NotFoundException(e);
+                case 6:
+                    return new AlreadyExistsException(e);
+                case 7:
+                    return new PermissionDeniedException(e);
+                case 8:
+                    return new ResourceExhaustedException(e);
+                case 9:
+                    return new FailedPreconditionException(e);
+                case 10:
+                    return new AbortedException(e);
+                case 11:
+                    return new OutOfRangeException(e);
+                case 12:
+                    return new UnimplementedException(e);
+                case 13:
+                    return new InternalException(e);
+                case 14:
+                    return new UnavailableException(e);
+                case 15:
+                    return new DataLossException(e);
+                case 16:
+                    return new UnauthenticatedException(e);
+                default:
+                    return null;
+            }
+        }
     }
 
-    /// <inheritdoc />
-    internal class ApiUpdateMessageRequest : IApiUpdateMessageRequest
+    /// <summary>
+    /// The operation was cancelled, typically by the caller.
+    /// </summary>
+    public class CancelledException : ApiStatusException
     {
-
-        /// <inheritdoc />
-        [DataMember(Name="consume_time"), Preserve]
-        public string ConsumeTime { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="read_time"), Preserve]
-        public string ReadTime { get; set; }
-
-        public override string ToString()
+        public CancelledException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "ConsumeTime: ", ConsumeTime, ", ");
-            output = string.Concat(output, "ReadTime: ", ReadTime, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// 
+    /// An unknown error.
     /// </summary>
-    public interface IFlagValueChangeReason
+    public class UnknownException : ApiStatusException
     {
-
-        /// <summary>
-        /// The name of the configuration that overrides the flag value.
-        /// </summary>
-        string Name { get; }
-
-        /// <summary>
-        /// The type of the configuration that declared the override.
-        /// </summary>
-        FlagValueChangeReasonType Type { get; }
-
-        /// <summary>
-        /// The variant name of the configuration that overrides the flag value.
-        /// </summary>
-        string VariantName { get; }
+        public UnknownException(ApiResponseException e) : base(e)
+        {
+        }
     }
 
-    /// <inheritdoc />
-    internal class FlagValueChangeReason : IFlagValueChangeReason
+    /// <summary>
+    /// The client specified an invalid argument.
+    /// </summary>
+    public class InvalidArgumentException : ApiStatusException
     {
-
-        /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
-
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public FlagValueChangeReasonType Type => _type;
-        [DataMember(Name="type"), Preserve]
-        public FlagValueChangeReasonType _type { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="variant_name"), Preserve]
-        public string VariantName { get; set; }
-
-        public override string ToString()
+        public InvalidArgumentException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "Type: ", Type, ", ");
-            output = string.Concat(output, "VariantName: ", VariantName, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// 
+    /// The deadline expired before the operation could complete.
     /// </summary>
-    public enum FlagValueChangeReasonType
+    public class DeadlineExceededException : ApiStatusException
     {
-        /// <summary>
-        /// 
-        /// </summary>
-        UNKNOWN = 0,
-        /// <summary>
-        /// 
-        /// </summary>
-        FLAG_VARIANT = 1,
-        /// <summary>
-        /// 
-        /// </summary>
-        LIVE_EVENT = 2,
-        /// <summary>
-        /// 
-        /// </summary>
-        EXPERIMENT = 3,
+        public DeadlineExceededException(ApiResponseException e) : base(e)
+        {
+        }
     }
 
     /// <summary>
-    /// Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
+    /// Some requested entity was not found.
     /// </summary>
-    public interface IApiAuthenticateLogoutRequest
+    public class NotFoundException : ApiStatusException
     {
+        public NotFoundException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <summary>
-        /// Refresh token to invalidate.
-        /// </summary>
-        string RefreshToken { get; }
-
-        /// <summary>
-        /// Session token to log out.
-        /// </summary>
-        string Token { get; }
+    /// <summary>
+    /// The entity a client attempted to create already exists.
+    /// </summary>
+    public class AlreadyExistsException : ApiStatusException
+    {
+        public AlreadyExistsException(ApiResponseException e) : base(e)
+        {
+        }
     }
 
-    /// <inheritdoc />
-    internal class ApiAuthenticateLogoutRequest : IApiAuthenticateLogoutRequest
+    /// <summary>
+    /// The caller does not have permission to execute the operation.
+    /// </summary>
+    public class PermissionDeniedException : ApiStatusException
     {
+        public PermissionDeniedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <inheritdoc />
-        [DataMember(Name="refresh_token"), Preserve]
-        public string RefreshToken { get; set; }
+    /// <summary>
+    /// Some resource has been exhausted, such as a per-user quota.
+    /// </summary>
+    public class ResourceExhaustedException : ApiStatusException
+    {
+        public ResourceExhaustedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
+    /// <summary>
+    /// The system is not in a state required for the operation's execution.
+    /// </summary>
+    public class FailedPreconditionException : ApiStatusException
+    {
+        public FailedPreconditionException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        public override string ToString()
+    /// <summary>
+    /// The operation was aborted, typically due to a concurrency issue.
+    /// </summary>
+    public class AbortedException : ApiStatusException
+    {
+        public AbortedException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "RefreshToken: ", RefreshToken, ", ");
-            output = string.Concat(output, "Token: ", Token, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// Authenticate against the server with a refresh token.
+    /// The operation was attempted past the valid range.
     /// </summary>
-    public interface IApiAuthenticateRefreshRequest
+    public class OutOfRangeException : ApiStatusException
     {
+        public OutOfRangeException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <summary>
-        /// Refresh token.
-        /// </summary>
-        string RefreshToken { get; }
+    /// <summary>
+    /// The operation is not implemented or not supported.
+    /// </summary>
+    public class UnimplementedException : ApiStatusException
+    {
+        public UnimplementedException(ApiResponseException e) : base(e)
+        {
+        }
     }
 
-    /// <inheritdoc />
-    internal class ApiAuthenticateRefreshRequest : IApiAuthenticateRefreshRequest
+    /// <summary>
+    /// An internal error of the server.
+    /// </summary>
+    public class InternalException : ApiStatusException
     {
+        public InternalException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        /// <inheritdoc />
-        [DataMember(Name="refresh_token"), Preserve]
-        public string RefreshToken { get; set; }
+    /// <summary>
+    /// The service is currently unavailable.
+    /// </summary>
+    public class UnavailableException : ApiStatusException
+    {
+        public UnavailableException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
-        public override string ToString()
+    /// <summary>
+    /// Unrecoverable data loss or corruption.
+    /// </summary>
+    public class DataLossException : ApiStatusException
+    {
+        public DataLossException(ApiResponseException e) : base(e)
         {
-            var output = "";
-            output = string.Concat(output, "RefreshToken: ", RefreshToken, ", ");
-            return output;
         }
     }
 
     /// <summary>
-    /// Authentication request
+    /// The request does not have valid authentication credentials.
     /// </summary>
-    public interface IApiAuthenticateRequest
+    public class UnauthenticatedException : ApiStatusException
     {
+        public UnauthenticatedException(ApiResponseException e) : base(e)
+        {
+        }
+    }
 
+
+    /// <summary>
+    /// Options of a single request, which override those of the client.
+    /// </summary>
+    public class RequestOptions
+    {
         /// <summary>
-        /// Optional custom properties to update with this call. If not set, properties are left as they are on the server.
+        /// The timeout of the request in seconds, in place of the timeout of the client.
         /// </summary>
-        IDictionary<string, string> Custom { get; }
+        public int? Timeout { get; set; }
 
         /// <summary>
-        /// Optional default properties to update with this call. If not set, properties are left as they are on the server.
+        /// Headers sent with the request, which replace those of the same name set by the method.
         /// </summary>
-        IDictionary<string, string> Default { get; }
+        public IDictionary<string, string> Headers { get; set; }
 
         /// <summary>
-        /// Identity ID. Must be between eight and 128 characters (inclusive). Must be an alphanumeric string with only underscores and hyphens allowed.
+        /// The token which cancels the request, unless one is passed to the method.
         /// </summary>
-        string Id { get; }
+        public CancellationToken? CancellationToken { get; set; }
 
         /// <summary>
-        /// Optional no_session modifies the request to only create/update an identity without creating a new session. If set to 'true' the response won't include a token and a refresh token.
+        /// The retry configuration of the request, used by clients which retry it.
         /// </summary>
-        bool NoSession { get; }
+        public RetryConfiguration RetryConfiguration { get; set; }
     }
 
-    /// <inheritdoc />
-    internal class ApiAuthenticateRequest : IApiAuthenticateRequest
+    /// <summary>
+    /// A model which reads and writes its own members as JSON, without reflection.
+    /// </summary>
+    internal interface IApiJsonObject
     {
+        /// <summary>
+        /// Reads the value of a member, and returns false when the model has no member of that name.
+        /// </summary>
+        bool ReadMember(string name, ApiJsonReader reader);
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Custom => _custom ?? new Dictionary<string, string>();
-        [DataMember(Name="custom"), Preserve]
-        public Dictionary<string, string> _custom { get; set; }
-
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Default => _default ?? new Dictionary<string, string>();
-        [DataMember(Name="default"), Preserve]
-        public Dictionary<string, string> _default { get; set; }
+        /// <summary>
+        /// Writes the members which are set.
+        /// </summary>
+        void WriteMembers(ApiJsonWriter writer);
+    }
 
-        /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
+    /// <summary>
+    /// A forward-only reader of JSON text, which values are read from in the order they appear.
+    /// </summary>
+    internal sealed class ApiJsonReader
+    {
+        private readonly string _json;
+        private int _position;
 
-        /// <inheritdoc />
-        [DataMember(Name="no_session"), Preserve]
-        public bool NoSession { get; set; }
+        public ApiJsonReader(string json)
+        {
+            _json = json;
+        }
 
-        public override string ToString()
+        /// <summary>
+        /// Reads an object into a new model, or returns null for a JSON null. Members the model doesn't know are skipped.
+        /// </summary>
+        public T ReadObject<T>() where T : class, IApiJsonObject, new()
         {
-            var output = "";
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var value = new T();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var name = ReadName();
+                    if (!value.ReadMember(name, this))
+                    {
+                        Skip();
+                    }
+                } while (Consume(','));
+                Expect('}');
+            }
+            return value;
+        }
 
-            var customString = "";
-            foreach (var kvp in Custom)
+        public List<T> ReadList<T>(Func<T> readItem)
+        {
+            if (ReadNull())
             {
-                customString = string.Concat(customString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                return null;
             }
-            output = string.Concat(output, "Custom: [" + customString + "]");
 
-            var defaultString = "";
-            foreach (var kvp in Default)
+            var list = new List<T>();
+            Expect('[');
+            if (!Consume(']'))
             {
-                defaultString = string.Concat(defaultString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                do
+                {
+                    list.Add(readItem());
+                } while (Consume(','));
+                Expect(']');
             }
-            output = string.Concat(output, "Default: [" + defaultString + "]");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "NoSession: ", NoSession, ", ");
-            return output;
+            return list;
         }
-    }
 
-    /// <summary>
-    /// A single event. Usually, but not necessarily, part of a batch.
-    /// </summary>
-    public interface IApiEvent
-    {
+        public Dictionary<string, T> ReadMap<T>(Func<T> readValue)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
 
-        /// <summary>
-        /// Optional event ID assigned by the client, used to de-duplicate in retransmission scenarios. If not supplied the server will assign a randomly generated unique event identifier.
-        /// </summary>
-        string Id { get; }
+            var map = new Dictionary<string, T>();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var key = ReadName();
+                    map[key] = readValue();
+                } while (Consume(','));
+                Expect('}');
+            }
+            return map;
+        }
 
-        /// <summary>
-        /// The identity id associated with the event. Ignored if the event is published as part of a session.
-        /// </summary>
-        string IdentityId { get; }
+        public T? ReadNullable<T>(Func<T> read) where T : struct => ReadNull() ? (T?) null : read();
 
         /// <summary>
-        /// Event metadata, if any.
+        /// Reads a string, or the text of a number or literal in its place.
         /// </summary>
-        IDictionary<string, string> Metadata { get; }
+        public string ReadString()
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+            if (Peek() != '"')
+            {
+                return ReadLiteral();
+            }
 
-        /// <summary>
-        /// Event name.
-        /// </summary>
-        string Name { get; }
+            _position++;
+            StringBuilder builder = null;
+            var start = _position;
+            while (_position < _json.Length)
+            {
+                var c = _json[_position];
+                if (c == '"')
+                {
+                    var value = builder == null
+                        ? _json.Substring(start, _position - start)
+                        : builder.Append(_json, start, _position - start).ToString();
+                    _position++;
+                    return value;
+                }
+                if (c != '\\')
+                {
+                    _position++;
+                    continue;
+                }
+
+                if (builder == null)
+                {
+                    builder = new StringBuilder();
+                }
+                builder.Append(_json, start, _position - start);
+                if (++_position >= _json.Length)
+                {
+                    break;
+                }
+                switch (_json[_position])
+                {
+                    case 'b':
+                        builder.Append('\b');
+                        break;
+                    case 'f':
+                        builder.Append('\f');
+                        break;
+                    case 'n':
+                        builder.Append('\n');
+                        break;
+                    case 'r':
+                        builder.Append('\r');
+                        break;
+                    case 't':
+                        builder.Append('\t');
+                        break;
+                    case 'u':
+                        if (_position + 4 >= _json.Length ||
+                            !int.TryParse(_json.Substring(_position + 1, 4), NumberStyles.AllowHexSpecifier,
+                                CultureInfo.InvariantCulture, out var code))
+                        {
+                            throw Error("invalid unicode escape");
+                        }
+                        builder.Append((char) code);
+                        _position += 4;
+                        break;
+                    default:
+                        builder.Append(_json[_position]);
+                        break;
+                }
+                start = ++_position;
+            }
+            throw Error("unterminated string");
+        }
 
-        /// <summary>
-        /// The session expires at associated with the event. Ignored if the event is published as part of a session.
-        /// </summary>
-        string SessionExpiresAt { get; }
+        public int ReadInt32() => int.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
 
-        /// <summary>
-        /// The session id associated with the event. Ignored if the event is published as part of a session.
-        /// </summary>
-        string SessionId { get; }
+        public long ReadInt64() => long.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
 
-        /// <summary>
-        /// The session issued at associated with the event. Ignored if the event is published as part of a session.
-        /// </summary>
-        string SessionIssuedAt { get; }
+        public ulong ReadUInt64() => ulong.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
 
-        /// <summary>
-        /// The time when the event was triggered on the producer side.
-        /// </summary>
-        string Timestamp { get; }
+        public double ReadDouble() => double.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public float ReadSingle() => float.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public bool ReadBoolean() => ReadString() == "true";
 
         /// <summary>
-        /// Optional value.
+        /// Skips the next value, whatever its type.
         /// </summary>
-        string Value { get; }
+        public void Skip()
+        {
+            switch (Peek())
+            {
+                case '{':
+                    ReadMap(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                case '[':
+                    ReadList(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                default:
+                    ReadString();
+                    break;
+            }
+        }
+
+        private string ReadName()
+        {
+            if (Peek() != '"')
+            {
+                throw Error("expected a member name");
+            }
+            var name = ReadString();
+            Expect(':');
+            return name;
+        }
+
+        // Numbers which don't fit a double are sent as strings, and a null is read as the default value.
+        private string ReadNumber() => ReadString() ?? "0";
+
+        private string ReadLiteral()
+        {
+            var start = _position;
+            while (_position < _json.Length && ",:]} \t\r\n".IndexOf(_json[_position]) < 0)
+            {
+                _position++;
+            }
+            if (_position == start)
+            {
+                throw Error("expected a value");
+            }
+            return _json.Substring(start, _position - start);
+        }
+
+        private bool ReadNull()
+        {
+            if (Peek() != 'n' || string.CompareOrdinal(_json, _position, "null", 0, 4) != 0)
+            {
+                return false;
+            }
+            _position += 4;
+            return true;
+        }
+
+        private bool Consume(char c)
+        {
+            if (Peek() != c)
+            {
+                return false;
+            }
+            _position++;
+            return true;
+        }
+
+        private void Expect(char c)
+        {
+            if (!Consume(c))
+            {
+                throw Error(string.Concat("expected '", c.ToString(), "'"));
+            }
+        }
+
+        private char Peek()
+        {
+            while (_position < _json.Length && char.IsWhiteSpace(_json[_position]))
+            {
+                _position++;
+            }
+            return _position < _json.Length ? _json[_position] : '\0';
+        }
+
+        private FormatException Error(string message) =>
+            new FormatException(string.Concat("Invalid JSON at position ",
+                _position.ToString(CultureInfo.InvariantCulture), ": ", message));
     }
 
-    /// <inheritdoc />
-    internal class ApiEvent : IApiEvent
+    /// <summary>
+    /// A writer of JSON text, which values are written to in order.
+    /// </summary>
+    internal sealed class ApiJsonWriter
     {
+        private readonly StringBuilder _builder = new StringBuilder();
+        private bool _separate;
 
-        /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="identity_id"), Preserve]
-        public string IdentityId { get; set; }
+        public void WriteName(string name)
+        {
+            WriteString(name);
+            _builder.Append(':');
+            _separate = false;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Metadata => _metadata ?? new Dictionary<string, string>();
-        [DataMember(Name="metadata"), Preserve]
-        public Dictionary<string, string> _metadata { get; set; }
+        public void WriteObject(IApiJsonObject value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            value.WriteMembers(this);
+            _builder.Append('}');
+            _separate = true;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
+        public void WriteList<T>(IEnumerable<T> values, Action<T> writeItem)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('[');
+            _separate = false;
+            foreach (var value in values)
+            {
+                writeItem(value);
+            }
+            _builder.Append(']');
+            _separate = true;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="session_expires_at"), Preserve]
-        public string SessionExpiresAt { get; set; }
+        public void WriteMap<T>(IDictionary<string, T> values, Action<T> writeValue)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            foreach (var kvp in values)
+            {
+                WriteName(kvp.Key);
+                writeValue(kvp.Value);
+            }
+            _builder.Append('}');
+            _separate = true;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="session_id"), Preserve]
-        public string SessionId { get; set; }
+        public void WriteString(string value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('"');
+            foreach (var c in value)
+            {
+                switch (c)
+                {
+                    case '"':
+                        _builder.Append("\\\"");
+                        break;
+                    case '\\':
+                        _builder.Append("\\\\");
+                        break;
+                    case '\n':
+                        _builder.Append("\\n");
+                        break;
+                    case '\r':
+                        _builder.Append("\\r");
+                        break;
+                    case '\t':
+                        _builder.Append("\\t");
+                        break;
+                    default:
+                        if (c < ' ')
+                        {
+                            _builder.Append("\\u").Append(((int) c).ToString("x4", CultureInfo.InvariantCulture));
+                        }
+                        else
+                        {
+                            _builder.Append(c);
+                        }
+                        break;
+                }
+            }
+            _builder.Append('"');
+            _separate = true;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="session_issued_at"), Preserve]
-        public string SessionIssuedAt { get; set; }
+        public void WriteInt32(int value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
 
-        /// <inheritdoc />
-        [DataMember(Name="timestamp"), Preserve]
-        public string Timestamp { get; set; }
+        public void WriteInt64(long value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
 
-        /// <inheritdoc />
-        [DataMember(Name="value"), Preserve]
-        public string Value { get; set; }
+        public void WriteUInt64(ulong value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
 
-        public override string ToString()
+        public void WriteDouble(double value)
         {
-            var output = "";
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "IdentityId: ", IdentityId, ", ");
+            // JSON has no literal for NaN or infinities, which are sent as strings.
+            if (double.IsNaN(value) || double.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
 
-            var metadataString = "";
-            foreach (var kvp in Metadata)
+        public void WriteSingle(float value)
+        {
+            // Formatted as a float, so that it isn't widened to a double with digits it never had, e.g. 0.1f.
+            if (float.IsNaN(value) || float.IsInfinity(value))
             {
-                metadataString = string.Concat(metadataString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
             }
-            output = string.Concat(output, "Metadata: [" + metadataString + "]");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "SessionExpiresAt: ", SessionExpiresAt, ", ");
-            output = string.Concat(output, "SessionId: ", SessionId, ", ");
-            output = string.Concat(output, "SessionIssuedAt: ", SessionIssuedAt, ", ");
-            output = string.Concat(output, "Timestamp: ", Timestamp, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            return output;
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
         }
-    }
 
-    /// <summary>
-    /// Publish an event to the server
-    /// </summary>
-    public interface IApiEventRequest
-    {
+        public void WriteBoolean(bool value) => WriteLiteral(value ? "true" : "false");
+
+        public void WriteNull() => WriteLiteral("null");
 
         /// <summary>
-        /// Some number of events produced by a client.
+        /// Writes a value of unknown type, as parsed into dictionaries, lists and primitives.
         /// </summary>
-        IEnumerable<IApiEvent> Events { get; }
-    }
+        public void WriteAny(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    WriteNull();
+                    break;
+                case string s:
+                    WriteString(s);
+                    break;
+                case bool b:
+                    WriteBoolean(b);
+                    break;
+                case int i:
+                    WriteInt32(i);
+                    break;
+                case long l:
+                    WriteInt64(l);
+                    break;
+                case double d:
+                    WriteDouble(d);
+                    break;
+                case float f:
+                    WriteSingle(f);
+                    break;
+                case IApiJsonObject o:
+                    WriteObject(o);
+                    break;
+                case IDictionary<string, object> map:
+                    WriteMap(map, WriteAny);
+                    break;
+                case System.Collections.IEnumerable list:
+                    Separate();
+                    _builder.Append('[');
+                    _separate = false;
+                    foreach (var item in list)
+                    {
+                        WriteAny(item);
+                    }
+                    _builder.Append(']');
+                    _separate = true;
+                    break;
+                default:
+                    WriteString(Convert.ToString(value, CultureInfo.InvariantCulture));
+                    break;
+            }
+        }
 
-    /// <inheritdoc />
-    internal class ApiEventRequest : IApiEventRequest
-    {
+        public override string ToString() => _builder.ToString();
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiEvent> Events => _events ?? new List<ApiEvent>(0);
-        [DataMember(Name="events"), Preserve]
-        public List<ApiEvent> _events { get; set; }
+        private void WriteLiteral(string value)
+        {
+            Separate();
+            _builder.Append(value);
+            _separate = true;
+        }
 
-        public override string ToString()
+        private void Separate()
         {
-            var output = "";
-            output = string.Concat(output, "Events: [", string.Join(", ", Events), "], ");
-            return output;
+            if (_separate)
+            {
+                _builder.Append(',');
+            }
         }
     }
 
     /// <summary>
-    /// An experiment that this user is partaking.
+    /// The request to update the status of a message.
     /// </summary>
-    public interface IApiExperiment
+    public interface IApiUpdateMessageRequest
     {
 
         /// <summary>
-        /// The labels associated with this experiment.
-        /// </summary>
-        List<string> Labels { get; }
-
-        /// <summary>
-        /// Experiment name
-        /// </summary>
-        string Name { get; }
-
-        /// <summary>
-        /// Experiment Phase name
+        /// The time the message was consumed by the identity.
         /// </summary>
-        string PhaseName { get; }
+        string ConsumeTime { get; }
 
         /// <summary>
-        /// Experiment Phase Variant name
+        /// The time the message was read at the client.
         /// </summary>
-        string PhaseVariantName { get; }
+        string ReadTime { get; }
 
         /// <summary>
-        /// Value associated with this Experiment.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Value { get; }
+        IApiUpdateMessageRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiExperiment : IApiExperiment
+    internal class ApiUpdateMessageRequest : IApiUpdateMessageRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="labels"), Preserve]
-        public List<string> Labels { get; set; }
+        [DataMember(Name="consume_time"), Preserve]
+        public string ConsumeTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
+        [DataMember(Name="read_time"), Preserve]
+        public string ReadTime { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="phase_name"), Preserve]
-        public string PhaseName { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "consume_time":
+                    ConsumeTime = reader.ReadString();
+                    return true;
+                case "read_time":
+                    ReadTime = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="phase_variant_name"), Preserve]
-        public string PhaseVariantName { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (ConsumeTime != null)
+            {
+                writer.WriteName("consume_time");
+                writer.WriteString(ConsumeTime);
+            }
+            if (ReadTime != null)
+            {
+                writer.WriteName("read_time");
+                writer.WriteString(ReadTime);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="value"), Preserve]
-        public string Value { get; set; }
+        public IApiUpdateMessageRequest Clone() => (ApiUpdateMessageRequest) CloneModel();
 
-        public override string ToString()
+        internal virtual object CloneModel()
         {
-            var output = "";
-            output = string.Concat(output, "Labels: [", string.Join(", ", Labels), "], ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "PhaseName: ", PhaseName, ", ");
-            output = string.Concat(output, "PhaseVariantName: ", PhaseVariantName, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            return output;
+            var clone = (ApiUpdateMessageRequest) MemberwiseClone();
+            return clone;
         }
-    }
-
-    /// <summary>
-    /// All experiments that this identity is involved with.
-    /// </summary>
-    public interface IApiExperimentList
-    {
 
-        /// <summary>
-        /// All experiments for this identity.
-        /// </summary>
-        IEnumerable<IApiExperiment> Experiments { get; }
-    }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-    /// <inheritdoc />
-    internal class ApiExperimentList : IApiExperimentList
-    {
+            var other = (ApiUpdateMessageRequest) obj;
+            return ConsumeTime == other.ConsumeTime &&
+                ReadTime == other.ReadTime;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiExperiment> Experiments => _experiments ?? new List<ApiExperiment>(0);
-        [DataMember(Name="experiments"), Preserve]
-        public List<ApiExperiment> _experiments { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (ConsumeTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ReadTime?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Experiments: [", string.Join(", ", Experiments), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ConsumeTime: " + ApiClient.FormatValue(ConsumeTime));
+            members.Add("ReadTime: " + ApiClient.FormatValue(ReadTime));
         }
     }
 
     /// <summary>
-    /// Feature flag available to the identity.
+    /// 
     /// </summary>
-    public interface IApiFlag
+    public interface IFlagValueChangeReason
     {
 
         /// <summary>
-        /// The origin of change on the flag value returned.
-        /// </summary>
-        IFlagValueChangeReason ChangeReason { get; }
-
-        /// <summary>
-        /// Whether the value for this flag has conditionally changed from the default state.
+        /// The name of the configuration that overrides the flag value.
         /// </summary>
-        bool ConditionChanged { get; }
+        string Name { get; }
 
         /// <summary>
-        /// The labels associated with this flag.
+        /// The type of the configuration that declared the override.
         /// </summary>
-        List<string> Labels { get; }
+        FlagValueChangeReasonType Type { get; }
 
         /// <summary>
-        /// Flag name
+        /// The variant name of the configuration that overrides the flag value.
         /// </summary>
-        string Name { get; }
+        string VariantName { get; }
 
         /// <summary>
-        /// Value associated with this flag.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Value { get; }
+        IFlagValueChangeReason Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiFlag : IApiFlag
+    internal class FlagValueChangeReason : IFlagValueChangeReason, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IFlagValueChangeReason ChangeReason => _changeReason;
-        [DataMember(Name="change_reason"), Preserve]
-        public FlagValueChangeReason _changeReason { get; set; }
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="condition_changed"), Preserve]
-        public bool ConditionChanged { get; set; }
+        [IgnoreDataMember]
+        public FlagValueChangeReasonType Type
+        {
+            get => FlagValueChangeReasonTypeConverter.Parse(_type);
+            set => _type = FlagValueChangeReasonTypeConverter.Format(value);
+        }
+        [DataMember(Name="type"), Preserve]
+        public string _type { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="labels"), Preserve]
-        public List<string> Labels { get; set; }
+        [DataMember(Name="variant_name"), Preserve]
+        public string VariantName { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "type":
+                    _type = reader.ReadString();
+                    return true;
+                case "variant_name":
+                    VariantName = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="value"), Preserve]
-        public string Value { get; set; }
-
-        public override string ToString()
+        public virtual void WriteMembers(ApiJsonWriter writer)
         {
-            var output = "";
-            output = string.Concat(output, "ChangeReason: ", ChangeReason, ", ");
-            output = string.Concat(output, "ConditionChanged: ", ConditionChanged, ", ");
-            output = string.Concat(output, "Labels: [", string.Join(", ", Labels), "], ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            return output;
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (_type != null)
+            {
+                writer.WriteName("type");
+                writer.WriteString(_type);
+            }
+            if (VariantName != null)
+            {
+                writer.WriteName("variant_name");
+                writer.WriteString(VariantName);
+            }
         }
-    }
-
-    /// <summary>
-    /// All flags available to the identity
-    /// </summary>
-    public interface IApiFlagList
-    {
-
-        /// <summary>
-        /// All flags
-        /// </summary>
-        IEnumerable<IApiFlag> Flags { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiFlagList : IApiFlagList
-    {
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiFlag> Flags => _flags ?? new List<ApiFlag>(0);
-        [DataMember(Name="flags"), Preserve]
-        public List<ApiFlag> _flags { get; set; }
+        public IFlagValueChangeReason Clone() => (FlagValueChangeReason) CloneModel();
 
-        public override string ToString()
+        internal virtual object CloneModel()
         {
-            var output = "";
-            output = string.Concat(output, "Flags: [", string.Join(", ", Flags), "], ");
-            return output;
+            var clone = (FlagValueChangeReason) MemberwiseClone();
+            return clone;
         }
-    }
-
-    /// <summary>
-    /// Feature flag available to the identity.
-    /// </summary>
-    public interface IApiFlagOverride
-    {
-
-        /// <summary>
-        /// Flag name
-        /// </summary>
-        string FlagName { get; }
-
-        /// <summary>
-        /// The labels associated with this flag.
-        /// </summary>
-        List<string> Labels { get; }
 
-        /// <summary>
-        /// The list of configuration that affect the value of the flag.
-        /// </summary>
-        IEnumerable<IApiFlagOverrideValue> Overrides { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiFlagOverride : IApiFlagOverride
-    {
-
-        /// <inheritdoc />
-        [DataMember(Name="flag_name"), Preserve]
-        public string FlagName { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="labels"), Preserve]
-        public List<string> Labels { get; set; }
+            var other = (FlagValueChangeReason) obj;
+            return Name == other.Name &&
+                _type == other._type &&
+                VariantName == other.VariantName;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiFlagOverrideValue> Overrides => _overrides ?? new List<ApiFlagOverrideValue>(0);
-        [DataMember(Name="overrides"), Preserve]
-        public List<ApiFlagOverrideValue> _overrides { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_type?.GetHashCode() ?? 0);
+                hash = hash * 31 + (VariantName?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "FlagName: ", FlagName, ", ");
-            output = string.Concat(output, "Labels: [", string.Join(", ", Labels), "], ");
-            output = string.Concat(output, "Overrides: [", string.Join(", ", Overrides), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
         }
-    }
-
-    /// <summary>
-    /// All flags available to the identity and their value overrides
-    /// </summary>
-    public interface IApiFlagOverrideList
-    {
-
-        /// <summary>
-        /// All flags
-        /// </summary>
-        IEnumerable<IApiFlagOverride> Flags { get; }
-    }
-
-    /// <inheritdoc />
-    internal class ApiFlagOverrideList : IApiFlagOverrideList
-    {
-
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiFlagOverride> Flags => _flags ?? new List<ApiFlagOverride>(0);
-        [DataMember(Name="flags"), Preserve]
-        public List<ApiFlagOverride> _flags { get; set; }
 
-        public override string ToString()
+        internal virtual void FormatMembers(List<string> members)
         {
-            var output = "";
-            output = string.Concat(output, "Flags: [", string.Join(", ", Flags), "], ");
-            return output;
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Type: " + ApiClient.FormatEncoded(_type));
+            members.Add("VariantName: " + ApiClient.FormatValue(VariantName));
         }
     }
 
     /// <summary>
     /// 
     /// </summary>
-    public enum ApiFlagOverrideType
+    public enum FlagValueChangeReasonType
     {
         /// <summary>
         /// 
         /// </summary>
-        FLAG = 0,
+        UNKNOWN = 0,
         /// <summary>
         /// 
         /// </summary>
@@ -731,155 +1080,282 @@ namespace Satori
         /// <summary>
         /// 
         /// </summary>
-        LIVE_EVENT_FLAG = 2,
+        LIVE_EVENT = 2,
         /// <summary>
         /// 
         /// </summary>
-        LIVE_EVENT_FLAG_VARIANT = 3,
+        EXPERIMENT = 3,
         /// <summary>
-        /// 
+        /// A value this client does not recognize, such as one added in a newer version of the server.
         /// </summary>
-        EXPERIMENT_PHASE_VARIANT_FLAG = 4,
+        Unrecognized = -1,
     }
 
     /// <summary>
-    /// The details of a flag value override.
+    /// Converts <see cref="FlagValueChangeReasonType"/> to and from JSON, which may carry a member by name or by number.
     /// </summary>
-    public interface IApiFlagOverrideValue
+    internal static class FlagValueChangeReasonTypeConverter
     {
+        public static FlagValueChangeReasonType Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(FlagValueChangeReasonType);
+                case "UNKNOWN":
+                case "0":
+                    return FlagValueChangeReasonType.UNKNOWN;
+                case "FLAG_VARIANT":
+                case "1":
+                    return FlagValueChangeReasonType.FLAG_VARIANT;
+                case "LIVE_EVENT":
+                case "2":
+                    return FlagValueChangeReasonType.LIVE_EVENT;
+                case "EXPERIMENT":
+                case "3":
+                    return FlagValueChangeReasonType.EXPERIMENT;
+                default:
+                    return FlagValueChangeReasonType.Unrecognized;
+            }
+        }
 
-        /// <summary>
-        /// The create time of the configuration that overrides the flag.
-        /// </summary>
-        string CreateTimeSec { get; }
+        public static string Format(FlagValueChangeReasonType value)
+        {
+            switch (value)
+            {
+                case FlagValueChangeReasonType.UNKNOWN:
+                    return "UNKNOWN";
+                case FlagValueChangeReasonType.FLAG_VARIANT:
+                    return "FLAG_VARIANT";
+                case FlagValueChangeReasonType.LIVE_EVENT:
+                    return "LIVE_EVENT";
+                case FlagValueChangeReasonType.EXPERIMENT:
+                    return "EXPERIMENT";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
+        }
+    }
 
-        /// <summary>
-        /// The name of the configuration that overrides the flag value.
-        /// </summary>
-        string Name { get; }
+    /// <summary>
+    /// Log out a session, invalidate a refresh token, or log out all sessions/refresh tokens for a user.
+    /// </summary>
+    public interface IApiAuthenticateLogoutRequest
+    {
 
         /// <summary>
-        /// The type of the configuration that declared the override.
+        /// Refresh token to invalidate.
         /// </summary>
-        ApiFlagOverrideType Type { get; }
+        string RefreshToken { get; }
 
         /// <summary>
-        /// The value of the configuration that overrides the flag.
+        /// Session token to log out.
         /// </summary>
-        string Value { get; }
+        string Token { get; }
 
         /// <summary>
-        /// The variant name of the configuration that overrides the flag value.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string VariantName { get; }
+        IApiAuthenticateLogoutRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiFlagOverrideValue : IApiFlagOverrideValue
+    internal class ApiAuthenticateLogoutRequest : IApiAuthenticateLogoutRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="create_time_sec"), Preserve]
-        public string CreateTimeSec { get; set; }
+        [DataMember(Name="refresh_token"), Preserve]
+        public string RefreshToken { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public ApiFlagOverrideType Type => _type;
-        [DataMember(Name="type"), Preserve]
-        public ApiFlagOverrideType _type { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "refresh_token":
+                    RefreshToken = reader.ReadString();
+                    return true;
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="value"), Preserve]
-        public string Value { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (RefreshToken != null)
+            {
+                writer.WriteName("refresh_token");
+                writer.WriteString(RefreshToken);
+            }
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="variant_name"), Preserve]
-        public string VariantName { get; set; }
+        public IApiAuthenticateLogoutRequest Clone() => (ApiAuthenticateLogoutRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiAuthenticateLogoutRequest) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiAuthenticateLogoutRequest) obj;
+            return RefreshToken == other.RefreshToken &&
+                Token == other.Token;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (RefreshToken?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "CreateTimeSec: ", CreateTimeSec, ", ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "Type: ", Type, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            output = string.Concat(output, "VariantName: ", VariantName, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("RefreshToken: " + ApiClient.FormatValue(RefreshToken));
+            members.Add("Token: " + ApiClient.FormatValue(Token));
         }
     }
 
     /// <summary>
-    /// A response containing all the messages for an identity.
+    /// Authenticate against the server with a refresh token.
     /// </summary>
-    public interface IApiGetMessageListResponse
+    public interface IApiAuthenticateRefreshRequest
     {
 
         /// <summary>
-        /// Cacheable cursor to list newer messages. Durable and designed to be stored, unlike next/prev cursors.
-        /// </summary>
-        string CacheableCursor { get; }
-
-        /// <summary>
-        /// The list of messages.
-        /// </summary>
-        IEnumerable<IApiMessage> Messages { get; }
-
-        /// <summary>
-        /// The cursor to send when retrieving the next page, if any.
+        /// Refresh token.
         /// </summary>
-        string NextCursor { get; }
+        string RefreshToken { get; }
 
         /// <summary>
-        /// The cursor to send when retrieving the previous page, if any.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string PrevCursor { get; }
+        IApiAuthenticateRefreshRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiGetMessageListResponse : IApiGetMessageListResponse
+    internal class ApiAuthenticateRefreshRequest : IApiAuthenticateRefreshRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="cacheable_cursor"), Preserve]
-        public string CacheableCursor { get; set; }
+        [DataMember(Name="refresh_token"), Preserve]
+        public string RefreshToken { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiMessage> Messages => _messages ?? new List<ApiMessage>(0);
-        [DataMember(Name="messages"), Preserve]
-        public List<ApiMessage> _messages { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "refresh_token":
+                    RefreshToken = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="next_cursor"), Preserve]
-        public string NextCursor { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (RefreshToken != null)
+            {
+                writer.WriteName("refresh_token");
+                writer.WriteString(RefreshToken);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="prev_cursor"), Preserve]
-        public string PrevCursor { get; set; }
+        public IApiAuthenticateRefreshRequest Clone() => (ApiAuthenticateRefreshRequest) CloneModel();
 
-        public override string ToString()
+        internal virtual object CloneModel()
         {
-            var output = "";
-            output = string.Concat(output, "CacheableCursor: ", CacheableCursor, ", ");
-            output = string.Concat(output, "Messages: [", string.Join(", ", Messages), "], ");
-            output = string.Concat(output, "NextCursor: ", NextCursor, ", ");
-            output = string.Concat(output, "PrevCursor: ", PrevCursor, ", ");
-            return output;
+            var clone = (ApiAuthenticateRefreshRequest) MemberwiseClone();
+            return clone;
         }
-    }
 
-    /// <summary>
-    /// Enrich/replace the current session with a new ID.
-    /// </summary>
-    public interface IApiIdentifyRequest
-    {
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <summary>
-        /// Optional custom properties to update with this call. If not set, properties are left as they are on the server.
+            var other = (ApiAuthenticateRefreshRequest) obj;
+            return RefreshToken == other.RefreshToken;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (RefreshToken?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("RefreshToken: " + ApiClient.FormatValue(RefreshToken));
+        }
+    }
+
+    /// <summary>
+    /// Authentication request
+    /// </summary>
+    public interface IApiAuthenticateRequest
+    {
+
+        /// <summary>
+        /// Optional custom properties to update with this call. If not set, properties are left as they are on the server.
         /// </summary>
         IDictionary<string, string> Custom { get; }
 
@@ -889,13 +1365,23 @@ namespace Satori
         IDictionary<string, string> Default { get; }
 
         /// <summary>
-        /// Identity ID to enrich the current session and return a new session. Old session will no longer be usable.
+        /// Identity ID. Must be between eight and 128 characters (inclusive). Must be an alphanumeric string with only underscores and hyphens allowed.
         /// </summary>
         string Id { get; }
+
+        /// <summary>
+        /// Optional no_session modifies the request to only create/update an identity without creating a new session. If set to 'true' the response won't include a token and a refresh token.
+        /// </summary>
+        bool NoSession { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiAuthenticateRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiIdentifyRequest : IApiIdentifyRequest
+    internal class ApiAuthenticateRequest : IApiAuthenticateRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
@@ -914,648 +1400,3618 @@ namespace Satori
         [DataMember(Name="id"), Preserve]
         public string Id { get; set; }
 
-        public override string ToString()
+        /// <inheritdoc />
+        [DataMember(Name="no_session"), Preserve]
+        public bool NoSession { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "custom":
+                    _custom = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "default":
+                    _default = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "no_session":
+                    NoSession = reader.ReadBoolean();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_custom != null)
+            {
+                writer.WriteName("custom");
+                writer.WriteMap(_custom, writer.WriteString);
+            }
+            if (_default != null)
+            {
+                writer.WriteName("default");
+                writer.WriteMap(_default, writer.WriteString);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            writer.WriteName("no_session");
+            writer.WriteBoolean(NoSession);
+        }
+
+        /// <inheritdoc />
+        public IApiAuthenticateRequest Clone() => (ApiAuthenticateRequest) CloneModel();
+
+        internal virtual object CloneModel()
         {
-            var output = "";
+            var clone = (ApiAuthenticateRequest) MemberwiseClone();
+            clone._custom = ApiClient.ConvertMap<string, string>(_custom, item => item);
+            clone._default = ApiClient.ConvertMap<string, string>(_default, item => item);
+            return clone;
+        }
 
-            var customString = "";
-            foreach (var kvp in Custom)
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
             {
-                customString = string.Concat(customString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                return false;
             }
-            output = string.Concat(output, "Custom: [" + customString + "]");
 
-            var defaultString = "";
-            foreach (var kvp in Default)
+            var other = (ApiAuthenticateRequest) obj;
+            return ApiClient.MapEquals(_custom, other._custom) &&
+                ApiClient.MapEquals(_default, other._default) &&
+                Id == other.Id &&
+                NoSession == other.NoSession;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
             {
-                defaultString = string.Concat(defaultString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                var hash = 17;
+                hash = hash * 31 + ApiClient.MapHashCode(_custom);
+                hash = hash * 31 + ApiClient.MapHashCode(_default);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + NoSession.GetHashCode();
+                return hash;
             }
-            output = string.Concat(output, "Default: [" + defaultString + "]");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            return output;
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Custom: " + ApiClient.FormatMap(_custom));
+            members.Add("Default: " + ApiClient.FormatMap(_default));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("NoSession: " + ApiClient.FormatValue(NoSession));
         }
     }
 
     /// <summary>
-    /// A single live event.
+    /// A single event. Usually, but not necessarily, part of a batch.
     /// </summary>
-    public interface IApiLiveEvent
+    public interface IApiEvent
     {
 
         /// <summary>
-        /// End time of current event run.
-        /// </summary>
-        string ActiveEndTimeSec { get; }
-
-        /// <summary>
-        /// Start time of current event run.
-        /// </summary>
-        string ActiveStartTimeSec { get; }
-
-        /// <summary>
-        /// Description.
+        /// Optional event ID assigned by the client, used to de-duplicate in retransmission scenarios. If not supplied the server will assign a randomly generated unique event identifier.
         /// </summary>
-        string Description { get; }
+        string Id { get; }
 
         /// <summary>
-        /// Duration in seconds.
+        /// The identity id associated with the event. Ignored if the event is published as part of a session.
         /// </summary>
-        string DurationSec { get; }
+        string IdentityId { get; }
 
         /// <summary>
-        /// End time, 0 if it repeats forever.
+        /// Event metadata, if any.
         /// </summary>
-        string EndTimeSec { get; }
+        IDictionary<string, string> Metadata { get; }
 
         /// <summary>
-        /// The live event identifier.
+        /// Event name.
         /// </summary>
-        string Id { get; }
+        string Name { get; }
 
         /// <summary>
-        /// The labels associated with this live event.
+        /// The session expires at associated with the event. Ignored if the event is published as part of a session.
         /// </summary>
-        List<string> Labels { get; }
+        long SessionExpiresAt { get; }
 
         /// <summary>
-        /// Name.
+        /// The session id associated with the event. Ignored if the event is published as part of a session.
         /// </summary>
-        string Name { get; }
+        string SessionId { get; }
 
         /// <summary>
-        /// Reset CRON schedule, if configured.
+        /// The session issued at associated with the event. Ignored if the event is published as part of a session.
         /// </summary>
-        string ResetCron { get; }
+        long SessionIssuedAt { get; }
 
         /// <summary>
-        /// Start time.
+        /// The time when the event was triggered on the producer side.
         /// </summary>
-        string StartTimeSec { get; }
+        DateTime Timestamp { get; }
 
         /// <summary>
-        /// The status of this live event run.
+        /// Optional value.
         /// </summary>
-        ApiLiveEventStatus Status { get; }
+        string Value { get; }
 
         /// <summary>
-        /// Event value.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string Value { get; }
+        IApiEvent Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiLiveEvent : IApiLiveEvent
+    internal class ApiEvent : IApiEvent, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="active_end_time_sec"), Preserve]
-        public string ActiveEndTimeSec { get; set; }
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="active_start_time_sec"), Preserve]
-        public string ActiveStartTimeSec { get; set; }
+        [DataMember(Name="identity_id"), Preserve]
+        public string IdentityId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="description"), Preserve]
-        public string Description { get; set; }
+        [IgnoreDataMember]
+        public IDictionary<string, string> Metadata => _metadata ?? new Dictionary<string, string>();
+        [DataMember(Name="metadata"), Preserve]
+        public Dictionary<string, string> _metadata { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="duration_sec"), Preserve]
-        public string DurationSec { get; set; }
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="end_time_sec"), Preserve]
-        public string EndTimeSec { get; set; }
+        [IgnoreDataMember]
+        public long SessionExpiresAt
+        {
+            get => ApiClient.ParseInt64(_sessionExpiresAt);
+            set => _sessionExpiresAt = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="session_expires_at"), Preserve]
+        public string _sessionExpiresAt { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
+        [DataMember(Name="session_id"), Preserve]
+        public string SessionId { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="labels"), Preserve]
-        public List<string> Labels { get; set; }
+        [IgnoreDataMember]
+        public long SessionIssuedAt
+        {
+            get => ApiClient.ParseInt64(_sessionIssuedAt);
+            set => _sessionIssuedAt = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="session_issued_at"), Preserve]
+        public string _sessionIssuedAt { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="name"), Preserve]
-        public string Name { get; set; }
+        [IgnoreDataMember]
+        public DateTime Timestamp
+        {
+            get => ApiClient.ParseDateTime(_timestamp);
+            set => _timestamp = ApiClient.FormatDateTime(value);
+        }
+        [DataMember(Name="timestamp"), Preserve]
+        public string _timestamp { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="reset_cron"), Preserve]
-        public string ResetCron { get; set; }
+        [DataMember(Name="value"), Preserve]
+        public string Value { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="start_time_sec"), Preserve]
-        public string StartTimeSec { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "identity_id":
+                    IdentityId = reader.ReadString();
+                    return true;
+                case "metadata":
+                    _metadata = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "session_expires_at":
+                    _sessionExpiresAt = reader.ReadString();
+                    return true;
+                case "session_id":
+                    SessionId = reader.ReadString();
+                    return true;
+                case "session_issued_at":
+                    _sessionIssuedAt = reader.ReadString();
+                    return true;
+                case "timestamp":
+                    _timestamp = reader.ReadString();
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public ApiLiveEventStatus Status => _status;
-        [DataMember(Name="status"), Preserve]
-        public ApiLiveEventStatus _status { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (IdentityId != null)
+            {
+                writer.WriteName("identity_id");
+                writer.WriteString(IdentityId);
+            }
+            if (_metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteMap(_metadata, writer.WriteString);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (_sessionExpiresAt != null)
+            {
+                writer.WriteName("session_expires_at");
+                writer.WriteString(_sessionExpiresAt);
+            }
+            if (SessionId != null)
+            {
+                writer.WriteName("session_id");
+                writer.WriteString(SessionId);
+            }
+            if (_sessionIssuedAt != null)
+            {
+                writer.WriteName("session_issued_at");
+                writer.WriteString(_sessionIssuedAt);
+            }
+            if (_timestamp != null)
+            {
+                writer.WriteName("timestamp");
+                writer.WriteString(_timestamp);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="value"), Preserve]
-        public string Value { get; set; }
+        public IApiEvent Clone() => (ApiEvent) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiEvent) MemberwiseClone();
+            clone._metadata = ApiClient.ConvertMap<string, string>(_metadata, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiEvent) obj;
+            return Id == other.Id &&
+                IdentityId == other.IdentityId &&
+                ApiClient.MapEquals(_metadata, other._metadata) &&
+                Name == other.Name &&
+                _sessionExpiresAt == other._sessionExpiresAt &&
+                SessionId == other.SessionId &&
+                _sessionIssuedAt == other._sessionIssuedAt &&
+                _timestamp == other._timestamp &&
+                Value == other.Value;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + (IdentityId?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_metadata);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_sessionExpiresAt?.GetHashCode() ?? 0);
+                hash = hash * 31 + (SessionId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_sessionIssuedAt?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_timestamp?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ActiveEndTimeSec: ", ActiveEndTimeSec, ", ");
-            output = string.Concat(output, "ActiveStartTimeSec: ", ActiveStartTimeSec, ", ");
-            output = string.Concat(output, "Description: ", Description, ", ");
-            output = string.Concat(output, "DurationSec: ", DurationSec, ", ");
-            output = string.Concat(output, "EndTimeSec: ", EndTimeSec, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "Labels: [", string.Join(", ", Labels), "], ");
-            output = string.Concat(output, "Name: ", Name, ", ");
-            output = string.Concat(output, "ResetCron: ", ResetCron, ", ");
-            output = string.Concat(output, "StartTimeSec: ", StartTimeSec, ", ");
-            output = string.Concat(output, "Status: ", Status, ", ");
-            output = string.Concat(output, "Value: ", Value, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("IdentityId: " + ApiClient.FormatValue(IdentityId));
+            members.Add("Metadata: " + ApiClient.FormatMap(_metadata));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("SessionExpiresAt: " + ApiClient.FormatValue(SessionExpiresAt));
+            members.Add("SessionId: " + ApiClient.FormatValue(SessionId));
+            members.Add("SessionIssuedAt: " + ApiClient.FormatValue(SessionIssuedAt));
+            members.Add("Timestamp: " + ApiClient.FormatValue(Timestamp));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
         }
     }
 
     /// <summary>
-    /// List of Live events.
+    /// Publish an event to the server
     /// </summary>
-    public interface IApiLiveEventList
+    public interface IApiEventRequest
     {
 
         /// <summary>
-        /// Live events that require explicit join.
+        /// Some number of events produced by a client.
         /// </summary>
-        IEnumerable<IApiLiveEvent> ExplicitJoinLiveEvents { get; }
+        IEnumerable<IApiEvent> Events { get; }
 
         /// <summary>
-        /// Live events.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IEnumerable<IApiLiveEvent> LiveEvents { get; }
+        IApiEventRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiLiveEventList : IApiLiveEventList
+    internal class ApiEventRequest : IApiEventRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IEnumerable<IApiLiveEvent> ExplicitJoinLiveEvents => _explicitJoinLiveEvents ?? new List<ApiLiveEvent>(0);
-        [DataMember(Name="explicit_join_live_events"), Preserve]
-        public List<ApiLiveEvent> _explicitJoinLiveEvents { get; set; }
+        public IEnumerable<IApiEvent> Events => _events ?? new List<ApiEvent>(0);
+        [DataMember(Name="events"), Preserve]
+        public List<ApiEvent> _events { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IApiLiveEvent> LiveEvents => _liveEvents ?? new List<ApiLiveEvent>(0);
-        [DataMember(Name="live_events"), Preserve]
-        public List<ApiLiveEvent> _liveEvents { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "events":
+                    _events = reader.ReadList(reader.ReadObject<ApiEvent>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_events != null)
+            {
+                writer.WriteName("events");
+                writer.WriteList(_events, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiEventRequest Clone() => (ApiEventRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiEventRequest) MemberwiseClone();
+            clone._events = _events?.ConvertAll(item => (ApiEvent) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiEventRequest) obj;
+            return ApiClient.ListEquals(_events, other._events);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_events);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ExplicitJoinLiveEvents: [", string.Join(", ", ExplicitJoinLiveEvents), "], ");
-            output = string.Concat(output, "LiveEvents: [", string.Join(", ", LiveEvents), "], ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Events: " + ApiClient.FormatList(_events));
         }
     }
 
     /// <summary>
-    /// 
+    /// An experiment that this user is partaking.
     /// </summary>
-    public enum ApiLiveEventStatus
+    public interface IApiExperiment
     {
+
         /// <summary>
-        /// The status variants of a live event.
+        /// The labels associated with this experiment.
         /// </summary>
-        UNKNOWN = 0,
+        List<string> Labels { get; }
+
         /// <summary>
-        /// 
+        /// Experiment name
         /// </summary>
-        ACTIVE = 1,
+        string Name { get; }
+
         /// <summary>
-        /// 
+        /// Experiment Phase name
         /// </summary>
-        UPCOMING = 2,
+        string PhaseName { get; }
+
         /// <summary>
-        /// 
+        /// Experiment Phase Variant name
         /// </summary>
-        TERMINATED = 3,
+        string PhaseVariantName { get; }
+
+        /// <summary>
+        /// Value associated with this Experiment.
+        /// </summary>
+        string Value { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiExperiment Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiExperiment : IApiExperiment, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="labels"), Preserve]
+        public List<string> Labels { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="phase_name"), Preserve]
+        public string PhaseName { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="phase_variant_name"), Preserve]
+        public string PhaseVariantName { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="value"), Preserve]
+        public string Value { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "labels":
+                    Labels = reader.ReadList(reader.ReadString);
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "phase_name":
+                    PhaseName = reader.ReadString();
+                    return true;
+                case "phase_variant_name":
+                    PhaseVariantName = reader.ReadString();
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (Labels != null)
+            {
+                writer.WriteName("labels");
+                writer.WriteList(Labels, writer.WriteString);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (PhaseName != null)
+            {
+                writer.WriteName("phase_name");
+                writer.WriteString(PhaseName);
+            }
+            if (PhaseVariantName != null)
+            {
+                writer.WriteName("phase_variant_name");
+                writer.WriteString(PhaseVariantName);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiExperiment Clone() => (ApiExperiment) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiExperiment) MemberwiseClone();
+            clone.Labels = Labels?.ConvertAll(item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiExperiment) obj;
+            return ApiClient.ListEquals(Labels, other.Labels) &&
+                Name == other.Name &&
+                PhaseName == other.PhaseName &&
+                PhaseVariantName == other.PhaseVariantName &&
+                Value == other.Value;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(Labels);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PhaseName?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PhaseVariantName?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Labels: " + ApiClient.FormatList(Labels));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("PhaseName: " + ApiClient.FormatValue(PhaseName));
+            members.Add("PhaseVariantName: " + ApiClient.FormatValue(PhaseVariantName));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
+        }
     }
 
     /// <summary>
-    /// A scheduled message.
+    /// All experiments that this identity is involved with.
     /// </summary>
-    public interface IApiMessage
+    public interface IApiExperimentList
     {
 
         /// <summary>
-        /// The time the message was consumed by the identity.
+        /// All experiments for this identity.
         /// </summary>
-        string ConsumeTime { get; }
+        IEnumerable<IApiExperiment> Experiments { get; }
 
         /// <summary>
-        /// The time the message was created.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiExperimentList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiExperimentList : IApiExperimentList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiExperiment> Experiments => _experiments ?? new List<ApiExperiment>(0);
+        [DataMember(Name="experiments"), Preserve]
+        public List<ApiExperiment> _experiments { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "experiments":
+                    _experiments = reader.ReadList(reader.ReadObject<ApiExperiment>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_experiments != null)
+            {
+                writer.WriteName("experiments");
+                writer.WriteList(_experiments, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiExperimentList Clone() => (ApiExperimentList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiExperimentList) MemberwiseClone();
+            clone._experiments = _experiments?.ConvertAll(item => (ApiExperiment) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiExperimentList) obj;
+            return ApiClient.ListEquals(_experiments, other._experiments);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_experiments);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Experiments: " + ApiClient.FormatList(_experiments));
+        }
+    }
+
+    /// <summary>
+    /// Feature flag available to the identity.
+    /// </summary>
+    public interface IApiFlag
+    {
+
+        /// <summary>
+        /// The origin of change on the flag value returned.
         /// </summary>
-        string CreateTime { get; }
+        IFlagValueChangeReason ChangeReason { get; }
 
         /// <summary>
-        /// The message's unique identifier.
+        /// Whether the value for this flag has conditionally changed from the default state.
         /// </summary>
-        string Id { get; }
+        bool ConditionChanged { get; }
 
         /// <summary>
-        /// The message's image url.
+        /// The labels associated with this flag.
         /// </summary>
-        string ImageUrl { get; }
+        List<string> Labels { get; }
 
         /// <summary>
-        /// A key-value pairs of metadata.
+        /// Flag name
         /// </summary>
-        IDictionary<string, string> Metadata { get; }
+        string Name { get; }
 
         /// <summary>
-        /// The time the message was read by the client.
+        /// Value associated with this flag.
         /// </summary>
-        string ReadTime { get; }
+        string Value { get; }
 
         /// <summary>
-        /// The identifier of the schedule.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string ScheduleId { get; }
+        IApiFlag Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiFlag : IApiFlag, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IFlagValueChangeReason ChangeReason => _changeReason;
+        [DataMember(Name="change_reason"), Preserve]
+        public FlagValueChangeReason _changeReason { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="condition_changed"), Preserve]
+        public bool ConditionChanged { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="labels"), Preserve]
+        public List<string> Labels { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="value"), Preserve]
+        public string Value { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "change_reason":
+                    _changeReason = reader.ReadObject<FlagValueChangeReason>();
+                    return true;
+                case "condition_changed":
+                    ConditionChanged = reader.ReadBoolean();
+                    return true;
+                case "labels":
+                    Labels = reader.ReadList(reader.ReadString);
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_changeReason != null)
+            {
+                writer.WriteName("change_reason");
+                writer.WriteObject(_changeReason);
+            }
+            writer.WriteName("condition_changed");
+            writer.WriteBoolean(ConditionChanged);
+            if (Labels != null)
+            {
+                writer.WriteName("labels");
+                writer.WriteList(Labels, writer.WriteString);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFlag Clone() => (ApiFlag) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFlag) MemberwiseClone();
+            clone._changeReason = (FlagValueChangeReason) _changeReason?.CloneModel();
+            clone.Labels = Labels?.ConvertAll(item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFlag) obj;
+            return Equals(_changeReason, other._changeReason) &&
+                ConditionChanged == other.ConditionChanged &&
+                ApiClient.ListEquals(Labels, other.Labels) &&
+                Name == other.Name &&
+                Value == other.Value;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_changeReason?.GetHashCode() ?? 0);
+                hash = hash * 31 + ConditionChanged.GetHashCode();
+                hash = hash * 31 + ApiClient.ListHashCode(Labels);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ChangeReason: " + ApiClient.FormatValue(_changeReason));
+            members.Add("ConditionChanged: " + ApiClient.FormatValue(ConditionChanged));
+            members.Add("Labels: " + ApiClient.FormatList(Labels));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
+        }
+    }
+
+    /// <summary>
+    /// All flags available to the identity
+    /// </summary>
+    public interface IApiFlagList
+    {
+
+        /// <summary>
+        /// All flags
+        /// </summary>
+        IEnumerable<IApiFlag> Flags { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFlagList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiFlagList : IApiFlagList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiFlag> Flags => _flags ?? new List<ApiFlag>(0);
+        [DataMember(Name="flags"), Preserve]
+        public List<ApiFlag> _flags { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "flags":
+                    _flags = reader.ReadList(reader.ReadObject<ApiFlag>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_flags != null)
+            {
+                writer.WriteName("flags");
+                writer.WriteList(_flags, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFlagList Clone() => (ApiFlagList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFlagList) MemberwiseClone();
+            clone._flags = _flags?.ConvertAll(item => (ApiFlag) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFlagList) obj;
+            return ApiClient.ListEquals(_flags, other._flags);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_flags);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Flags: " + ApiClient.FormatList(_flags));
+        }
+    }
+
+    /// <summary>
+    /// Feature flag available to the identity.
+    /// </summary>
+    public interface IApiFlagOverride
+    {
+
+        /// <summary>
+        /// Flag name
+        /// </summary>
+        string FlagName { get; }
+
+        /// <summary>
+        /// The labels associated with this flag.
+        /// </summary>
+        List<string> Labels { get; }
+
+        /// <summary>
+        /// The list of configuration that affect the value of the flag.
+        /// </summary>
+        IEnumerable<IApiFlagOverrideValue> Overrides { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFlagOverride Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiFlagOverride : IApiFlagOverride, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="flag_name"), Preserve]
+        public string FlagName { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="labels"), Preserve]
+        public List<string> Labels { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiFlagOverrideValue> Overrides => _overrides ?? new List<ApiFlagOverrideValue>(0);
+        [DataMember(Name="overrides"), Preserve]
+        public List<ApiFlagOverrideValue> _overrides { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "flag_name":
+                    FlagName = reader.ReadString();
+                    return true;
+                case "labels":
+                    Labels = reader.ReadList(reader.ReadString);
+                    return true;
+                case "overrides":
+                    _overrides = reader.ReadList(reader.ReadObject<ApiFlagOverrideValue>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (FlagName != null)
+            {
+                writer.WriteName("flag_name");
+                writer.WriteString(FlagName);
+            }
+            if (Labels != null)
+            {
+                writer.WriteName("labels");
+                writer.WriteList(Labels, writer.WriteString);
+            }
+            if (_overrides != null)
+            {
+                writer.WriteName("overrides");
+                writer.WriteList(_overrides, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFlagOverride Clone() => (ApiFlagOverride) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFlagOverride) MemberwiseClone();
+            clone.Labels = Labels?.ConvertAll(item => item);
+            clone._overrides = _overrides?.ConvertAll(item => (ApiFlagOverrideValue) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFlagOverride) obj;
+            return FlagName == other.FlagName &&
+                ApiClient.ListEquals(Labels, other.Labels) &&
+                ApiClient.ListEquals(_overrides, other._overrides);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (FlagName?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(Labels);
+                hash = hash * 31 + ApiClient.ListHashCode(_overrides);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("FlagName: " + ApiClient.FormatValue(FlagName));
+            members.Add("Labels: " + ApiClient.FormatList(Labels));
+            members.Add("Overrides: " + ApiClient.FormatList(_overrides));
+        }
+    }
+
+    /// <summary>
+    /// All flags available to the identity and their value overrides
+    /// </summary>
+    public interface IApiFlagOverrideList
+    {
+
+        /// <summary>
+        /// All flags
+        /// </summary>
+        IEnumerable<IApiFlagOverride> Flags { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFlagOverrideList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiFlagOverrideList : IApiFlagOverrideList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiFlagOverride> Flags => _flags ?? new List<ApiFlagOverride>(0);
+        [DataMember(Name="flags"), Preserve]
+        public List<ApiFlagOverride> _flags { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "flags":
+                    _flags = reader.ReadList(reader.ReadObject<ApiFlagOverride>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_flags != null)
+            {
+                writer.WriteName("flags");
+                writer.WriteList(_flags, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFlagOverrideList Clone() => (ApiFlagOverrideList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFlagOverrideList) MemberwiseClone();
+            clone._flags = _flags?.ConvertAll(item => (ApiFlagOverride) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFlagOverrideList) obj;
+            return ApiClient.ListEquals(_flags, other._flags);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_flags);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Flags: " + ApiClient.FormatList(_flags));
+        }
+    }
+
+    /// <summary>
+    /// 
+    /// </summary>
+    public enum ApiFlagOverrideType
+    {
+        /// <summary>
+        /// 
+        /// </summary>
+        FLAG = 0,
+        /// <summary>
+        /// 
+        /// </summary>
+        FLAG_VARIANT = 1,
+        /// <summary>
+        /// 
+        /// </summary>
+        LIVE_EVENT_FLAG = 2,
+        /// <summary>
+        /// 
+        /// </summary>
+        LIVE_EVENT_FLAG_VARIANT = 3,
+        /// <summary>
+        /// 
+        /// </summary>
+        EXPERIMENT_PHASE_VARIANT_FLAG = 4,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
+        /// </summary>
+        Unknown = -1,
+    }
+
+    /// <summary>
+    /// Converts <see cref="ApiFlagOverrideType"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ApiFlagOverrideTypeConverter
+    {
+        public static ApiFlagOverrideType Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ApiFlagOverrideType);
+                case "FLAG":
+                case "0":
+                    return ApiFlagOverrideType.FLAG;
+                case "FLAG_VARIANT":
+                case "1":
+                    return ApiFlagOverrideType.FLAG_VARIANT;
+                case "LIVE_EVENT_FLAG":
+                case "2":
+                    return ApiFlagOverrideType.LIVE_EVENT_FLAG;
+                case "LIVE_EVENT_FLAG_VARIANT":
+                case "3":
+                    return ApiFlagOverrideType.LIVE_EVENT_FLAG_VARIANT;
+                case "EXPERIMENT_PHASE_VARIANT_FLAG":
+                case "4":
+                    return ApiFlagOverrideType.EXPERIMENT_PHASE_VARIANT_FLAG;
+                default:
+                    return ApiFlagOverrideType.Unknown;
+            }
+        }
+
+        public static string Format(ApiFlagOverrideType value)
+        {
+            switch (value)
+            {
+                case ApiFlagOverrideType.FLAG:
+                    return "FLAG";
+                case ApiFlagOverrideType.FLAG_VARIANT:
+                    return "FLAG_VARIANT";
+                case ApiFlagOverrideType.LIVE_EVENT_FLAG:
+                    return "LIVE_EVENT_FLAG";
+                case ApiFlagOverrideType.LIVE_EVENT_FLAG_VARIANT:
+                    return "LIVE_EVENT_FLAG_VARIANT";
+                case ApiFlagOverrideType.EXPERIMENT_PHASE_VARIANT_FLAG:
+                    return "EXPERIMENT_PHASE_VARIANT_FLAG";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
+        }
+    }
+
+    /// <summary>
+    /// The details of a flag value override.
+    /// </summary>
+    public interface IApiFlagOverrideValue
+    {
+
+        /// <summary>
+        /// The create time of the configuration that overrides the flag.
+        /// </summary>
+        long CreateTimeSec { get; }
+
+        /// <summary>
+        /// The name of the configuration that overrides the flag value.
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// The type of the configuration that declared the override.
+        /// </summary>
+        ApiFlagOverrideType Type { get; }
+
+        /// <summary>
+        /// The value of the configuration that overrides the flag.
+        /// </summary>
+        string Value { get; }
+
+        /// <summary>
+        /// The variant name of the configuration that overrides the flag value.
+        /// </summary>
+        string VariantName { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiFlagOverrideValue Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiFlagOverrideValue : IApiFlagOverrideValue, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long CreateTimeSec
+        {
+            get => ApiClient.ParseInt64(_createTimeSec);
+            set => _createTimeSec = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="create_time_sec"), Preserve]
+        public string _createTimeSec { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public ApiFlagOverrideType Type
+        {
+            get => ApiFlagOverrideTypeConverter.Parse(_type);
+            set => _type = ApiFlagOverrideTypeConverter.Format(value);
+        }
+        [DataMember(Name="type"), Preserve]
+        public string _type { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="value"), Preserve]
+        public string Value { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="variant_name"), Preserve]
+        public string VariantName { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "create_time_sec":
+                    _createTimeSec = reader.ReadString();
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "type":
+                    _type = reader.ReadString();
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                case "variant_name":
+                    VariantName = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_createTimeSec != null)
+            {
+                writer.WriteName("create_time_sec");
+                writer.WriteString(_createTimeSec);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (_type != null)
+            {
+                writer.WriteName("type");
+                writer.WriteString(_type);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+            if (VariantName != null)
+            {
+                writer.WriteName("variant_name");
+                writer.WriteString(VariantName);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiFlagOverrideValue Clone() => (ApiFlagOverrideValue) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiFlagOverrideValue) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiFlagOverrideValue) obj;
+            return _createTimeSec == other._createTimeSec &&
+                Name == other.Name &&
+                _type == other._type &&
+                Value == other.Value &&
+                VariantName == other.VariantName;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_createTimeSec?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_type?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                hash = hash * 31 + (VariantName?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CreateTimeSec: " + ApiClient.FormatValue(CreateTimeSec));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("Type: " + ApiClient.FormatEncoded(_type));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
+            members.Add("VariantName: " + ApiClient.FormatValue(VariantName));
+        }
+    }
+
+    /// <summary>
+    /// A response containing all the messages for an identity.
+    /// </summary>
+    public interface IApiGetMessageListResponse
+    {
+
+        /// <summary>
+        /// Cacheable cursor to list newer messages. Durable and designed to be stored, unlike next/prev cursors.
+        /// </summary>
+        string CacheableCursor { get; }
+
+        /// <summary>
+        /// The list of messages.
+        /// </summary>
+        IEnumerable<IApiMessage> Messages { get; }
+
+        /// <summary>
+        /// The cursor to send when retrieving the next page, if any.
+        /// </summary>
+        string NextCursor { get; }
+
+        /// <summary>
+        /// The cursor to send when retrieving the previous page, if any.
+        /// </summary>
+        string PrevCursor { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiGetMessageListResponse Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiGetMessageListResponse : IApiGetMessageListResponse, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="cacheable_cursor"), Preserve]
+        public string CacheableCursor { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiMessage> Messages => _messages ?? new List<ApiMessage>(0);
+        [DataMember(Name="messages"), Preserve]
+        public List<ApiMessage> _messages { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="next_cursor"), Preserve]
+        public string NextCursor { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="prev_cursor"), Preserve]
+        public string PrevCursor { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "cacheable_cursor":
+                    CacheableCursor = reader.ReadString();
+                    return true;
+                case "messages":
+                    _messages = reader.ReadList(reader.ReadObject<ApiMessage>);
+                    return true;
+                case "next_cursor":
+                    NextCursor = reader.ReadString();
+                    return true;
+                case "prev_cursor":
+                    PrevCursor = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (CacheableCursor != null)
+            {
+                writer.WriteName("cacheable_cursor");
+                writer.WriteString(CacheableCursor);
+            }
+            if (_messages != null)
+            {
+                writer.WriteName("messages");
+                writer.WriteList(_messages, writer.WriteObject);
+            }
+            if (NextCursor != null)
+            {
+                writer.WriteName("next_cursor");
+                writer.WriteString(NextCursor);
+            }
+            if (PrevCursor != null)
+            {
+                writer.WriteName("prev_cursor");
+                writer.WriteString(PrevCursor);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiGetMessageListResponse Clone() => (ApiGetMessageListResponse) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiGetMessageListResponse) MemberwiseClone();
+            clone._messages = _messages?.ConvertAll(item => (ApiMessage) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiGetMessageListResponse) obj;
+            return CacheableCursor == other.CacheableCursor &&
+                ApiClient.ListEquals(_messages, other._messages) &&
+                NextCursor == other.NextCursor &&
+                PrevCursor == other.PrevCursor;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (CacheableCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_messages);
+                hash = hash * 31 + (NextCursor?.GetHashCode() ?? 0);
+                hash = hash * 31 + (PrevCursor?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("CacheableCursor: " + ApiClient.FormatValue(CacheableCursor));
+            members.Add("Messages: " + ApiClient.FormatList(_messages));
+            members.Add("NextCursor: " + ApiClient.FormatValue(NextCursor));
+            members.Add("PrevCursor: " + ApiClient.FormatValue(PrevCursor));
+        }
+    }
+
+    /// <summary>
+    /// Enrich/replace the current session with a new ID.
+    /// </summary>
+    public interface IApiIdentifyRequest
+    {
+
+        /// <summary>
+        /// Optional custom properties to update with this call. If not set, properties are left as they are on the server.
+        /// </summary>
+        IDictionary<string, string> Custom { get; }
+
+        /// <summary>
+        /// Optional default properties to update with this call. If not set, properties are left as they are on the server.
+        /// </summary>
+        IDictionary<string, string> Default { get; }
+
+        /// <summary>
+        /// Identity ID to enrich the current session and return a new session. Old session will no longer be usable.
+        /// </summary>
+        string Id { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiIdentifyRequest Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiIdentifyRequest : IApiIdentifyRequest, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Custom => _custom ?? new Dictionary<string, string>();
+        [DataMember(Name="custom"), Preserve]
+        public Dictionary<string, string> _custom { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Default => _default ?? new Dictionary<string, string>();
+        [DataMember(Name="default"), Preserve]
+        public Dictionary<string, string> _default { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "custom":
+                    _custom = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "default":
+                    _default = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_custom != null)
+            {
+                writer.WriteName("custom");
+                writer.WriteMap(_custom, writer.WriteString);
+            }
+            if (_default != null)
+            {
+                writer.WriteName("default");
+                writer.WriteMap(_default, writer.WriteString);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiIdentifyRequest Clone() => (ApiIdentifyRequest) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiIdentifyRequest) MemberwiseClone();
+            clone._custom = ApiClient.ConvertMap<string, string>(_custom, item => item);
+            clone._default = ApiClient.ConvertMap<string, string>(_default, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiIdentifyRequest) obj;
+            return ApiClient.MapEquals(_custom, other._custom) &&
+                ApiClient.MapEquals(_default, other._default) &&
+                Id == other.Id;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.MapHashCode(_custom);
+                hash = hash * 31 + ApiClient.MapHashCode(_default);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Custom: " + ApiClient.FormatMap(_custom));
+            members.Add("Default: " + ApiClient.FormatMap(_default));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+        }
+    }
+
+    /// <summary>
+    /// A single live event.
+    /// </summary>
+    public interface IApiLiveEvent
+    {
+
+        /// <summary>
+        /// End time of current event run.
+        /// </summary>
+        long ActiveEndTimeSec { get; }
+
+        /// <summary>
+        /// Start time of current event run.
+        /// </summary>
+        long ActiveStartTimeSec { get; }
+
+        /// <summary>
+        /// Description.
+        /// </summary>
+        string Description { get; }
+
+        /// <summary>
+        /// Duration in seconds.
+        /// </summary>
+        long DurationSec { get; }
+
+        /// <summary>
+        /// End time, 0 if it repeats forever.
+        /// </summary>
+        long EndTimeSec { get; }
+
+        /// <summary>
+        /// The live event identifier.
+        /// </summary>
+        string Id { get; }
+
+        /// <summary>
+        /// The labels associated with this live event.
+        /// </summary>
+        List<string> Labels { get; }
+
+        /// <summary>
+        /// Name.
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// Reset CRON schedule, if configured.
+        /// </summary>
+        string ResetCron { get; }
+
+        /// <summary>
+        /// Start time.
+        /// </summary>
+        long StartTimeSec { get; }
+
+        /// <summary>
+        /// The status of this live event run.
+        /// </summary>
+        ApiLiveEventStatus Status { get; }
+
+        /// <summary>
+        /// Event value.
+        /// </summary>
+        string Value { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiLiveEvent Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiLiveEvent : IApiLiveEvent, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long ActiveEndTimeSec
+        {
+            get => ApiClient.ParseInt64(_activeEndTimeSec);
+            set => _activeEndTimeSec = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="active_end_time_sec"), Preserve]
+        public string _activeEndTimeSec { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long ActiveStartTimeSec
+        {
+            get => ApiClient.ParseInt64(_activeStartTimeSec);
+            set => _activeStartTimeSec = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="active_start_time_sec"), Preserve]
+        public string _activeStartTimeSec { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="description"), Preserve]
+        public string Description { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long DurationSec
+        {
+            get => ApiClient.ParseInt64(_durationSec);
+            set => _durationSec = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="duration_sec"), Preserve]
+        public string _durationSec { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long EndTimeSec
+        {
+            get => ApiClient.ParseInt64(_endTimeSec);
+            set => _endTimeSec = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="end_time_sec"), Preserve]
+        public string _endTimeSec { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="labels"), Preserve]
+        public List<string> Labels { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="name"), Preserve]
+        public string Name { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="reset_cron"), Preserve]
+        public string ResetCron { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long StartTimeSec
+        {
+            get => ApiClient.ParseInt64(_startTimeSec);
+            set => _startTimeSec = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="start_time_sec"), Preserve]
+        public string _startTimeSec { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public ApiLiveEventStatus Status
+        {
+            get => ApiLiveEventStatusConverter.Parse(_status);
+            set => _status = ApiLiveEventStatusConverter.Format(value);
+        }
+        [DataMember(Name="status"), Preserve]
+        public string _status { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="value"), Preserve]
+        public string Value { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "active_end_time_sec":
+                    _activeEndTimeSec = reader.ReadString();
+                    return true;
+                case "active_start_time_sec":
+                    _activeStartTimeSec = reader.ReadString();
+                    return true;
+                case "description":
+                    Description = reader.ReadString();
+                    return true;
+                case "duration_sec":
+                    _durationSec = reader.ReadString();
+                    return true;
+                case "end_time_sec":
+                    _endTimeSec = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "labels":
+                    Labels = reader.ReadList(reader.ReadString);
+                    return true;
+                case "name":
+                    Name = reader.ReadString();
+                    return true;
+                case "reset_cron":
+                    ResetCron = reader.ReadString();
+                    return true;
+                case "start_time_sec":
+                    _startTimeSec = reader.ReadString();
+                    return true;
+                case "status":
+                    _status = reader.ReadString();
+                    return true;
+                case "value":
+                    Value = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_activeEndTimeSec != null)
+            {
+                writer.WriteName("active_end_time_sec");
+                writer.WriteString(_activeEndTimeSec);
+            }
+            if (_activeStartTimeSec != null)
+            {
+                writer.WriteName("active_start_time_sec");
+                writer.WriteString(_activeStartTimeSec);
+            }
+            if (Description != null)
+            {
+                writer.WriteName("description");
+                writer.WriteString(Description);
+            }
+            if (_durationSec != null)
+            {
+                writer.WriteName("duration_sec");
+                writer.WriteString(_durationSec);
+            }
+            if (_endTimeSec != null)
+            {
+                writer.WriteName("end_time_sec");
+                writer.WriteString(_endTimeSec);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (Labels != null)
+            {
+                writer.WriteName("labels");
+                writer.WriteList(Labels, writer.WriteString);
+            }
+            if (Name != null)
+            {
+                writer.WriteName("name");
+                writer.WriteString(Name);
+            }
+            if (ResetCron != null)
+            {
+                writer.WriteName("reset_cron");
+                writer.WriteString(ResetCron);
+            }
+            if (_startTimeSec != null)
+            {
+                writer.WriteName("start_time_sec");
+                writer.WriteString(_startTimeSec);
+            }
+            if (_status != null)
+            {
+                writer.WriteName("status");
+                writer.WriteString(_status);
+            }
+            if (Value != null)
+            {
+                writer.WriteName("value");
+                writer.WriteString(Value);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiLiveEvent Clone() => (ApiLiveEvent) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiLiveEvent) MemberwiseClone();
+            clone.Labels = Labels?.ConvertAll(item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiLiveEvent) obj;
+            return _activeEndTimeSec == other._activeEndTimeSec &&
+                _activeStartTimeSec == other._activeStartTimeSec &&
+                Description == other.Description &&
+                _durationSec == other._durationSec &&
+                _endTimeSec == other._endTimeSec &&
+                Id == other.Id &&
+                ApiClient.ListEquals(Labels, other.Labels) &&
+                Name == other.Name &&
+                ResetCron == other.ResetCron &&
+                _startTimeSec == other._startTimeSec &&
+                _status == other._status &&
+                Value == other.Value;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_activeEndTimeSec?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_activeStartTimeSec?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Description?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_durationSec?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_endTimeSec?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(Labels);
+                hash = hash * 31 + (Name?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ResetCron?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_startTimeSec?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_status?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Value?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ActiveEndTimeSec: " + ApiClient.FormatValue(ActiveEndTimeSec));
+            members.Add("ActiveStartTimeSec: " + ApiClient.FormatValue(ActiveStartTimeSec));
+            members.Add("Description: " + ApiClient.FormatValue(Description));
+            members.Add("DurationSec: " + ApiClient.FormatValue(DurationSec));
+            members.Add("EndTimeSec: " + ApiClient.FormatValue(EndTimeSec));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("Labels: " + ApiClient.FormatList(Labels));
+            members.Add("Name: " + ApiClient.FormatValue(Name));
+            members.Add("ResetCron: " + ApiClient.FormatValue(ResetCron));
+            members.Add("StartTimeSec: " + ApiClient.FormatValue(StartTimeSec));
+            members.Add("Status: " + ApiClient.FormatEncoded(_status));
+            members.Add("Value: " + ApiClient.FormatValue(Value));
+        }
+    }
+
+    /// <summary>
+    /// List of Live events.
+    /// </summary>
+    public interface IApiLiveEventList
+    {
+
+        /// <summary>
+        /// Live events that require explicit join.
+        /// </summary>
+        IEnumerable<IApiLiveEvent> ExplicitJoinLiveEvents { get; }
+
+        /// <summary>
+        /// Live events.
+        /// </summary>
+        IEnumerable<IApiLiveEvent> LiveEvents { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiLiveEventList Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiLiveEventList : IApiLiveEventList, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiLiveEvent> ExplicitJoinLiveEvents => _explicitJoinLiveEvents ?? new List<ApiLiveEvent>(0);
+        [DataMember(Name="explicit_join_live_events"), Preserve]
+        public List<ApiLiveEvent> _explicitJoinLiveEvents { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IApiLiveEvent> LiveEvents => _liveEvents ?? new List<ApiLiveEvent>(0);
+        [DataMember(Name="live_events"), Preserve]
+        public List<ApiLiveEvent> _liveEvents { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "explicit_join_live_events":
+                    _explicitJoinLiveEvents = reader.ReadList(reader.ReadObject<ApiLiveEvent>);
+                    return true;
+                case "live_events":
+                    _liveEvents = reader.ReadList(reader.ReadObject<ApiLiveEvent>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_explicitJoinLiveEvents != null)
+            {
+                writer.WriteName("explicit_join_live_events");
+                writer.WriteList(_explicitJoinLiveEvents, writer.WriteObject);
+            }
+            if (_liveEvents != null)
+            {
+                writer.WriteName("live_events");
+                writer.WriteList(_liveEvents, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiLiveEventList Clone() => (ApiLiveEventList) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiLiveEventList) MemberwiseClone();
+            clone._explicitJoinLiveEvents = _explicitJoinLiveEvents?.ConvertAll(item => (ApiLiveEvent) item?.CloneModel());
+            clone._liveEvents = _liveEvents?.ConvertAll(item => (ApiLiveEvent) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiLiveEventList) obj;
+            return ApiClient.ListEquals(_explicitJoinLiveEvents, other._explicitJoinLiveEvents) &&
+                ApiClient.ListEquals(_liveEvents, other._liveEvents);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.ListHashCode(_explicitJoinLiveEvents);
+                hash = hash * 31 + ApiClient.ListHashCode(_liveEvents);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ExplicitJoinLiveEvents: " + ApiClient.FormatList(_explicitJoinLiveEvents));
+            members.Add("LiveEvents: " + ApiClient.FormatList(_liveEvents));
+        }
+    }
+
+    /// <summary>
+    /// The status variants of a live event.
+    /// </summary>
+    public enum ApiLiveEventStatus
+    {
+        /// <summary>
+        /// 
+        /// </summary>
+        UNKNOWN = 0,
+        /// <summary>
+        /// 
+        /// </summary>
+        ACTIVE = 1,
+        /// <summary>
+        /// 
+        /// </summary>
+        UPCOMING = 2,
+        /// <summary>
+        /// 
+        /// </summary>
+        TERMINATED = 3,
+        /// <summary>
+        /// A value this client does not recognize, such as one added in a newer version of the server.
+        /// </summary>
+        Unrecognized = -1,
+    }
+
+    /// <summary>
+    /// Converts <see cref="ApiLiveEventStatus"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class ApiLiveEventStatusConverter
+    {
+        public static ApiLiveEventStatus Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default(ApiLiveEventStatus);
+                case "UNKNOWN":
+                case "0":
+                    return ApiLiveEventStatus.UNKNOWN;
+                case "ACTIVE":
+                case "1":
+                    return ApiLiveEventStatus.ACTIVE;
+                case "UPCOMING":
+                case "2":
+                    return ApiLiveEventStatus.UPCOMING;
+                case "TERMINATED":
+                case "3":
+                    return ApiLiveEventStatus.TERMINATED;
+                default:
+                    return ApiLiveEventStatus.Unrecognized;
+            }
+        }
+
+        public static string Format(ApiLiveEventStatus value)
+        {
+            switch (value)
+            {
+                case ApiLiveEventStatus.UNKNOWN:
+                    return "UNKNOWN";
+                case ApiLiveEventStatus.ACTIVE:
+                    return "ACTIVE";
+                case ApiLiveEventStatus.UPCOMING:
+                    return "UPCOMING";
+                case ApiLiveEventStatus.TERMINATED:
+                    return "TERMINATED";
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
+        }
+    }
+
+    /// <summary>
+    /// A scheduled message.
+    /// </summary>
+    public interface IApiMessage
+    {
+
+        /// <summary>
+        /// The time the message was consumed by the identity.
+        /// </summary>
+        long ConsumeTime { get; }
+
+        /// <summary>
+        /// The time the message was created.
+        /// </summary>
+        long CreateTime { get; }
+
+        /// <summary>
+        /// The message's unique identifier.
+        /// </summary>
+        string Id { get; }
+
+        /// <summary>
+        /// The message's image url.
+        /// </summary>
+        string ImageUrl { get; }
+
+        /// <summary>
+        /// A key-value pairs of metadata.
+        /// </summary>
+        IDictionary<string, string> Metadata { get; }
+
+        /// <summary>
+        /// The time the message was read by the client.
+        /// </summary>
+        long ReadTime { get; }
+
+        /// <summary>
+        /// The identifier of the schedule.
+        /// </summary>
+        string ScheduleId { get; }
+
+        /// <summary>
+        /// The send time for the message.
+        /// </summary>
+        long SendTime { get; }
+
+        /// <summary>
+        /// The message's text.
+        /// </summary>
+        string Text { get; }
+
+        /// <summary>
+        /// The message's title.
+        /// </summary>
+        string Title { get; }
+
+        /// <summary>
+        /// The time the message was updated.
+        /// </summary>
+        long UpdateTime { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiMessage Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiMessage : IApiMessage, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long ConsumeTime
+        {
+            get => ApiClient.ParseInt64(_consumeTime);
+            set => _consumeTime = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="consume_time"), Preserve]
+        public string _consumeTime { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long CreateTime
+        {
+            get => ApiClient.ParseInt64(_createTime);
+            set => _createTime = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="create_time"), Preserve]
+        public string _createTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="id"), Preserve]
+        public string Id { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="image_url"), Preserve]
+        public string ImageUrl { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Metadata => _metadata ?? new Dictionary<string, string>();
+        [DataMember(Name="metadata"), Preserve]
+        public Dictionary<string, string> _metadata { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long ReadTime
+        {
+            get => ApiClient.ParseInt64(_readTime);
+            set => _readTime = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="read_time"), Preserve]
+        public string _readTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="schedule_id"), Preserve]
+        public string ScheduleId { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long SendTime
+        {
+            get => ApiClient.ParseInt64(_sendTime);
+            set => _sendTime = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="send_time"), Preserve]
+        public string _sendTime { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="text"), Preserve]
+        public string Text { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="title"), Preserve]
+        public string Title { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public long UpdateTime
+        {
+            get => ApiClient.ParseInt64(_updateTime);
+            set => _updateTime = ApiClient.FormatInt64(value);
+        }
+        [DataMember(Name="update_time"), Preserve]
+        public string _updateTime { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "consume_time":
+                    _consumeTime = reader.ReadString();
+                    return true;
+                case "create_time":
+                    _createTime = reader.ReadString();
+                    return true;
+                case "id":
+                    Id = reader.ReadString();
+                    return true;
+                case "image_url":
+                    ImageUrl = reader.ReadString();
+                    return true;
+                case "metadata":
+                    _metadata = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "read_time":
+                    _readTime = reader.ReadString();
+                    return true;
+                case "schedule_id":
+                    ScheduleId = reader.ReadString();
+                    return true;
+                case "send_time":
+                    _sendTime = reader.ReadString();
+                    return true;
+                case "text":
+                    Text = reader.ReadString();
+                    return true;
+                case "title":
+                    Title = reader.ReadString();
+                    return true;
+                case "update_time":
+                    _updateTime = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_consumeTime != null)
+            {
+                writer.WriteName("consume_time");
+                writer.WriteString(_consumeTime);
+            }
+            if (_createTime != null)
+            {
+                writer.WriteName("create_time");
+                writer.WriteString(_createTime);
+            }
+            if (Id != null)
+            {
+                writer.WriteName("id");
+                writer.WriteString(Id);
+            }
+            if (ImageUrl != null)
+            {
+                writer.WriteName("image_url");
+                writer.WriteString(ImageUrl);
+            }
+            if (_metadata != null)
+            {
+                writer.WriteName("metadata");
+                writer.WriteMap(_metadata, writer.WriteString);
+            }
+            if (_readTime != null)
+            {
+                writer.WriteName("read_time");
+                writer.WriteString(_readTime);
+            }
+            if (ScheduleId != null)
+            {
+                writer.WriteName("schedule_id");
+                writer.WriteString(ScheduleId);
+            }
+            if (_sendTime != null)
+            {
+                writer.WriteName("send_time");
+                writer.WriteString(_sendTime);
+            }
+            if (Text != null)
+            {
+                writer.WriteName("text");
+                writer.WriteString(Text);
+            }
+            if (Title != null)
+            {
+                writer.WriteName("title");
+                writer.WriteString(Title);
+            }
+            if (_updateTime != null)
+            {
+                writer.WriteName("update_time");
+                writer.WriteString(_updateTime);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiMessage Clone() => (ApiMessage) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiMessage) MemberwiseClone();
+            clone._metadata = ApiClient.ConvertMap<string, string>(_metadata, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiMessage) obj;
+            return _consumeTime == other._consumeTime &&
+                _createTime == other._createTime &&
+                Id == other.Id &&
+                ImageUrl == other.ImageUrl &&
+                ApiClient.MapEquals(_metadata, other._metadata) &&
+                _readTime == other._readTime &&
+                ScheduleId == other.ScheduleId &&
+                _sendTime == other._sendTime &&
+                Text == other.Text &&
+                Title == other.Title &&
+                _updateTime == other._updateTime;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_consumeTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_createTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Id?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ImageUrl?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.MapHashCode(_metadata);
+                hash = hash * 31 + (_readTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (ScheduleId?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_sendTime?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Text?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Title?.GetHashCode() ?? 0);
+                hash = hash * 31 + (_updateTime?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("ConsumeTime: " + ApiClient.FormatValue(ConsumeTime));
+            members.Add("CreateTime: " + ApiClient.FormatValue(CreateTime));
+            members.Add("Id: " + ApiClient.FormatValue(Id));
+            members.Add("ImageUrl: " + ApiClient.FormatValue(ImageUrl));
+            members.Add("Metadata: " + ApiClient.FormatMap(_metadata));
+            members.Add("ReadTime: " + ApiClient.FormatValue(ReadTime));
+            members.Add("ScheduleId: " + ApiClient.FormatValue(ScheduleId));
+            members.Add("SendTime: " + ApiClient.FormatValue(SendTime));
+            members.Add("Text: " + ApiClient.FormatValue(Text));
+            members.Add("Title: " + ApiClient.FormatValue(Title));
+            members.Add("UpdateTime: " + ApiClient.FormatValue(UpdateTime));
+        }
+    }
+
+    /// <summary>
+    /// Properties associated with an identity.
+    /// </summary>
+    public interface IApiProperties
+    {
+
+        /// <summary>
+        /// Event computed properties.
+        /// </summary>
+        IDictionary<string, string> Computed { get; }
+
+        /// <summary>
+        /// Event custom properties.
+        /// </summary>
+        IDictionary<string, string> Custom { get; }
+
+        /// <summary>
+        /// Event default properties.
+        /// </summary>
+        IDictionary<string, string> Default { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiProperties Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiProperties : IApiProperties, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Computed => _computed ?? new Dictionary<string, string>();
+        [DataMember(Name="computed"), Preserve]
+        public Dictionary<string, string> _computed { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Custom => _custom ?? new Dictionary<string, string>();
+        [DataMember(Name="custom"), Preserve]
+        public Dictionary<string, string> _custom { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IDictionary<string, string> Default => _default ?? new Dictionary<string, string>();
+        [DataMember(Name="default"), Preserve]
+        public Dictionary<string, string> _default { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "computed":
+                    _computed = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "custom":
+                    _custom = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "default":
+                    _default = reader.ReadMap(reader.ReadString);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_computed != null)
+            {
+                writer.WriteName("computed");
+                writer.WriteMap(_computed, writer.WriteString);
+            }
+            if (_custom != null)
+            {
+                writer.WriteName("custom");
+                writer.WriteMap(_custom, writer.WriteString);
+            }
+            if (_default != null)
+            {
+                writer.WriteName("default");
+                writer.WriteMap(_default, writer.WriteString);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiProperties Clone() => (ApiProperties) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiProperties) MemberwiseClone();
+            clone._computed = ApiClient.ConvertMap<string, string>(_computed, item => item);
+            clone._custom = ApiClient.ConvertMap<string, string>(_custom, item => item);
+            clone._default = ApiClient.ConvertMap<string, string>(_default, item => item);
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiProperties) obj;
+            return ApiClient.MapEquals(_computed, other._computed) &&
+                ApiClient.MapEquals(_custom, other._custom) &&
+                ApiClient.MapEquals(_default, other._default);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.MapHashCode(_computed);
+                hash = hash * 31 + ApiClient.MapHashCode(_custom);
+                hash = hash * 31 + ApiClient.MapHashCode(_default);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Computed: " + ApiClient.FormatMap(_computed));
+            members.Add("Custom: " + ApiClient.FormatMap(_custom));
+            members.Add("Default: " + ApiClient.FormatMap(_default));
+        }
+    }
+
+    /// <summary>
+    /// A session.
+    /// </summary>
+    public interface IApiSession
+    {
+
+        /// <summary>
+        /// Properties associated with this identity.
+        /// </summary>
+        IApiProperties Properties { get; }
+
+        /// <summary>
+        /// Refresh token.
+        /// </summary>
+        string RefreshToken { get; }
+
+        /// <summary>
+        /// Token credential.
+        /// </summary>
+        string Token { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IApiSession Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ApiSession : IApiSession, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IApiProperties Properties => _properties;
+        [DataMember(Name="properties"), Preserve]
+        public ApiProperties _properties { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="refresh_token"), Preserve]
+        public string RefreshToken { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="token"), Preserve]
+        public string Token { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "properties":
+                    _properties = reader.ReadObject<ApiProperties>();
+                    return true;
+                case "refresh_token":
+                    RefreshToken = reader.ReadString();
+                    return true;
+                case "token":
+                    Token = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_properties != null)
+            {
+                writer.WriteName("properties");
+                writer.WriteObject(_properties);
+            }
+            if (RefreshToken != null)
+            {
+                writer.WriteName("refresh_token");
+                writer.WriteString(RefreshToken);
+            }
+            if (Token != null)
+            {
+                writer.WriteName("token");
+                writer.WriteString(Token);
+            }
+        }
+
+        /// <inheritdoc />
+        public IApiSession Clone() => (ApiSession) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiSession) MemberwiseClone();
+            clone._properties = (ApiProperties) _properties?.CloneModel();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ApiSession) obj;
+            return Equals(_properties, other._properties) &&
+                RefreshToken == other.RefreshToken &&
+                Token == other.Token;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (_properties?.GetHashCode() ?? 0);
+                hash = hash * 31 + (RefreshToken?.GetHashCode() ?? 0);
+                hash = hash * 31 + (Token?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Properties: " + ApiClient.FormatValue(_properties));
+            members.Add("RefreshToken: " + ApiClient.FormatValue(RefreshToken));
+            members.Add("Token: " + ApiClient.FormatValue(Token));
+        }
+    }
+
+    /// <summary>
+    /// Update Properties associated with this identity.
+    /// </summary>
+    public interface IApiUpdatePropertiesRequest
+    {
 
         /// <summary>
-        /// The send time for the message.
+        /// Event custom properties.
         /// </summary>
-        string SendTime { get; }
+        IDictionary<string, string> Custom { get; }
 
         /// <summary>
-        /// The message's text.
+        /// Event default properties.
         /// </summary>
-        string Text { get; }
+        IDictionary<string, string> Default { get; }
 
         /// <summary>
-        /// The message's title.
+        /// Informs the server to recompute the audience membership of the identity.
         /// </summary>
-        string Title { get; }
+        bool Recompute { get; }
 
         /// <summary>
-        /// The time the message was updated.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        string UpdateTime { get; }
+        IApiUpdatePropertiesRequest Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiMessage : IApiMessage
+    internal class ApiUpdatePropertiesRequest : IApiUpdatePropertiesRequest, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [DataMember(Name="consume_time"), Preserve]
-        public string ConsumeTime { get; set; }
-
-        /// <inheritdoc />
-        [DataMember(Name="create_time"), Preserve]
-        public string CreateTime { get; set; }
+        [IgnoreDataMember]
+        public IDictionary<string, string> Custom => _custom ?? new Dictionary<string, string>();
+        [DataMember(Name="custom"), Preserve]
+        public Dictionary<string, string> _custom { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="id"), Preserve]
-        public string Id { get; set; }
+        [IgnoreDataMember]
+        public IDictionary<string, string> Default => _default ?? new Dictionary<string, string>();
+        [DataMember(Name="default"), Preserve]
+        public Dictionary<string, string> _default { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="image_url"), Preserve]
-        public string ImageUrl { get; set; }
+        [DataMember(Name="recompute"), Preserve]
+        public bool Recompute { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Metadata => _metadata ?? new Dictionary<string, string>();
-        [DataMember(Name="metadata"), Preserve]
-        public Dictionary<string, string> _metadata { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "custom":
+                    _custom = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "default":
+                    _default = reader.ReadMap(reader.ReadString);
+                    return true;
+                case "recompute":
+                    Recompute = reader.ReadBoolean();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="read_time"), Preserve]
-        public string ReadTime { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (_custom != null)
+            {
+                writer.WriteName("custom");
+                writer.WriteMap(_custom, writer.WriteString);
+            }
+            if (_default != null)
+            {
+                writer.WriteName("default");
+                writer.WriteMap(_default, writer.WriteString);
+            }
+            writer.WriteName("recompute");
+            writer.WriteBoolean(Recompute);
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="schedule_id"), Preserve]
-        public string ScheduleId { get; set; }
+        public IApiUpdatePropertiesRequest Clone() => (ApiUpdatePropertiesRequest) CloneModel();
 
-        /// <inheritdoc />
-        [DataMember(Name="send_time"), Preserve]
-        public string SendTime { get; set; }
+        internal virtual object CloneModel()
+        {
+            var clone = (ApiUpdatePropertiesRequest) MemberwiseClone();
+            clone._custom = ApiClient.ConvertMap<string, string>(_custom, item => item);
+            clone._default = ApiClient.ConvertMap<string, string>(_default, item => item);
+            return clone;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="text"), Preserve]
-        public string Text { get; set; }
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="title"), Preserve]
-        public string Title { get; set; }
+            var other = (ApiUpdatePropertiesRequest) obj;
+            return ApiClient.MapEquals(_custom, other._custom) &&
+                ApiClient.MapEquals(_default, other._default) &&
+                Recompute == other.Recompute;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="update_time"), Preserve]
-        public string UpdateTime { get; set; }
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + ApiClient.MapHashCode(_custom);
+                hash = hash * 31 + ApiClient.MapHashCode(_default);
+                hash = hash * 31 + Recompute.GetHashCode();
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "ConsumeTime: ", ConsumeTime, ", ");
-            output = string.Concat(output, "CreateTime: ", CreateTime, ", ");
-            output = string.Concat(output, "Id: ", Id, ", ");
-            output = string.Concat(output, "ImageUrl: ", ImageUrl, ", ");
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
 
-            var metadataString = "";
-            foreach (var kvp in Metadata)
-            {
-                metadataString = string.Concat(metadataString, "{" + kvp.Key + "=" + kvp.Value + "}");
-            }
-            output = string.Concat(output, "Metadata: [" + metadataString + "]");
-            output = string.Concat(output, "ReadTime: ", ReadTime, ", ");
-            output = string.Concat(output, "ScheduleId: ", ScheduleId, ", ");
-            output = string.Concat(output, "SendTime: ", SendTime, ", ");
-            output = string.Concat(output, "Text: ", Text, ", ");
-            output = string.Concat(output, "Title: ", Title, ", ");
-            output = string.Concat(output, "UpdateTime: ", UpdateTime, ", ");
-            return output;
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Custom: " + ApiClient.FormatMap(_custom));
+            members.Add("Default: " + ApiClient.FormatMap(_default));
+            members.Add("Recompute: " + ApiClient.FormatValue(Recompute));
         }
     }
 
     /// <summary>
-    /// Properties associated with an identity.
+    /// 
     /// </summary>
-    public interface IApiProperties
+    public interface IProtobufAny
     {
 
         /// <summary>
-        /// Event computed properties.
-        /// </summary>
-        IDictionary<string, string> Computed { get; }
-
-        /// <summary>
-        /// Event custom properties.
+        /// 
         /// </summary>
-        IDictionary<string, string> Custom { get; }
+        string @type { get; }
 
         /// <summary>
-        /// Event default properties.
+        /// Creates a deep copy, along with the models, lists and maps it holds.
         /// </summary>
-        IDictionary<string, string> Default { get; }
+        IProtobufAny Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiProperties : IApiProperties
+    internal class ProtobufAny : IProtobufAny, IApiJsonObject
     {
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Computed => _computed ?? new Dictionary<string, string>();
-        [DataMember(Name="computed"), Preserve]
-        public Dictionary<string, string> _computed { get; set; }
+        [DataMember(Name="@type"), Preserve]
+        public string @type { get; set; }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Custom => _custom ?? new Dictionary<string, string>();
-        [DataMember(Name="custom"), Preserve]
-        public Dictionary<string, string> _custom { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "@type":
+                    @type = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Default => _default ?? new Dictionary<string, string>();
-        [DataMember(Name="default"), Preserve]
-        public Dictionary<string, string> _default { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (@type != null)
+            {
+                writer.WriteName("@type");
+                writer.WriteString(@type);
+            }
+        }
 
-        public override string ToString()
+        /// <inheritdoc />
+        public IProtobufAny Clone() => (ProtobufAny) CloneModel();
+
+        internal virtual object CloneModel()
         {
-            var output = "";
+            var clone = (ProtobufAny) MemberwiseClone();
+            return clone;
+        }
 
-            var computedString = "";
-            foreach (var kvp in Computed)
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
             {
-                computedString = string.Concat(computedString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                return true;
             }
-            output = string.Concat(output, "Computed: [" + computedString + "]");
-
-            var customString = "";
-            foreach (var kvp in Custom)
+            if (obj == null || obj.GetType() != GetType())
             {
-                customString = string.Concat(customString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                return false;
             }
-            output = string.Concat(output, "Custom: [" + customString + "]");
 
-            var defaultString = "";
-            foreach (var kvp in Default)
+            var other = (ProtobufAny) obj;
+            return @type == other.@type;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
             {
-                defaultString = string.Concat(defaultString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                var hash = 17;
+                hash = hash * 31 + (@type?.GetHashCode() ?? 0);
+                return hash;
             }
-            output = string.Concat(output, "Default: [" + defaultString + "]");
-            return output;
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("@type: " + ApiClient.FormatValue(@type));
         }
     }
 
     /// <summary>
-    /// A session.
+    /// 
     /// </summary>
-    public interface IApiSession
+    public interface IRpcStatus
     {
 
         /// <summary>
-        /// Properties associated with this identity.
+        /// 
         /// </summary>
-        IApiProperties Properties { get; }
+        int Code { get; }
 
         /// <summary>
-        /// Refresh token.
+        /// 
         /// </summary>
-        string RefreshToken { get; }
+        string Message { get; }
 
         /// <summary>
-        /// Token credential.
+        /// 
         /// </summary>
-        string Token { get; }
+        IEnumerable<IProtobufAny> Details { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IRpcStatus Clone();
     }
 
     /// <inheritdoc />
-    internal class ApiSession : IApiSession
+    internal class RpcStatus : IRpcStatus, IApiJsonObject
     {
 
+        /// <inheritdoc />
+        [DataMember(Name="code"), Preserve]
+        public int Code { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="message"), Preserve]
+        public string Message { get; set; }
+
         /// <inheritdoc />
         [IgnoreDataMember]
-        public IApiProperties Properties => _properties;
-        [DataMember(Name="properties"), Preserve]
-        public ApiProperties _properties { get; set; }
+        public IEnumerable<IProtobufAny> Details => _details ?? new List<ProtobufAny>(0);
+        [DataMember(Name="details"), Preserve]
+        public List<ProtobufAny> _details { get; set; }
 
         /// <inheritdoc />
-        [DataMember(Name="refresh_token"), Preserve]
-        public string RefreshToken { get; set; }
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "code":
+                    Code = reader.ReadInt32();
+                    return true;
+                case "message":
+                    Message = reader.ReadString();
+                    return true;
+                case "details":
+                    _details = reader.ReadList(reader.ReadObject<ProtobufAny>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
 
         /// <inheritdoc />
-        [DataMember(Name="token"), Preserve]
-        public string Token { get; set; }
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("code");
+            writer.WriteInt32(Code);
+            if (Message != null)
+            {
+                writer.WriteName("message");
+                writer.WriteString(Message);
+            }
+            if (_details != null)
+            {
+                writer.WriteName("details");
+                writer.WriteList(_details, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IRpcStatus Clone() => (RpcStatus) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (RpcStatus) MemberwiseClone();
+            clone._details = _details?.ConvertAll(item => (ProtobufAny) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (RpcStatus) obj;
+            return Code == other.Code &&
+                Message == other.Message &&
+                ApiClient.ListEquals(_details, other._details);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Message?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_details);
+                return hash;
+            }
+        }
 
         public override string ToString()
         {
-            var output = "";
-            output = string.Concat(output, "Properties: ", Properties, ", ");
-            output = string.Concat(output, "RefreshToken: ", RefreshToken, ", ");
-            output = string.Concat(output, "Token: ", Token, ", ");
-            return output;
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Message: " + ApiClient.FormatValue(Message));
+            members.Add("Details: " + ApiClient.FormatList(_details));
         }
     }
 
     /// <summary>
-    /// Update Properties associated with this identity.
+    /// The low level client for the Satori API.
     /// </summary>
-    public interface IApiUpdatePropertiesRequest
+    internal class ApiClient
     {
+        public readonly IHttpAdapter HttpAdapter;
+        public int Timeout { get; set; }
 
-        /// <summary>
-        /// Event custom properties.
-        /// </summary>
-        IDictionary<string, string> Custom { get; }
+        private readonly Uri _baseUri;
 
-        /// <summary>
-        /// Event default properties.
-        /// </summary>
-        IDictionary<string, string> Default { get; }
+        public ApiClient(Uri baseUri, IHttpAdapter httpAdapter, int timeout = 10)
+        {
+            _baseUri = baseUri;
+            HttpAdapter = httpAdapter;
+            Timeout = timeout;
+        }
 
-        /// <summary>
-        /// Informs the server to recompute the audience membership of the identity.
-        /// </summary>
-        bool Recompute { get; }
-    }
+        private async Task<string> SendAsync(string method, Uri uri, IDictionary<string, string> headers, byte[] body,
+            CancellationToken? cancellationToken, RequestOptions options)
+        {
+            if (options?.Headers != null)
+            {
+                foreach (var header in options.Headers)
+                {
+                    headers[header.Key] = header.Value;
+                }
+            }
+            var timeout = options?.Timeout ?? Timeout;
+            cancellationToken = cancellationToken ?? options?.CancellationToken;
+            try
+            {
+                return await HttpAdapter.SendAsync(method, uri, headers, body, timeout, cancellationToken);
+            }
+            catch (ApiResponseException e) when (e.GetType() == typeof(ApiResponseException))
+            {
+                var exception = ApiStatusException.FromResponse(e);
+                if (exception == null)
+                {
+                    throw;
+                }
+                throw exception;
+            }
+        }
 
-    /// <inheritdoc />
-    internal class ApiUpdatePropertiesRequest : IApiUpdatePropertiesRequest
-    {
+        private static T ParseResponse<T>(string contents, Func<ApiJsonReader, T> read) =>
+            string.IsNullOrEmpty(contents) ? default(T) : read(new ApiJsonReader(contents));
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Custom => _custom ?? new Dictionary<string, string>();
-        [DataMember(Name="custom"), Preserve]
-        public Dictionary<string, string> _custom { get; set; }
+        internal static long ParseInt64(string value)
+        {
+            long.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IDictionary<string, string> Default => _default ?? new Dictionary<string, string>();
-        [DataMember(Name="default"), Preserve]
-        public Dictionary<string, string> _default { get; set; }
+        internal static string FormatInt64(long value) => value.ToString(CultureInfo.InvariantCulture);
 
-        /// <inheritdoc />
-        [DataMember(Name="recompute"), Preserve]
-        public bool Recompute { get; set; }
+        internal static ulong ParseUInt64(string value)
+        {
+            ulong.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
 
-        public override string ToString()
+        internal static string FormatUInt64(ulong value) => value.ToString(CultureInfo.InvariantCulture);
+
+        internal static DateTime ParseDateTime(string value)
         {
-            var output = "";
+            DateTime.TryParse(value, CultureInfo.InvariantCulture,
+                DateTimeStyles.AdjustToUniversal | DateTimeStyles.AssumeUniversal, out var result);
+            return result;
+        }
+
+        internal static string FormatDateTime(DateTime value) =>
+            value.ToUniversalTime().ToString("yyyy-MM-dd'T'HH:mm:ss.FFFFFFF'Z'", CultureInfo.InvariantCulture);
+
+        internal static byte[] ParseBytes(string value) => value == null ? null : Convert.FromBase64String(value);
+
+        internal static string FormatBytes(byte[] value) => value == null ? null : Convert.ToBase64String(value);
 
-            var customString = "";
-            foreach (var kvp in Custom)
+        private static byte[] EncodeForm(List<KeyValuePair<string, object>> form)
+        {
+            var fields = new List<string>(form.Count);
+            foreach (var field in form)
             {
-                customString = string.Concat(customString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                fields.Add(string.Concat(Uri.EscapeDataString(field.Key), "=", Uri.EscapeDataString((string) field.Value)));
             }
-            output = string.Concat(output, "Custom: [" + customString + "]");
+            return Encoding.UTF8.GetBytes(string.Join("&", fields));
+        }
 
-            var defaultString = "";
-            foreach (var kvp in Default)
+        private static byte[] EncodeMultipart(List<KeyValuePair<string, object>> form, string boundary)
+        {
+            using (var stream = new MemoryStream())
             {
-                defaultString = string.Concat(defaultString, "{" + kvp.Key + "=" + kvp.Value + "}");
+                foreach (var field in form)
+                {
+                    var file = field.Value as byte[];
+                    var header = file == null
+                        ? $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"\r\n\r\n"
+                        : $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"; filename=\"{field.Key}\"\r\nContent-Type: application/octet-stream\r\n\r\n";
+                    var part = Encoding.UTF8.GetBytes(header);
+                    stream.Write(part, 0, part.Length);
+                    part = file ?? Encoding.UTF8.GetBytes((string) field.Value);
+                    stream.Write(part, 0, part.Length);
+                    part = Encoding.UTF8.GetBytes("\r\n");
+                    stream.Write(part, 0, part.Length);
+                }
+                var end = Encoding.UTF8.GetBytes($"--{boundary}--\r\n");
+                stream.Write(end, 0, end.Length);
+                return stream.ToArray();
             }
-            output = string.Concat(output, "Default: [" + defaultString + "]");
-            output = string.Concat(output, "Recompute: ", Recompute, ", ");
-            return output;
         }
-    }
-
-    /// <summary>
-    /// 
-    /// </summary>
-    public interface IGooglerpcStatus
-    {
-
-        /// <summary>
-        /// 
-        /// </summary>
-        int Code { get; }
 
-        /// <summary>
-        /// 
-        /// </summary>
-        IEnumerable<IProtobufAny> Details { get; }
+        internal static Dictionary<string, TOutput> ConvertMap<TInput, TOutput>(Dictionary<string, TInput> map,
+            Converter<TInput, TOutput> converter)
+        {
+            if (map == null)
+            {
+                return null;
+            }
 
-        /// <summary>
-        /// 
-        /// </summary>
-        string Message { get; }
-    }
+            var result = new Dictionary<string, TOutput>(map.Count);
+            foreach (var kvp in map)
+            {
+                result.Add(kvp.Key, converter(kvp.Value));
+            }
+            return result;
+        }
 
-    /// <inheritdoc />
-    internal class GooglerpcStatus : IGooglerpcStatus
-    {
+        // Models compare a missing list or map as equal to an empty one, as the server may leave either out.
+        internal static bool ListEquals<T>(List<T> a, List<T> b)
+        {
+            var count = a?.Count ?? 0;
+            if (count != (b?.Count ?? 0))
+            {
+                return false;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="code"), Preserve]
-        public int Code { get; set; }
+            var comparer = EqualityComparer<T>.Default;
+            for (var i = 0; i < count; i++)
+            {
+                if (!comparer.Equals(a[i], b[i]))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
 
-        /// <inheritdoc />
-        [IgnoreDataMember]
-        public IEnumerable<IProtobufAny> Details => _details ?? new List<ProtobufAny>(0);
-        [DataMember(Name="details"), Preserve]
-        public List<ProtobufAny> _details { get; set; }
+        internal static bool MapEquals<T>(Dictionary<string, T> a, Dictionary<string, T> b)
+        {
+            if ((a?.Count ?? 0) != (b?.Count ?? 0))
+            {
+                return false;
+            }
+            if (a == null || b == null)
+            {
+                return true;
+            }
 
-        /// <inheritdoc />
-        [DataMember(Name="message"), Preserve]
-        public string Message { get; set; }
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in a)
+            {
+                if (!b.TryGetValue(kvp.Key, out var value) || !comparer.Equals(kvp.Value, value))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
 
-        public override string ToString()
+        internal static int ListHashCode<T>(List<T> list)
         {
-            var output = "";
-            output = string.Concat(output, "Code: ", Code, ", ");
-            output = string.Concat(output, "Details: [", string.Join(", ", Details), "], ");
-            output = string.Concat(output, "Message: ", Message, ", ");
-            return output;
-        }
-    }
+            var hash = 0;
+            if (list == null)
+            {
+                return hash;
+            }
 
-    /// <summary>
-    /// 
-    /// </summary>
-    public interface IProtobufAny
-    {
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var item in list)
+            {
+                hash = unchecked(hash * 31 + comparer.GetHashCode(item));
+            }
+            return hash;
+        }
 
-        /// <summary>
-        /// 
-        /// </summary>
-        string @type { get; }
-    }
+        // Entries are hashed apart and summed, so that maps hash alike whatever order they were filled in.
+        internal static int MapHashCode<T>(Dictionary<string, T> map)
+        {
+            var hash = 0;
+            if (map == null)
+            {
+                return hash;
+            }
 
-    /// <inheritdoc />
-    internal class ProtobufAny : IProtobufAny
-    {
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in map)
+            {
+                hash = unchecked(hash + (kvp.Key.GetHashCode() ^ comparer.GetHashCode(kvp.Value)));
+            }
+            return hash;
+        }
 
-        /// <inheritdoc />
-        [DataMember(Name="@type"), Preserve]
-        public string @type { get; set; }
+        internal static string FormatModel(string name, List<string> members) =>
+            members.Count == 0 ? string.Concat(name, " {}") : string.Concat(name, " { ", string.Join(", ", members), " }");
 
-        public override string ToString()
+        internal static string FormatValue(object value)
         {
-            var output = "";
-            output = string.Concat(output, "@type: ", @type, ", ");
-            return output;
+            switch (value)
+            {
+                case null:
+                    return "null";
+                case string text:
+                    return string.Concat("\"", text.Replace("\\", "\\\\").Replace("\"", "\\\""), "\"");
+                case bool flag:
+                    return flag ? "true" : "false";
+                case DateTime time:
+                    return FormatDateTime(time);
+                case IFormattable formattable:
+                    return formattable.ToString(null, CultureInfo.InvariantCulture);
+                default:
+                    return value.ToString();
+            }
         }
-    }
 
-    /// <summary>
-    /// The low level client for the Satori API.
-    /// </summary>
-    internal class ApiClient
-    {
-        public readonly IHttpAdapter HttpAdapter;
-        public int Timeout { get; set; }
+        internal static string FormatEncoded(string value) => value ?? "null";
 
-        private readonly Uri _baseUri;
+        internal static string FormatList<T>(List<T> list, Func<T, string> format = null)
+        {
+            if (list == null || list.Count == 0)
+            {
+                return "[]";
+            }
 
-        public ApiClient(Uri baseUri, IHttpAdapter httpAdapter, int timeout = 10)
+            var items = new List<string>(list.Count);
+            foreach (var item in list)
+            {
+                items.Add(format == null ? FormatValue(item) : format(item));
+            }
+            return string.Concat("[", string.Join(", ", items), "]");
+        }
+
+        internal static string FormatMap<T>(IDictionary<string, T> map, Func<T, string> format = null)
         {
-            _baseUri = baseUri;
-            HttpAdapter = httpAdapter;
-            Timeout = timeout;
+            if (map == null || map.Count == 0)
+            {
+                return "{}";
+            }
+
+            var entries = new List<string>(map.Count);
+            foreach (var kvp in map)
+            {
+                entries.Add(string.Concat(FormatValue(kvp.Key), ": ", format == null ? FormatValue(kvp.Value) : format(kvp.Value)));
+            }
+            return string.Concat("{ ", string.Join(", ", entries), " }");
         }
 
         /// <summary>
@@ -1563,7 +5019,8 @@ namespace Satori
         /// </summary>
         public async Task SatoriHealthcheckAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/healthcheck";
@@ -1580,11 +5037,14 @@ namespace Satori
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -1592,7 +5052,8 @@ namespace Satori
         /// </summary>
         public async Task SatoriReadycheckAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/readycheck";
@@ -1609,11 +5070,14 @@ namespace Satori
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -1623,7 +5087,8 @@ namespace Satori
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAuthenticateRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -1652,10 +5117,11 @@ namespace Satori
             }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -1664,7 +5130,8 @@ namespace Satori
         public async Task SatoriAuthenticateLogoutAsync(
             string bearerToken,
             ApiAuthenticateLogoutRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -1685,13 +5152,17 @@ namespace Satori
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -1701,7 +5172,8 @@ namespace Satori
             string basicAuthUsername,
             string basicAuthPassword,
             ApiAuthenticateRefreshRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -1730,10 +5202,11 @@ namespace Satori
             }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -1742,7 +5215,8 @@ namespace Satori
         public async Task SatoriEventAsync(
             string bearerToken,
             ApiEventRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -1763,13 +5237,17 @@ namespace Satori
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -1777,9 +5255,10 @@ namespace Satori
         /// </summary>
         public async Task<IApiExperimentList> SatoriGetExperimentsAsync(
             string bearerToken,
-            IEnumerable<string> names,
-            IEnumerable<string> labels,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> names = null,
+            IEnumerable<string> labels = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v1/experiment";
@@ -1804,12 +5283,15 @@ namespace Satori
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiExperimentList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiExperimentList>());
         }
 
         /// <summary>
@@ -1818,10 +5300,10 @@ namespace Satori
         public async Task<IApiFlagList> SatoriGetFlagsAsync(
             string basicAuthUsername,
             string basicAuthPassword,
-            string bearerToken,
-            IEnumerable<string> names,
-            IEnumerable<string> labels,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> names = null,
+            IEnumerable<string> labels = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v1/flag";
@@ -1852,6 +5334,45 @@ namespace Satori
                 var header = string.Concat("Basic ", Convert.ToBase64String(credentials));
                 headers.Add("Authorization", header);
             }
+
+            byte[] content = null;
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiFlagList>());
+        }
+
+        /// <summary>
+        /// List all available flags for this identity.
+        /// </summary>
+        public async Task<IApiFlagList> SatoriGetFlagsAsync(
+            string bearerToken,
+            IEnumerable<string> names = null,
+            IEnumerable<string> labels = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
+        {
+
+            var urlpath = "/v1/flag";
+
+            var queryParams = "";
+            foreach (var elem in names ?? new string[0])
+            {
+                queryParams = string.Concat(queryParams, "names=", Uri.EscapeDataString(elem), "&");
+            }
+            foreach (var elem in labels ?? new string[0])
+            {
+                queryParams = string.Concat(queryParams, "labels=", Uri.EscapeDataString(elem), "&");
+            }
+
+            string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
+
+            var uri = new UriBuilder(_baseUri)
+            {
+                Path = path,
+                Query = queryParams
+            }.Uri;
+
+            var httpMethod = "GET";
+            var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(bearerToken))
             {
                 var header = string.Concat("Bearer ", bearerToken);
@@ -1859,8 +5380,8 @@ namespace Satori
             }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiFlagList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiFlagList>());
         }
 
         /// <summary>
@@ -1869,10 +5390,10 @@ namespace Satori
         public async Task<IApiFlagOverrideList> SatoriGetFlagOverridesAsync(
             string basicAuthUsername,
             string basicAuthPassword,
-            string bearerToken,
-            IEnumerable<string> names,
-            IEnumerable<string> labels,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> names = null,
+            IEnumerable<string> labels = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v1/flag/override";
@@ -1903,6 +5424,45 @@ namespace Satori
                 var header = string.Concat("Basic ", Convert.ToBase64String(credentials));
                 headers.Add("Authorization", header);
             }
+
+            byte[] content = null;
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiFlagOverrideList>());
+        }
+
+        /// <summary>
+        /// List all available flags and their value overrides for this identity.
+        /// </summary>
+        public async Task<IApiFlagOverrideList> SatoriGetFlagOverridesAsync(
+            string bearerToken,
+            IEnumerable<string> names = null,
+            IEnumerable<string> labels = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
+        {
+
+            var urlpath = "/v1/flag/override";
+
+            var queryParams = "";
+            foreach (var elem in names ?? new string[0])
+            {
+                queryParams = string.Concat(queryParams, "names=", Uri.EscapeDataString(elem), "&");
+            }
+            foreach (var elem in labels ?? new string[0])
+            {
+                queryParams = string.Concat(queryParams, "labels=", Uri.EscapeDataString(elem), "&");
+            }
+
+            string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
+
+            var uri = new UriBuilder(_baseUri)
+            {
+                Path = path,
+                Query = queryParams
+            }.Uri;
+
+            var httpMethod = "GET";
+            var headers = new Dictionary<string, string>();
             if (!string.IsNullOrEmpty(bearerToken))
             {
                 var header = string.Concat("Bearer ", bearerToken);
@@ -1910,8 +5470,8 @@ namespace Satori
             }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiFlagOverrideList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiFlagOverrideList>());
         }
 
         /// <summary>
@@ -1920,7 +5480,8 @@ namespace Satori
         public async Task<IApiSession> SatoriIdentifyAsync(
             string bearerToken,
             ApiIdentifyRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -1941,14 +5502,18 @@ namespace Satori
 
             var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiSession>();
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiSession>());
         }
 
         /// <summary>
@@ -1956,7 +5521,8 @@ namespace Satori
         /// </summary>
         public async Task SatoriDeleteIdentityAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v1/identity";
@@ -1973,11 +5539,14 @@ namespace Satori
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -1985,13 +5554,14 @@ namespace Satori
         /// </summary>
         public async Task<IApiLiveEventList> SatoriGetLiveEventsAsync(
             string bearerToken,
-            IEnumerable<string> names,
-            IEnumerable<string> labels,
-            int? pastRunCount,
-            int? futureRunCount,
-            string startTimeSec,
-            string endTimeSec,
-            CancellationToken? cancellationToken)
+            IEnumerable<string> names = null,
+            IEnumerable<string> labels = null,
+            int? pastRunCount = null,
+            int? futureRunCount = null,
+            string startTimeSec = null,
+            string endTimeSec = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v1/live-event";
@@ -2006,10 +5576,10 @@ namespace Satori
                 queryParams = string.Concat(queryParams, "labels=", Uri.EscapeDataString(elem), "&");
             }
             if (pastRunCount != null) {
-                queryParams = string.Concat(queryParams, "past_run_count=", pastRunCount, "&");
+                queryParams = string.Concat(queryParams, "past_run_count=", Uri.EscapeDataString(pastRunCount.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (futureRunCount != null) {
-                queryParams = string.Concat(queryParams, "future_run_count=", futureRunCount, "&");
+                queryParams = string.Concat(queryParams, "future_run_count=", Uri.EscapeDataString(futureRunCount.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (startTimeSec != null) {
                 queryParams = string.Concat(queryParams, "start_time_sec=", Uri.EscapeDataString(startTimeSec), "&");
@@ -2028,12 +5598,15 @@ namespace Satori
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiLiveEventList>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiLiveEventList>());
         }
 
         /// <summary>
@@ -2042,7 +5615,8 @@ namespace Satori
         public async Task SatoriJoinLiveEventAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -2064,11 +5638,14 @@ namespace Satori
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -2076,21 +5653,22 @@ namespace Satori
         /// </summary>
         public async Task<IApiGetMessageListResponse> SatoriGetMessageListAsync(
             string bearerToken,
-            int? limit,
-            bool? forward,
-            string cursor,
-            IEnumerable<string> messageIds,
-            CancellationToken? cancellationToken)
+            int? limit = null,
+            bool? forward = null,
+            string cursor = null,
+            IEnumerable<string> messageIds = null,
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v1/message";
 
             var queryParams = "";
             if (limit != null) {
-                queryParams = string.Concat(queryParams, "limit=", limit, "&");
+                queryParams = string.Concat(queryParams, "limit=", Uri.EscapeDataString(limit.Value.ToString(CultureInfo.InvariantCulture)), "&");
             }
             if (forward != null) {
-                queryParams = string.Concat(queryParams, "forward=", forward.ToString().ToLower(), "&");
+                queryParams = string.Concat(queryParams, "forward=", Uri.EscapeDataString(forward.Value ? "true" : "false"), "&");
             }
             if (cursor != null) {
                 queryParams = string.Concat(queryParams, "cursor=", Uri.EscapeDataString(cursor), "&");
@@ -2110,12 +5688,15 @@ namespace Satori
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiGetMessageListResponse>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiGetMessageListResponse>());
         }
 
         /// <summary>
@@ -2124,7 +5705,8 @@ namespace Satori
         public async Task SatoriDeleteMessageAsync(
             string bearerToken,
             string id,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -2146,11 +5728,14 @@ namespace Satori
 
             var httpMethod = "DELETE";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -2160,7 +5745,8 @@ namespace Satori
             string bearerToken,
             string id,
             ApiUpdateMessageRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (id == null)
             {
@@ -2186,13 +5772,17 @@ namespace Satori
 
             var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -2200,7 +5790,8 @@ namespace Satori
         /// </summary>
         public async Task<IApiProperties> SatoriListPropertiesAsync(
             string bearerToken,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
 
             var urlpath = "/v1/properties";
@@ -2217,12 +5808,15 @@ namespace Satori
 
             var httpMethod = "GET";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<ApiProperties>();
+            var contents = await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
+            return ParseResponse(contents, reader => reader.ReadObject<ApiProperties>());
         }
 
         /// <summary>
@@ -2231,7 +5825,8 @@ namespace Satori
         public async Task SatoriUpdatePropertiesAsync(
             string bearerToken,
             ApiUpdatePropertiesRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -2252,13 +5847,17 @@ namespace Satori
 
             var httpMethod = "PUT";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
 
         /// <summary>
@@ -2267,7 +5866,8 @@ namespace Satori
         public async Task SatoriServerEventAsync(
             string bearerToken,
             ApiEventRequest body,
-            CancellationToken? cancellationToken)
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
         {
             if (body == null)
             {
@@ -2288,13 +5888,17 @@ namespace Satori
 
             var httpMethod = "POST";
             var headers = new Dictionary<string, string>();
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
 
             byte[] content = null;
-            var jsonBody = body.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            var writer = new ApiJsonWriter();
+            writer.WriteObject(body);
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            await SendAsync(httpMethod, uri, headers, content, cancellationToken, options);
         }
     }
 }
@@ -279,9 +279,8 @@ namespace Satori
                 await SessionRefreshAsync(session, cancellationToken);
             }
 
-            return await _retryInvoker.InvokeWithRetry(() => _apiClient.SatoriGetFlagsAsync(string.Empty,
-                    string.Empty, session.AuthToken, names, labels,
-                    cancellationToken),
+            return await _retryInvoker.InvokeWithRetry(() => _apiClient.SatoriGetFlagsAsync(session.AuthToken,
+                    names, labels, cancellationToken),
                 new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, cancellationToken));
         }
 
@@ -291,7 +290,7 @@ namespace Satori
             RetryConfiguration retryConfiguration = null)
         {
             return _retryInvoker.InvokeWithRetry(
-                () => _apiClient.SatoriGetFlagsAsync(this.ApiKey, string.Empty, string.Empty, names, labels, cancellationToken),
+                () => _apiClient.SatoriGetFlagsAsync(this.ApiKey, string.Empty, names, labels, cancellationToken),
                 new RetryHistory(string.Empty, retryConfiguration ?? GlobalRetryConfiguration, cancellationToken));
         }
 
@@ -493,7 +492,7 @@ namespace Satori
             }
 
             return await _retryInvoker.InvokeWithRetry(
-                () => _apiClient.SatoriGetFlagOverridesAsync(string.Empty, string.Empty, session.AuthToken, names, labels, cancellationToken),
+                () => _apiClient.SatoriGetFlagOverridesAsync(session.AuthToken, names, labels, cancellationToken),
                 new RetryHistory(session, retryConfiguration ?? GlobalRetryConfiguration, cancellationToken));
         }
     }
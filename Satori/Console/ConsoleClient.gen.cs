@@ -3,16 +3,17 @@ namespace Satori.Console
 {
     using System;
     using System.Collections.Generic;
+    using System.Globalization;
+    using System.IO;
     using System.Runtime.Serialization;
     using System.Text;
     using System.Threading;
     using System.Threading.Tasks;
-    using TinyJson;
 
     /// <summary>
     /// An exception generated for <c>HttpResponse</c> objects don't return a success status.
     /// </summary>
-    public sealed class ApiResponseException : Exception
+    public class ApiResponseException : Exception
     {
         public long StatusCode { get; }
 
@@ -34,9 +35,775 @@ namespace Satori.Console
         {
         }
 
+        protected ApiResponseException(ApiResponseException e) : base(e.Message, e)
+        {
+            StatusCode = e.StatusCode;
+            GrpcStatusCode = e.GrpcStatusCode;
+            foreach (var key in e.Data.Keys)
+            {
+                Data[key] = e.Data[key];
+            }
+        }
+
         public override string ToString()
         {
-            return $"ApiResponseException(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
+            return $"{GetType().Name}(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
+        }
+    }
+
+
+    /// <summary>
+    /// Options of a single request, which override those of the client.
+    /// </summary>
+    public class RequestOptions
+    {
+        /// <summary>
+        /// The timeout of the request in seconds, in place of the timeout of the client.
+        /// </summary>
+        public int? Timeout { get; set; }
+
+        /// <summary>
+        /// Headers sent with the request, which replace those of the same name set by the method.
+        /// </summary>
+        public IDictionary<string, string> Headers { get; set; }
+
+        /// <summary>
+        /// The token which cancels the request, unless one is passed to the method.
+        /// </summary>
+        public CancellationToken? CancellationToken { get; set; }
+
+        /// <summary>
+        /// The retry configuration of the request, used by clients which retry it.
+        /// </summary>
+        public RetryConfiguration RetryConfiguration { get; set; }
+    }
+
+    /// <summary>
+    /// A model which reads and writes its own members as JSON, without reflection.
+    /// </summary>
+    internal interface IApiJsonObject
+    {
+        /// <summary>
+        /// Reads the value of a member, and returns false when the model has no member of that name.
+        /// </summary>
+        bool ReadMember(string name, ApiJsonReader reader);
+
+        /// <summary>
+        /// Writes the members which are set.
+        /// </summary>
+        void WriteMembers(ApiJsonWriter writer);
+    }
+
+    /// <summary>
+    /// A forward-only reader of JSON text, which values are read from in the order they appear.
+    /// </summary>
+    internal sealed class ApiJsonReader
+    {
+        private readonly string _json;
+        private int _position;
+
+        public ApiJsonReader(string json)
+        {
+            _json = json;
+        }
+
+        /// <summary>
+        /// Reads an object into a new model, or returns null for a JSON null. Members the model doesn't know are skipped.
+        /// </summary>
+        public T ReadObject<T>() where T : class, IApiJsonObject, new()
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var value = new T();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var name = ReadName();
+                    if (!value.ReadMember(name, this))
+                    {
+                        Skip();
+                    }
+                } while (Consume(','));
+                Expect('}');
+            }
+            return value;
+        }
+
+        public List<T> ReadList<T>(Func<T> readItem)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var list = new List<T>();
+            Expect('[');
+            if (!Consume(']'))
+            {
+                do
+                {
+                    list.Add(readItem());
+                } while (Consume(','));
+                Expect(']');
+            }
+            return list;
+        }
+
+        public Dictionary<string, T> ReadMap<T>(Func<T> readValue)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var map = new Dictionary<string, T>();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var key = ReadName();
+                    map[key] = readValue();
+                } while (Consume(','));
+                Expect('}');
+            }
+            return map;
+        }
+
+        public T? ReadNullable<T>(Func<T> read) where T : struct => ReadNull() ? (T?) null : read();
+
+        /// <summary>
+        /// Reads a string, or the text of a number or literal in its place.
+        /// </summary>
+        public string ReadString()
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+            if (Peek() != '"')
+            {
+                return ReadLiteral();
+            }
+
+            _position++;
+            StringBuilder builder = null;
+            var start = _position;
+            while (_position < _json.Length)
+            {
+                var c = _json[_position];
+                if (c == '"')
+                {
+                    var value = builder == null
+                        ? _json.Substring(start, _position - start)
+                        : builder.Append(_json, start, _position - start).ToString();
+                    _position++;
+                    return value;
+                }
+                if (c != '\\')
+                {
+                    _position++;
+                    continue;
+                }
+
+                if (builder == null)
+                {
+                    builder = new StringBuilder();
+                }
+                builder.Append(_json, start, _position - start);
+                if (++_position >= _json.Length)
+                {
+                    break;
+                }
+                switch (_json[_position])
+                {
+                    case 'b':
+                        builder.Append('\b');
+                        break;
+                    case 'f':
+                        builder.Append('\f');
+                        break;
+                    case 'n':
+                        builder.Append('\n');
+                        break;
+                    case 'r':
+                        builder.Append('\r');
+                        break;
+                    case 't':
+                        builder.Append('\t');
+                        break;
+                    case 'u':
+                        if (_position + 4 >= _json.Length ||
+                            !int.TryParse(_json.Substring(_position + 1, 4), NumberStyles.AllowHexSpecifier,
+                                CultureInfo.InvariantCulture, out var code))
+                        {
+                            throw Error("invalid unicode escape");
+                        }
+                        builder.Append((char) code);
+                        _position += 4;
+                        break;
+                    default:
+                        builder.Append(_json[_position]);
+                        break;
+                }
+                start = ++_position;
+            }
+            throw Error("unterminated string");
+        }
+
+        public int ReadInt32() => int.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public long ReadInt64() => long.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public ulong ReadUInt64() => ulong.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public double ReadDouble() => double.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public float ReadSingle() => float.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public bool ReadBoolean() => ReadString() == "true";
+
+        /// <summary>
+        /// Skips the next value, whatever its type.
+        /// </summary>
+        public void Skip()
+        {
+            switch (Peek())
+            {
+                case '{':
+                    ReadMap(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                case '[':
+                    ReadList(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                default:
+                    ReadString();
+                    break;
+            }
+        }
+
+        private string ReadName()
+        {
+            if (Peek() != '"')
+            {
+                throw Error("expected a member name");
+            }
+            var name = ReadString();
+            Expect(':');
+            return name;
+        }
+
+        // Numbers which don't fit a double are sent as strings, and a null is read as the default value.
+        private string ReadNumber() => ReadString() ?? "0";
+
+        private string ReadLiteral()
+        {
+            var start = _position;
+            while (_position < _json.Length && ",:]} \t\r\n".IndexOf(_json[_position]) < 0)
+            {
+                _position++;
+            }
+            if (_position == start)
+            {
+                throw Error("expected a value");
+            }
+            return _json.Substring(start, _position - start);
+        }
+
+        private bool ReadNull()
+        {
+            if (Peek() != 'n' || string.CompareOrdinal(_json, _position, "null", 0, 4) != 0)
+            {
+                return false;
+            }
+            _position += 4;
+            return true;
+        }
+
+        private bool Consume(char c)
+        {
+            if (Peek() != c)
+            {
+                return false;
+            }
+            _position++;
+            return true;
+        }
+
+        private void Expect(char c)
+        {
+            if (!Consume(c))
+            {
+                throw Error(string.Concat("expected '", c.ToString(), "'"));
+            }
+        }
+
+        private char Peek()
+        {
+            while (_position < _json.Length && char.IsWhiteSpace(_json[_position]))
+            {
+                _position++;
+            }
+            return _position < _json.Length ? _json[_position] : '\0';
+        }
+
+        private FormatException Error(string message) =>
+            new FormatException(string.Concat("Invalid JSON at position ",
+                _position.ToString(CultureInfo.InvariantCulture), ": ", message));
+    }
+
+    /// <summary>
+    /// A writer of JSON text, which values are written to in order.
+    /// </summary>
+    internal sealed class ApiJsonWriter
+    {
+        private readonly StringBuilder _builder = new StringBuilder();
+        private bool _separate;
+
+        public void WriteName(string name)
+        {
+            WriteString(name);
+            _builder.Append(':');
+            _separate = false;
+        }
+
+        public void WriteObject(IApiJsonObject value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            value.WriteMembers(this);
+            _builder.Append('}');
+            _separate = true;
+        }
+
+        public void WriteList<T>(IEnumerable<T> values, Action<T> writeItem)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('[');
+            _separate = false;
+            foreach (var value in values)
+            {
+                writeItem(value);
+            }
+            _builder.Append(']');
+            _separate = true;
+        }
+
+        public void WriteMap<T>(IDictionary<string, T> values, Action<T> writeValue)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            foreach (var kvp in values)
+            {
+                WriteName(kvp.Key);
+                writeValue(kvp.Value);
+            }
+            _builder.Append('}');
+            _separate = true;
+        }
+
+        public void WriteString(string value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('"');
+            foreach (var c in value)
+            {
+                switch (c)
+                {
+                    case '"':
+                        _builder.Append("\\\"");
+                        break;
+                    case '\\':
+                        _builder.Append("\\\\");
+                        break;
+                    case '\n':
+                        _builder.Append("\\n");
+                        break;
+                    case '\r':
+                        _builder.Append("\\r");
+                        break;
+                    case '\t':
+                        _builder.Append("\\t");
+                        break;
+                    default:
+                        if (c < ' ')
+                        {
+                            _builder.Append("\\u").Append(((int) c).ToString("x4", CultureInfo.InvariantCulture));
+                        }
+                        else
+                        {
+                            _builder.Append(c);
+                        }
+                        break;
+                }
+            }
+            _builder.Append('"');
+            _separate = true;
+        }
+
+        public void WriteInt32(int value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteInt64(long value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteUInt64(ulong value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteDouble(double value)
+        {
+            // JSON has no literal for NaN or infinities, which are sent as strings.
+            if (double.IsNaN(value) || double.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
+
+        public void WriteSingle(float value)
+        {
+            // Formatted as a float, so that it isn't widened to a double with digits it never had, e.g. 0.1f.
+            if (float.IsNaN(value) || float.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
+
+        public void WriteBoolean(bool value) => WriteLiteral(value ? "true" : "false");
+
+        public void WriteNull() => WriteLiteral("null");
+
+        /// <summary>
+        /// Writes a value of unknown type, as parsed into dictionaries, lists and primitives.
+        /// </summary>
+        public void WriteAny(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    WriteNull();
+                    break;
+                case string s:
+                    WriteString(s);
+                    break;
+                case bool b:
+                    WriteBoolean(b);
+                    break;
+                case int i:
+                    WriteInt32(i);
+                    break;
+                case long l:
+                    WriteInt64(l);
+                    break;
+                case double d:
+                    WriteDouble(d);
+                    break;
+                case float f:
+                    WriteSingle(f);
+                    break;
+                case IApiJsonObject o:
+                    WriteObject(o);
+                    break;
+                case IDictionary<string, object> map:
+                    WriteMap(map, WriteAny);
+                    break;
+                case System.Collections.IEnumerable list:
+                    Separate();
+                    _builder.Append('[');
+                    _separate = false;
+                    foreach (var item in list)
+                    {
+                        WriteAny(item);
+                    }
+                    _builder.Append(']');
+                    _separate = true;
+                    break;
+                default:
+                    WriteString(Convert.ToString(value, CultureInfo.InvariantCulture));
+                    break;
+            }
+        }
+
+        public override string ToString() => _builder.ToString();
+
+        private void WriteLiteral(string value)
+        {
+            Separate();
+            _builder.Append(value);
+            _separate = true;
+        }
+
+        private void Separate()
+        {
+            if (_separate)
+            {
+                _builder.Append(',');
+            }
+        }
+    }
+
+    /// <summary>
+    /// 
+    /// </summary>
+    public interface IProtobufAny
+    {
+
+        /// <summary>
+        /// 
+        /// </summary>
+        string @type { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IProtobufAny Clone();
+    }
+
+    /// <inheritdoc />
+    internal class ProtobufAny : IProtobufAny, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="@type"), Preserve]
+        public string @type { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "@type":
+                    @type = reader.ReadString();
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            if (@type != null)
+            {
+                writer.WriteName("@type");
+                writer.WriteString(@type);
+            }
+        }
+
+        /// <inheritdoc />
+        public IProtobufAny Clone() => (ProtobufAny) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (ProtobufAny) MemberwiseClone();
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (ProtobufAny) obj;
+            return @type == other.@type;
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + (@type?.GetHashCode() ?? 0);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("@type: " + ApiClient.FormatValue(@type));
+        }
+    }
+
+    /// <summary>
+    /// 
+    /// </summary>
+    public interface IRpcStatus
+    {
+
+        /// <summary>
+        /// 
+        /// </summary>
+        int Code { get; }
+
+        /// <summary>
+        /// 
+        /// </summary>
+        string Message { get; }
+
+        /// <summary>
+        /// 
+        /// </summary>
+        IEnumerable<IProtobufAny> Details { get; }
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        IRpcStatus Clone();
+    }
+
+    /// <inheritdoc />
+    internal class RpcStatus : IRpcStatus, IApiJsonObject
+    {
+
+        /// <inheritdoc />
+        [DataMember(Name="code"), Preserve]
+        public int Code { get; set; }
+
+        /// <inheritdoc />
+        [DataMember(Name="message"), Preserve]
+        public string Message { get; set; }
+
+        /// <inheritdoc />
+        [IgnoreDataMember]
+        public IEnumerable<IProtobufAny> Details => _details ?? new List<ProtobufAny>(0);
+        [DataMember(Name="details"), Preserve]
+        public List<ProtobufAny> _details { get; set; }
+
+        /// <inheritdoc />
+        public virtual bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                case "code":
+                    Code = reader.ReadInt32();
+                    return true;
+                case "message":
+                    Message = reader.ReadString();
+                    return true;
+                case "details":
+                    _details = reader.ReadList(reader.ReadObject<ProtobufAny>);
+                    return true;
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public virtual void WriteMembers(ApiJsonWriter writer)
+        {
+            writer.WriteName("code");
+            writer.WriteInt32(Code);
+            if (Message != null)
+            {
+                writer.WriteName("message");
+                writer.WriteString(Message);
+            }
+            if (_details != null)
+            {
+                writer.WriteName("details");
+                writer.WriteList(_details, writer.WriteObject);
+            }
+        }
+
+        /// <inheritdoc />
+        public IRpcStatus Clone() => (RpcStatus) CloneModel();
+
+        internal virtual object CloneModel()
+        {
+            var clone = (RpcStatus) MemberwiseClone();
+            clone._details = _details?.ConvertAll(item => (ProtobufAny) item?.CloneModel());
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            if (ReferenceEquals(this, obj))
+            {
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
+            }
+
+            var other = (RpcStatus) obj;
+            return Code == other.Code &&
+                Message == other.Message &&
+                ApiClient.ListEquals(_details, other._details);
+        }
+
+        public override int GetHashCode()
+        {
+            unchecked
+            {
+                var hash = 17;
+                hash = hash * 31 + Code.GetHashCode();
+                hash = hash * 31 + (Message?.GetHashCode() ?? 0);
+                hash = hash * 31 + ApiClient.ListHashCode(_details);
+                return hash;
+            }
+        }
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
+
+        internal virtual void FormatMembers(List<string> members)
+        {
+            members.Add("Code: " + ApiClient.FormatValue(Code));
+            members.Add("Message: " + ApiClient.FormatValue(Message));
+            members.Add("Details: " + ApiClient.FormatList(_details));
         }
     }
 
@@ -56,5 +823,231 @@ namespace Satori.Console
             HttpAdapter = httpAdapter;
             Timeout = timeout;
         }
+
+        private async Task<string> SendAsync(string method, Uri uri, IDictionary<string, string> headers, byte[] body,
+            CancellationToken? cancellationToken, RequestOptions options)
+        {
+            if (options?.Headers != null)
+            {
+                foreach (var header in options.Headers)
+                {
+                    headers[header.Key] = header.Value;
+                }
+            }
+            var timeout = options?.Timeout ?? Timeout;
+            cancellationToken = cancellationToken ?? options?.CancellationToken;
+            return await HttpAdapter.SendAsync(method, uri, headers, body, timeout, cancellationToken);
+        }
+
+        private static T ParseResponse<T>(string contents, Func<ApiJsonReader, T> read) =>
+            string.IsNullOrEmpty(contents) ? default(T) : read(new ApiJsonReader(contents));
+
+        internal static long ParseInt64(string value)
+        {
+            long.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
+
+        internal static string FormatInt64(long value) => value.ToString(CultureInfo.InvariantCulture);
+
+        internal static ulong ParseUInt64(string value)
+        {
+            ulong.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
+
+        internal static string FormatUInt64(ulong value) => value.ToString(CultureInfo.InvariantCulture);
+
+        internal static DateTime ParseDateTime(string value)
+        {
+            DateTime.TryParse(value, CultureInfo.InvariantCulture,
+                DateTimeStyles.AdjustToUniversal | DateTimeStyles.AssumeUniversal, out var result);
+            return result;
+        }
+
+        internal static string FormatDateTime(DateTime value) =>
+            value.ToUniversalTime().ToString("yyyy-MM-dd'T'HH:mm:ss.FFFFFFF'Z'", CultureInfo.InvariantCulture);
+
+        internal static byte[] ParseBytes(string value) => value == null ? null : Convert.FromBase64String(value);
+
+        internal static string FormatBytes(byte[] value) => value == null ? null : Convert.ToBase64String(value);
+
+        private static byte[] EncodeForm(List<KeyValuePair<string, object>> form)
+        {
+            var fields = new List<string>(form.Count);
+            foreach (var field in form)
+            {
+                fields.Add(string.Concat(Uri.EscapeDataString(field.Key), "=", Uri.EscapeDataString((string) field.Value)));
+            }
+            return Encoding.UTF8.GetBytes(string.Join("&", fields));
+        }
+
+        private static byte[] EncodeMultipart(List<KeyValuePair<string, object>> form, string boundary)
+        {
+            using (var stream = new MemoryStream())
+            {
+                foreach (var field in form)
+                {
+                    var file = field.Value as byte[];
+                    var header = file == null
+                        ? $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"\r\n\r\n"
+                        : $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"; filename=\"{field.Key}\"\r\nContent-Type: application/octet-stream\r\n\r\n";
+                    var part = Encoding.UTF8.GetBytes(header);
+                    stream.Write(part, 0, part.Length);
+                    part = file ?? Encoding.UTF8.GetBytes((string) field.Value);
+                    stream.Write(part, 0, part.Length);
+                    part = Encoding.UTF8.GetBytes("\r\n");
+                    stream.Write(part, 0, part.Length);
+                }
+                var end = Encoding.UTF8.GetBytes($"--{boundary}--\r\n");
+                stream.Write(end, 0, end.Length);
+                return stream.ToArray();
+            }
+        }
+
+        internal static Dictionary<string, TOutput> ConvertMap<TInput, TOutput>(Dictionary<string, TInput> map,
+            Converter<TInput, TOutput> converter)
+        {
+            if (map == null)
+            {
+                return null;
+            }
+
+            var result = new Dictionary<string, TOutput>(map.Count);
+            foreach (var kvp in map)
+            {
+                result.Add(kvp.Key, converter(kvp.Value));
+            }
+            return result;
+        }
+
+        // Models compare a missing list or map as equal to an empty one, as the server may leave either out.
+        internal static bool ListEquals<T>(List<T> a, List<T> b)
+        {
+            var count = a?.Count ?? 0;
+            if (count != (b?.Count ?? 0))
+            {
+                return false;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            for (var i = 0; i < count; i++)
+            {
+                if (!comparer.Equals(a[i], b[i]))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+
+        internal static bool MapEquals<T>(Dictionary<string, T> a, Dictionary<string, T> b)
+        {
+            if ((a?.Count ?? 0) != (b?.Count ?? 0))
+            {
+                return false;
+            }
+            if (a == null || b == null)
+            {
+                return true;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in a)
+            {
+                if (!b.TryGetValue(kvp.Key, out var value) || !comparer.Equals(kvp.Value, value))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+
+        internal static int ListHashCode<T>(List<T> list)
+        {
+            var hash = 0;
+            if (list == null)
+            {
+                return hash;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var item in list)
+            {
+                hash = unchecked(hash * 31 + comparer.GetHashCode(item));
+            }
+            return hash;
+        }
+
+        // Entries are hashed apart and summed, so that maps hash alike whatever order they were filled in.
+        internal static int MapHashCode<T>(Dictionary<string, T> map)
+        {
+            var hash = 0;
+            if (map == null)
+            {
+                return hash;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in map)
+            {
+                hash = unchecked(hash + (kvp.Key.GetHashCode() ^ comparer.GetHashCode(kvp.Value)));
+            }
+            return hash;
+        }
+
+        internal static string FormatModel(string name, List<string> members) =>
+            members.Count == 0 ? string.Concat(name, " {}") : string.Concat(name, " { ", string.Join(", ", members), " }");
+
+        internal static string FormatValue(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    return "null";
+                case string text:
+                    return string.Concat("\"", text.Replace("\\", "\\\\").Replace("\"", "\\\""), "\"");
+                case bool flag:
+                    return flag ? "true" : "false";
+                case DateTime time:
+                    return FormatDateTime(time);
+                case IFormattable formattable:
+                    return formattable.ToString(null, CultureInfo.InvariantCulture);
+                default:
+                    return value.ToString();
+            }
+        }
+
+        internal static string FormatEncoded(string value) => value ?? "null";
+
+        internal static string FormatList<T>(List<T> list, Func<T, string> format = null)
+        {
+            if (list == null || list.Count == 0)
+            {
+                return "[]";
+            }
+
+            var items = new List<string>(list.Count);
+            foreach (var item in list)
+            {
+                items.Add(format == null ? FormatValue(item) : format(item));
+            }
+            return string.Concat("[", string.Join(", ", items), "]");
+        }
+
+        internal static string FormatMap<T>(IDictionary<string, T> map, Func<T, string> format = null)
+        {
+            if (map == null || map.Count == 0)
+            {
+                return "{}";
+            }
+
+            var entries = new List<string>(map.Count);
+            foreach (var kvp in map)
+            {
+                entries.Add(string.Concat(FormatValue(kvp.Key), ": ", format == null ? FormatValue(kvp.Value) : format(kvp.Value)));
+            }
+            return string.Concat("{ ", string.Join(", ", entries), " }");
+        }
     }
 }
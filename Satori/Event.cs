@@ -14,7 +14,6 @@
 
 using System;
 using System.Collections.Generic;
-using System.Xml;
 
 namespace Satori
 {
@@ -102,14 +101,13 @@ namespace Satori
             {
                 Id = this.Id,
                 Name = this.Name,
-                // Protobuf requires a DateTime string formatted as per RFC 3339
-                Timestamp = XmlConvert.ToString(this.Timestamp, XmlDateTimeSerializationMode.Utc),
+                Timestamp = this.Timestamp,
                 Value = this.Value,
                 _metadata = this.Metadata,
                 IdentityId = this.IdentityId,
                 SessionId = this.SessionId,
-                SessionIssuedAt = this.SessionIssuedAt,
-                SessionExpiresAt = this.SessionExpiresAt,
+                _sessionIssuedAt = this.SessionIssuedAt,
+                _sessionExpiresAt = this.SessionExpiresAt,
             };
         }
     }
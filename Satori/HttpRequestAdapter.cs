@@ -27,9 +27,9 @@ namespace Satori
     /// HTTP Request adapter which uses the .NET HttpClient to send requests.
     /// </summary>
     /// <remarks>
-    /// Accept header is always set as 'application/json'.
+    /// Accept header is set as 'application/json', except for binary requests which set their own.
     /// </remarks>
-    public class HttpRequestAdapter : IHttpAdapter
+    public class HttpRequestAdapter : IHttpBinaryAdapter
     {
         /// <inheritdoc cref="IHttpAdapter.Logger"/>
         public ILogger Logger { get; set; }
@@ -48,21 +48,11 @@ namespace Satori
         public async Task<string> SendAsync(string method, Uri uri, IDictionary<string, string> headers, byte[] body,
             int timeout, CancellationToken? userCancelToken)
         {
-            var request = new HttpRequestMessage
-            {
-                RequestUri = uri,
-                Method = new HttpMethod(method)
-            };
+            var request = CreateRequest(method, uri, headers, body);
             request.Headers.Accept.Add(new MediaTypeWithQualityHeaderValue("application/json"));
 
-            foreach (var kv in headers)
-            {
-                request.Headers.TryAddWithoutValidation(kv.Key, kv.Value);
-            }
-
             if (body != null)
             {
-                request.Content = new ByteArrayContent(body);
                 Logger?.InfoFormat("Send: method='{0}', uri='{1}', body='{2}'", method, uri,
                     System.Text.Encoding.UTF8.GetString(body));
             }
@@ -107,6 +97,61 @@ namespace Satori
                     HttpAdapterUtil.CopyResponseError(this, value, exception);
                 }
 
+                if (decoded.TryGetValue("details", out var details) && details is List<object> detailsList &&
+                    detailsList.Count > 0)
+                {
+                    exception.Data["details"] = detailsList;
+                }
+
+                throw exception;
+            }
+            catch (TaskCanceledException e) when (ctsTimeout.IsCancellationRequested)
+            {
+                Logger?.ErrorFormat("Request timed out: method='{0}', uri='{1}'", method, uri);
+                throw new TimeoutException($"The request timed out after {timeout} seconds.", e);
+            }
+            catch (Exception exception) when (!(exception is ApiResponseException))
+            {
+                Logger?.ErrorFormat("Request failed: method='{0}', uri='{1}', exception='{2}'", method, uri,
+                    exception);
+                throw;
+            }
+        }
+
+        /// <inheritdoc cref="IHttpBinaryAdapter"/>
+        public async Task<byte[]> SendBinaryAsync(string method, Uri uri, IDictionary<string, string> headers,
+            byte[] body, int timeout, CancellationToken? userCancelToken)
+        {
+            var request = CreateRequest(method, uri, headers, body);
+            Logger?.InfoFormat("Send: method='{0}', uri='{1}', length={2}", method, uri, body?.Length ?? 0);
+
+            using var ctsTimeout = new CancellationTokenSource(TimeSpan.FromSeconds(timeout));
+            using var cts =
+                CancellationTokenSource.CreateLinkedTokenSource(ctsTimeout.Token,
+                    userCancelToken ?? CancellationToken.None);
+
+            try
+            {
+                using var response = await _httpClient.SendAsync(request, cts.Token).ConfigureAwait(false);
+                var contents = await response.Content.ReadAsByteArrayAsync();
+
+                if (response.IsSuccessStatusCode)
+                {
+                    Logger?.InfoFormat("Received: status={0}, length={1}", response.StatusCode, contents.Length);
+                    return contents;
+                }
+
+                Logger?.ErrorFormat("Received: status={0}, length={1}", response.StatusCode, contents.Length);
+
+                var exception = new ApiResponseException((int)response.StatusCode,
+                    response.ReasonPhrase ?? string.Empty, -1);
+                // Only the client can decode its own media type, while other contents come from proxies in between.
+                if (headers.TryGetValue("Accept", out var accept) &&
+                    response.Content.Headers.ContentType?.MediaType == accept)
+                {
+                    exception.Data["content"] = contents;
+                }
+
                 throw exception;
             }
             catch (TaskCanceledException e) when (ctsTimeout.IsCancellationRequested)
@@ -122,6 +167,33 @@ namespace Satori
             }
         }
 
+        private static HttpRequestMessage CreateRequest(string method, Uri uri, IDictionary<string, string> headers,
+            byte[] body)
+        {
+            var request = new HttpRequestMessage
+            {
+                RequestUri = uri,
+                Method = new HttpMethod(method)
+            };
+
+            foreach (var kv in headers)
+            {
+                request.Headers.TryAddWithoutValidation(kv.Key, kv.Value);
+            }
+
+            if (body != null)
+            {
+                request.Content = new ByteArrayContent(body);
+                if (headers.TryGetValue("Content-Type", out var contentType))
+                {
+                    // Content headers are rejected by the request headers, so the body carries its own type.
+                    request.Content.Headers.TryAddWithoutValidation("Content-Type", contentType);
+                }
+            }
+
+            return request;
+        }
+
         /// <summary>
         /// A new HTTP adapter with configuration for gzip support in the underlying HTTP client.
         /// </summary>
@@ -0,0 +1,465 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+const clientTemplate string = `/* Code generated by codegen/main.go. DO NOT EDIT. */
+
+{{- if ne .Namespace "" }}
+namespace {{.Namespace}}
+{{- end }}
+{
+    using System;
+    using System.Collections.Generic;
+    using System.Linq;
+    using System.Threading;
+    using System.Threading.Tasks;
+
+    public partial interface {{ .Facade.Interface }}
+    {
+        {{- range $method := .Facade.Methods }}
+
+        /// <summary>
+        /// {{ $method.Summary | stripNewlines }}
+        /// </summary>
+        {{- if $method.Session }}
+        /// <param name="session">The session of the user.</param>
+        {{- end }}
+        {{- range $param := $method.Params }}
+        /// <param name="{{ $param.Name }}">{{ $param.Description | stripNewlines }}</param>
+        {{- end }}
+        /// <param name="retryConfiguration">The retry configuration. See <see cref="RetryConfiguration"/></param>
+        /// <param name="canceller">The <see cref="CancellationToken"/> that can be used to cancel the request while mid-flight, in place of the one of the options.</param>
+        /// <param name="options">The options of the request, such as its timeout and extra headers.</param>
+        {{- if $method.Returns }}
+        /// <returns>A task which resolves to the <see cref="{{ $method.Returns }}"/> response.</returns>
+        {{- else }}
+        /// <returns>A task which represents the asynchronous operation.</returns>
+        {{- end }}
+        {{ template "signature" $method }};
+        {{- end }}
+    }
+
+    public partial class {{ .Facade.Class }}
+    {
+        {{- range $method := .Facade.Methods }}
+
+        /// <inheritdoc cref="{{ $method.Name }}"/>
+        public async {{ template "signature" $method }}
+        {
+            if (canceller == default && options?.CancellationToken != null)
+            {
+                canceller = options.CancellationToken.Value;
+            }
+            {{- if $method.Session }}
+            if (AutoRefreshSession && !string.IsNullOrEmpty(session.RefreshToken) &&
+                session.HasExpired(DateTime.UtcNow.Add(DefaultExpiredTimeSpan)))
+            {
+                await SessionRefreshAsync(session, null, retryConfiguration ?? options?.RetryConfiguration, canceller);
+            }
+{{ end }}
+            {{- if $method.Result }}
+            var response = await _retryInvoker.InvokeWithRetry(
+            {{- else if $method.Returns }}
+            return await _retryInvoker.InvokeWithRetry(
+            {{- else }}
+            await _retryInvoker.InvokeWithRetry(
+            {{- end }}
+                () => _apiClient.{{ $method.Target }}({{ range $argument := $method.Arguments }}{{ $argument }}, {{ end }}canceller, options),
+                new RetryHistory({{ $method.RetryKey }},
+                    retryConfiguration ?? options?.RetryConfiguration ?? GlobalRetryConfiguration, canceller));
+            {{- if $method.Result }}
+            return {{ $method.Result }};
+            {{- end }}
+        }
+        {{- end }}
+    }
+}
+
+{{- define "signature" }}
+{{- if .Returns }}Task<{{ .Returns }}>{{ else }}Task{{ end }} {{ .Name }}(
+{{- if .Session }}ISession session, {{ end }}
+{{- range $param := .Params }}{{ $param.Type }} {{ $param.Name }}{{ if $param.Default }} = {{ $param.Default }}{{ end }}, {{ end -}}
+RetryConfiguration retryConfiguration = null, CancellationToken canceller = default, RequestOptions options = null)
+{{- end }}
+`
+
+// ClientConfig shapes the Client facade generated over the low-level ApiClient.
+type ClientConfig struct {
+	// Name of the facade class, which must declare the hand-written members the generated methods rely on.
+	Class string `json:"class"`
+	// Name of the interface the facade implements.
+	Interface string `json:"interface"`
+	// Expression passed as the basic auth username of operations called without a session.
+	ServerKey string `json:"serverKey"`
+	// Options for each operation, by operation id. These replace any x-client extension of the operation.
+	Operations map[string]*ClientOptions `json:"operations"`
+}
+
+// ClientOptions customizes the facade method of one operation. It is read from the client config or the operation's
+// x-client vendor extension.
+type ClientOptions struct {
+	// Leaves the operation out of the facade, for methods that are written by hand.
+	Skip bool `json:"skip"`
+	// Name of the facade method, which defaults to the name of the ApiClient method it calls.
+	Name string `json:"name"`
+	// New names for the method's parameters, by their generated name.
+	Rename map[string]string `json:"rename"`
+	// Default value expressions, by parameter name. Parameters with a default move after the required ones.
+	Defaults map[string]string `json:"defaults"`
+	// Fixed value expressions for request fields which are not exposed as parameters, by parameter name.
+	Values map[string]string `json:"values"`
+	// Type returned by the facade method in place of the response, built by the Result expression.
+	ResultType string `json:"resultType"`
+	// Expression over "response" returned by the facade method.
+	Result string `json:"result"`
+}
+
+// Facade holds the methods of the generated IClient interface and Client class.
+type Facade struct {
+	Class     string
+	Interface string
+	Methods   []FacadeMethod
+}
+
+// FacadeMethod is a session-aware, retried wrapper around one ApiClient method.
+type FacadeMethod struct {
+	Name    string
+	Summary string
+	Returns string
+	// Whether the method takes the user's session, which is refreshed before the call when it is about to expire.
+	Session bool
+	Params  []FacadeParam
+	// The wrapped ApiClient method and its arguments, without the cancellation token and request options.
+	Target    string
+	Arguments []string
+	RetryKey  string
+	Result    string
+}
+
+// FacadeParam is one parameter of a facade method.
+type FacadeParam struct {
+	Name        string
+	Type        string
+	Default     string
+	Description string
+}
+
+// loadClientConfig reads a client config file. An empty path gives the default configuration.
+func loadClientConfig(path string) (*ClientConfig, error) {
+	config := &ClientConfig{}
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(content, config); err != nil {
+			return nil, err
+		}
+	}
+	if config.Class == "" {
+		config.Class = "Client"
+	}
+	if config.Interface == "" {
+		config.Interface = "I" + config.Class
+	}
+	if config.ServerKey == "" {
+		config.ServerKey = "ServerKey"
+	}
+	return config, nil
+}
+
+// generateFacade builds a facade method for every operation of the schema. Operations the facade cannot express are
+// reported and left out, to be written by hand or shaped with client options.
+func generateFacade(s *Schema, config *ClientConfig) {
+	s.Facade = &Facade{
+		Class:     config.Class,
+		Interface: config.Interface,
+	}
+
+	for _, path := range s.Paths {
+		for _, operation := range path {
+			options := operation.Client
+			if configured, ok := config.Operations[operation.OperationId]; ok {
+				options = configured
+			}
+			if options == nil {
+				options = &ClientOptions{}
+			}
+			if options.Skip {
+				continue
+			}
+
+			method, err := s.facadeMethod(operation, options, config)
+			if err != nil {
+				warnf(operation.Source, "Skipping facade method for %s: %s", operation.OperationId, err)
+				continue
+			}
+			s.Facade.Methods = append(s.Facade.Methods, method)
+		}
+	}
+
+	sort.Slice(s.Facade.Methods, func(i, j int) bool {
+		if s.Facade.Methods[i].Name != s.Facade.Methods[j].Name {
+			return s.Facade.Methods[i].Name < s.Facade.Methods[j].Name
+		}
+		return s.Facade.Methods[i].Target < s.Facade.Methods[j].Target
+	})
+}
+
+// facadeMethod maps the parameters of an ApiClient method onto the facade. Authentication comes from the session, or
+// the server key when the operation does not accept a bearer token, and body fields become parameters of their own.
+func (s *Schema) facadeMethod(operation Operation, options *ClientOptions, config *ClientConfig) (FacadeMethod, error) {
+	method := FacadeMethod{
+		Name:    methodName(operation.OperationId),
+		Summary: operation.Summary,
+		Target:  methodName(operation.OperationId),
+		Result:  options.Result,
+	}
+	if options.Name != "" {
+		method.Name = options.Name
+	}
+	method.Returns = operation.Result.Type
+	if options.ResultType != "" {
+		method.Returns = options.ResultType
+	}
+
+	// The authentication arguments come first, in the order the ApiClient declares them. A session authenticates with
+	// its token wherever it can, and the server key stands in for the credentials of the others.
+	auth := facadeAuthentication(operation.Auth)
+	for _, credential := range auth.Credentials {
+		if credential.Kind == "bearer" {
+			method.Session = true
+		}
+	}
+	for _, credential := range auth.Credentials {
+		switch {
+		case credential.Kind == "bearer":
+			method.Arguments = append(method.Arguments, "session.AuthToken")
+		case method.Session && credential.Kind == "basic":
+			method.Arguments = append(method.Arguments, "string.Empty", "string.Empty")
+		case method.Session:
+			method.Arguments = append(method.Arguments, "null")
+		case credential.Kind == "basic":
+			method.Arguments = append(method.Arguments, config.ServerKey, "string.Empty")
+		case credential.Kind == "apiKey":
+			// The key is sent as the header or query parameter the scheme names. An API key in the Authorization
+			// header is the session token, which is resolved as a bearer credential above.
+			method.Arguments = append(method.Arguments, config.ServerKey)
+		default:
+			method.Arguments = append(method.Arguments, "null")
+		}
+	}
+
+	var required, optional []FacadeParam
+	declared := make(map[string]bool)
+	// argument exposes a value as a facade parameter, unless it is fixed by the options, and returns the expression
+	// that passes it on.
+	argument := func(name string, param FacadeParam) string {
+		if value, ok := options.Values[name]; ok {
+			return value
+		}
+		param.Name = unclashedName(csharpIdentifier(name), facadeNames)
+		if rename, ok := options.Rename[name]; ok {
+			param.Name = rename
+		}
+		if param.Description == "" {
+			param.Description = fmt.Sprintf("The %s.", strings.ReplaceAll(camelToSnake(name), "_", " "))
+		}
+		if declared[param.Name] {
+			return param.Name
+		}
+		declared[param.Name] = true
+		if value, ok := options.Defaults[name]; ok {
+			param.Default = value
+		}
+		if param.Default != "" {
+			optional = append(optional, param)
+		} else {
+			required = append(required, param)
+		}
+		return param.Name
+	}
+
+	for _, parameter := range operation.OrderedParameters() {
+		name := argumentName(parameter.Name)
+		switch {
+		case parameter.In == "body" && parameter.Schema.Ref == "":
+			method.Arguments = append(method.Arguments, argument(name, FacadeParam{
+				Type:        "string",
+				Description: descriptionOrTitle(parameter.Description, parameter.Schema.Description),
+			}))
+		case parameter.In == "body":
+			body, err := s.facadeBody(parameter.Schema.Ref, argument)
+			if err != nil {
+				return method, err
+			}
+			method.Arguments = append(method.Arguments, body)
+		default:
+			param := FacadeParam{
+				Type:        parameterType(parameter),
+				Description: parameter.Description,
+			}
+			if !parameter.Required {
+				param.Default = "null"
+			}
+			method.Arguments = append(method.Arguments, argument(name, param))
+		}
+	}
+	method.Params = append(required, optional...)
+
+	method.RetryKey = config.ServerKey
+	if method.Session {
+		method.RetryKey = "session"
+	} else {
+		for _, param := range method.Params {
+			if param.Type == "string" {
+				method.RetryKey = param.Name
+				break
+			}
+		}
+	}
+	return method, nil
+}
+
+// facadeBody builds the request object of a body parameter from one facade parameter per field.
+func (s *Schema) facadeBody(ref string, argument func(string, FacadeParam) string) (string, error) {
+	className := convertRefToClassName(ref)
+	definition, ok := s.lookupDefinition(strings.TrimPrefix(ref, "#/definitions/"))
+	if !ok {
+		return "", fmt.Errorf("no definition found for body %s", ref)
+	}
+	if len(definition.Enum) > 0 {
+		return "", fmt.Errorf("body %s is an enum", ref)
+	}
+
+	var fields []string
+	for _, property := range orderedProperties(definition) {
+		name := snakeToCamel(property.Name)
+		member, param, value := s.facadeField(property)
+		param.Description = descriptionOrTitle(property.Description, property.Title)
+		fields = append(fields, fmt.Sprintf("%s = %s", member, strings.ReplaceAll(value, "{}", argument(name, param))))
+	}
+	if len(fields) == 0 {
+		return fmt.Sprintf("new %s()", className), nil
+	}
+	return fmt.Sprintf("new %s { %s }", className, strings.Join(fields, ", ")), nil
+}
+
+// facadeField returns the member of the generated request class a body field is set through, the facade parameter
+// for it, and the value assigned from that parameter with "{}" in place of its name.
+func (s *Schema) facadeField(property NamedProperty) (member string, param FacadeParam, value string) {
+	backing := "_" + snakeToCamel(property.Name)
+	if t := primitive(property.Type, property.Format); t != "" {
+		param = FacadeParam{Type: nullable(property.Nullable, t)}
+		if property.Nullable {
+			// Unset fields are left out of the request, so the server keeps their current value.
+			param.Default = "null"
+		}
+		return snakeToPascal(property.Name), param, "{}"
+	}
+
+	switch property.Type {
+	case "array":
+		if t := primitive(property.Items.Type, property.Items.Format); t != "" {
+			return snakeToPascal(property.Name), FacadeParam{Type: "IEnumerable<" + t + ">", Default: "null"}, "{}?.ToList()"
+		}
+		className := convertRefToClassName(property.Items.Ref)
+		if s.isEnum(property.Items.Ref) {
+			return snakeToPascal(property.Name), FacadeParam{Type: "IEnumerable<" + className + ">", Default: "null"}, "{}?.ToList()"
+		}
+		return backing, FacadeParam{Type: "IEnumerable<I" + className + ">", Default: "null"}, "{}?.Cast<" + className + ">().ToList()"
+	case "object":
+		t := primitive(property.AdditionalProperties.Type, property.AdditionalProperties.Format)
+		switch {
+		case t != "" && isEncoded(property.AdditionalProperties.Type, property.AdditionalProperties.Format):
+			value = "ApiClient.ConvertMap({}, ApiClient.Format" + converter(t) + ")"
+			return backing, FacadeParam{Type: "Dictionary<string, " + t + ">", Default: "null"}, value
+		case t != "":
+			return backing, FacadeParam{Type: "Dictionary<string, " + t + ">", Default: "null"}, "{}"
+		}
+		className := convertRefToClassName(property.AdditionalProperties.Ref)
+		if s.isEnum(property.AdditionalProperties.Ref) {
+			value = "ApiClient.ConvertMap({}, " + enumConverter(className) + ".Format)"
+			return backing, FacadeParam{Type: "Dictionary<string, " + className + ">", Default: "null"}, value
+		}
+		value = "ApiClient.ConvertMap({}, value => (" + className + ") value)"
+		return backing, FacadeParam{Type: "Dictionary<string, I" + className + ">", Default: "null"}, value
+	}
+
+	className := convertRefToClassName(property.Ref)
+	if s.isEnum(property.Ref) {
+		param = FacadeParam{Type: nullable(property.Nullable, className)}
+		if property.Nullable {
+			param.Default = "null"
+		}
+		return snakeToPascal(property.Name), param, "{}"
+	}
+	return backing, FacadeParam{Type: "I" + className, Default: "null"}, "(" + className + ") {}"
+}
+
+// facadeNames are the names which the generated facade methods declare besides the parameters of operations.
+var facadeNames = map[string]bool{
+	"canceller": true, "options": true, "response": true, "retryConfiguration": true, "session": true, "value": true,
+}
+
+// csharpIdentifier escapes names which are reserved keywords in C#.
+func csharpIdentifier(name string) string {
+	switch name {
+	case "base", "bool", "byte", "case", "catch", "char", "checked", "class", "const", "continue", "decimal", "default",
+		"delegate", "do", "double", "else", "enum", "event", "explicit", "extern", "false", "finally", "fixed", "float",
+		"for", "foreach", "goto", "if", "implicit", "in", "int", "interface", "internal", "is", "lock", "long",
+		"namespace", "new", "null", "object", "operator", "out", "override", "params", "private", "protected", "public",
+		"readonly", "ref", "return", "sbyte", "sealed", "short", "sizeof", "stackalloc", "static", "string", "struct",
+		"switch", "this", "throw", "true", "try", "typeof", "uint", "ulong", "unchecked", "unsafe", "ushort", "using",
+		"virtual", "void", "volatile", "while":
+		return "@" + name
+	}
+	return name
+}
+
+func sortedKeys(requirement map[string][]struct{}) []string {
+	keys := make([]string, 0, len(requirement))
+	for key := range requirement {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// facadeAuthentication picks the overload a facade method calls: the first which takes a session token, else the
+// first which takes basic credentials, else the first of all.
+func facadeAuthentication(auth []Authentication) Authentication {
+	for _, kind := range []string{"bearer", "basic"} {
+		for _, a := range auth {
+			for _, credential := range a.Credentials {
+				if credential.Kind == kind {
+					return a
+				}
+			}
+		}
+	}
+	if len(auth) == 0 {
+		return Authentication{}
+	}
+	return auth[0]
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFacadeMethod(t *testing.T) {
+	schema, err := decodeInput([]byte(`{
+  "swagger": "2.0",
+  "securityDefinitions": {
+    "BasicAuth": {"type": "basic"},
+    "BearerJwt": {"type": "apiKey", "name": "Authorization", "in": "header"},
+    "HttpKeyAuth": {"type": "apiKey", "name": "http_key", "in": "header"}
+  },
+  "security": [{"BearerJwt": []}],
+  "paths": {
+    "/v2/account": {
+      "get": {"operationId": "Nakama_GetAccount", "responses": {"200": {"description": ""}}}
+    },
+    "/v2/account/authenticate": {
+      "post": {"operationId": "Nakama_AuthenticateDevice", "security": [{"BasicAuth": []}],
+        "responses": {"200": {"description": ""}}}
+    },
+    "/v2/rpc/{id}": {
+      "get": {"operationId": "Nakama_RpcFunc", "security": [{"HttpKeyAuth": []}],
+        "parameters": [{"name": "id", "in": "path", "required": true, "type": "string"}],
+        "responses": {"200": {"description": ""}}}
+    },
+    "/v1/flag": {
+      "get": {"operationId": "Satori_GetFlags", "security": [],
+        "responses": {"200": {"description": ""}}}
+    }
+  }
+}`), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Facade methods are named after the ApiClient methods they call, whichever namespace they're generated in.
+	schema.Namespace = "Satori"
+	if err := resolveSchema(schema); err != nil {
+		t.Fatal(err)
+	}
+	resolveResults(schema)
+	config, err := loadClientConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		url, method string
+		name        string
+		session     bool
+		arguments   []string
+	}{
+		// An API key in the Authorization header is the session token.
+		{"/v2/account", "get", "GetAccountAsync", true, []string{"session.AuthToken"}},
+		{"/v2/account/authenticate", "post", "AuthenticateDeviceAsync", false, []string{"ServerKey", "string.Empty"}},
+		// Any other API key takes the server key, which is sent as the header the scheme names.
+		{"/v2/rpc/{id}", "get", "RpcFuncAsync", false, []string{"ServerKey", "id"}},
+		{"/v1/flag", "get", "SatoriGetFlagsAsync", false, nil},
+	}
+	for _, test := range tests {
+		operation := schema.Paths[test.url][test.method]
+		method, err := schema.facadeMethod(operation, &ClientOptions{}, config)
+		if err != nil {
+			t.Errorf("%s: %s", operation.OperationId, err)
+			continue
+		}
+		if method.Name != test.name || method.Target != test.name {
+			t.Errorf("%s: got the method %s calling %s, want both named %s", operation.OperationId, method.Name, method.Target, test.name)
+		}
+		if method.Session != test.session || !reflect.DeepEqual(method.Arguments, test.arguments) {
+			t.Errorf("%s: got session %v and arguments %q, want %v and %q", operation.OperationId, method.Session, method.Arguments, test.session, test.arguments)
+		}
+	}
+
+	// A client option renames the facade method, but not the ApiClient method it calls.
+	operation := schema.Paths["/v2/account"]["get"]
+	method, err := schema.facadeMethod(operation, &ClientOptions{Name: "FetchAccountAsync"}, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method.Name != "FetchAccountAsync" || method.Target != "GetAccountAsync" {
+		t.Errorf("got the method %s calling %s, want FetchAccountAsync calling GetAccountAsync", method.Name, method.Target)
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Discriminator names the property which tells the variants of a composed schema apart. Swagger 2.0 gives only the
+// property name, while OpenAPI 3 may also map its values to schemas.
+type Discriminator struct {
+	PropertyName string
+	Mapping      map[string]string
+}
+
+func (d *Discriminator) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &d.PropertyName); err == nil {
+		return nil
+	}
+	type discriminator Discriminator
+	return json.Unmarshal(data, (*discriminator)(d))
+}
+
+// Variant is one of the definitions a oneOf or anyOf definition may hold.
+type Variant struct {
+	// The property which returns the value as this variant.
+	Name  string
+	Class string
+	// The C# condition under which the value holds this variant, or empty when it always may.
+	Condition string
+}
+
+// composer resolves the allOf, oneOf and anyOf of definitions into the inheritance and variants the template renders.
+type composer struct {
+	schema   *Schema
+	composed map[string]bool
+}
+
+// composeDefinitions resolves the composed schemas of every definition. An allOf derives from the class of its first
+// referenced object and extends the interfaces of the others, while a oneOf or anyOf becomes a class with the
+// properties of all its variants and a property for each which returns the value as that variant. Composition which
+// can't be represented is reported on stderr, along with what is left out.
+func composeDefinitions(s *Schema) {
+	c := &composer{schema: s, composed: make(map[string]bool, len(s.Definitions))}
+	names := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c.compose(name)
+	}
+}
+
+// definition returns a definition by reference, composing it first.
+func (c *composer) definition(ref string) (ObjectDefinition, bool) {
+	name := strings.TrimPrefix(ref, "#/definitions/")
+	for _, key := range []string{name, camelToPascal(name), pascalToCamel(name)} {
+		if _, ok := c.schema.Definitions[key]; ok {
+			c.compose(key)
+			return c.schema.Definitions[key], true
+		}
+	}
+	return c.schema.lookupDefinition(name)
+}
+
+func (c *composer) compose(name string) {
+	if c.composed[name] {
+		return
+	}
+	// Mark the definition first so that a cycle of references terminates.
+	c.composed[name] = true
+
+	def := c.schema.Definitions[name]
+	if len(def.AllOf) == 0 && len(def.OneOf) == 0 && len(def.AnyOf) == 0 {
+		return
+	}
+
+	var diagnostics []string
+	report := func(format string, args ...interface{}) {
+		diagnostics = append(diagnostics, fmt.Sprintf(format, args...))
+	}
+
+	properties := make(map[string]ObjectProperty, len(def.Properties))
+	for name, property := range def.Properties {
+		properties[name] = property
+	}
+	def.Properties = properties
+	def.PropertyOrder = nil
+
+	if len(def.AllOf) > 0 {
+		c.composeAllOf(&def, report)
+	}
+	switch {
+	case len(def.OneOf) > 0 && len(def.AnyOf) > 0:
+		report("has both oneOf and anyOf; the anyOf is left out")
+		c.composeVariants(&def, def.OneOf, true, report)
+	case len(def.OneOf) > 0:
+		c.composeVariants(&def, def.OneOf, true, report)
+	case len(def.AnyOf) > 0:
+		c.composeVariants(&def, def.AnyOf, false, report)
+	}
+
+	c.schema.Definitions[name] = def
+	for _, diagnostic := range diagnostics {
+		warnf(def.Source, "Definition %s %s", name, diagnostic)
+	}
+}
+
+func (c *composer) composeAllOf(def *ObjectDefinition, report func(string, ...interface{})) {
+	var inherited map[string]ObjectProperty
+	for i, part := range def.AllOf {
+		if part.Ref == "" {
+			if len(part.AllOf) > 0 || len(part.OneOf) > 0 || len(part.AnyOf) > 0 {
+				report("has a composed schema inline in allOf[%d], which is left out", i)
+			}
+			for _, property := range orderedProperties(part) {
+				c.addProperty(def, property, inherited, report)
+			}
+			continue
+		}
+
+		className := convertRefToClassName(part.Ref)
+		partDef, ok := c.definition(part.Ref)
+		switch {
+		case !ok:
+			report("references unknown definition %s in allOf[%d], which is left out", part.Ref, i)
+			continue
+		case len(partDef.Enum) > 0:
+			report("references enum %s in allOf[%d], which is left out", className, i)
+			continue
+		case len(partDef.Variants) > 0:
+			report("references oneOf or anyOf %s in allOf[%d], which is left out", className, i)
+			continue
+		}
+
+		properties := c.allProperties(partDef)
+		if def.Base == "" {
+			def.Base = className
+			def.Extends = append(def.Extends, "I"+className)
+			inherited = make(map[string]ObjectProperty, len(properties))
+			for _, property := range properties {
+				inherited[property.Name] = property.ObjectProperty
+			}
+			// Properties declared alongside the allOf are already declared by the base class.
+			for name, property := range def.Properties {
+				if base, ok := inherited[name]; ok {
+					if !sameType(base, property) {
+						report("redeclares property %s of %s with another type, which is left out", name, className)
+					}
+					delete(def.Properties, name)
+				}
+			}
+			continue
+		}
+
+		// An interface which shares a property with another would make that property ambiguous, so its properties
+		// are declared again instead.
+		shared := ""
+		for _, property := range properties {
+			if !c.addProperty(def, property, inherited, report) && shared == "" {
+				shared = property.Name
+			}
+		}
+		if shared != "" {
+			report("shares property %s with another member of allOf, so it doesn't extend the interface of %s", shared, className)
+			continue
+		}
+		def.Extends = append(def.Extends, "I"+className)
+		if def.Inherited == nil {
+			def.Inherited = make(map[string]bool)
+		}
+		for _, property := range properties {
+			def.Inherited[property.Name] = true
+		}
+	}
+}
+
+// addProperty adds a property to a composed definition unless it, or its base class, already declares one by that
+// name. It reports whether the property was added.
+func (c *composer) addProperty(def *ObjectDefinition, property NamedProperty, inherited map[string]ObjectProperty, report func(string, ...interface{})) bool {
+	existing, ok := inherited[property.Name]
+	if !ok {
+		existing, ok = def.Properties[property.Name]
+	}
+	if !ok {
+		def.Properties[property.Name] = property.ObjectProperty
+		return true
+	}
+	if !sameType(existing, property.ObjectProperty) {
+		report("declares property %s with conflicting types, and keeps the first", property.Name)
+	}
+	return false
+}
+
+func (c *composer) composeVariants(def *ObjectDefinition, parts []ObjectDefinition, exclusive bool, report func(string, ...interface{})) {
+	type candidate struct {
+		ref        string
+		class      string
+		properties []NamedProperty
+	}
+	var candidates []candidate
+	for i, part := range parts {
+		if part.Ref == "" {
+			report("has an inline schema as variant %d, which is left out", i)
+			continue
+		}
+		className := convertRefToClassName(part.Ref)
+		partDef, ok := c.definition(part.Ref)
+		switch {
+		case !ok:
+			report("references unknown definition %s as variant %d, which is left out", part.Ref, i)
+			continue
+		case len(partDef.Enum) > 0 || len(partDef.Variants) > 0:
+			report("has %s as variant %d, which is not an object and is left out", className, i)
+			continue
+		}
+
+		properties := c.allProperties(partDef)
+		conflict := false
+		for _, property := range properties {
+			if existing, ok := def.Properties[property.Name]; ok && !sameType(existing, property.ObjectProperty) {
+				report("has variant %s whose property %s conflicts with another variant; the variant is left out", className, property.Name)
+				conflict = true
+				break
+			}
+		}
+		if conflict {
+			continue
+		}
+		for _, property := range properties {
+			if _, ok := def.Properties[property.Name]; !ok {
+				def.Properties[property.Name] = property.ObjectProperty
+			}
+		}
+		candidates = append(candidates, candidate{ref: part.Ref, class: className, properties: properties})
+	}
+
+	// Properties which only one variant declares tell it apart when there is no discriminator.
+	declaredBy := make(map[string]int)
+	for _, candidate := range candidates {
+		for _, property := range candidate.properties {
+			declaredBy[property.Name]++
+		}
+	}
+
+	names := make(map[string]bool, len(def.Properties))
+	for name := range def.Properties {
+		names[snakeToPascal(name)] = true
+	}
+
+	var discriminator NamedProperty
+	if def.Discriminator != nil {
+		property, ok := def.Properties[def.Discriminator.PropertyName]
+		switch {
+		case !ok:
+			report("has discriminator %s which is not one of its properties, and is told apart by its properties instead", def.Discriminator.PropertyName)
+		case primitive(property.Type, property.Format) != "string" && !c.schema.isEnum(property.Ref):
+			report("has discriminator %s which is not a string, and is told apart by its properties instead", def.Discriminator.PropertyName)
+		default:
+			discriminator = NamedProperty{Name: def.Discriminator.PropertyName, ObjectProperty: property}
+		}
+	}
+
+	for _, candidate := range candidates {
+		variant := Variant{Name: variantName(candidate.class), Class: candidate.class}
+		for names[variant.Name] {
+			variant.Name += "Variant"
+		}
+		names[variant.Name] = true
+
+		var conditions []string
+		if discriminator.Name != "" {
+			for _, value := range discriminatorValues(def.Discriminator, candidate.ref) {
+				conditions = append(conditions, fmt.Sprintf("%s == %q", stringMember(discriminator), value))
+			}
+		} else {
+			for _, property := range candidate.properties {
+				if presence := presence(property); presence != "" && declaredBy[property.Name] == 1 {
+					conditions = append(conditions, presence)
+				}
+			}
+			if len(conditions) == 0 && exclusive && len(candidates) > 1 {
+				report("can't tell variant %s apart without a discriminator, so it is always returned", candidate.class)
+			}
+		}
+		variant.Condition = strings.Join(conditions, " || ")
+		if len(conditions) > 1 {
+			variant.Condition = "(" + variant.Condition + ")"
+		}
+
+		def.Implements = append(def.Implements, "I"+candidate.class)
+		def.Variants = append(def.Variants, variant)
+	}
+}
+
+// allProperties lists the properties of a definition along with those of the classes it derives from.
+func (c *composer) allProperties(def ObjectDefinition) []NamedProperty {
+	properties := orderedProperties(def)
+	if def.Base == "" {
+		return properties
+	}
+	base, ok := c.definition(def.Base)
+	if !ok {
+		return properties
+	}
+	return append(c.allProperties(base), properties...)
+}
+
+// sameType reports whether two properties are rendered with the same C# type.
+func sameType(a, b ObjectProperty) bool {
+	return a.Type == b.Type && a.Format == b.Format && a.Ref == b.Ref && a.Nullable == b.Nullable &&
+		a.Items.Type == b.Items.Type && a.Items.Format == b.Items.Format && a.Items.Ref == b.Items.Ref &&
+		a.AdditionalProperties.Type == b.AdditionalProperties.Type &&
+		a.AdditionalProperties.Format == b.AdditionalProperties.Format &&
+		a.AdditionalProperties.Ref == b.AdditionalProperties.Ref
+}
+
+// discriminatorValues lists the discriminator values which select a variant, which default to the definition name.
+func discriminatorValues(discriminator *Discriminator, ref string) []string {
+	var values []string
+	for value, target := range discriminator.Mapping {
+		if target == ref {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return []string{strings.TrimPrefix(ref, "#/definitions/")}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// variantName names the property of a variant after its class, without the legacy "Api" prefix.
+func variantName(className string) string {
+	if name := strings.TrimPrefix(className, "Api"); name != "" && unicode.IsUpper(rune(name[0])) {
+		return name
+	}
+	return className
+}
+
+// stringMember is the member holding a string or enum property as the string sent in JSON.
+func stringMember(property NamedProperty) string {
+	if property.Ref != "" {
+		return "_" + snakeToCamel(property.Name)
+	}
+	return snakeToPascal(property.Name)
+}
+
+// presence is the C# condition under which a property was set, or empty for value types which can't tell.
+func presence(property NamedProperty) string {
+	backing := "_" + snakeToCamel(property.Name) + " != null"
+	switch property.Type {
+	case "array":
+		if t := primitive(property.Items.Type, property.Items.Format); t != "" && !isEncoded(property.Items.Type, property.Items.Format) {
+			return snakeToPascal(property.Name) + " != null"
+		}
+		return backing
+	case "object":
+		return backing
+	}
+
+	t := primitive(property.Type, property.Format)
+	switch {
+	case t == "":
+		return backing
+	case isEncoded(property.Type, property.Format):
+		return backing
+	case t == "string" || isNullable(nullable(property.Nullable, t)):
+		return snakeToPascal(property.Name) + " != null"
+	}
+	return ""
+}
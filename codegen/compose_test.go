@@ -0,0 +1,59 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestComposeReports checks that composition which can't be represented is reported rather than silently dropped.
+func TestComposeReports(t *testing.T) {
+	_, stderr, code := runCommand(t, "testdata/compose.openapi3.json", "Nakama")
+	if code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+	for _, want := range []string{
+		"Definition Bad has variant Conflict whose property radius conflicts with another variant; the variant is left out",
+		"Definition Bad can't tell variant Circle apart without a discriminator, so it is always returned",
+		"Definition Cat shares property name with another member of allOf, so it doesn't extend the interface of Tagged",
+	} {
+		if !strings.Contains(stderr, want) {
+			t.Errorf("stderr doesn't report %q:\n%s", want, stderr)
+		}
+	}
+	// The inline variant is hoisted into a definition of its own, and the discriminated Shape is fully represented.
+	for _, unwanted := range []string{"inline schema", "Definition Shape"} {
+		if strings.Contains(stderr, unwanted) {
+			t.Errorf("stderr reports %q:\n%s", unwanted, stderr)
+		}
+	}
+}
+
+func TestDiscriminatorValues(t *testing.T) {
+	discriminator := &Discriminator{
+		PropertyName: "kind",
+		Mapping: map[string]string{
+			"circle": "#/definitions/Circle",
+			"round":  "#/definitions/Circle",
+		},
+	}
+	if got := discriminatorValues(discriminator, "#/definitions/Circle"); strings.Join(got, ",") != "circle,round" {
+		t.Errorf("got %v for a mapped variant", got)
+	}
+	if got := discriminatorValues(discriminator, "#/definitions/Square"); strings.Join(got, ",") != "Square" {
+		t.Errorf("got %v for an unmapped variant", got)
+	}
+}
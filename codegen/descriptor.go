@@ -0,0 +1,766 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	httpRuleExtension         = "google.api.http"
+	openAPIOperationExtension = "grpc.gateway.protoc_gen_openapiv2.options.openapiv2_operation"
+	openAPISwaggerExtension   = "grpc.gateway.protoc_gen_openapiv2.options.openapiv2_swagger"
+)
+
+// pathParamPattern matches the "{field}" and "{field=pattern}" variables of a google.api.http path template.
+var pathParamPattern = regexp.MustCompile(`{([^}=]+)(=[^}]*)?}`)
+
+// wellKnownTypes maps google.protobuf messages to the primitive they are rendered as in JSON.
+var wellKnownTypes = map[protoreflect.FullName]struct{ Type, Format string }{
+	"google.protobuf.Timestamp":   {"string", "date-time"},
+	"google.protobuf.Duration":    {"string", ""},
+	"google.protobuf.FieldMask":   {"string", ""},
+	"google.protobuf.StringValue": {"string", ""},
+	"google.protobuf.BytesValue":  {"string", "byte"},
+	"google.protobuf.BoolValue":   {"boolean", ""},
+	"google.protobuf.Int32Value":  {"integer", "int32"},
+	"google.protobuf.UInt32Value": {"integer", "int64"},
+	"google.protobuf.Int64Value":  {"string", "int64"},
+	"google.protobuf.UInt64Value": {"string", "uint64"},
+	"google.protobuf.FloatValue":  {"number", "float"},
+	"google.protobuf.DoubleValue": {"number", "double"},
+	"google.protobuf.Struct":      {"object", ""},
+	"google.protobuf.ListValue":   {"array", ""},
+	"google.protobuf.Value":       {"", ""},
+}
+
+// wrapperTypes are the google.protobuf wrappers, whose fields tell an unset value apart from the zero value.
+var wrapperTypes = map[protoreflect.FullName]bool{
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.DoubleValue": true,
+}
+
+// descriptorLoader builds a Schema from a FileDescriptorSet and the google.api.http annotations of its services.
+type descriptorLoader struct {
+	files  *protoregistry.Files
+	types  *protoregistry.Types
+	schema *Schema
+	names  map[protoreflect.FullName]string
+	// imported reports types whose definitions are generated elsewhere, and so only go into Schema.Imports.
+	imported func(protoreflect.Descriptor) bool
+}
+
+// isFileDescriptorSet reports whether the content is a binary descriptor set rather than a JSON or YAML document.
+func isFileDescriptorSet(content []byte) bool {
+	for _, b := range content {
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case '{':
+			return false
+		default:
+			return !isYAMLDocument(content)
+		}
+	}
+	return false
+}
+
+// newDescriptorLoader decodes a FileDescriptorSet, as written by "protoc --include_imports --include_source_info
+// --descriptor_set_out", ready to build definitions from its messages.
+func newDescriptorLoader(content []byte) (*descriptorLoader, error) {
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(content, set); err != nil {
+		return nil, err
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("descriptor set must be built with --include_imports: %w", err)
+	}
+
+	l := &descriptorLoader{
+		files: files,
+		types: &protoregistry.Types{},
+		schema: &Schema{
+			Paths:       make(map[string]map[string]Operation),
+			Definitions: make(map[string]ObjectDefinition),
+			Imports:     make(map[string]ObjectDefinition),
+		},
+	}
+
+	// Options are only kept as unknown fields, so register every extension in the set to decode them dynamically.
+	var fullNames []protoreflect.FullName
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		rangeExtensions(fd.Extensions(), fd.Messages(), func(xd protoreflect.ExtensionDescriptor) {
+			_ = l.types.RegisterExtension(dynamicpb.NewExtensionType(xd))
+		})
+		rangeTypes(fd.Messages(), fd.Enums(), func(d protoreflect.Descriptor) {
+			fullNames = append(fullNames, d.FullName())
+		})
+		return true
+	})
+	l.names = definitionNames(fullNames)
+
+	return l, nil
+}
+
+// loadDescriptorSchema builds the HTTP client model from the services of a FileDescriptorSet.
+func loadDescriptorSchema(content []byte) (*Schema, error) {
+	l, err := newDescriptorLoader(content)
+	if err != nil {
+		return nil, err
+	}
+
+	l.files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		if services.Len() > 0 {
+			l.addSecurity(fd)
+		}
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				if err = l.addMethod(methods.Get(j)); err != nil {
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The gateway always declares its error response types, so they are generated even when unreferenced.
+	l.schema.Definitions["rpcStatus"] = ObjectDefinition{
+		Properties: map[string]ObjectProperty{
+			"code":    {Type: "integer", Format: "int32", Proto: &ProtoField{Number: 1, Kind: "int32"}},
+			"message": {Type: "string", Proto: &ProtoField{Number: 2, Kind: "string"}},
+			"details": {Type: "array", Items: Items{Type: "object", Ref: l.anyRef()}, Proto: &ProtoField{Number: 3, Kind: "message"}},
+		},
+		PropertyOrder: []string{"code", "message", "details"},
+	}
+
+	return l.schema, nil
+}
+
+// addMethod adds one operation for the method's http rule and each of its additional bindings.
+func (l *descriptorLoader) addMethod(md protoreflect.MethodDescriptor) error {
+	rule := l.option(md.Options(), httpRuleExtension)
+	if rule == nil {
+		return nil
+	}
+
+	operationId := fmt.Sprintf("%s_%s", md.Parent().Name(), md.Name())
+	summary := comment(md)
+	var security []map[string][]struct{}
+	var client *ClientOptions
+	if op := l.option(md.Options(), openAPIOperationExtension); op != nil {
+		if id := stringField(op, "operation_id"); id != "" {
+			operationId = id
+		}
+		if s := stringField(op, "summary"); s != "" {
+			summary = s
+		}
+		security = securityRequirements(op)
+		var err error
+		if client, err = clientExtension(op); err != nil {
+			return fmt.Errorf("invalid x-client extension on %s: %w", md.FullName(), err)
+		}
+	}
+	if idx := strings.Index(summary, "\n\n"); idx >= 0 {
+		summary = summary[:idx]
+	}
+
+	rules := []protoreflect.Message{rule}
+	if bindings := listField(rule, "additional_bindings"); bindings != nil {
+		for i := 0; i < bindings.Len(); i++ {
+			rules = append(rules, bindings.Get(i).Message())
+		}
+	}
+
+	for i, r := range rules {
+		method, url := httpPattern(r)
+		if method == "" {
+			return fmt.Errorf("unsupported http rule on %s", md.FullName())
+		}
+		op := Operation{
+			Summary:     summary,
+			OperationId: operationId,
+			Security:    security,
+			Client:      client,
+		}
+		if i > 0 {
+			op.OperationId = fmt.Sprintf("%s%d", operationId, i+1)
+		}
+		if err := l.addParameters(&op, md, url, stringField(r, "body")); err != nil {
+			return err
+		}
+
+		output := md.Output()
+		if field := stringField(r, "response_body"); field != "" {
+			if fd := output.Fields().ByName(protoreflect.Name(field)); fd != nil {
+				op.Responses.Ok.Schema.Ref = l.property(fd).Ref
+			}
+		} else if output.FullName() != "google.protobuf.Empty" {
+			op.Responses.Ok.Schema.Ref = l.ref(output)
+		}
+		// The gateway fails every method with its status message.
+		op.Responses.Default.Schema.Ref = "#/definitions/rpcStatus"
+
+		if _, ok := l.schema.Paths[url]; !ok {
+			l.schema.Paths[url] = make(map[string]Operation)
+		}
+		l.schema.Paths[url][method] = op
+	}
+	return nil
+}
+
+// addParameters splits the input message into path, body and query parameters as grpc-gateway does.
+func (l *descriptorLoader) addParameters(op *Operation, md protoreflect.MethodDescriptor, url, body string) error {
+	input := md.Input()
+	bound := make(map[string]bool)
+
+	for _, match := range pathParamPattern.FindAllStringSubmatch(url, -1) {
+		name := match[1]
+		fd := fieldByPath(input, name)
+		if fd == nil {
+			return fmt.Errorf("path parameter %q of %s is not a field of %s", name, md.FullName(), input.FullName())
+		}
+		bound[strings.SplitN(name, ".", 2)[0]] = true
+		p := l.property(fd)
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        name,
+			In:          "path",
+			Required:    true,
+			Type:        p.Type,
+			Format:      p.Format,
+			Description: descriptionOrTitle(p.Description, p.Title),
+		})
+	}
+
+	switch body {
+	case "":
+	case "*":
+		param := Parameter{
+			Name:     "body",
+			In:       "body",
+			Required: true,
+		}
+		if len(bound) == 0 {
+			param.Schema.Ref = l.ref(input)
+		} else {
+			// grpc-gateway inlines the remaining fields, which hoistInlineSchemas names later.
+			title, description := schemaComment(comment(input))
+			param.Schema.Type = "object"
+			param.Schema.Description = descriptionOrTitle(description, title)
+			param.Schema.Properties = make(map[string]ObjectProperty)
+			fields := input.Fields()
+			for i := 0; i < fields.Len(); i++ {
+				fd := fields.Get(i)
+				if !bound[string(fd.Name())] {
+					param.Schema.Properties[string(fd.Name())] = l.property(fd)
+				}
+			}
+		}
+		op.Parameters = append(op.Parameters, param)
+		return nil
+	default:
+		fd := input.Fields().ByName(protoreflect.Name(body))
+		if fd == nil {
+			return fmt.Errorf("body %q of %s is not a field of %s", body, md.FullName(), input.FullName())
+		}
+		bound[body] = true
+		p := l.property(fd)
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        body,
+			In:          "body",
+			Required:    true,
+			Description: descriptionOrTitle(p.Description, p.Title),
+			Schema: ObjectSchema{
+				Type: p.Type,
+				Ref:  p.Ref,
+			},
+		})
+	}
+
+	l.addQueryParameters(op, input, "", bound, map[protoreflect.FullName]bool{input.FullName(): true})
+	return nil
+}
+
+// addQueryParameters flattens the unbound fields of a message into query parameters, using dotted names for nested
+// messages.
+func (l *descriptorLoader) addQueryParameters(op *Operation, md protoreflect.MessageDescriptor, prefix string, bound map[string]bool, seen map[protoreflect.FullName]bool) {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := prefix + string(fd.Name())
+		if bound[name] {
+			continue
+		}
+
+		if fd.IsMap() {
+			// grpc-gateway reads map fields from name[key]=value pairs.
+			p := l.property(fd)
+			op.Parameters = append(op.Parameters, Parameter{
+				Name:                 name,
+				In:                   "query",
+				Description:          descriptionOrTitle(p.Description, p.Title),
+				Type:                 "object",
+				AdditionalProperties: p.AdditionalProperties,
+			})
+			continue
+		}
+		if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+			if _, ok := wellKnownTypes[fd.Message().FullName()]; !ok {
+				if !fd.IsList() && !seen[fd.Message().FullName()] {
+					seen[fd.Message().FullName()] = true
+					l.addQueryParameters(op, fd.Message(), name+".", bound, seen)
+					delete(seen, fd.Message().FullName())
+				}
+				continue
+			}
+		}
+
+		title, description := schemaComment(comment(fd))
+		param := Parameter{
+			Name:        name,
+			In:          "query",
+			Description: descriptionOrTitle(description, title),
+		}
+		t := l.scalar(fd)
+		if fd.Kind() == protoreflect.EnumKind {
+			// Enums are sent by name in the query string.
+			t.Type = "string"
+		}
+		if fd.IsList() {
+			param.Type = "array"
+			param.Items = Items{Type: t.Type, Format: t.Format, Ref: t.Ref}
+			param.CollectionFormat = "multi"
+		} else {
+			param.Type = t.Type
+			param.Format = t.Format
+			param.Ref = t.Ref
+		}
+		op.Parameters = append(op.Parameters, param)
+	}
+}
+
+// ref returns the definition reference for a message or enum, generating its definition on first use.
+func (l *descriptorLoader) ref(d protoreflect.Descriptor) string {
+	if d == nil {
+		return ""
+	}
+	name := l.names[d.FullName()]
+	ref := "#/definitions/" + name
+	definitions := l.schema.Definitions
+	if l.imported != nil && l.imported(d) {
+		definitions = l.schema.Imports
+	}
+	if _, ok := definitions[name]; ok {
+		return ref
+	}
+
+	title, description := schemaComment(comment(d))
+	def := ObjectDefinition{
+		Description: description,
+		Title:       title,
+	}
+	// Reserve the name before descending into fields so recursive messages terminate.
+	definitions[name] = def
+
+	switch d := d.(type) {
+	case protoreflect.EnumDescriptor:
+		values := d.Values()
+		var lines []string
+		for i := 0; i < values.Len(); i++ {
+			v := values.Get(i)
+			def.Enum = append(def.Enum, string(v.Name()))
+			def.EnumValues = append(def.EnumValues, int(v.Number()))
+			if c := comment(v); c != "" {
+				lines = append(lines, fmt.Sprintf(" - %s: %s", v.Name(), c))
+			}
+		}
+		// Mirror the description layout of protoc-gen-openapiv2, where each value is documented on its own line.
+		if len(lines) > 0 {
+			def.Description = strings.TrimPrefix(def.Description+"\n\n"+strings.Join(lines, "\n"), "\n\n")
+		}
+	case protoreflect.MessageDescriptor:
+		def.Properties = make(map[string]ObjectProperty)
+		fields := d.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			def.Properties[string(fd.Name())] = l.property(fd)
+			def.PropertyOrder = append(def.PropertyOrder, string(fd.Name()))
+		}
+	}
+
+	definitions[name] = def
+	return ref
+}
+
+// property converts a message field, including repeated and map fields, into a definition property.
+func (l *descriptorLoader) property(fd protoreflect.FieldDescriptor) ObjectProperty {
+	title, description := schemaComment(comment(fd))
+	p := ObjectProperty{
+		Description: description,
+		Title:       title,
+	}
+
+	switch {
+	case fd.IsMap():
+		value := l.scalar(fd.MapValue())
+		p.Type = "object"
+		p.AdditionalProperties = AdditionalProperties{
+			Type:   value.Type,
+			Format: value.Format,
+			Ref:    value.Ref,
+		}
+	case fd.IsList():
+		item := l.scalar(fd)
+		p.Type = "array"
+		p.Items = Items{
+			Type:   item.Type,
+			Format: item.Format,
+			Ref:    item.Ref,
+		}
+	default:
+		t := l.scalar(fd)
+		p.Type = t.Type
+		p.Format = t.Format
+		p.Ref = t.Ref
+		p.Nullable = fd.HasOptionalKeyword() || (fd.Message() != nil && wrapperTypes[fd.Message().FullName()])
+	}
+	p.Proto = protoField(fd)
+	return p
+}
+
+// protoField describes how a field is encoded in the wire format.
+func protoField(fd protoreflect.FieldDescriptor) *ProtoField {
+	field := &ProtoField{
+		Number:   int(fd.Number()),
+		Optional: fd.HasOptionalKeyword(),
+	}
+	value := fd
+	if fd.IsMap() {
+		field.Key = fd.MapKey().Kind().String()
+		value = fd.MapValue()
+	}
+	field.Kind = value.Kind().String()
+	if value.Message() != nil {
+		if _, ok := wellKnownTypes[value.Message().FullName()]; ok {
+			field.Message = string(value.Message().FullName())
+		}
+	}
+	return field
+}
+
+type scalarType struct {
+	Type   string
+	Format string
+	Ref    string
+}
+
+// scalar maps the element type of a field to its JSON schema type, following the proto3 JSON mapping.
+func (l *descriptorLoader) scalar(fd protoreflect.FieldDescriptor) scalarType {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return scalarType{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return scalarType{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return scalarType{Type: "integer", Format: "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return scalarType{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return scalarType{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return scalarType{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return scalarType{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return scalarType{Type: "string"}
+	case protoreflect.BytesKind:
+		return scalarType{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		return scalarType{Ref: l.ref(fd.Enum())}
+	default:
+		if fd.Message().FullName() == "google.protobuf.Any" {
+			return scalarType{Ref: l.anyRef()}
+		}
+		if wkt, ok := wellKnownTypes[fd.Message().FullName()]; ok {
+			return scalarType{Type: wkt.Type, Format: wkt.Format}
+		}
+		return scalarType{Ref: l.ref(fd.Message())}
+	}
+}
+
+// anyRef returns the reference to google.protobuf.Any, which is rendered in JSON with its type URL under "@type".
+// The wire format only keeps the type URL too, as the packed message can't be decoded without knowing its type.
+func (l *descriptorLoader) anyRef() string {
+	l.schema.Definitions["protobufAny"] = ObjectDefinition{
+		Properties: map[string]ObjectProperty{
+			"@type": {Type: "string", Proto: &ProtoField{Number: 1, Kind: "string"}},
+		},
+	}
+	return "#/definitions/protobufAny"
+}
+
+// option decodes a custom option extension, returning nil when it is not set.
+func (l *descriptorLoader) option(opts proto.Message, name protoreflect.FullName) protoreflect.Message {
+	xt, err := l.types.FindExtensionByName(name)
+	if err != nil || opts == nil {
+		return nil
+	}
+
+	raw, err := proto.Marshal(opts)
+	if err != nil {
+		return nil
+	}
+	decoded := opts.ProtoReflect().New().Interface()
+	if err := (proto.UnmarshalOptions{Resolver: l.types}).Unmarshal(raw, decoded); err != nil {
+		return nil
+	}
+	if !decoded.ProtoReflect().Has(xt.TypeDescriptor()) {
+		return nil
+	}
+	return decoded.ProtoReflect().Get(xt.TypeDescriptor()).Message()
+}
+
+// httpPattern returns the lowercase HTTP method and path template of a google.api.HttpRule.
+func httpPattern(rule protoreflect.Message) (method, url string) {
+	for _, m := range []protoreflect.Name{"get", "put", "post", "delete", "patch"} {
+		if url = stringField(rule, m); url != "" {
+			return string(m), url
+		}
+	}
+	if custom := messageField(rule, "custom"); custom != nil {
+		return strings.ToLower(stringField(custom, "kind")), stringField(custom, "path")
+	}
+	return "", ""
+}
+
+// addSecurity reads the security schemes and the default security requirements of a file's openapiv2 Swagger option.
+func (l *descriptorLoader) addSecurity(fd protoreflect.FileDescriptor) {
+	swagger := l.option(fd.Options(), openAPISwaggerExtension)
+	if swagger == nil {
+		return
+	}
+	if requirements := securityRequirements(swagger); requirements != nil {
+		l.schema.Security = requirements
+	}
+	definitions := messageField(swagger, "security_definitions")
+	if definitions == nil {
+		return
+	}
+	if l.schema.SecurityDefinitions == nil {
+		l.schema.SecurityDefinitions = make(map[string]SecurityScheme)
+	}
+	field := definitions.Descriptor().Fields().ByName("security")
+	definitions.Get(field).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		scheme := v.Message()
+		l.schema.SecurityDefinitions[k.String()] = SecurityScheme{
+			Type: map[string]string{"TYPE_BASIC": "basic", "TYPE_API_KEY": "apiKey", "TYPE_OAUTH2": "oauth2"}[enumField(scheme, "type")],
+			Name: stringField(scheme, "name"),
+			In:   map[string]string{"IN_HEADER": "header", "IN_QUERY": "query"}[enumField(scheme, "in")],
+		}
+		return true
+	})
+}
+
+// securityRequirements reads the "security" field of an openapiv2 Swagger or Operation option.
+func securityRequirements(m protoreflect.Message) []map[string][]struct{} {
+	list := listField(m, "security")
+	if list == nil {
+		return nil
+	}
+
+	requirements := make([]map[string][]struct{}, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		requirement := make(map[string][]struct{})
+		fd := list.Get(i).Message().Descriptor().Fields().ByName("security_requirement")
+		list.Get(i).Message().Get(fd).Map().Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			requirement[k.String()] = []struct{}{}
+			return true
+		})
+		requirements = append(requirements, requirement)
+	}
+	return requirements
+}
+
+// clientExtension reads the "x-client" entry of an openapiv2 Operation's extensions, which shapes the facade method
+// generated for the operation.
+func clientExtension(op protoreflect.Message) (*ClientOptions, error) {
+	fd := op.Descriptor().Fields().ByName("extensions")
+	if fd == nil || !fd.IsMap() || !op.Has(fd) {
+		return nil, nil
+	}
+	value := op.Get(fd).Map().Get(protoreflect.ValueOfString("x-client").MapKey())
+	if !value.IsValid() {
+		return nil, nil
+	}
+
+	raw, err := protojson.Marshal(value.Message().Interface())
+	if err != nil {
+		return nil, err
+	}
+	client := &ClientOptions{}
+	if err := json.Unmarshal(raw, client); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func stringField(m protoreflect.Message, name protoreflect.Name) string {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || !m.Has(fd) {
+		return ""
+	}
+	return m.Get(fd).String()
+}
+
+func enumField(m protoreflect.Message, name protoreflect.Name) string {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || !m.Has(fd) {
+		return ""
+	}
+	if value := fd.Enum().Values().ByNumber(m.Get(fd).Enum()); value != nil {
+		return string(value.Name())
+	}
+	return ""
+}
+
+func messageField(m protoreflect.Message, name protoreflect.Name) protoreflect.Message {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || !m.Has(fd) {
+		return nil
+	}
+	return m.Get(fd).Message()
+}
+
+func listField(m protoreflect.Message, name protoreflect.Name) protoreflect.List {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || !m.Has(fd) {
+		return nil
+	}
+	return m.Get(fd).List()
+}
+
+// fieldByPath resolves a dotted field path such as "account.id" against a message.
+func fieldByPath(md protoreflect.MessageDescriptor, path string) protoreflect.FieldDescriptor {
+	var fd protoreflect.FieldDescriptor
+	for _, part := range strings.Split(path, ".") {
+		if md == nil {
+			return nil
+		}
+		if fd = md.Fields().ByName(protoreflect.Name(part)); fd == nil {
+			return nil
+		}
+		md = fd.Message()
+	}
+	return fd
+}
+
+// comment returns the cleaned leading comment of a descriptor, when the set was built with source info.
+func comment(d protoreflect.Descriptor) string {
+	loc := d.ParentFile().SourceLocations().ByDescriptor(d)
+	lines := strings.Split(strings.TrimSpace(loc.LeadingComments), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// schemaComment splits a comment the way protoc-gen-openapiv2 does: a first paragraph without a closing period is a
+// title, anything else is the description.
+func schemaComment(c string) (title, description string) {
+	paragraphs := strings.SplitN(c, "\n\n", 2)
+	first := strings.TrimSpace(paragraphs[0])
+	if first == "" || strings.HasSuffix(first, ".") {
+		return "", c
+	}
+	if len(paragraphs) > 1 {
+		description = strings.TrimSpace(paragraphs[1])
+	}
+	return first, description
+}
+
+// definitionNames assigns protoc-gen-openapiv2's legacy names: the shortest suffix of the full name which no other
+// type shares, joined with one more component before it, so that a message is named by its package and only gains
+// components where two types would otherwise collide.
+func definitionNames(fullNames []protoreflect.FullName) map[protoreflect.FullName]string {
+	suffixes := make(map[string]int)
+	for _, n := range fullNames {
+		parts := strings.Split(string(n), ".")
+		for d := 1; d <= len(parts); d++ {
+			suffixes[strings.Join(parts[len(parts)-d:], ".")]++
+		}
+	}
+
+	names := make(map[protoreflect.FullName]string, len(fullNames))
+	for _, n := range fullNames {
+		parts := strings.Split(string(n), ".")
+		d := 1
+		for d < len(parts) && suffixes[strings.Join(parts[len(parts)-d:], ".")] > 1 {
+			d++
+		}
+		if d < len(parts) {
+			d++
+		}
+		names[n] = strings.Join(parts[len(parts)-d:], "")
+	}
+	return names
+}
+
+// rangeTypes visits every message and enum, including nested ones, in declaration order.
+func rangeTypes(messages protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors, f func(protoreflect.Descriptor)) {
+	for i := 0; i < enums.Len(); i++ {
+		f(enums.Get(i))
+	}
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		f(md)
+		rangeTypes(md.Messages(), md.Enums(), f)
+	}
+}
+
+// rangeExtensions visits every extension declared at file scope or nested within messages.
+func rangeExtensions(extensions protoreflect.ExtensionDescriptors, messages protoreflect.MessageDescriptors, f func(protoreflect.ExtensionDescriptor)) {
+	for i := 0; i < extensions.Len(); i++ {
+		f(extensions.Get(i))
+	}
+	for i := 0; i < messages.Len(); i++ {
+		rangeExtensions(messages.Get(i).Extensions(), messages.Get(i).Messages(), f)
+	}
+}
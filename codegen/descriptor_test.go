@@ -0,0 +1,165 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestDefinitionNames(t *testing.T) {
+	names := definitionNames([]protoreflect.FullName{
+		"nakama.api.Account",
+		"nakama.api.User",
+		"nakama.api.Group.User",
+		"nakama.console.User",
+		"nakama.console.UserList.User",
+		"google.protobuf.Any",
+		"google.rpc.Status",
+		"Unpackaged",
+	})
+
+	want := map[protoreflect.FullName]string{
+		"nakama.api.Account":           "apiAccount",
+		"nakama.api.User":              "nakamaapiUser",
+		"nakama.api.Group.User":        "apiGroupUser",
+		"nakama.console.User":          "nakamaconsoleUser",
+		"nakama.console.UserList.User": "consoleUserListUser",
+		"google.protobuf.Any":          "protobufAny",
+		"google.rpc.Status":            "rpcStatus",
+		"Unpackaged":                   "Unpackaged",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+}
+
+func TestLoadDescriptorSchema(t *testing.T) {
+	content, err := os.ReadFile("testdata/greeter.pb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isFileDescriptorSet(content) {
+		t.Fatal("greeter.pb isn't detected as a descriptor set")
+	}
+	schema, err := loadDescriptorSchema(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := schema.Paths["/v1/user/{user_id}/greeting"]["get"]
+	if get.OperationId != "Greeter_GetGreeting" || get.Summary != "Fetch a greeting for a user." {
+		t.Errorf("got operation %q with summary %q", get.OperationId, get.Summary)
+	}
+	if get.Responses.Ok.Schema.Ref != "#/definitions/apiGreeting" {
+		t.Errorf("got response %q", get.Responses.Ok.Schema.Ref)
+	}
+	// Unbound fields become query parameters: repeated fields are sent as one pair per item, enums by name and maps
+	// in the map syntax of grpc-gateway.
+	wantParams := []Parameter{
+		{Name: "user_id", In: "path", Required: true, Type: "string"},
+		{Name: "ids", In: "query", Type: "array", Items: Items{Type: "string"}, CollectionFormat: "multi"},
+		{Name: "role", In: "query", Type: "string", Ref: "#/definitions/GroupRole"},
+		{Name: "vars", In: "query", Type: "object", AdditionalProperties: AdditionalProperties{Type: "string"}},
+	}
+	if !reflect.DeepEqual(get.Parameters, wantParams) {
+		t.Errorf("got parameters %+v, want %+v", get.Parameters, wantParams)
+	}
+
+	// Additional bindings are numbered operations, and keep the patterns of their path variables for
+	// resolvePathParameters.
+	binding := schema.Paths["/v1/greeting/{user_id=users/*}"]["get"]
+	if binding.OperationId != "Greeter_GetGreeting2" || !reflect.DeepEqual(binding.Parameters, wantParams) {
+		t.Errorf("got binding %q with parameters %+v", binding.OperationId, binding.Parameters)
+	}
+
+	// A "*" body with bound path fields inlines the remaining fields.
+	put := schema.Paths["/v1/user/{user_id}/greeting"]["put"]
+	if len(put.Parameters) != 2 || put.Parameters[1].In != "body" || put.Parameters[1].Schema.Type != "object" {
+		t.Fatalf("got parameters %+v", put.Parameters)
+	}
+	if body := put.Parameters[1].Schema.Properties; len(body) != 2 || body["text"].Type != "string" || body["priority"].Format != "int64" {
+		t.Errorf("got body properties %+v", body)
+	}
+	if put.Responses.Ok.Schema.Ref != "" {
+		t.Errorf("got response %q for google.protobuf.Empty", put.Responses.Ok.Schema.Ref)
+	}
+
+	// The openapiv2_operation option overrides the id and summary, and sets the security requirements.
+	post := schema.Paths["/v1/group/{group_id}/greeting"]["post"]
+	if post.OperationId != "Greeter_Broadcast" || post.Summary != "Broadcast a greeting." {
+		t.Errorf("got operation %q with summary %q", post.OperationId, post.Summary)
+	}
+	if len(post.Security) != 1 || post.Security[0]["HttpKeyAuth"] == nil {
+		t.Errorf("got security %+v", post.Security)
+	}
+	wantParams = []Parameter{
+		{Name: "group_id", In: "path", Required: true, Type: "string"},
+		{Name: "greeting", In: "body", Required: true, Schema: ObjectSchema{Ref: "#/definitions/apiGreeting"}},
+		{Name: "notify", In: "query", Type: "boolean"},
+	}
+	if !reflect.DeepEqual(post.Parameters, wantParams) {
+		t.Errorf("got parameters %+v, want %+v", post.Parameters, wantParams)
+	}
+
+	// Fields follow the proto3 JSON mapping, in declaration order.
+	greeting := schema.Definitions["apiGreeting"]
+	if want := []string{"text", "lang_tag", "sent_at", "metadata", "author", "note"}; !reflect.DeepEqual(greeting.PropertyOrder, want) {
+		t.Errorf("got property order %v, want %v", greeting.PropertyOrder, want)
+	}
+	if p := greeting.Properties["sent_at"]; p.Type != "string" || p.Format != "int64" {
+		t.Errorf("got int64 field %+v", p)
+	}
+	// Wrappers and optional fields tell an unset value apart from the zero value.
+	if p := greeting.Properties["lang_tag"]; p.Type != "string" || !p.Nullable {
+		t.Errorf("got wrapper field %+v", p)
+	}
+	if p := greeting.Properties["note"]; p.Type != "string" || !p.Nullable {
+		t.Errorf("got optional field %+v", p)
+	}
+	if p := greeting.Properties["text"]; p.Nullable {
+		t.Errorf("got plain field %+v", p)
+	}
+	if p := greeting.Properties["metadata"]; p.Type != "object" || p.AdditionalProperties.Type != "string" {
+		t.Errorf("got map field %+v", p)
+	}
+	if p := greeting.Properties["author"]; p.Ref != "#/definitions/exampleapiUser" {
+		t.Errorf("got message field %+v", p)
+	}
+	if role := schema.Definitions["GroupRole"]; !reflect.DeepEqual(role.Enum, enumNames{"MEMBER", "ADMIN"}) || !reflect.DeepEqual(role.EnumValues, []int{0, 1}) {
+		t.Errorf("got enum %+v", role)
+	}
+
+	// The openapiv2_swagger option of the file declares the security schemes and the default requirements.
+	wantSchemes := map[string]SecurityScheme{
+		"OAuth":       {Type: "oauth2"},
+		"HttpKeyAuth": {Type: "apiKey", Name: "http_key", In: "query"},
+	}
+	if !reflect.DeepEqual(schema.SecurityDefinitions, wantSchemes) {
+		t.Errorf("got security schemes %+v, want %+v", schema.SecurityDefinitions, wantSchemes)
+	}
+	if len(schema.Security) != 1 || schema.Security[0]["OAuth"] == nil {
+		t.Errorf("got default security %+v", schema.Security)
+	}
+
+	for _, name := range []string{"rpcStatus", "protobufAny"} {
+		if _, ok := schema.Definitions[name]; !ok {
+			t.Errorf("%s isn't defined", name)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Diagnostic is a warning or error about an input, located by a JSON pointer into it when it's a JSON document.
+type Diagnostic struct {
+	// Either warning, for what is left out or worked around, or error, for what stops the command.
+	Severity string `json:"severity"`
+	File     string `json:"file,omitempty"`
+	// The JSON pointer of the part of the file the diagnostic is about, e.g. /definitions/apiAccount.
+	Pointer string `json:"pointer,omitempty"`
+	Message string `json:"message"`
+}
+
+// diagnosticFormat is how diagnostics are written to stderr: text, or json for one JSON object per line.
+var diagnosticFormat = "text"
+
+// diagnosticFile is the input which the diagnostics being reported are about, unless they name another.
+var diagnosticFile string
+
+// diagnosticOutput is where diagnostics are written, which is stderr outside of tests.
+var diagnosticOutput io.Writer = os.Stderr
+
+// diagnosticsFlag adds the option which picks the diagnosticFormat to the flags of a command.
+func diagnosticsFlag(flags *flag.FlagSet) *string {
+	return flags.String("diagnostics", "text", "The format of warnings and errors on stderr: text, or json for one JSON object per line.")
+}
+
+// setDiagnosticFormat sets the diagnosticFormat from its option, and fails when it's unknown.
+func setDiagnosticFormat(format string) {
+	if format != "text" && format != "json" {
+		fatalf("", "Unknown diagnostics format %s, which must be text or json.", format)
+	}
+	diagnosticFormat = format
+}
+
+// report writes a diagnostic to the diagnosticOutput. In text, it's prefixed by its file and pointer as a URI reference, e.g.
+// "nakama.swagger.json#/definitions/apiAccount: warning: ...".
+func report(d Diagnostic) {
+	if d.File == "" {
+		d.File = diagnosticFile
+	}
+	if diagnosticFormat == "json" {
+		content, _ := json.Marshal(d)
+		diagnosticOutput.Write(append(content, '\n'))
+		return
+	}
+
+	location := d.File
+	if d.Pointer != "" {
+		location += "#" + d.Pointer
+	}
+	if location == "" {
+		fmt.Fprintf(diagnosticOutput, "%s: %s\n", d.Severity, d.Message)
+		return
+	}
+	fmt.Fprintf(diagnosticOutput, "%s: %s: %s\n", location, d.Severity, d.Message)
+}
+
+// warnf reports a warning about the part of the input at a pointer, which is empty when it has none.
+func warnf(pointer, format string, args ...interface{}) {
+	report(Diagnostic{Severity: "warning", Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+// errorf reports an error about the part of the input at a pointer, for failures which are reported together before
+// the command stops.
+func errorf(pointer, format string, args ...interface{}) {
+	report(Diagnostic{Severity: "error", Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+// fatalf reports an error and exits with status 1, without writing any output.
+func fatalf(pointer, format string, args ...interface{}) {
+	errorf(pointer, format, args...)
+	os.Exit(1)
+}
+
+// usageError prints the usage of a command and exits with status 2, as the flag package does for unknown flags.
+func usageError(flags *flag.FlagSet, format string, args ...interface{}) {
+	if format != "" {
+		fmt.Fprintf(flags.Output(), format+"\n\n", args...)
+	}
+	flags.Usage()
+	os.Exit(2)
+}
+
+// pointer builds the JSON pointer of a part of the input from the tokens of its Swagger 2.0 location, mapping them
+// to those of an OpenAPI 3.x document. Descriptor sets aren't JSON, so nothing in them has a pointer.
+func (s *Schema) pointer(tokens ...string) string {
+	if s.Document == "" {
+		return ""
+	}
+	if s.Document == "openapi3" && len(tokens) > 0 {
+		switch tokens[0] {
+		case "definitions":
+			tokens = append([]string{"components", "schemas"}, tokens[1:]...)
+		case "securityDefinitions":
+			tokens = append([]string{"components", "securitySchemes"}, tokens[1:]...)
+		}
+	}
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString("/")
+		b.WriteString(escapePointer(token))
+	}
+	return b.String()
+}
+
+// childPointer extends a pointer by the tokens of a part within it, and stays empty when the pointer is, as for the
+// parts of a descriptor set.
+func childPointer(pointer string, tokens ...string) string {
+	if pointer == "" {
+		return ""
+	}
+	for _, token := range tokens {
+		pointer += "/" + escapePointer(token)
+	}
+	return pointer
+}
+
+// escapePointer escapes a member name as a reference token of a JSON pointer.
+func escapePointer(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
+
+// locateSources sets the pointers of the definitions and operations a spec declares, before they're hoisted into,
+// composed and moved to other paths.
+func locateSources(s *Schema) {
+	for name, def := range s.Definitions {
+		def.Source = s.pointer("definitions", name)
+		s.Definitions[name] = def
+	}
+	for url, path := range s.Paths {
+		for method, operation := range path {
+			operation.Source = s.pointer("paths", url, method)
+			s.Paths[url][method] = operation
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// captureDiagnostics collects the diagnostics reported during a test in text, without a file, and restores the
+// diagnosticOutput when the test ends.
+func captureDiagnostics(t *testing.T) *strings.Builder {
+	t.Helper()
+	output, format, file := diagnosticOutput, diagnosticFormat, diagnosticFile
+	t.Cleanup(func() {
+		diagnosticOutput, diagnosticFormat, diagnosticFile = output, format, file
+	})
+	var b strings.Builder
+	diagnosticOutput, diagnosticFormat, diagnosticFile = &b, "text", ""
+	return &b
+}
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		file       string
+		diagnostic Diagnostic
+		want       string
+	}{
+		{
+			name:       "pointer",
+			format:     "text",
+			file:       "nakama.swagger.json",
+			diagnostic: Diagnostic{Severity: "warning", Pointer: "/definitions/apiAccount", Message: "Left out."},
+			want:       "nakama.swagger.json#/definitions/apiAccount: warning: Left out.\n",
+		},
+		{
+			name:       "file",
+			format:     "text",
+			file:       "nakama.swagger.json",
+			diagnostic: Diagnostic{Severity: "error", Message: "Unable to read."},
+			want:       "nakama.swagger.json: error: Unable to read.\n",
+		},
+		{
+			// A diagnostic which names its file, as those about fixtures do, isn't located in the input.
+			name:       "other file",
+			format:     "text",
+			file:       "nakama.swagger.json",
+			diagnostic: Diagnostic{Severity: "warning", File: "GetAccount.json", Pointer: "/0", Message: "No match."},
+			want:       "GetAccount.json#/0: warning: No match.\n",
+		},
+		{
+			name:       "no location",
+			format:     "text",
+			diagnostic: Diagnostic{Severity: "error", Message: "Unable to serve."},
+			want:       "error: Unable to serve.\n",
+		},
+		{
+			name:       "json",
+			format:     "json",
+			file:       "nakama.swagger.json",
+			diagnostic: Diagnostic{Severity: "warning", Pointer: "/definitions/apiAccount", Message: "Left out."},
+			want:       `{"severity":"warning","file":"nakama.swagger.json","pointer":"/definitions/apiAccount","message":"Left out."}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diagnostics := captureDiagnostics(t)
+			diagnosticFormat, diagnosticFile = test.format, test.file
+			report(test.diagnostic)
+			if diagnostics.String() != test.want {
+				t.Errorf("report() wrote %q, want %q", diagnostics, test.want)
+			}
+		})
+	}
+}
+
+func TestSchemaPointer(t *testing.T) {
+	tests := []struct {
+		document string
+		tokens   []string
+		want     string
+	}{
+		{"swagger", []string{"definitions", "apiAccount"}, "/definitions/apiAccount"},
+		{"openapi3", []string{"definitions", "apiAccount"}, "/components/schemas/apiAccount"},
+		{"openapi3", []string{"securityDefinitions", "BearerJwt"}, "/components/securitySchemes/BearerJwt"},
+		// Paths are escaped as single reference tokens.
+		{"swagger", []string{"paths", "/v2/account/{id}", "get"}, "/paths/~1v2~1account~1{id}/get"},
+		{"swagger", []string{"definitions", "a~b"}, "/definitions/a~0b"},
+		// Descriptor sets aren't JSON documents.
+		{"", []string{"definitions", "apiAccount"}, ""},
+	}
+	for _, test := range tests {
+		s := &Schema{Document: test.document}
+		if got := s.pointer(test.tokens...); got != test.want {
+			t.Errorf("pointer(%q) in %q = %q, want %q", test.tokens, test.document, got, test.want)
+		}
+	}
+	if got := childPointer("", "properties", "id"); got != "" {
+		t.Errorf("childPointer of an empty pointer = %q, want it empty", got)
+	}
+}
+
+func TestCheckReferences(t *testing.T) {
+	schema, err := decodeInput([]byte(`{
+  "swagger": "2.0",
+  "paths": {
+    "/v2/account": {
+      "get": {"operationId": "Nakama_GetAccounts",
+        "responses": {
+          "201": {"description": "", "schema": {"type": "array", "items": {"$ref": "#/definitions/apiGone"}}},
+          "default": {"description": "", "schema": {"$ref": "#/definitions/rpcStatus"}}
+        }},
+      "put": {"operationId": "Nakama_UpdateAccount",
+        "parameters": [{"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/apiMissing"}}],
+        "responses": {"200": {"description": "", "schema": {"$ref": "#/definitions/apiAccount"}}}}
+    }
+  },
+  "definitions": {
+    "apiAccount": {"type": "object", "properties": {
+      "self": {"$ref": "#/definitions/apiAccount"},
+      "user": {"$ref": "#/definitions/apiUser"},
+      "friends": {"type": "array", "items": {"$ref": "#/definitions/apiFriend"}},
+      "wallet": {"type": "object", "additionalProperties": {"$ref": "#/definitions/apiCoin"}}
+    }}
+  }
+}`), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	diagnostics := captureDiagnostics(t)
+	if err := checkReferences(schema); err == nil {
+		t.Fatal("want an error for the unknown definitions")
+	}
+	want := "#/definitions/apiAccount/properties/friends/items: error: Property friends of apiAccount references unknown definition #/definitions/apiFriend\n" +
+		"#/definitions/apiAccount/properties/user: error: Property user of apiAccount references unknown definition #/definitions/apiUser\n" +
+		"#/definitions/apiAccount/properties/wallet/additionalProperties: error: Property wallet of apiAccount references unknown definition #/definitions/apiCoin\n" +
+		"#/paths/~1v2~1account/get/responses/201: error: The 201 response of Nakama_GetAccounts references unknown definition #/definitions/apiGone\n" +
+		"#/paths/~1v2~1account/put: error: The body of Nakama_UpdateAccount references unknown definition #/definitions/apiMissing\n"
+	if diagnostics.String() != want {
+		t.Errorf("got diagnostics\n%s\nwant\n%s", diagnostics, want)
+	}
+}
@@ -0,0 +1,461 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// diffCommand reports how the generated API changes between two versions of a spec.
+func diffCommand(args []string) {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	format := flags.String("format", "markdown", "The format of the report: markdown, for a changelog, or json.")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "openapi-gen diff [flags] old new")
+		flags.PrintDefaults()
+	}
+	diagnostics := diagnosticsFlag(flags)
+	flags.Parse(args)
+	setDiagnosticFormat(*diagnostics)
+	if flags.NArg() < 2 {
+		usageError(flags, "")
+	}
+	if *format != "markdown" && *format != "json" {
+		usageError(flags, "Unknown report format %s, expected markdown or json.", *format)
+	}
+
+	schemas := make([]*Schema, 2)
+	for i, inputFile := range flags.Args()[:2] {
+		schema, err := readSpec(inputFile)
+		if err != nil {
+			fatalf("", "Unable to read spec %s : %s", inputFile, err)
+		}
+		// Results and parameter enums are part of the method signatures which are compared.
+		resolveResults(schema)
+		resolveParameterEnums(schema)
+		schemas[i] = schema
+	}
+
+	report := DiffReport{Old: flags.Arg(0), New: flags.Arg(1), Changes: diffSchemas(schemas[0], schemas[1])}
+	for _, change := range report.Changes {
+		report.Breaking = report.Breaking || change.Breaking
+	}
+	if *format == "json" {
+		content, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(content))
+		return
+	}
+	report.writeMarkdown(os.Stdout)
+}
+
+// DiffReport lists the changes to the generated API between two versions of a spec.
+type DiffReport struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+	// Whether any change breaks code compiled against, or data written by, the old API.
+	Breaking bool     `json:"breaking"`
+	Changes  []Change `json:"changes"`
+}
+
+// Change is a change to one member of the generated API.
+type Change struct {
+	// What changed: method, parameter, result, authentication, model, property, enum or enum member.
+	Kind string `json:"kind"`
+	// How it changed: added, removed or changed.
+	Action string `json:"action"`
+	// The C# member which changed, e.g. GetAccountAsync, IApiAccount.Wallet or ApiStoreProvider.APPLE_APP_STORE.
+	Subject string `json:"subject"`
+	// The change, as a changelog entry.
+	Description string `json:"description"`
+	Breaking    bool   `json:"breaking"`
+}
+
+// writeMarkdown writes the report as a changelog section, with the breaking changes first.
+func (r DiffReport) writeMarkdown(w io.Writer) {
+	fmt.Fprintf(w, "## API changes from %s to %s\n", r.Old, r.New)
+	if len(r.Changes) == 0 {
+		fmt.Fprintf(w, "\nThe generated API is unchanged.\n")
+		return
+	}
+	for _, breaking := range []bool{true, false} {
+		heading := "Breaking changes"
+		if !breaking {
+			heading = "Non-breaking changes"
+		}
+		written := false
+		for _, change := range r.Changes {
+			if change.Breaking != breaking {
+				continue
+			}
+			if !written {
+				fmt.Fprintf(w, "\n### %s\n\n", heading)
+				written = true
+			}
+			fmt.Fprintf(w, "- %s\n", change.Description)
+		}
+	}
+}
+
+// apiMethod is the ApiClient method generated for an operation.
+type apiMethod struct {
+	Name      string
+	Operation Operation
+	Route     string
+}
+
+// apiArgument is an argument of a generated method.
+type apiArgument struct {
+	Name     string
+	Type     string
+	Required bool
+}
+
+// diffSchemas compares the methods, models and enums generated from two versions of a spec. Changes are sorted by
+// their subject, so that those of one member are listed together.
+func diffSchemas(old, updated *Schema) []Change {
+	var changes []Change
+	add := func(kind, action, subject string, breaking bool, format string, args ...interface{}) {
+		changes = append(changes, Change{
+			Kind:        kind,
+			Action:      action,
+			Subject:     subject,
+			Description: fmt.Sprintf(format, args...),
+			Breaking:    breaking,
+		})
+	}
+
+	oldMethods, newMethods := apiMethods(old), apiMethods(updated)
+	for _, id := range unionKeys(oldMethods, newMethods) {
+		before, inOld := oldMethods[id]
+		after, inNew := newMethods[id]
+		switch {
+		case !inNew:
+			add("method", "removed", before.Name, true, "Removed method `%s` of `%s`.", before.Name, before.Route)
+		case !inOld:
+			add("method", "added", after.Name, false, "Added method `%s` for `%s`.", after.Name, after.Route)
+		default:
+			diffMethods(before, after, add)
+		}
+	}
+
+	for _, name := range unionKeys(old.Definitions, updated.Definitions) {
+		before, inOld := old.Definitions[name]
+		after, inNew := updated.Definitions[name]
+		className := convertRefToClassName(name)
+		subject := "I" + className
+		if len(before.Enum) > 0 || (!inOld && len(after.Enum) > 0) {
+			subject = className
+		}
+		switch {
+		case !inNew:
+			add(definitionKind(before), "removed", subject, true, "Removed %s `%s`.", definitionKind(before), subject)
+		case !inOld:
+			add(definitionKind(after), "added", subject, false, "Added %s `%s`.", definitionKind(after), subject)
+		case definitionKind(before) != definitionKind(after):
+			add(definitionKind(after), "changed", subject, true, "Changed `%s` from %s to %s.", subject, aOrAn(definitionKind(before)), aOrAn(definitionKind(after)))
+		case len(after.Enum) > 0:
+			diffEnums(className, before, after, add)
+		default:
+			diffModels(old, updated, subject, before, after, add)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Subject != changes[j].Subject {
+			return changes[i].Subject < changes[j].Subject
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+	return changes
+}
+
+// diffMethods compares the signatures of the methods generated for one operation.
+func diffMethods(before, after apiMethod, add func(kind, action, subject string, breaking bool, format string, args ...interface{})) {
+	name := after.Name
+	if before.Route != after.Route {
+		add("method", "changed", name, false, "Changed the route of `%s` from `%s` to `%s`.", name, before.Route, after.Route)
+	}
+	if beforeType, afterType := resultType(before.Operation), resultType(after.Operation); beforeType != afterType {
+		add("result", "changed", name, true, "Changed the result of `%s` from `%s` to `%s`.", name, beforeType, afterType)
+	}
+
+	// Each way to authenticate is an overload, which starts with the arguments of its credentials.
+	beforeAuth, afterAuth := authOverloads(before.Operation), authOverloads(after.Operation)
+	for _, overload := range unionKeys(beforeAuth, afterAuth) {
+		switch {
+		case !afterAuth[overload]:
+			add("authentication", "removed", name, true, "Removed the overload of `%s` taking `%s`.", name, overload)
+		case !beforeAuth[overload]:
+			add("authentication", "added", name, false, "Added an overload of `%s` taking `%s`.", name, overload)
+		}
+	}
+
+	beforeArgs, afterArgs := methodArguments(before.Operation), methodArguments(after.Operation)
+	beforeByName, afterByName := make(map[string]apiArgument), make(map[string]apiArgument)
+	for _, argument := range beforeArgs {
+		beforeByName[argument.Name] = argument
+	}
+	for _, argument := range afterArgs {
+		afterByName[argument.Name] = argument
+	}
+	for _, argument := range beforeArgs {
+		subject := fmt.Sprintf("%s(%s)", name, argument.Name)
+		changed, ok := afterByName[argument.Name]
+		switch {
+		case !ok:
+			add("parameter", "removed", subject, true, "Removed parameter `%s` of `%s`.", argument.Name, name)
+		case changed.Type != argument.Type:
+			add("parameter", "changed", subject, true, "Changed parameter `%s` of `%s` from `%s` to `%s`.", argument.Name, name, argument.Type, changed.Type)
+		case changed.Required && !argument.Required:
+			add("parameter", "changed", subject, true, "Made parameter `%s` of `%s` required.", argument.Name, name)
+		case !changed.Required && argument.Required:
+			add("parameter", "changed", subject, false, "Made parameter `%s` of `%s` optional.", argument.Name, name)
+		}
+	}
+	for _, argument := range afterArgs {
+		if _, ok := beforeByName[argument.Name]; ok {
+			continue
+		}
+		subject := fmt.Sprintf("%s(%s)", name, argument.Name)
+		if argument.Required {
+			add("parameter", "added", subject, true, "Added required parameter `%s %s` to `%s`.", argument.Type, argument.Name, name)
+		} else {
+			add("parameter", "added", subject, false, "Added optional parameter `%s %s` to `%s`.", argument.Type, argument.Name, name)
+		}
+	}
+
+	// Arguments are passed by position, so the ones kept must keep their order.
+	var beforeOrder, afterOrder []string
+	for _, argument := range beforeArgs {
+		if _, ok := afterByName[argument.Name]; ok {
+			beforeOrder = append(beforeOrder, argument.Name)
+		}
+	}
+	for _, argument := range afterArgs {
+		if _, ok := beforeByName[argument.Name]; ok {
+			afterOrder = append(afterOrder, argument.Name)
+		}
+	}
+	if strings.Join(beforeOrder, ",") != strings.Join(afterOrder, ",") {
+		add("method", "changed", name, true, "Reordered the parameters of `%s` from `%s` to `%s`.", name, strings.Join(beforeOrder, ", "), strings.Join(afterOrder, ", "))
+	}
+}
+
+// diffModels compares the interfaces generated for a model, including the properties they inherit.
+func diffModels(old, updated *Schema, subject string, before, after ObjectDefinition, add func(kind, action, subject string, breaking bool, format string, args ...interface{})) {
+	beforeExtends, afterExtends := make(map[string]bool), make(map[string]bool)
+	for _, name := range before.Extends {
+		beforeExtends[name] = true
+	}
+	for _, name := range after.Extends {
+		afterExtends[name] = true
+	}
+	for _, name := range unionKeys(beforeExtends, afterExtends) {
+		switch {
+		case !afterExtends[name]:
+			add("model", "changed", subject, true, "`%s` no longer extends `%s`.", subject, name)
+		case !beforeExtends[name]:
+			add("model", "changed", subject, false, "`%s` now extends `%s`.", subject, name)
+		}
+	}
+
+	beforeProperties, afterProperties := old.interfaceMembers(before), updated.interfaceMembers(after)
+	for _, name := range unionKeys(beforeProperties, afterProperties) {
+		beforeType, inOld := beforeProperties[name]
+		afterType, inNew := afterProperties[name]
+		member := subject + "." + name
+		switch {
+		case !inNew:
+			add("property", "removed", member, true, "Removed property `%s`.", member)
+		case !inOld:
+			add("property", "added", member, false, "Added property `%s %s`.", afterType, member)
+		case beforeType != afterType:
+			add("property", "changed", member, true, "Changed property `%s` from `%s` to `%s`.", member, beforeType, afterType)
+		}
+	}
+}
+
+// diffEnums compares the members of an enum. Members are also breaking to change when only their value or wire name
+// does, as both are what values are stored and sent as.
+func diffEnums(subject string, before, after ObjectDefinition, add func(kind, action, subject string, breaking bool, format string, args ...interface{})) {
+	beforeMembers, afterMembers := make(map[string]EnumMember), make(map[string]EnumMember)
+	for _, member := range enumMembers(before) {
+		beforeMembers[member.Name] = member
+	}
+	for _, member := range enumMembers(after) {
+		afterMembers[member.Name] = member
+	}
+	for _, name := range unionKeys(beforeMembers, afterMembers) {
+		beforeMember, inOld := beforeMembers[name]
+		afterMember, inNew := afterMembers[name]
+		member := subject + "." + name
+		switch {
+		case !inNew:
+			add("enum member", "removed", member, true, "Removed enum member `%s`.", member)
+		case !inOld:
+			add("enum member", "added", member, false, "Added enum member `%s`.", member)
+		case beforeMember.Value != afterMember.Value:
+			add("enum member", "changed", member, true, "Changed the value of enum member `%s` from %d to %d.", member, beforeMember.Value, afterMember.Value)
+		case beforeMember.Wire != afterMember.Wire:
+			add("enum member", "changed", member, true, "Changed the wire name of enum member `%s` from `%s` to `%s`.", member, beforeMember.Wire, afterMember.Wire)
+		}
+	}
+}
+
+// apiMethods lists the methods generated for the operations of a spec, by operation ID.
+func apiMethods(s *Schema) map[string]apiMethod {
+	methods := make(map[string]apiMethod)
+	for url, path := range s.Paths {
+		for method, operation := range path {
+			methods[operation.OperationId] = apiMethod{
+				Name:      methodName(operation.OperationId),
+				Operation: operation,
+				Route:     strings.ToUpper(method) + " " + url,
+			}
+		}
+	}
+	return methods
+}
+
+// resultType is the type the method of an operation returns.
+func resultType(operation Operation) string {
+	if operation.Result.Type == "" {
+		return "Task"
+	}
+	return "Task<" + operation.Result.Type + ">"
+}
+
+// authOverloads lists the credential arguments of each overload generated for an operation.
+func authOverloads(operation Operation) map[string]bool {
+	overloads := make(map[string]bool)
+	for _, auth := range operation.Auth {
+		arguments := auth.Arguments()
+		if len(arguments) == 0 {
+			overloads["no credentials"] = true
+			continue
+		}
+		overloads[strings.Join(arguments, ", ")] = true
+	}
+	return overloads
+}
+
+// methodArguments lists the arguments of an operation's method after its credentials, in order and typed as the
+// method declares them.
+func methodArguments(operation Operation) []apiArgument {
+	var arguments []apiArgument
+	for _, parameter := range operation.OrderedParameters() {
+		argument := apiArgument{Name: parameterName(parameter), Type: parameterType(parameter), Required: parameter.Required}
+		if parameter.In == "body" {
+			argument.Type = "string"
+			if parameter.Schema.Type != "string" {
+				argument.Type = convertRefToClassName(parameter.Schema.Ref)
+			}
+		}
+		arguments = append(arguments, argument)
+	}
+	return arguments
+}
+
+// interfaceMembers lists the C# types of the properties of a model's interface by name, including those it inherits
+// and the accessors of its variants.
+func (s *Schema) interfaceMembers(definition ObjectDefinition) map[string]string {
+	members := make(map[string]string)
+	for _, property := range s.classProperties(definition) {
+		members[snakeToPascal(property.Name)] = s.interfaceType(property)
+	}
+	for _, variant := range definition.Variants {
+		members[variant.Name] = "I" + variant.Class
+	}
+	return members
+}
+
+// classProperties lists the properties of a definition along with those of the classes it derives from.
+func (s *Schema) classProperties(definition ObjectDefinition) []NamedProperty {
+	properties := orderedProperties(definition)
+	if definition.Base == "" {
+		return properties
+	}
+	if base, ok := s.lookupDefinition(definition.Base); ok {
+		return append(s.classProperties(base), properties...)
+	}
+	return properties
+}
+
+// interfaceType is the C# type of a property as the interface of its model declares it.
+func (s *Schema) interfaceType(property NamedProperty) string {
+	if csharpType := primitive(property.Type, property.Format); csharpType != "" {
+		return nullable(property.Nullable, csharpType)
+	}
+	switch property.Type {
+	case "array":
+		items := property.Items
+		if itemType := primitive(items.Type, items.Format); itemType != "" {
+			return "List<" + itemType + ">"
+		}
+		if s.isEnum(items.Ref) {
+			return "List<" + convertRefToClassName(items.Ref) + ">"
+		}
+		return "IEnumerable<I" + convertRefToClassName(items.Ref) + ">"
+	case "object":
+		values := property.AdditionalProperties
+		if valueType := primitive(values.Type, values.Format); valueType != "" {
+			return "IDictionary<string, " + valueType + ">"
+		}
+		if s.isEnum(values.Ref) {
+			return "IDictionary<string, " + convertRefToClassName(values.Ref) + ">"
+		}
+		return "IDictionary<string, I" + convertRefToClassName(values.Ref) + ">"
+	}
+	if s.isEnum(property.Ref) {
+		return nullable(property.Nullable, convertRefToClassName(property.Ref))
+	}
+	return "I" + convertRefToClassName(property.Ref)
+}
+
+func definitionKind(definition ObjectDefinition) string {
+	if len(definition.Enum) > 0 {
+		return "enum"
+	}
+	return "model"
+}
+
+func aOrAn(kind string) string {
+	if strings.ContainsAny(kind[:1], "aeiou") {
+		return "an " + kind
+	}
+	return "a " + kind
+}
+
+// unionKeys lists the keys of two maps, sorted.
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	keys := make([]string, 0, len(a)+len(b))
+	for _, m := range []map[string]V{a, b} {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,304 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// diffSpec is a Swagger spec with one operation, GetAccount, and the apiAccount model it returns. The replacements
+// change the text of the spec, e.g. to add a parameter or remove an enum member.
+func diffSpec(t *testing.T, replacements ...string) *Schema {
+	t.Helper()
+	content := strings.NewReplacer(replacements...).Replace(`{
+  "swagger": "2.0",
+  "securityDefinitions": {
+    "BasicAuth": {"type": "basic"},
+    "BearerJwt": {"type": "apiKey", "name": "Authorization", "in": "header"}
+  },
+  "security": [{"BearerJwt": []}],
+  "paths": {
+    "/v2/account/{id}": {
+      "get": {"operationId": "Nakama_GetAccount", "summary": "Get an account.",
+        "security": [{"BearerJwt": []}, {"BasicAuth": []}],
+        "parameters": [
+          {"name": "id", "in": "path", "required": true, "type": "string"},
+          {"name": "lang", "in": "query", "type": "string"},
+          {"name": "limit", "in": "query", "type": "integer", "format": "int32"}
+        ],
+        "responses": {"200": {"schema": {"$ref": "#/definitions/apiAccount"}}}}
+    }
+  },
+  "definitions": {
+    "apiAccount": {
+      "type": "object",
+      "properties": {
+        "username": {"type": "string"},
+        "wallet": {"type": "string"},
+        "color": {"$ref": "#/definitions/apiColor"}
+      }
+    },
+    "apiUser": {
+      "type": "object",
+      "properties": {"id": {"type": "string"}}
+    },
+    "apiColor": {"type": "string", "enum": ["RED", "GREEN"], "default": "RED"}
+  }
+}`)
+	schema, err := decodeInput([]byte(content), "")
+	if err != nil {
+		t.Fatalf("decoding the spec: %s", err)
+	}
+	if err := resolveSchema(schema); err != nil {
+		t.Fatalf("resolving the spec: %s", err)
+	}
+	resolveResults(schema)
+	resolveParameterEnums(schema)
+	return schema
+}
+
+func TestDiffSchemas(t *testing.T) {
+	const (
+		lang  = `{"name": "lang", "in": "query", "type": "string"}`
+		limit = `{"name": "limit", "in": "query", "type": "integer", "format": "int32"}`
+	)
+	tests := []struct {
+		name         string
+		replacements []string
+		// The changes expected, as "kind action subject", with a trailing " breaking" when they're breaking.
+		want []string
+	}{
+		{
+			name: "unchanged",
+		},
+		{
+			name:         "method added",
+			replacements: []string{`"paths": {`, `"paths": {"/v2/user": {"get": {"operationId": "Nakama_GetUser", "responses": {"200": {"schema": {"$ref": "#/definitions/apiUser"}}}}},`},
+			want:         []string{"method added GetUserAsync"},
+		},
+		{
+			name:         "method removed",
+			replacements: []string{`"operationId": "Nakama_GetAccount"`, `"operationId": "Nakama_FetchAccount"`},
+			want:         []string{"method added FetchAccountAsync", "method removed GetAccountAsync breaking"},
+		},
+		{
+			name:         "optional parameter added",
+			replacements: []string{limit, limit + `, {"name": "cursor", "in": "query", "type": "string"}`},
+			want:         []string{"parameter added GetAccountAsync(cursor)"},
+		},
+		{
+			name:         "required parameter added",
+			replacements: []string{limit, limit + `, {"name": "cursor", "in": "query", "required": true, "type": "string"}`},
+			want:         []string{"parameter added GetAccountAsync(cursor) breaking"},
+		},
+		{
+			name:         "parameter removed",
+			replacements: []string{lang + ",", ""},
+			want:         []string{"parameter removed GetAccountAsync(lang) breaking"},
+		},
+		{
+			name:         "parameter type changed",
+			replacements: []string{`"format": "int32"}`, `"format": "int64"}`},
+			want:         []string{"parameter changed GetAccountAsync(limit) breaking"},
+		},
+		{
+			name:         "parameter made required",
+			replacements: []string{lang, `{"name": "lang", "in": "query", "required": true, "type": "string"}`},
+			want:         []string{"parameter changed GetAccountAsync(lang) breaking"},
+		},
+		{
+			name:         "parameters reordered",
+			replacements: []string{lang + ",\n          " + limit, limit + ", " + lang},
+			want:         []string{"method changed GetAccountAsync breaking"},
+		},
+		{
+			name:         "route changed",
+			replacements: []string{`"/v2/account/{id}"`, `"/v3/account/{id}"`},
+			want:         []string{"method changed GetAccountAsync"},
+		},
+		{
+			name:         "result changed",
+			replacements: []string{`"responses": {"200": {"schema": {"$ref": "#/definitions/apiAccount"}}}`, `"responses": {"200": {"schema": {"$ref": "#/definitions/apiUser"}}}`},
+			want:         []string{"result changed GetAccountAsync breaking"},
+		},
+		{
+			name:         "authentication removed",
+			replacements: []string{`[{"BearerJwt": []}, {"BasicAuth": []}]`, `[{"BearerJwt": []}]`},
+			want:         []string{"authentication removed GetAccountAsync breaking"},
+		},
+		{
+			name:         "property added",
+			replacements: []string{`"wallet": {"type": "string"},`, `"wallet": {"type": "string"}, "metadata": {"type": "string"},`},
+			want:         []string{"property added IApiAccount.Metadata"},
+		},
+		{
+			name:         "property removed",
+			replacements: []string{`"wallet": {"type": "string"},`, ""},
+			want:         []string{"property removed IApiAccount.Wallet breaking"},
+		},
+		{
+			name:         "property changed",
+			replacements: []string{`"wallet": {"type": "string"},`, `"wallet": {"type": "integer", "format": "int64"},`},
+			want:         []string{"property changed IApiAccount.Wallet breaking"},
+		},
+		{
+			name:         "enum member added",
+			replacements: []string{`["RED", "GREEN"]`, `["RED", "GREEN", "BLUE"]`},
+			want:         []string{"enum member added ApiColor.BLUE"},
+		},
+		{
+			name:         "enum member removed",
+			replacements: []string{`["RED", "GREEN"]`, `["RED"]`},
+			want:         []string{"enum member removed ApiColor.GREEN breaking"},
+		},
+		{
+			name:         "enum members reordered",
+			replacements: []string{`["RED", "GREEN"]`, `["GREEN", "RED"]`},
+			want:         []string{"enum member changed ApiColor.GREEN breaking", "enum member changed ApiColor.RED breaking"},
+		},
+		{
+			name:         "model removed",
+			replacements: []string{`"apiUser": {`, `"apiPlayer": {`},
+			want:         []string{"model added IApiPlayer", "model removed IApiUser breaking"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			old, updated := diffSpec(t), diffSpec(t, test.replacements...)
+			var got []string
+			for _, change := range diffSchemas(old, updated) {
+				entry := fmt.Sprintf("%s %s %s", change.Kind, change.Action, change.Subject)
+				if change.Breaking {
+					entry += " breaking"
+				}
+				got = append(got, entry)
+			}
+			if strings.Join(got, "\n") != strings.Join(test.want, "\n") {
+				t.Errorf("diffSchemas() =\n\t%s\nwant\n\t%s", strings.Join(got, "\n\t"), strings.Join(test.want, "\n\t"))
+			}
+		})
+	}
+}
+
+func TestDiffReportMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		changes []Change
+		want    string
+	}{
+		{
+			name: "unchanged",
+			want: "## API changes from old.json to new.json\n\nThe generated API is unchanged.\n",
+		},
+		{
+			// Breaking changes are listed first whatever their order in the report.
+			name: "breaking first",
+			changes: []Change{
+				{Description: "Added GetUsersAsync."},
+				{Description: "Removed IApiAccount.Wallet.", Breaking: true},
+			},
+			want: "## API changes from old.json to new.json\n" +
+				"\n### Breaking changes\n\n- Removed IApiAccount.Wallet.\n" +
+				"\n### Non-breaking changes\n\n- Added GetUsersAsync.\n",
+		},
+		{
+			name:    "only non-breaking",
+			changes: []Change{{Description: "Added GetUsersAsync."}},
+			want:    "## API changes from old.json to new.json\n\n### Non-breaking changes\n\n- Added GetUsersAsync.\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var b strings.Builder
+			DiffReport{Old: "old.json", New: "new.json", Changes: test.changes}.writeMarkdown(&b)
+			if b.String() != test.want {
+				t.Errorf("writeMarkdown() =\n%s\nwant\n%s", b.String(), test.want)
+			}
+		})
+	}
+}
+
+func TestDiffCommand(t *testing.T) {
+	dir := t.TempDir()
+	oldSpec, newSpec := filepath.Join(dir, "old.json"), filepath.Join(dir, "new.json")
+	writeSpec := func(name string, replacements ...string) {
+		content := strings.NewReplacer(replacements...).Replace(`{
+  "swagger": "2.0",
+  "paths": {
+    "/v2/account": {
+      "get": {"operationId": "Nakama_GetAccount",
+        "parameters": [{"name": "lang", "in": "query", "type": "string"}],
+        "responses": {"200": {"description": "", "schema": {"$ref": "#/definitions/apiAccount"}}}}
+    }
+  },
+  "definitions": {
+    "apiAccount": {"type": "object", "properties": {"wallet": {"type": "string"}}}
+  }
+}`)
+		if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSpec(oldSpec)
+	writeSpec(newSpec, `"wallet": {"type": "string"}`, `"coins": {"type": "string"}`)
+
+	stdout, stderr, code := runCommand(t, "diff", "-format", "json", oldSpec, newSpec)
+	if code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+	var report DiffReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("the report %q is not JSON: %s", stdout, err)
+	}
+	if !report.Breaking || len(report.Changes) != 2 {
+		t.Errorf("got a report with breaking %v and %d changes, want a breaking report of 2 changes:\n%s", report.Breaking, len(report.Changes), stdout)
+	}
+
+	stdout, _, _ = runCommand(t, "diff", oldSpec, oldSpec)
+	if !strings.HasSuffix(stdout, "\nThe generated API is unchanged.\n") {
+		t.Errorf("got output %q for an unchanged spec", stdout)
+	}
+
+	missing := filepath.Join(dir, "missing.json")
+	errorTests := []struct {
+		args []string
+		code int
+		want string
+	}{
+		{
+			[]string{"diff", "-format", "yaml", oldSpec, newSpec},
+			2,
+			"Unknown report format yaml, expected markdown or json.\n",
+		},
+		{
+			[]string{"diff", oldSpec, missing},
+			1,
+			missing + ": error: Unable to read spec " + missing + " : ",
+		},
+	}
+	for _, test := range errorTests {
+		stdout, stderr, code := runCommand(t, test.args...)
+		if code != test.code || stdout != "" || !strings.HasPrefix(stderr, test.want) {
+			t.Errorf("%s: got exit code %d, output %q and error %q, want %d and the error %q", strings.Join(test.args, " "), code, stdout, stderr, test.code, test.want)
+		}
+	}
+}
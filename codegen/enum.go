@@ -0,0 +1,156 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EnumMember is one member of a generated enum.
+type EnumMember struct {
+	// The C# member name.
+	Name string
+	// The name the member is sent by in JSON.
+	Wire  string
+	Value int
+	// Whether an earlier member has the same value, as with protobuf's allow_alias.
+	Alias       bool
+	Description string
+}
+
+// enumNames holds the enum values of a schema, which may be given as strings or numbers.
+type enumNames []string
+
+func (e *enumNames) UnmarshalJSON(data []byte) error {
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*e = make(enumNames, 0, len(values))
+	for _, v := range values {
+		var name string
+		if err := json.Unmarshal(v, &name); err != nil {
+			name = string(v)
+		}
+		*e = append(*e, name)
+	}
+	return nil
+}
+
+// enumMembers lists the members of an enum definition with their values. Integer enums are named by their
+// x-enum-varnames, and member documentation is read from the " - NAME: description" lines protoc-gen-openapiv2
+// appends to the enum's description.
+func enumMembers(definition ObjectDefinition) []EnumMember {
+	docs := enumMemberDescriptions(definition.Description)
+	members := make([]EnumMember, 0, len(definition.Enum))
+	seen := make(map[int]bool, len(definition.Enum))
+	for i, wire := range definition.Enum {
+		member := EnumMember{Name: wire, Wire: wire, Value: i}
+		if len(definition.EnumValues) == len(definition.Enum) {
+			member.Value = definition.EnumValues[i]
+		} else if value, err := strconv.Atoi(wire); err == nil {
+			member.Value = value
+			member.Name = "Value" + strings.Replace(wire, "-", "Minus", 1)
+			if i < len(definition.EnumVarNames) {
+				member.Name = definition.EnumVarNames[i]
+			}
+		}
+		member.Name = enumIdentifier(member.Name)
+		member.Description = docs[wire]
+		member.Alias = seen[member.Value]
+		seen[member.Value] = true
+		members = append(members, member)
+	}
+	return members
+}
+
+// enumUnknown is the extra member values which aren't members of the enum are parsed into, so that a client keeps
+// working when the server adds a value. It takes a name and value no declared member uses.
+func enumUnknown(definition ObjectDefinition) EnumMember {
+	unknown := EnumMember{
+		Name:        "Unknown",
+		Value:       -1,
+		Description: "A value this client does not recognize, such as one added in a newer version of the server.",
+	}
+	seen := make(map[int]bool, len(definition.Enum))
+	for _, member := range enumMembers(definition) {
+		if strings.EqualFold(member.Name, unknown.Name) {
+			unknown.Name = "Unrecognized"
+		}
+		seen[member.Value] = true
+	}
+	for seen[unknown.Value] {
+		unknown.Value--
+	}
+	return unknown
+}
+
+// enumSummary is the documentation of the enum type itself, which precedes the documentation of its members.
+func enumSummary(definition ObjectDefinition) string {
+	if definition.Title != "" {
+		return definition.Title
+	}
+	header, _, _ := strings.Cut(definition.Description, "\n\n - ")
+	if strings.HasPrefix(header, " - ") {
+		return ""
+	}
+	return strings.TrimSpace(header)
+}
+
+// enumMemberDescriptions parses the per-member documentation of an enum description by wire name. A member's
+// description continues over the following lines until the next member.
+func enumMemberDescriptions(description string) map[string]string {
+	docs := make(map[string]string)
+	current := ""
+	for _, line := range strings.Split(description, "\n") {
+		if strings.HasPrefix(line, " - ") {
+			if name, doc, ok := strings.Cut(strings.TrimPrefix(line, " - "), ":"); ok && !strings.ContainsAny(name, " \t") {
+				current = name
+				docs[current] = strings.TrimSpace(doc)
+				continue
+			}
+		}
+		if current != "" && strings.TrimSpace(line) != "" {
+			docs[current] = strings.TrimSpace(docs[current] + " " + strings.TrimSpace(line))
+		}
+	}
+	return docs
+}
+
+// enumIdentifier makes a wire name usable as a C# member name.
+func enumIdentifier(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	identifier := b.String()
+	if identifier == "" || unicode.IsDigit(rune(identifier[0])) {
+		identifier = "_" + identifier
+	}
+	return csharpIdentifier(identifier)
+}
+
+// enumConverter names the generated class which parses and formats the wire form of an enum.
+func enumConverter(ref string) string {
+	return fmt.Sprintf("%sConverter", convertRefToClassName(ref))
+}
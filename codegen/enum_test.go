@@ -0,0 +1,88 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestEnumMembers(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       []EnumMember
+	}{
+		{
+			name:       "string enum with member descriptions",
+			definition: `{"type": "string", "enum": ["RED", "GREEN"], "description": "A color.\n\n - RED: The red one.\n - GREEN: The green one,\nwhich spans lines."}`,
+			want: []EnumMember{
+				{Name: "RED", Wire: "RED", Value: 0, Description: "The red one."},
+				{Name: "GREEN", Wire: "GREEN", Value: 1, Description: "The green one, which spans lines."},
+			},
+		},
+		{
+			name:       "integer enum named by x-enum-varnames",
+			definition: `{"type": "integer", "enum": [0, 5, -1], "x-enum-varnames": ["Low", "Mid"]}`,
+			want: []EnumMember{
+				{Name: "Low", Wire: "0", Value: 0},
+				{Name: "Mid", Wire: "5", Value: 5},
+				{Name: "ValueMinus1", Wire: "-1", Value: -1},
+			},
+		},
+		{
+			name:       "names which aren't identifiers",
+			definition: `{"type": "string", "enum": ["dark-red", "2x", "class"]}`,
+			want: []EnumMember{
+				{Name: "dark_red", Wire: "dark-red", Value: 0},
+				{Name: "_2x", Wire: "2x", Value: 1},
+				{Name: "@class", Wire: "class", Value: 2},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var definition ObjectDefinition
+			if err := json.Unmarshal([]byte(test.definition), &definition); err != nil {
+				t.Fatal(err)
+			}
+			if got := enumMembers(definition); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnumUnknown(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition ObjectDefinition
+		wantName   string
+		wantValue  int
+	}{
+		{"no clash", ObjectDefinition{Enum: []string{"ROOM", "GROUP"}}, "Unknown", -1},
+		{"declared unknown", ObjectDefinition{Enum: []string{"UNKNOWN", "ROOM"}}, "Unrecognized", -1},
+		{"negative values", ObjectDefinition{Enum: []string{"-1", "-2", "0"}}, "Unknown", -3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			unknown := enumUnknown(test.definition)
+			if unknown.Name != test.wantName || unknown.Value != test.wantValue {
+				t.Errorf("got %s = %d, want %s = %d", unknown.Name, unknown.Value, test.wantName, test.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "sort"
+
+// ErrorModel describes the error response the server fails with, from which a typed exception per gRPC status code
+// is generated.
+type ErrorModel struct {
+	// The class of the error response, e.g. RpcStatus.
+	Class string
+	// Whether the error response carries a list of details.
+	Details bool
+	Codes   []GrpcCode
+}
+
+// GrpcCode is a gRPC status code with the exception it is raised as.
+type GrpcCode struct {
+	Code        int
+	Name        string
+	Description string
+}
+
+// grpcCodes lists the gRPC status codes which report a failure.
+var grpcCodes = []GrpcCode{
+	{1, "Cancelled", "The operation was cancelled, typically by the caller."},
+	{2, "Unknown", "An unknown error."},
+	{3, "InvalidArgument", "The client specified an invalid argument."},
+	{4, "DeadlineExceeded", "The deadline expired before the operation could complete."},
+	{5, "NotFound", "Some requested entity was not found."},
+	{6, "AlreadyExists", "The entity a client attempted to create already exists."},
+	{7, "PermissionDenied", "The caller does not have permission to execute the operation."},
+	{8, "ResourceExhausted", "Some resource has been exhausted, such as a per-user quota."},
+	{9, "FailedPrecondition", "The system is not in a state required for the operation's execution."},
+	{10, "Aborted", "The operation was aborted, typically due to a concurrency issue."},
+	{11, "OutOfRange", "The operation was attempted past the valid range."},
+	{12, "Unimplemented", "The operation is not implemented or not supported."},
+	{13, "Internal", "An internal error of the server."},
+	{14, "Unavailable", "The service is currently unavailable."},
+	{15, "DataLoss", "Unrecoverable data loss or corruption."},
+	{16, "Unauthenticated", "The request does not have valid authentication credentials."},
+}
+
+// resolveErrorModel picks the error response most operations declare as their default response. It must have the
+// integer code and string message of a gRPC status, or failures are left as the untyped ApiResponseException.
+func resolveErrorModel(s *Schema) {
+	counts := make(map[string]int)
+	// The first default response of each error response, in the order of their pointers, to report it at.
+	pointers := make(map[string]string)
+	for _, path := range s.Paths {
+		for _, operation := range path {
+			if ref := operation.Responses.Default.Schema.Ref; ref != "" {
+				counts[ref]++
+				pointer := childPointer(operation.Source, "responses", "default")
+				if first, ok := pointers[ref]; !ok || pointer < first {
+					pointers[ref] = pointer
+				}
+			}
+		}
+	}
+	if len(counts) == 0 {
+		return
+	}
+
+	refs := make([]string, 0, len(counts))
+	for ref := range counts {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if counts[refs[i]] != counts[refs[j]] {
+			return counts[refs[i]] > counts[refs[j]]
+		}
+		return refs[i] < refs[j]
+	})
+	for _, ref := range refs[1:] {
+		warnf(pointers[ref], "Error response %s of %d operations is parsed as %s instead", ref, counts[ref], refs[0])
+	}
+
+	def, ok := s.lookupDefinition(convertRefToClassName(refs[0]))
+	if !ok {
+		warnf(pointers[refs[0]], "Error response %s has no definition, so failures are not typed", refs[0])
+		return
+	}
+	code, message := def.Properties["code"], def.Properties["message"]
+	if code.Type != "integer" || message.Type != "string" {
+		warnf(def.Source, "Error response %s has no integer code and string message, so failures are not typed", refs[0])
+		return
+	}
+	details, ok := def.Properties["details"]
+	s.Errors = &ErrorModel{
+		Class:   convertRefToClassName(refs[0]),
+		Details: ok && details.Type == "array",
+		Codes:   grpcCodes,
+	}
+}
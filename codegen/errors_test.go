@@ -0,0 +1,107 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+)
+
+// errorSchema declares an operation for each error response reference, with the given definitions.
+func errorSchema(definitions map[string]ObjectDefinition, refs ...string) *Schema {
+	s := &Schema{
+		Paths:       map[string]map[string]Operation{"/v2/op": {}},
+		Definitions: definitions,
+	}
+	for i, ref := range refs {
+		var op Operation
+		op.Responses.Default.Schema.Ref = ref
+		s.Paths["/v2/op"][[]string{"get", "put", "post", "delete"}[i]] = op
+	}
+	return s
+}
+
+func TestResolveErrorModel(t *testing.T) {
+	status := ObjectDefinition{Properties: map[string]ObjectProperty{
+		"code":    {Type: "integer", Format: "int32"},
+		"message": {Type: "string"},
+		"details": {Type: "array", Items: Items{Ref: "#/definitions/protobufAny"}},
+	}}
+	legacy := ObjectDefinition{Properties: map[string]ObjectProperty{
+		"code":  {Type: "integer"},
+		"error": {Type: "string"},
+	}}
+
+	tests := []struct {
+		name        string
+		schema      *Schema
+		wantClass   string
+		wantDetails bool
+	}{
+		{
+			name:        "gRPC status",
+			schema:      errorSchema(map[string]ObjectDefinition{"rpcStatus": status}, "#/definitions/rpcStatus"),
+			wantClass:   "RpcStatus",
+			wantDetails: true,
+		},
+		{
+			name: "the response most operations declare",
+			schema: errorSchema(map[string]ObjectDefinition{"rpcStatus": status, "apiLegacy": legacy},
+				"#/definitions/apiLegacy", "#/definitions/rpcStatus", "#/definitions/rpcStatus"),
+			wantClass:   "RpcStatus",
+			wantDetails: true,
+		},
+		{
+			name: "status without details",
+			schema: errorSchema(map[string]ObjectDefinition{"apiError": {Properties: map[string]ObjectProperty{
+				"code":    {Type: "integer"},
+				"message": {Type: "string"},
+			}}}, "#/definitions/apiError"),
+			wantClass: "ApiError",
+		},
+		{
+			name:   "no message",
+			schema: errorSchema(map[string]ObjectDefinition{"apiLegacy": legacy}, "#/definitions/apiLegacy"),
+		},
+		{
+			name:   "undefined",
+			schema: errorSchema(map[string]ObjectDefinition{}, "#/definitions/rpcStatus"),
+		},
+		{
+			name:   "no error responses",
+			schema: errorSchema(map[string]ObjectDefinition{"rpcStatus": status}, ""),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolveErrorModel(test.schema)
+			if test.wantClass == "" {
+				if test.schema.Errors != nil {
+					t.Errorf("got error model %+v, want none", test.schema.Errors)
+				}
+				return
+			}
+			if test.schema.Errors == nil {
+				t.Fatal("got no error model")
+			}
+			if test.schema.Errors.Class != test.wantClass || test.schema.Errors.Details != test.wantDetails {
+				t.Errorf("got %s with details %v, want %s with details %v", test.schema.Errors.Class,
+					test.schema.Errors.Details, test.wantClass, test.wantDetails)
+			}
+			if len(test.schema.Errors.Codes) != 16 {
+				t.Errorf("got %d codes, want the 16 failure codes of gRPC", len(test.schema.Errors.Codes))
+			}
+		})
+	}
+}
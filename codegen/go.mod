@@ -9,6 +9,11 @@ tool (
 	google.golang.org/protobuf/cmd/protoc-gen-go
 )
 
+require (
+	google.golang.org/protobuf v1.36.10
+	gopkg.in/yaml.v3 v3.0.1
+)
+
 require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.18.2-0.20231220213037-30552a56c2c4 // indirect
 	github.com/kr/text v0.2.0 // indirect
@@ -18,6 +23,4 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251202230838-ff82c1b0f217 // indirect
 	google.golang.org/grpc v1.79.3 // indirect
 	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.5.1 // indirect
-	google.golang.org/protobuf v1.36.10 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,203 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// hoister moves anonymous object schemas into definitions of their own.
+type hoister struct {
+	schema *Schema
+}
+
+// hoistInlineSchemas moves every anonymous object schema, at any depth, into a named definition so that it's
+// generated as a class like any other. Inline request bodies are named after their operation (e.g.
+// ApiUpdateAccountRequest) and inline responses likewise with a Response suffix, as are the items or values of an
+// array or map response. Objects nested in a definition are named after it and their property, with an Item suffix
+// for array items, a Value suffix for map values, and a Variant suffix for the members of a oneOf or anyOf. A name
+// already taken gets a number appended.
+func hoistInlineSchemas(s *Schema) {
+	h := &hoister{schema: s}
+
+	names := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		def := s.Definitions[name]
+		h.definition(name, &def)
+		s.Definitions[name] = def
+	}
+
+	urls := make([]string, 0, len(s.Paths))
+	for url := range s.Paths {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+	for _, url := range urls {
+		methods := make([]string, 0, len(s.Paths[url]))
+		for method := range s.Paths[url] {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			operation := s.Paths[url][method]
+			prefix := "Api" + strings.TrimPrefix(operation.OperationId, fmt.Sprintf("%s_", s.Namespace))
+
+			parameters := make([]Parameter, len(operation.Parameters))
+			copy(parameters, operation.Parameters)
+			for i, param := range parameters {
+				if param.In == "body" && isInlineObject(param.Schema) {
+					source := childPointer(operation.Source, "parameters", strconv.Itoa(i), "schema")
+					if s.Document == "openapi3" {
+						source = childPointer(operation.Source, "requestBody")
+					}
+					parameters[i].Schema.Ref = h.hoist(prefix+"Request", param.Schema.definition(), source)
+				}
+			}
+			operation.Parameters = parameters
+
+			// An empty object response, such as google.protobuf.Empty, stays without a result.
+			response := &operation.Responses.Ok.Schema
+			source := childPointer(operation.Source, "responses", operation.Responses.Ok.Status)
+			if isInlineObject(*response) && len(response.Properties) > 0 {
+				response.Ref = h.hoist(prefix+"Response", response.definition(), source)
+			}
+			if response.Items.Ref == "" && len(response.Items.Properties) > 0 {
+				response.Items.Ref = h.hoist(prefix+"ResponseItem", ObjectDefinition{Properties: response.Items.Properties}, source)
+				response.Items.Type = ""
+				response.Items.Properties = nil
+			}
+			if response.AdditionalProperties.Ref == "" && len(response.AdditionalProperties.Properties) > 0 {
+				response.AdditionalProperties.Ref = h.hoist(prefix+"ResponseValue", ObjectDefinition{Properties: response.AdditionalProperties.Properties}, source)
+				response.AdditionalProperties.Type = ""
+				response.AdditionalProperties.Properties = nil
+			}
+			if isInlineObject(operation.Responses.Default.Schema) {
+				operation.Responses.Default.Schema.Ref = h.hoist(prefix+"Error", operation.Responses.Default.Schema.definition(), childPointer(operation.Source, "responses", "default"))
+			}
+			s.Paths[url][method] = operation
+		}
+	}
+}
+
+// isInlineObject reports an anonymous object schema, which may also be one without any properties.
+func isInlineObject(schema ObjectSchema) bool {
+	return schema.Ref == "" && (schema.Type == "object" || (schema.Type == "" && len(schema.Properties) > 0))
+}
+
+// definition returns the definition an inline object schema is hoisted into.
+func (s ObjectSchema) definition() ObjectDefinition {
+	return ObjectDefinition{
+		Properties:  s.Properties,
+		Description: s.Description,
+	}
+}
+
+// hoist adds an anonymous object as a definition under a name no other definition has, and returns its reference.
+// The definition keeps the pointer of the schema it was inline at.
+func (h *hoister) hoist(name string, def ObjectDefinition, source string) string {
+	unique := name
+	for i := 2; h.taken(unique); i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	def.Source = source
+	// Reserve the name before descending into its properties, which are named after it.
+	h.schema.Definitions[unique] = def
+	h.definition(unique, &def)
+	h.schema.Definitions[unique] = def
+	return "#/definitions/" + unique
+}
+
+// taken reports whether a definition name is in use, in either of the casings definition keys are looked up by.
+func (h *hoister) taken(name string) bool {
+	for _, definitions := range []map[string]ObjectDefinition{h.schema.Definitions, h.schema.Imports} {
+		for _, key := range []string{name, camelToPascal(name), pascalToCamel(name)} {
+			if _, ok := definitions[key]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// definition hoists the anonymous objects nested in a definition.
+func (h *hoister) definition(name string, def *ObjectDefinition) {
+	if len(def.Properties) > 0 {
+		properties := make(map[string]ObjectProperty, len(def.Properties))
+		for _, property := range orderedProperties(*def) {
+			properties[property.Name] = h.property(name, property, childPointer(def.Source, "properties", property.Name))
+		}
+		def.Properties = properties
+	}
+
+	allOf := make([]ObjectDefinition, len(def.AllOf))
+	for i, part := range def.AllOf {
+		// The members of an allOf are merged into the definition, so only the objects within them are hoisted.
+		part.Source = childPointer(def.Source, "allOf", strconv.Itoa(i))
+		h.definition(name, &part)
+		allOf[i] = part
+	}
+	def.AllOf = allOf
+	def.OneOf = h.variants(name, def.OneOf, childPointer(def.Source, "oneOf"))
+	def.AnyOf = h.variants(name, def.AnyOf, childPointer(def.Source, "anyOf"))
+}
+
+func (h *hoister) variants(name string, parts []ObjectDefinition, source string) []ObjectDefinition {
+	if len(parts) == 0 {
+		return parts
+	}
+	variants := make([]ObjectDefinition, len(parts))
+	for i, part := range parts {
+		if part.Ref == "" && (len(part.Properties) > 0 || len(part.AllOf) > 0 || len(part.OneOf) > 0 || len(part.AnyOf) > 0) {
+			part = ObjectDefinition{Ref: h.hoist(fmt.Sprintf("%sVariant%d", name, i+1), part, childPointer(source, strconv.Itoa(i)))}
+		}
+		variants[i] = part
+	}
+	return variants
+}
+
+// property hoists the anonymous objects of a property, its array items and its map values.
+func (h *hoister) property(parent string, property NamedProperty, source string) ObjectProperty {
+	p := property.ObjectProperty
+	name := parent + snakeToPascal(property.Name)
+
+	switch {
+	case p.Ref == "" && len(p.Properties) > 0:
+		p.Ref = h.hoist(name, ObjectDefinition{Properties: p.Properties, Description: p.Description, Title: p.Title}, source)
+		p.Type = ""
+		p.Properties = nil
+	case p.Type == "object" && p.AdditionalProperties.Type == "" && p.AdditionalProperties.Ref == "" && len(p.AdditionalProperties.Properties) == 0:
+		// An object which declares neither properties nor their type is taken as a map of strings.
+		p.AdditionalProperties.Type = "string"
+	}
+
+	if p.Items.Ref == "" && len(p.Items.Properties) > 0 {
+		p.Items.Ref = h.hoist(name+"Item", ObjectDefinition{Properties: p.Items.Properties}, childPointer(source, "items"))
+		p.Items.Type = ""
+		p.Items.Properties = nil
+	}
+	if p.AdditionalProperties.Ref == "" && len(p.AdditionalProperties.Properties) > 0 {
+		p.AdditionalProperties.Ref = h.hoist(name+"Value", ObjectDefinition{Properties: p.AdditionalProperties.Properties}, childPointer(source, "additionalProperties"))
+		p.AdditionalProperties.Type = ""
+		p.AdditionalProperties.Properties = nil
+	}
+	return p
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// jsonType is the C# type a value is read from and written to JSON as: a string, a primitive or a model class, on
+// its own or as the items of a list or the values of a map.
+type jsonType struct {
+	Element   string
+	Container string
+	// Whether a primitive is nullable, which only values on their own can be.
+	Nullable bool
+}
+
+// JSONMember is how a model reads and writes one property, through the C# member which holds its JSON form.
+type JSONMember struct {
+	// The name of the property in JSON, and the member which holds it.
+	Name   string
+	Member string
+	// The expression which reads the member's value from "reader", and the statement which writes it to "writer".
+	Read  string
+	Write string
+	// Whether the member may be null, in which case it is left out.
+	CheckNull bool
+}
+
+// jsonMember returns how a property is serialized, through the member which holds its JSON form.
+func (s *Schema) jsonMember(property NamedProperty) JSONMember {
+	member, t := s.storage(property)
+	return JSONMember{
+		Name:      camelToSnake(property.Name),
+		Member:    member,
+		Read:      readJSON(t),
+		Write:     writeJSON(t, member),
+		CheckNull: t.Container != "" || t.Nullable || !isValueType(t.Element),
+	}
+}
+
+// storage returns the member which holds a property as it is serialized, and its type, following the members the
+// definitions template declares: encoded primitives and enums are held as strings, and references as their class.
+func (s *Schema) storage(property NamedProperty) (string, jsonType) {
+	field := snakeToPascal(property.Name)
+	backing := "_" + snakeToCamel(property.Name)
+
+	var member string
+	var t jsonType
+	switch {
+	case primitive(property.Type, property.Format) != "":
+		if isEncoded(property.Type, property.Format) {
+			member, t = backing, jsonType{Element: "string"}
+		} else {
+			csharpType := nullable(property.Nullable, primitive(property.Type, property.Format))
+			member, t = field, jsonType{Element: strings.TrimSuffix(csharpType, "?"), Nullable: isNullable(csharpType)}
+		}
+	case property.Type == "array":
+		items := property.Items
+		itemType := primitive(items.Type, items.Format)
+		switch {
+		case itemType != "" && isEncoded(items.Type, items.Format):
+			member, t = backing, jsonType{Element: "string", Container: "list"}
+		case itemType != "":
+			member, t = field, jsonType{Element: itemType, Container: "list"}
+		default:
+			member, t = backing, jsonType{Element: s.jsonRef(items.Ref), Container: "list"}
+		}
+	case property.Type == "object":
+		values := property.AdditionalProperties
+		valueType := primitive(values.Type, values.Format)
+		switch {
+		case valueType != "" && isEncoded(values.Type, values.Format):
+			member, t = backing, jsonType{Element: "string", Container: "map"}
+		case valueType != "":
+			member, t = backing, jsonType{Element: valueType, Container: "map"}
+		default:
+			member, t = backing, jsonType{Element: s.jsonRef(values.Ref), Container: "map"}
+		}
+	default:
+		member, t = backing, jsonType{Element: s.jsonRef(property.Ref)}
+	}
+	return member, t
+}
+
+// envelopeMember returns how a field of the realtime envelope is serialized, which holds its value as is.
+func envelopeMember(field EnvelopeField) JSONMember {
+	t := jsonType{Element: field.Type}
+	member := snakeToPascal(field.Name)
+	return JSONMember{
+		Name:      field.Name,
+		Member:    member,
+		Read:      readJSON(t),
+		Write:     writeJSON(t, member),
+		CheckNull: !isValueType(t.Element),
+	}
+}
+
+// jsonRef is the type a reference is held as: the name of an enum, or the class of a model.
+func (s *Schema) jsonRef(ref string) string {
+	if s.isEnum(ref) {
+		return "string"
+	}
+	return convertRefToClassName(ref)
+}
+
+// jsonMethods names the ApiJsonReader and ApiJsonWriter methods of each primitive, after the Read and Write prefix.
+var jsonMethods = map[string]string{
+	"string": "String",
+	"int":    "Int32",
+	"long":   "Int64",
+	"ulong":  "UInt64",
+	"double": "Double",
+	"float":  "Single",
+	"bool":   "Boolean",
+}
+
+func isValueType(csharpType string) bool {
+	_, ok := jsonMethods[csharpType]
+	return ok && csharpType != "string"
+}
+
+// readElement is the method group which reads one value of an element type.
+func readElement(element string) string {
+	if method, ok := jsonMethods[element]; ok {
+		return "reader.Read" + method
+	}
+	return fmt.Sprintf("reader.ReadObject<%s>", element)
+}
+
+// writeElement is the method group which writes one value of an element type.
+func writeElement(element string) string {
+	if method, ok := jsonMethods[element]; ok {
+		return "writer.Write" + method
+	}
+	return "writer.WriteObject"
+}
+
+// readJSON returns the expression which reads a value of a type from "reader".
+func readJSON(t jsonType) string {
+	switch {
+	case t.Container == "list":
+		return fmt.Sprintf("reader.ReadList(%s)", readElement(t.Element))
+	case t.Container == "map":
+		return fmt.Sprintf("reader.ReadMap(%s)", readElement(t.Element))
+	case t.Nullable:
+		return fmt.Sprintf("reader.ReadNullable(%s)", readElement(t.Element))
+	}
+	return readElement(t.Element) + "()"
+}
+
+// writeJSON returns the statement which writes a value of a type to "writer".
+func writeJSON(t jsonType, value string) string {
+	switch {
+	case t.Container == "list":
+		return fmt.Sprintf("writer.WriteList(%s, %s)", value, writeElement(t.Element))
+	case t.Container == "map":
+		return fmt.Sprintf("writer.WriteMap(%s, %s)", value, writeElement(t.Element))
+	case t.Nullable:
+		value += ".Value"
+	}
+	return fmt.Sprintf("%s(%s)", writeElement(t.Element), value)
+}
+
+// csharpType is the C# type a value of a type is deserialized into.
+func (t jsonType) csharpType() string {
+	switch {
+	case t.Container == "list":
+		return fmt.Sprintf("List<%s>", t.Element)
+	case t.Container == "map":
+		return fmt.Sprintf("Dictionary<string, %s>", t.Element)
+	case t.Nullable:
+		return t.Element + "?"
+	}
+	return t.Element
+}
+
+// parseResponse returns the expression which parses the contents of a response as a value of a type, through the
+// generated readers or the JSON library.
+func (s *Schema) parseResponse(t jsonType) string {
+	if s.JSON != "tinyjson" {
+		return fmt.Sprintf("ParseResponse<%s>(contents)", t.csharpType())
+	}
+	return fmt.Sprintf("ParseResponse(contents, reader => %s)", readJSON(t))
+}
+
+// jsonBackend is a JSON library the generated models are annotated for.
+type jsonBackend struct {
+	// The namespaces of the library, the attribute which names a member and the one which leaves it out.
+	Namespaces []string
+	Property   string
+	Ignore     string
+}
+
+// jsonBackends are the libraries of the -json option. The default generates its own readers and writers, which
+// keep the attributes TinyJson reads.
+var jsonBackends = map[string]jsonBackend{
+	"tinyjson": {
+		Namespaces: []string{"System.Runtime.Serialization"},
+		Property:   "DataMember(Name=%q)",
+		Ignore:     "IgnoreDataMember",
+	},
+	"system": {
+		Namespaces: []string{"System.Text.Json", "System.Text.Json.Serialization"},
+		Property:   "JsonPropertyName(%q)",
+		Ignore:     "JsonIgnore",
+	},
+	"newtonsoft": {
+		Namespaces: []string{"Newtonsoft.Json"},
+		Property:   "JsonProperty(%q)",
+		Ignore:     "JsonIgnore",
+	},
+}
+
+// property returns the attributes of a serialized member, which is preserved from code stripping as libraries reach
+// it by reflection.
+func (b jsonBackend) property(name string) string {
+	return fmt.Sprintf(b.Property, name) + ", Preserve"
+}
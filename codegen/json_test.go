@@ -0,0 +1,96 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestJSONMember(t *testing.T) {
+	s := &Schema{Definitions: map[string]ObjectDefinition{
+		"apiColor": {Enum: enumNames{"RED", "GREEN"}},
+		"apiUser":  {},
+	}}
+	tests := []struct {
+		property NamedProperty
+		want     JSONMember
+	}{
+		{
+			NamedProperty{"count", ObjectProperty{Type: "integer", Format: "int32"}},
+			JSONMember{Name: "count", Member: "Count", Read: "reader.ReadInt32()", Write: "writer.WriteInt32(Count)"},
+		},
+		{
+			NamedProperty{"lang_tag", ObjectProperty{Type: "string", Nullable: true}},
+			JSONMember{Name: "lang_tag", Member: "LangTag", Read: "reader.ReadString()", Write: "writer.WriteString(LangTag)", CheckNull: true},
+		},
+		{
+			NamedProperty{"ratio", ObjectProperty{Type: "number", Format: "float", Nullable: true}},
+			JSONMember{Name: "ratio", Member: "Ratio", Read: "reader.ReadNullable(reader.ReadSingle)", Write: "writer.WriteSingle(Ratio.Value)", CheckNull: true},
+		},
+		// Encoded primitives and enums are held in their JSON form.
+		{
+			NamedProperty{"sent_at", ObjectProperty{Type: "string", Format: "int64"}},
+			JSONMember{Name: "sent_at", Member: "_sentAt", Read: "reader.ReadString()", Write: "writer.WriteString(_sentAt)", CheckNull: true},
+		},
+		{
+			NamedProperty{"color", ObjectProperty{Ref: "#/definitions/apiColor"}},
+			JSONMember{Name: "color", Member: "_color", Read: "reader.ReadString()", Write: "writer.WriteString(_color)", CheckNull: true},
+		},
+		{
+			NamedProperty{"user", ObjectProperty{Ref: "#/definitions/apiUser"}},
+			JSONMember{Name: "user", Member: "_user", Read: "reader.ReadObject<ApiUser>()", Write: "writer.WriteObject(_user)", CheckNull: true},
+		},
+		{
+			NamedProperty{"ids", ObjectProperty{Type: "array", Items: Items{Type: "string", Format: "uint64"}}},
+			JSONMember{Name: "ids", Member: "_ids", Read: "reader.ReadList(reader.ReadString)", Write: "writer.WriteList(_ids, writer.WriteString)", CheckNull: true},
+		},
+		{
+			NamedProperty{"scores", ObjectProperty{Type: "array", Items: Items{Type: "number", Format: "double"}}},
+			JSONMember{Name: "scores", Member: "Scores", Read: "reader.ReadList(reader.ReadDouble)", Write: "writer.WriteList(Scores, writer.WriteDouble)", CheckNull: true},
+		},
+		{
+			NamedProperty{"users", ObjectProperty{Type: "object", AdditionalProperties: AdditionalProperties{Ref: "#/definitions/apiUser"}}},
+			JSONMember{Name: "users", Member: "_users", Read: "reader.ReadMap(reader.ReadObject<ApiUser>)", Write: "writer.WriteMap(_users, writer.WriteObject)", CheckNull: true},
+		},
+	}
+	for _, test := range tests {
+		if got := s.jsonMember(test.property); got != test.want {
+			t.Errorf("jsonMember(%s) = %+v, want %+v", test.property.Name, got, test.want)
+		}
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		json string
+		t    jsonType
+		want string
+	}{
+		{"tinyjson", jsonType{Element: "ApiUser"}, "ParseResponse(contents, reader => reader.ReadObject<ApiUser>())"},
+		{"tinyjson", jsonType{Element: "string", Container: "list"}, "ParseResponse(contents, reader => reader.ReadList(reader.ReadString))"},
+		{"tinyjson", jsonType{Element: "long", Container: "map"}, "ParseResponse(contents, reader => reader.ReadMap(reader.ReadInt64))"},
+		// The other libraries deserialize into the C# type of the response.
+		{"system", jsonType{Element: "ApiUser"}, "ParseResponse<ApiUser>(contents)"},
+		{"system", jsonType{Element: "string", Container: "list"}, "ParseResponse<List<string>>(contents)"},
+		{"newtonsoft", jsonType{Element: "long", Container: "map"}, "ParseResponse<Dictionary<string, long>>(contents)"},
+		{"newtonsoft", jsonType{Element: "bool", Nullable: true}, "ParseResponse<bool?>(contents)"},
+	}
+	for _, test := range tests {
+		s := &Schema{JSON: test.json}
+		if got := s.parseResponse(test.t); got != test.want {
+			t.Errorf("parseResponse(%+v) with %s = %q, want %q", test.t, test.json, got, test.want)
+		}
+	}
+}
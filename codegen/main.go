@@ -15,11 +15,13 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
 	"unicode"
@@ -33,16 +35,19 @@ namespace {{.Namespace}}
 {
     using System;
     using System.Collections.Generic;
-    using System.Runtime.Serialization;
+    using System.Globalization;
+    using System.IO;
+    {{- range jsonNamespaces }}
+    using {{ . }};
+    {{- end }}
     using System.Text;
     using System.Threading;
     using System.Threading.Tasks;
-    using TinyJson;
 
     /// <summary>
     /// An exception generated for <c>HttpResponse</c> objects don't return a success status.
     /// </summary>
-    public sealed class ApiResponseException : Exception
+    public class ApiResponseException : Exception
     {
         public long StatusCode { get; }
 
@@ -64,386 +69,2087 @@ namespace {{.Namespace}}
         {
         }
 
+        protected ApiResponseException(ApiResponseException e) : base(e.Message, e)
+        {
+            StatusCode = e.StatusCode;
+            GrpcStatusCode = e.GrpcStatusCode;
+            foreach (var key in e.Data.Keys)
+            {
+                Data[key] = e.Data[key];
+            }
+        }
+
         public override string ToString()
         {
-            return $"ApiResponseException(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
+            return $"{GetType().Name}(StatusCode={StatusCode}, Message='{Message}', GrpcStatusCode={GrpcStatusCode})";
         }
     }
+    {{- with .Errors }}
 
-    {{- range $defname, $definition := .Definitions }}
-    {{- $classname := $defname | title }}
+    /// <summary>
+    /// A failure the server reported with a gRPC status code.
+    /// </summary>
+    public class ApiStatusException : ApiResponseException
+    {
+        /// <summary>
+        /// The error response, with the details the <see cref="IHttpAdapter"/> passed on in the exception data.
+        /// </summary>
+        public I{{ .Class }} Status { get; }
 
-    {{- if isRefToEnum $defname }}
+        public ApiStatusException(ApiResponseException e) : base(e)
+        {
+            {{- if eq $.Transport "protobuf" }}
+            if (e.Data["content"] is byte[] content)
+            {
+                Status = ApiClient.ParseResponse<{{ .Class }}>(content);
+                return;
+            }
+            {{- end }}
+            var status = new Dictionary<string, object>
+            {
+                {"code", e.GrpcStatusCode},
+                {"message", e.Message}
+            };
+            {{- if .Details }}
+            if (e.Data.Contains("details"))
+            {
+                status["details"] = e.Data["details"];
+            }
+            {{- end }}
+            {{- if eq $.JSON "system" }}
+            Status = JsonSerializer.Deserialize<{{ .Class }}>(JsonSerializer.Serialize(status, ApiClient.JsonOptions),
+                ApiClient.JsonOptions);
+            {{- else if eq $.JSON "newtonsoft" }}
+            Status = JsonConvert.DeserializeObject<{{ .Class }}>(JsonConvert.SerializeObject(status, ApiClient.JsonSettings),
+                ApiClient.JsonSettings);
+            {{- else }}
+            var writer = new ApiJsonWriter();
+            writer.WriteAny(status);
+            Status = new ApiJsonReader(writer.ToString()).ReadObject<{{ .Class }}>();
+            {{- end }}
+        }
+
+        /// <summary>
+        /// The exception for the gRPC status code of a failure, or null when the code is not a known one.
+        /// </summary>
+        internal static ApiStatusException FromResponse(ApiResponseException e)
+        {
+            switch (e.GrpcStatusCode)
+            {
+                {{- range .Codes }}
+                case {{ .Code }}:
+                    return new {{ .Name }}Exception(e);
+                {{- end }}
+                default:
+                    return null;
+            }
+        }
+    }
+    {{- range .Codes }}
 
     /// <summary>
-    /// {{ $definition.Title | commentify }}
+    /// {{ .Description }}
     /// </summary>
-    public enum {{ $classname }}
+    public class {{ .Name }}Exception : ApiStatusException
+    {
+        public {{ .Name }}Exception(ApiResponseException e) : base(e)
+        {
+        }
+    }
+    {{- end }}
+    {{- end }}
+
+
+    /// <summary>
+    /// Options of a single request, which override those of the client.
+    /// </summary>
+    public class RequestOptions
     {
-        {{- range $idx, $enum := $definition.Enum }}
         /// <summary>
-        /// {{ (index (splitEnumDescription $definition.Description $idx) $idx) }}
+        /// The timeout of the request in seconds, in place of the timeout of the client.
         /// </summary>
-        {{ $enum }} = {{ $idx }},
-        {{- end }}
+        public int? Timeout { get; set; }
+
+        /// <summary>
+        /// Headers sent with the request, which replace those of the same name set by the method.
+        /// </summary>
+        public IDictionary<string, string> Headers { get; set; }
+
+        /// <summary>
+        /// The token which cancels the request, unless one is passed to the method.
+        /// </summary>
+        public CancellationToken? CancellationToken { get; set; }
+
+        /// <summary>
+        /// The retry configuration of the request, used by clients which retry it.
+        /// </summary>
+        public RetryConfiguration RetryConfiguration { get; set; }
     }
-    {{- else }}
+
+    {{- if eq .JSON "tinyjson" }}
 
     /// <summary>
-    /// {{ (descriptionOrTitle $definition.Description $definition.Title) | stripNewlines }}
+    /// A model which reads and writes its own members as JSON, without reflection.
     /// </summary>
-    public interface I{{ $classname }}
+    internal interface IApiJsonObject
     {
-        {{- range $propname, $property := $definition.Properties }}
-        {{- $fieldname := $propname | snakeToPascal }}
+        /// <summary>
+        /// Reads the value of a member, and returns false when the model has no member of that name.
+        /// </summary>
+        bool ReadMember(string name, ApiJsonReader reader);
 
         /// <summary>
-        /// {{ (descriptionOrTitle $property.Description $property.Title) | stripNewlines }}
+        /// Writes the members which are set.
         /// </summary>
-        {{- if eq $property.Type "integer"}}
-        int {{ $fieldname }} { get; }
-        {{- else if eq $property.Type "number" }}
-        double {{ $fieldname }} { get; }
-        {{- else if eq $property.Type "boolean" }}
-        bool {{ $fieldname }} { get; }
-        {{- else if eq $property.Type "string"}}
-        string {{ $fieldname }} { get; }
-        {{- else if eq $property.Type "array"}}
-            {{- if eq $property.Items.Type "string"}}
-        List<string> {{ $fieldname }} { get; }
-            {{- else if eq $property.Items.Type "integer"}}
-        List<int> {{ $fieldname }} { get; }
-            {{- else if eq $property.Items.Type "number"}}
-        List<double> {{ $fieldname }} { get; }
-            {{- else if eq $property.Items.Type "boolean"}}
-        List<bool> {{ $fieldname }} { get; }
-            {{- else}}
-        IEnumerable<I{{ $property.Items.Ref | cleanRef }}> {{ $fieldname }} { get; }
-            {{- end }}
-        {{- else if eq $property.Type "object"}}
-            {{- if eq $property.AdditionalProperties.Type "string" }}
-                {{- if eq $property.AdditionalProperties.Format "int64" }}
-        IDictionary<string, int> {{$fieldname}} { get; }
-                {{- else }}
-        IDictionary<string, string> {{$fieldname}} { get; }
-                {{- end }}
-            {{- else if eq $property.AdditionalProperties.Type "integer"}}
-        IDictionary<string, int> {{$fieldname}} { get; }
-            {{- else if eq $property.AdditionalProperties.Type "number"}}
-        IDictionary<string, double> {{$fieldname}} { get; }
-            {{- else if eq $property.AdditionalProperties.Type "boolean"}}
-        IDictionary<string, bool> {{$fieldname}} { get; }
-            {{- else }}
-        IDictionary<string, I{{$property.AdditionalProperties.Ref | cleanRef}}> {{$fieldname}} { get; }
-            {{- end}}
-        {{- else if isRefToEnum (cleanRef $property.Ref) }}
-        {{ $property.Ref | cleanRef }} {{ $fieldname }} { get; }
-        {{- else }}
-        I{{ $property.Ref | cleanRef }} {{ $fieldname }} { get; }
-        {{- end }}
-        {{- end }}
+        void WriteMembers(ApiJsonWriter writer);
     }
 
-    /// <inheritdoc />
-    internal class {{ $classname }} : I{{ $classname }}
+    /// <summary>
+    /// A forward-only reader of JSON text, which values are read from in the order they appear.
+    /// </summary>
+    internal sealed class ApiJsonReader
     {
-        {{- range $propname, $property := $definition.Properties }}
-        {{- $fieldname := $propname | snakeToPascal }}
-        {{- $attrDataName := $propname | camelToSnake }}
+        private readonly string _json;
+        private int _position;
 
-        /// <inheritdoc />
-        {{- if eq $property.Type "integer" }}
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public int {{ $fieldname }} { get; set; }
-        {{- else if eq $property.Type "number" }}
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public double {{ $fieldname }} { get; set; }
-        {{- else if eq $property.Type "boolean" }}
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public bool {{ $fieldname }} { get; set; }
-        {{- else if eq $property.Type "string" }}
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public string {{ $fieldname }} { get; set; }
-        {{- else if eq $property.Type "array" }}
-            {{- if eq $property.Items.Type "string" }}
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public List<string> {{ $fieldname }} { get; set; }
-            {{- else if eq $property.Items.Type "integer" }}
-        [DataMember(Name="{{ $propname }}"), Preserve]
-        public List<int> {{ $fieldname }} { get; set; }
-            {{- else if eq $property.Items.Type "number" }}
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public List<double> {{ $fieldname }} { get; set; }
-            {{- else if eq $property.Items.Type "boolean" }}
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public List<bool> {{ $fieldname }} { get; set; }
-            {{- else}}
-        [IgnoreDataMember]
-        public IEnumerable<I{{ $property.Items.Ref | cleanRef }}> {{ $fieldname }} => _{{ $propname | snakeToCamel }} ?? new List<{{ $property.Items.Ref | cleanRef }}>(0);
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public List<{{ $property.Items.Ref | cleanRef }}> _{{ $propname | snakeToCamel }} { get; set; }
-            {{- end }}
-        {{- else if eq $property.Type "object"}}
-            {{- if eq $property.AdditionalProperties.Type "string"}}
-                {{- if eq $property.AdditionalProperties.Format "int64" }}
-        [IgnoreDataMember]
-        public IDictionary<string, int> {{ $fieldname }} => ApiClient.DeserializeIntProperties(_{{ $propname | snakeToCamel }}) ?? new Dictionary<string, int>();
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public Dictionary<string, string> _{{ $propname | snakeToCamel }} { get; set; }
-                {{- else }}
-        [IgnoreDataMember]
-        public IDictionary<string, string> {{ $fieldname }} => _{{ $propname | snakeToCamel }} ?? new Dictionary<string, string>();
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public Dictionary<string, string> _{{ $propname | snakeToCamel }} { get; set; }
-                 {{- end }}
-            {{- else if eq $property.AdditionalProperties.Type "integer"}}
-        [IgnoreDataMember]
-        public IDictionary<string, int> {{ $fieldname }} => _{{ $propname | snakeToCamel }} ?? new Dictionary<string, int>();
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-           {{- else if eq $property.AdditionalProperties.Type "number"}}
-        [IgnoreDataMember]
-        public IDictionary<string, double> {{ $fieldname }} => _{{ $propname | snakeToCamel }} ?? new Dictionary<string, double>();
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public Dictionary<string, int> _{{ $propname | snakeToCamel }} { get; set; }
-            {{- else if eq $property.AdditionalProperties.Type "boolean"}}
-        [IgnoreDataMember]
-        public IDictionary<string, bool> {{ $fieldname }} => _{{ $propname | snakeToCamel }} ?? new Dictionary<string, bool>();
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public Dictionary<string, bool> _{{ $propname | snakeToCamel }} { get; set; }
-            {{- else}}
-        [IgnoreDataMember]
-        public IDictionary<string, I{{$property.AdditionalProperties.Ref | cleanRef}}> {{ $fieldname }}  => _{{ $propname | snakeToCamel }} ?? new Dictionary<string, I{{$property.AdditionalProperties.Ref | cleanRef}}>();
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public Dictionary<string, I{{$property.AdditionalProperties.Ref | cleanRef}}> _{{ $propname | snakeToCamel }} { get; set; }
-            {{- end}}
-        {{- else if isRefToEnum (cleanRef $property.Ref) }}
-        [IgnoreDataMember]
-        public {{ $property.Ref | cleanRef }} {{ $fieldname }} => _{{ $propname | snakeToCamel }};
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public {{ $property.Ref | cleanRef }} _{{ $propname | snakeToCamel }} { get; set; }
-        {{- else }}
-        [IgnoreDataMember]
-        public I{{ $property.Ref | cleanRef }} {{ $fieldname }} => _{{ $propname | snakeToCamel }};
-        [DataMember(Name="{{ $attrDataName }}"), Preserve]
-        public {{ $property.Ref | cleanRef }} _{{ $propname | snakeToCamel }} { get; set; }
-        {{- end }}
-        {{- end }}
+        public ApiJsonReader(string json)
+        {
+            _json = json;
+        }
 
-        public override string ToString()
+        /// <summary>
+        /// Reads an object into a new model, or returns null for a JSON null. Members the model doesn't know are skipped.
+        /// </summary>
+        public T ReadObject<T>() where T : class, IApiJsonObject, new()
         {
-            var output = "";
-            {{- range $fieldname, $property := $definition.Properties }}
-            {{- if eq $property.Type "array" }}
-            output = string.Concat(output, "{{ $fieldname | snakeToPascal }}: [", string.Join(", ", {{ $fieldname | snakeToPascal }}), "], ");
-            {{- else if eq $property.Type "object" }}
+            if (ReadNull())
+            {
+                return null;
+            }
 
-            var {{ $fieldname }}String = "";
-            foreach (var kvp in {{ $fieldname | snakeToPascal }})
+            var value = new T();
+            Expect('{');
+            if (!Consume('}'))
             {
-                {{ $fieldname }}String = string.Concat({{ $fieldname }}String, "{" + kvp.Key + "=" + kvp.Value + "}");
+                do
+                {
+                    var name = ReadName();
+                    if (!value.ReadMember(name, this))
+                    {
+                        Skip();
+                    }
+                } while (Consume(','));
+                Expect('}');
             }
-            output = string.Concat(output, "{{ $fieldname | snakeToPascal }}: [" + {{ $fieldname }}String + "]");
-            {{- else }}
-            output = string.Concat(output, "{{ $fieldname | snakeToPascal }}: ", {{ $fieldname | snakeToPascal }}, ", ");
-            {{- end }}
-            {{- end }}
-            return output;
+            return value;
         }
-    }
-    {{- end }}
 
+        public List<T> ReadList<T>(Func<T> readItem)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
 
-    {{- end }}
+            var list = new List<T>();
+            Expect('[');
+            if (!Consume(']'))
+            {
+                do
+                {
+                    list.Add(readItem());
+                } while (Consume(','));
+                Expect(']');
+            }
+            return list;
+        }
+
+        public Dictionary<string, T> ReadMap<T>(Func<T> readValue)
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+
+            var map = new Dictionary<string, T>();
+            Expect('{');
+            if (!Consume('}'))
+            {
+                do
+                {
+                    var key = ReadName();
+                    map[key] = readValue();
+                } while (Consume(','));
+                Expect('}');
+            }
+            return map;
+        }
+
+        public T? ReadNullable<T>(Func<T> read) where T : struct => ReadNull() ? (T?) null : read();
+
+        /// <summary>
+        /// Reads a string, or the text of a number or literal in its place.
+        /// </summary>
+        public string ReadString()
+        {
+            if (ReadNull())
+            {
+                return null;
+            }
+            if (Peek() != '"')
+            {
+                return ReadLiteral();
+            }
+
+            _position++;
+            StringBuilder builder = null;
+            var start = _position;
+            while (_position < _json.Length)
+            {
+                var c = _json[_position];
+                if (c == '"')
+                {
+                    var value = builder == null
+                        ? _json.Substring(start, _position - start)
+                        : builder.Append(_json, start, _position - start).ToString();
+                    _position++;
+                    return value;
+                }
+                if (c != '\\')
+                {
+                    _position++;
+                    continue;
+                }
+
+                if (builder == null)
+                {
+                    builder = new StringBuilder();
+                }
+                builder.Append(_json, start, _position - start);
+                if (++_position >= _json.Length)
+                {
+                    break;
+                }
+                switch (_json[_position])
+                {
+                    case 'b':
+                        builder.Append('\b');
+                        break;
+                    case 'f':
+                        builder.Append('\f');
+                        break;
+                    case 'n':
+                        builder.Append('\n');
+                        break;
+                    case 'r':
+                        builder.Append('\r');
+                        break;
+                    case 't':
+                        builder.Append('\t');
+                        break;
+                    case 'u':
+                        if (_position + 4 >= _json.Length ||
+                            !int.TryParse(_json.Substring(_position + 1, 4), NumberStyles.AllowHexSpecifier,
+                                CultureInfo.InvariantCulture, out var code))
+                        {
+                            throw Error("invalid unicode escape");
+                        }
+                        builder.Append((char) code);
+                        _position += 4;
+                        break;
+                    default:
+                        builder.Append(_json[_position]);
+                        break;
+                }
+                start = ++_position;
+            }
+            throw Error("unterminated string");
+        }
+
+        public int ReadInt32() => int.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public long ReadInt64() => long.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public ulong ReadUInt64() => ulong.Parse(ReadNumber(), NumberStyles.Integer, CultureInfo.InvariantCulture);
+
+        public double ReadDouble() => double.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public float ReadSingle() => float.Parse(ReadNumber(), NumberStyles.Float, CultureInfo.InvariantCulture);
+
+        public bool ReadBoolean() => ReadString() == "true";
+
+        /// <summary>
+        /// Skips the next value, whatever its type.
+        /// </summary>
+        public void Skip()
+        {
+            switch (Peek())
+            {
+                case '{':
+                    ReadMap(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                case '[':
+                    ReadList(() =>
+                    {
+                        Skip();
+                        return false;
+                    });
+                    break;
+                default:
+                    ReadString();
+                    break;
+            }
+        }
+
+        private string ReadName()
+        {
+            if (Peek() != '"')
+            {
+                throw Error("expected a member name");
+            }
+            var name = ReadString();
+            Expect(':');
+            return name;
+        }
+
+        // Numbers which don't fit a double are sent as strings, and a null is read as the default value.
+        private string ReadNumber() => ReadString() ?? "0";
+
+        private string ReadLiteral()
+        {
+            var start = _position;
+            while (_position < _json.Length && ",:]} \t\r\n".IndexOf(_json[_position]) < 0)
+            {
+                _position++;
+            }
+            if (_position == start)
+            {
+                throw Error("expected a value");
+            }
+            return _json.Substring(start, _position - start);
+        }
+
+        private bool ReadNull()
+        {
+            if (Peek() != 'n' || string.CompareOrdinal(_json, _position, "null", 0, 4) != 0)
+            {
+                return false;
+            }
+            _position += 4;
+            return true;
+        }
+
+        private bool Consume(char c)
+        {
+            if (Peek() != c)
+            {
+                return false;
+            }
+            _position++;
+            return true;
+        }
+
+        private void Expect(char c)
+        {
+            if (!Consume(c))
+            {
+                throw Error(string.Concat("expected '", c.ToString(), "'"));
+            }
+        }
+
+        private char Peek()
+        {
+            while (_position < _json.Length && char.IsWhiteSpace(_json[_position]))
+            {
+                _position++;
+            }
+            return _position < _json.Length ? _json[_position] : '\0';
+        }
+
+        private FormatException Error(string message) =>
+            new FormatException(string.Concat("Invalid JSON at position ",
+                _position.ToString(CultureInfo.InvariantCulture), ": ", message));
+    }
 
     /// <summary>
-    /// The low level client for the {{ .Namespace }} API.
+    /// A writer of JSON text, which values are written to in order.
     /// </summary>
-    internal class ApiClient
+    internal sealed class ApiJsonWriter
     {
-        public readonly IHttpAdapter HttpAdapter;
-        public int Timeout { get; set; }
+        private readonly StringBuilder _builder = new StringBuilder();
+        private bool _separate;
 
-        private readonly Uri _baseUri;
+        public void WriteName(string name)
+        {
+            WriteString(name);
+            _builder.Append(':');
+            _separate = false;
+        }
 
-        public ApiClient(Uri baseUri, IHttpAdapter httpAdapter, int timeout = 10)
+        public void WriteObject(IApiJsonObject value)
         {
-            _baseUri = baseUri;
-            HttpAdapter = httpAdapter;
-            Timeout = timeout;
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            value.WriteMembers(this);
+            _builder.Append('}');
+            _separate = true;
         }
 
-        {{- range $url, $path := .Paths }}
-        {{- range $method, $operation := $path}}
+        public void WriteList<T>(IEnumerable<T> values, Action<T> writeItem)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('[');
+            _separate = false;
+            foreach (var value in values)
+            {
+                writeItem(value);
+            }
+            _builder.Append(']');
+            _separate = true;
+        }
+
+        public void WriteMap<T>(IDictionary<string, T> values, Action<T> writeValue)
+        {
+            if (values == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('{');
+            _separate = false;
+            foreach (var kvp in values)
+            {
+                WriteName(kvp.Key);
+                writeValue(kvp.Value);
+            }
+            _builder.Append('}');
+            _separate = true;
+        }
+
+        public void WriteString(string value)
+        {
+            if (value == null)
+            {
+                WriteNull();
+                return;
+            }
+            Separate();
+            _builder.Append('"');
+            foreach (var c in value)
+            {
+                switch (c)
+                {
+                    case '"':
+                        _builder.Append("\\\"");
+                        break;
+                    case '\\':
+                        _builder.Append("\\\\");
+                        break;
+                    case '\n':
+                        _builder.Append("\\n");
+                        break;
+                    case '\r':
+                        _builder.Append("\\r");
+                        break;
+                    case '\t':
+                        _builder.Append("\\t");
+                        break;
+                    default:
+                        if (c < ' ')
+                        {
+                            _builder.Append("\\u").Append(((int) c).ToString("x4", CultureInfo.InvariantCulture));
+                        }
+                        else
+                        {
+                            _builder.Append(c);
+                        }
+                        break;
+                }
+            }
+            _builder.Append('"');
+            _separate = true;
+        }
+
+        public void WriteInt32(int value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteInt64(long value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteUInt64(ulong value) => WriteLiteral(value.ToString(CultureInfo.InvariantCulture));
+
+        public void WriteDouble(double value)
+        {
+            // JSON has no literal for NaN or infinities, which are sent as strings.
+            if (double.IsNaN(value) || double.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
+
+        public void WriteSingle(float value)
+        {
+            // Formatted as a float, so that it isn't widened to a double with digits it never had, e.g. 0.1f.
+            if (float.IsNaN(value) || float.IsInfinity(value))
+            {
+                WriteString(value.ToString(CultureInfo.InvariantCulture));
+                return;
+            }
+            WriteLiteral(value.ToString("R", CultureInfo.InvariantCulture));
+        }
+
+        public void WriteBoolean(bool value) => WriteLiteral(value ? "true" : "false");
+
+        public void WriteNull() => WriteLiteral("null");
 
         /// <summary>
-        /// {{ $operation.Summary | stripNewlines }}
+        /// Writes a value of unknown type, as parsed into dictionaries, lists and primitives.
         /// </summary>
-        {{- if $operation.Responses.Ok.Schema.Ref }}
-        public async Task<I{{ $operation.Responses.Ok.Schema.Ref | cleanRef }}> {{ $operation.OperationId | stripOperationPrefix | snakeToPascal }}Async(
-        {{- else }}
-        public async Task {{ $operation.OperationId | stripOperationPrefix | snakeToPascal }}Async(
-        {{- end}}
+        public void WriteAny(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    WriteNull();
+                    break;
+                case string s:
+                    WriteString(s);
+                    break;
+                case bool b:
+                    WriteBoolean(b);
+                    break;
+                case int i:
+                    WriteInt32(i);
+                    break;
+                case long l:
+                    WriteInt64(l);
+                    break;
+                case double d:
+                    WriteDouble(d);
+                    break;
+                case float f:
+                    WriteSingle(f);
+                    break;
+                case IApiJsonObject o:
+                    WriteObject(o);
+                    break;
+                case IDictionary<string, object> map:
+                    WriteMap(map, WriteAny);
+                    break;
+                case System.Collections.IEnumerable list:
+                    Separate();
+                    _builder.Append('[');
+                    _separate = false;
+                    foreach (var item in list)
+                    {
+                        WriteAny(item);
+                    }
+                    _builder.Append(']');
+                    _separate = true;
+                    break;
+                default:
+                    WriteString(Convert.ToString(value, CultureInfo.InvariantCulture));
+                    break;
+            }
+        }
 
-        {{- $isPreviousParam := false}}
+        public override string ToString() => _builder.ToString();
 
-        {{- if $operation.Security }}
-            {{- range $idx, $security := $operation.Security}}
-                {{- range $key, $value := $security}}
-                    {{- if or (eq $key "BasicAuth") (eq $key "HttpKeyAuth") }}
-            string basicAuthUsername,
-            string basicAuthPassword
-                        {{- $isPreviousParam = true}}
-                    {{- else if (eq $key "BearerJwt") }}
-           {{- if eq $isPreviousParam true}},{{- end}}
-                        {{- $isPreviousParam = true}}
-            string bearerToken
-                    {{- end }}
-                {{- end }}
+        private void WriteLiteral(string value)
+        {
+            Separate();
+            _builder.Append(value);
+            _separate = true;
+        }
+
+        private void Separate()
+        {
+            if (_separate)
+            {
+                _builder.Append(',');
+            }
+        }
+    }
+    {{- end }}
+    {{- if eq .Transport "protobuf" }}
+
+    /// <summary>
+    /// A model which reads and writes its own fields in the protobuf wire format, without reflection.
+    /// </summary>
+    internal interface IApiProtoMessage
+    {
+        /// <summary>
+        /// Reads the value of a field, and returns false when the model has no field of that number.
+        /// </summary>
+        bool ReadField(int number, ApiProtoReader reader);
+
+        /// <summary>
+        /// Writes the fields which are set.
+        /// </summary>
+        void WriteFields(ApiProtoWriter writer);
+    }
+
+    /// <summary>
+    /// A forward-only reader of protobuf messages, which fields are read from in the order they appear.
+    /// </summary>
+    internal sealed class ApiProtoReader
+    {
+        internal const int Varint = 0;
+        internal const int Fixed64 = 1;
+        internal const int LengthDelimited = 2;
+        internal const int StartGroup = 3;
+        internal const int EndGroup = 4;
+        internal const int Fixed32 = 5;
+
+        private static readonly DateTime Epoch = new DateTime(1970, 1, 1, 0, 0, 0, DateTimeKind.Utc);
+
+        private readonly byte[] _buffer;
+        private int _position;
+        private int _limit;
+        private int _wireType;
+
+        public ApiProtoReader(byte[] buffer)
+        {
+            _buffer = buffer ?? new byte[0];
+            _limit = _buffer.Length;
+        }
+
+        /// <summary>
+        /// Reads the remaining fields into a new model. Fields the model doesn't know are skipped.
+        /// </summary>
+        public T ReadFields<T>() where T : IApiProtoMessage, new()
+        {
+            var value = new T();
+            while (_position < _limit)
+            {
+                if (!value.ReadField(ReadTag(), this))
+                {
+                    Skip();
+                }
+            }
+            return value;
+        }
+
+        public T ReadMessage<T>() where T : IApiProtoMessage, new()
+        {
+            var limit = PushLimit();
+            var value = ReadFields<T>();
+            _limit = limit;
+            return value;
+        }
+
+        /// <summary>
+        /// Reads an item of a repeated field into a list, creating it for the first item.
+        /// </summary>
+        public List<T> ReadRepeated<T>(List<T> list, Func<T> readItem)
+        {
+            list = list ?? new List<T>();
+            list.Add(readItem());
+            return list;
+        }
+
+        /// <summary>
+        /// Reads the items of a repeated scalar field into a list, whether they are packed or not.
+        /// </summary>
+        public List<T> ReadPacked<T>(List<T> list, Func<T> readItem)
+        {
+            if (_wireType != LengthDelimited)
+            {
+                return ReadRepeated(list, readItem);
+            }
+
+            list = list ?? new List<T>();
+            var limit = PushLimit();
+            while (_position < _limit)
+            {
+                list.Add(readItem());
+            }
+            _limit = limit;
+            return list;
+        }
+
+        /// <summary>
+        /// Reads an entry of a map field into a map, where an entry may leave out a zero key or value.
+        /// </summary>
+        public Dictionary<string, T> ReadMapEntry<T>(Dictionary<string, T> map, Func<string> readKey, string zeroKey,
+            Func<T> readValue, T zeroValue)
+        {
+            map = map ?? new Dictionary<string, T>();
+            var key = zeroKey;
+            var value = zeroValue;
+            var limit = PushLimit();
+            while (_position < _limit)
+            {
+                switch (ReadTag())
+                {
+                    case 1:
+                        key = readKey();
+                        break;
+                    case 2:
+                        value = readValue();
+                        break;
+                    default:
+                        Skip();
+                        break;
+                }
+            }
+            _limit = limit;
+            map[key] = value;
+            return map;
+        }
+
+        /// <summary>
+        /// Reads the value of a google.protobuf wrapper, which leaves out a zero value.
+        /// </summary>
+        public T ReadWrapper<T>(Func<T> read, T zero)
+        {
+            var value = zero;
+            var limit = PushLimit();
+            while (_position < _limit)
+            {
+                if (ReadTag() == 1)
+                {
+                    value = read();
+                }
+                else
+                {
+                    Skip();
+                }
+            }
+            _limit = limit;
+            return value;
+        }
+
+        /// <summary>
+        /// Reads a google.protobuf.Timestamp as the text JSON carries it in.
+        /// </summary>
+        public string ReadTimestamp()
+        {
+            ReadSecondsAndNanos(out var seconds, out var nanos);
+            return ApiClient.FormatDateTime(Epoch.AddTicks(seconds * TimeSpan.TicksPerSecond + nanos / 100));
+        }
+
+        /// <summary>
+        /// Reads a google.protobuf.Duration as the text JSON carries it in, e.g. "1.5s".
+        /// </summary>
+        public string ReadDuration()
+        {
+            ReadSecondsAndNanos(out var seconds, out var nanos);
+            var text = Math.Abs(seconds).ToString(CultureInfo.InvariantCulture);
+            if (nanos != 0)
+            {
+                text = string.Concat(text, ".", Math.Abs(nanos).ToString("D9", CultureInfo.InvariantCulture).TrimEnd('0'));
+            }
+            return string.Concat(seconds < 0 || nanos < 0 ? "-" : "", text, "s");
+        }
+
+        public int ReadInt32() => (int) ReadVarint();
+
+        public long ReadInt64() => (long) ReadVarint();
+
+        public uint ReadUInt32() => (uint) ReadVarint();
+
+        public ulong ReadUInt64() => ReadVarint();
+
+        public int ReadSInt32()
+        {
+            var value = (uint) ReadVarint();
+            return (int) (value >> 1) ^ -(int) (value & 1);
+        }
+
+        public long ReadSInt64()
+        {
+            var value = ReadVarint();
+            return (long) (value >> 1) ^ -(long) (value & 1);
+        }
+
+        public uint ReadFixed32() => (uint) ReadLittleEndian(4);
+
+        public int ReadSFixed32() => (int) ReadLittleEndian(4);
+
+        public ulong ReadFixed64() => ReadLittleEndian(8);
+
+        public long ReadSFixed64() => (long) ReadLittleEndian(8);
+
+        public float ReadFloat() => BitConverter.ToSingle(BitConverter.GetBytes(ReadFixed32()), 0);
+
+        public double ReadDouble() => BitConverter.Int64BitsToDouble(ReadSFixed64());
+
+        public bool ReadBool() => ReadVarint() != 0;
+
+        public string ReadString()
+        {
+            var length = ReadLength();
+            var value = Encoding.UTF8.GetString(_buffer, _position, length);
+            _position += length;
+            return value;
+        }
+
+        public byte[] ReadBytes()
+        {
+            var value = new byte[ReadLength()];
+            Array.Copy(_buffer, _position, value, 0, value.Length);
+            _position += value.Length;
+            return value;
+        }
+
+        /// <summary>
+        /// Skips the value of the field the reader is at.
+        /// </summary>
+        public void Skip()
+        {
+            switch (_wireType)
+            {
+                case Varint:
+                    ReadVarint();
+                    break;
+                case Fixed64:
+                    ReadLittleEndian(8);
+                    break;
+                case LengthDelimited:
+                    var length = ReadLength();
+                    _position += length;
+                    break;
+                case StartGroup:
+                    while (true)
+                    {
+                        ReadTag();
+                        if (_wireType == EndGroup)
+                        {
+                            break;
+                        }
+                        Skip();
+                    }
+                    break;
+                case Fixed32:
+                    ReadLittleEndian(4);
+                    break;
+                default:
+                    throw new FormatException($"Unexpected wire type {_wireType} at position {_position}.");
+            }
+        }
+
+        private void ReadSecondsAndNanos(out long seconds, out int nanos)
+        {
+            seconds = 0;
+            nanos = 0;
+            var limit = PushLimit();
+            while (_position < _limit)
+            {
+                switch (ReadTag())
+                {
+                    case 1:
+                        seconds = ReadInt64();
+                        break;
+                    case 2:
+                        nanos = ReadInt32();
+                        break;
+                    default:
+                        Skip();
+                        break;
+                }
+            }
+            _limit = limit;
+        }
+
+        private int ReadTag()
+        {
+            var tag = ReadVarint();
+            _wireType = (int) (tag & 7);
+            if (tag >> 3 == 0 || tag >> 3 > int.MaxValue)
+            {
+                throw new FormatException($"Invalid field number at position {_position}.");
+            }
+            return (int) (tag >> 3);
+        }
+
+        private ulong ReadVarint()
+        {
+            var value = 0UL;
+            for (var shift = 0; shift < 64; shift += 7)
+            {
+                var b = ReadByte();
+                value |= (ulong) (b & 0x7F) << shift;
+                if (b < 0x80)
+                {
+                    return value;
+                }
+            }
+            throw new FormatException($"Malformed varint at position {_position}.");
+        }
+
+        private ulong ReadLittleEndian(int size)
+        {
+            var value = 0UL;
+            for (var i = 0; i < size; i++)
+            {
+                value |= (ulong) ReadByte() << (8 * i);
+            }
+            return value;
+        }
+
+        private byte ReadByte()
+        {
+            if (_position >= _limit)
+            {
+                throw new FormatException($"Unexpected end of message at position {_position}.");
+            }
+            return _buffer[_position++];
+        }
+
+        private int ReadLength()
+        {
+            var length = ReadVarint();
+            if (length > (ulong) (_limit - _position))
+            {
+                throw new FormatException($"Length {length} exceeds the message at position {_position}.");
+            }
+            return (int) length;
+        }
+
+        /// <summary>
+        /// Limits reading to the length-delimited value the reader is at, and returns the limit to restore after it.
+        /// </summary>
+        private int PushLimit()
+        {
+            var length = ReadLength();
+            var limit = _limit;
+            _limit = _position + length;
+            return limit;
+        }
+    }
+
+    /// <summary>
+    /// A writer of protobuf messages, which writes each field with its number.
+    /// </summary>
+    internal sealed class ApiProtoWriter
+    {
+        private static readonly DateTime Epoch = new DateTime(1970, 1, 1, 0, 0, 0, DateTimeKind.Utc);
+
+        private readonly MemoryStream _stream = new MemoryStream();
+
+        /// <summary>
+        /// The number of an enum member from its wire name, keeping the number of a member this client doesn't know.
+        /// </summary>
+        internal static int EnumNumber(string name, Func<string, int> parse) =>
+            int.TryParse(name, NumberStyles.Integer, CultureInfo.InvariantCulture, out var number) ? number : parse(name);
+
+        public void WriteMessage(int number, IApiProtoMessage value) => WriteMessage(number, value.WriteFields);
+
+        public void WriteMessage(int number, Action<ApiProtoWriter> writeFields)
+        {
+            var message = new ApiProtoWriter();
+            writeFields(message);
+            WriteBytes(number, message.ToArray());
+        }
+
+        /// <summary>
+        /// Writes a google.protobuf.Timestamp from the text JSON carries it in.
+        /// </summary>
+        public void WriteTimestamp(int number, string value)
+        {
+            var ticks = (ApiClient.ParseDateTime(value) - Epoch).Ticks;
+            var seconds = ticks / TimeSpan.TicksPerSecond;
+            var remainder = ticks % TimeSpan.TicksPerSecond;
+            if (remainder < 0)
+            {
+                seconds--;
+                remainder += TimeSpan.TicksPerSecond;
+            }
+            WriteSecondsAndNanos(number, seconds, (int) remainder * 100);
+        }
+
+        /// <summary>
+        /// Writes a google.protobuf.Duration from the text JSON carries it in, e.g. "1.5s".
+        /// </summary>
+        public void WriteDuration(int number, string value)
+        {
+            var text = value.TrimEnd('s');
+            var negative = text.StartsWith("-");
+            var parts = text.TrimStart('-').Split('.');
+            var seconds = long.Parse(parts[0], NumberStyles.None, CultureInfo.InvariantCulture);
+            var nanos = parts.Length > 1
+                ? int.Parse(parts[1].PadRight(9, '0').Substring(0, 9), NumberStyles.None, CultureInfo.InvariantCulture)
+                : 0;
+            WriteSecondsAndNanos(number, negative ? -seconds : seconds, negative ? -nanos : nanos);
+        }
+
+        public void WriteInt32(int number, int value)
+        {
+            WriteTag(number, ApiProtoReader.Varint);
+            WriteVarint((ulong) value);
+        }
+
+        public void WriteInt64(int number, long value)
+        {
+            WriteTag(number, ApiProtoReader.Varint);
+            WriteVarint((ulong) value);
+        }
+
+        public void WriteUInt32(int number, uint value)
+        {
+            WriteTag(number, ApiProtoReader.Varint);
+            WriteVarint(value);
+        }
+
+        public void WriteUInt64(int number, ulong value)
+        {
+            WriteTag(number, ApiProtoReader.Varint);
+            WriteVarint(value);
+        }
+
+        public void WriteSInt32(int number, int value)
+        {
+            WriteTag(number, ApiProtoReader.Varint);
+            WriteVarint((uint) ((value << 1) ^ (value >> 31)));
+        }
+
+        public void WriteSInt64(int number, long value)
+        {
+            WriteTag(number, ApiProtoReader.Varint);
+            WriteVarint((ulong) ((value << 1) ^ (value >> 63)));
+        }
+
+        public void WriteFixed32(int number, uint value)
+        {
+            WriteTag(number, ApiProtoReader.Fixed32);
+            WriteLittleEndian(value, 4);
+        }
+
+        public void WriteSFixed32(int number, int value) => WriteFixed32(number, (uint) value);
+
+        public void WriteFixed64(int number, ulong value)
+        {
+            WriteTag(number, ApiProtoReader.Fixed64);
+            WriteLittleEndian(value, 8);
+        }
+
+        public void WriteSFixed64(int number, long value) => WriteFixed64(number, (ulong) value);
+
+        public void WriteFloat(int number, float value) =>
+            WriteFixed32(number, BitConverter.ToUInt32(BitConverter.GetBytes(value), 0));
+
+        public void WriteDouble(int number, double value) =>
+            WriteSFixed64(number, BitConverter.DoubleToInt64Bits(value));
+
+        public void WriteBool(int number, bool value)
+        {
+            WriteTag(number, ApiProtoReader.Varint);
+            WriteVarint(value ? 1UL : 0UL);
+        }
+
+        public void WriteString(int number, string value) => WriteBytes(number, Encoding.UTF8.GetBytes(value ?? ""));
+
+        public void WriteBytes(int number, byte[] value)
+        {
+            value = value ?? new byte[0];
+            WriteTag(number, ApiProtoReader.LengthDelimited);
+            WriteVarint((ulong) value.Length);
+            _stream.Write(value, 0, value.Length);
+        }
+
+        public byte[] ToArray() => _stream.ToArray();
+
+        private void WriteSecondsAndNanos(int number, long seconds, int nanos)
+        {
+            WriteMessage(number, message =>
+            {
+                if (seconds != 0)
+                {
+                    message.WriteInt64(1, seconds);
+                }
+                if (nanos != 0)
+                {
+                    message.WriteInt32(2, nanos);
+                }
+            });
+        }
+
+        private void WriteTag(int number, int wireType) => WriteVarint((ulong) number << 3 | (uint) wireType);
+
+        private void WriteVarint(ulong value)
+        {
+            while (value >= 0x80)
+            {
+                _stream.WriteByte((byte) (value | 0x80));
+                value >>= 7;
+            }
+            _stream.WriteByte((byte) value);
+        }
+
+        private void WriteLittleEndian(ulong value, int size)
+        {
+            for (var i = 0; i < size; i++)
+            {
+                _stream.WriteByte((byte) value);
+                value >>= 8;
+            }
+        }
+    }
+    {{- end }}
+    {{- template "definitions" . }}
+
+    /// <summary>
+    /// The low level client for the {{ .Namespace }} API.
+    /// </summary>
+    internal class ApiClient
+    {
+        public readonly IHttpAdapter HttpAdapter;
+        public int Timeout { get; set; }
+        {{- if eq .Transport "protobuf" }}
+
+        /// <summary>
+        /// The media type of request and response bodies, which the gateway must marshal protobuf messages for.
+        /// </summary>
+        public string ContentType { get; set; } = "application/protobuf";
+        {{- end }}
+
+        private readonly Uri _baseUri;
+
+        public ApiClient(Uri baseUri, IHttpAdapter httpAdapter, int timeout = 10)
+        {
+            _baseUri = baseUri;
+            HttpAdapter = httpAdapter;
+            Timeout = timeout;
+        }
+
+        private async Task<string> SendAsync(string method, Uri uri, IDictionary<string, string> headers, byte[] body,
+            CancellationToken? cancellationToken, RequestOptions options)
+        {
+            if (options?.Headers != null)
+            {
+                foreach (var header in options.Headers)
+                {
+                    headers[header.Key] = header.Value;
+                }
+            }
+            var timeout = options?.Timeout ?? Timeout;
+            cancellationToken = cancellationToken ?? options?.CancellationToken;
+            {{- if .Errors }}
+            try
+            {
+                return await HttpAdapter.SendAsync(method, uri, headers, body, timeout, cancellationToken);
+            }
+            catch (ApiResponseException e) when (e.GetType() == typeof(ApiResponseException))
+            {
+                var exception = ApiStatusException.FromResponse(e);
+                if (exception == null)
+                {
+                    throw;
+                }
+                throw exception;
+            }
+            {{- else }}
+            return await HttpAdapter.SendAsync(method, uri, headers, body, timeout, cancellationToken);
+            {{- end }}
+        }
+        {{- if eq .Transport "protobuf" }}
+
+        private async Task<byte[]> SendBinaryAsync(string method, Uri uri, IDictionary<string, string> headers,
+            byte[] body, CancellationToken? cancellationToken, RequestOptions options)
+        {
+            if (!(HttpAdapter is IHttpBinaryAdapter adapter))
+            {
+                throw new NotSupportedException("The protobuf transport sends requests with an IHttpBinaryAdapter.");
+            }
+            headers["Accept"] = ContentType;
+            if (body != null)
+            {
+                headers["Content-Type"] = ContentType;
+            }
+            if (options?.Headers != null)
+            {
+                foreach (var header in options.Headers)
+                {
+                    headers[header.Key] = header.Value;
+                }
+            }
+            var timeout = options?.Timeout ?? Timeout;
+            cancellationToken = cancellationToken ?? options?.CancellationToken;
+            {{- if .Errors }}
+            try
+            {
+                return await adapter.SendBinaryAsync(method, uri, headers, body, timeout, cancellationToken);
+            }
+            catch (ApiResponseException e) when (e.GetType() == typeof(ApiResponseException) && e.Data["content"] is byte[] content)
+            {
+                var status = ParseResponse<{{ .Errors.Class }}>(content);
+                var failure = new ApiResponseException(e.StatusCode, status.Message ?? string.Empty, status.Code);
+                failure.Data["content"] = content;
+                var exception = ApiStatusException.FromResponse(failure);
+                if (exception == null)
+                {
+                    throw failure;
+                }
+                throw exception;
+            }
+            {{- else }}
+            return await adapter.SendBinaryAsync(method, uri, headers, body, timeout, cancellationToken);
+            {{- end }}
+        }
+        {{- end }}
+
+        {{- if eq .JSON "system" }}
+
+        internal static readonly JsonSerializerOptions JsonOptions = new JsonSerializerOptions
+        {
+            DefaultIgnoreCondition = JsonIgnoreCondition.WhenWritingNull,
+            NumberHandling = JsonNumberHandling.AllowReadingFromString | JsonNumberHandling.AllowNamedFloatingPointLiterals
+        };
+
+        private static T ParseResponse<T>(string contents) =>
+            string.IsNullOrEmpty(contents) ? default(T) : JsonSerializer.Deserialize<T>(contents, JsonOptions);
+        {{- else if eq .JSON "newtonsoft" }}
+
+        // Strings are kept as sent, rather than read as dates.
+        internal static readonly JsonSerializerSettings JsonSettings = new JsonSerializerSettings
+        {
+            NullValueHandling = NullValueHandling.Ignore,
+            DateParseHandling = DateParseHandling.None
+        };
+
+        private static T ParseResponse<T>(string contents) =>
+            string.IsNullOrEmpty(contents) ? default(T) : JsonConvert.DeserializeObject<T>(contents, JsonSettings);
+        {{- else }}
+
+        private static T ParseResponse<T>(string contents, Func<ApiJsonReader, T> read) =>
+            string.IsNullOrEmpty(contents) ? default(T) : read(new ApiJsonReader(contents));
+        {{- end }}
+        {{- if eq .Transport "protobuf" }}
+
+        internal static T ParseResponse<T>(byte[] contents) where T : IApiProtoMessage, new() =>
+            new ApiProtoReader(contents).ReadFields<T>();
+        {{- end }}
+
+        internal static long ParseInt64(string value)
+        {
+            long.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
+
+        internal static string FormatInt64(long value) => value.ToString(CultureInfo.InvariantCulture);
+
+        internal static ulong ParseUInt64(string value)
+        {
+            ulong.TryParse(value, NumberStyles.Integer, CultureInfo.InvariantCulture, out var result);
+            return result;
+        }
+
+        internal static string FormatUInt64(ulong value) => value.ToString(CultureInfo.InvariantCulture);
+
+        internal static DateTime ParseDateTime(string value)
+        {
+            DateTime.TryParse(value, CultureInfo.InvariantCulture,
+                DateTimeStyles.AdjustToUniversal | DateTimeStyles.AssumeUniversal, out var result);
+            return result;
+        }
+
+        internal static string FormatDateTime(DateTime value) =>
+            value.ToUniversalTime().ToString("yyyy-MM-dd'T'HH:mm:ss.FFFFFFF'Z'", CultureInfo.InvariantCulture);
+
+        internal static byte[] ParseBytes(string value) => value == null ? null : Convert.FromBase64String(value);
+
+        internal static string FormatBytes(byte[] value) => value == null ? null : Convert.ToBase64String(value);
+
+        private static byte[] EncodeForm(List<KeyValuePair<string, object>> form)
+        {
+            var fields = new List<string>(form.Count);
+            foreach (var field in form)
+            {
+                fields.Add(string.Concat(Uri.EscapeDataString(field.Key), "=", Uri.EscapeDataString((string) field.Value)));
+            }
+            return Encoding.UTF8.GetBytes(string.Join("&", fields));
+        }
+
+        private static byte[] EncodeMultipart(List<KeyValuePair<string, object>> form, string boundary)
+        {
+            using (var stream = new MemoryStream())
+            {
+                foreach (var field in form)
+                {
+                    var file = field.Value as byte[];
+                    var header = file == null
+                        ? $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"\r\n\r\n"
+                        : $"--{boundary}\r\nContent-Disposition: form-data; name=\"{field.Key}\"; filename=\"{field.Key}\"\r\nContent-Type: application/octet-stream\r\n\r\n";
+                    var part = Encoding.UTF8.GetBytes(header);
+                    stream.Write(part, 0, part.Length);
+                    part = file ?? Encoding.UTF8.GetBytes((string) field.Value);
+                    stream.Write(part, 0, part.Length);
+                    part = Encoding.UTF8.GetBytes("\r\n");
+                    stream.Write(part, 0, part.Length);
+                }
+                var end = Encoding.UTF8.GetBytes($"--{boundary}--\r\n");
+                stream.Write(end, 0, end.Length);
+                return stream.ToArray();
+            }
+        }
+
+        internal static Dictionary<string, TOutput> ConvertMap<TInput, TOutput>(Dictionary<string, TInput> map,
+            Converter<TInput, TOutput> converter)
+        {
+            if (map == null)
+            {
+                return null;
+            }
+
+            var result = new Dictionary<string, TOutput>(map.Count);
+            foreach (var kvp in map)
+            {
+                result.Add(kvp.Key, converter(kvp.Value));
+            }
+            return result;
+        }
+
+        // Models compare a missing list or map as equal to an empty one, as the server may leave either out.
+        internal static bool ListEquals<T>(List<T> a, List<T> b)
+        {
+            var count = a?.Count ?? 0;
+            if (count != (b?.Count ?? 0))
+            {
+                return false;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            for (var i = 0; i < count; i++)
+            {
+                if (!comparer.Equals(a[i], b[i]))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+
+        internal static bool MapEquals<T>(Dictionary<string, T> a, Dictionary<string, T> b)
+        {
+            if ((a?.Count ?? 0) != (b?.Count ?? 0))
+            {
+                return false;
+            }
+            if (a == null || b == null)
+            {
+                return true;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in a)
+            {
+                if (!b.TryGetValue(kvp.Key, out var value) || !comparer.Equals(kvp.Value, value))
+                {
+                    return false;
+                }
+            }
+            return true;
+        }
+
+        internal static int ListHashCode<T>(List<T> list)
+        {
+            var hash = 0;
+            if (list == null)
+            {
+                return hash;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var item in list)
+            {
+                hash = unchecked(hash * 31 + comparer.GetHashCode(item));
+            }
+            return hash;
+        }
+
+        // Entries are hashed apart and summed, so that maps hash alike whatever order they were filled in.
+        internal static int MapHashCode<T>(Dictionary<string, T> map)
+        {
+            var hash = 0;
+            if (map == null)
+            {
+                return hash;
+            }
+
+            var comparer = EqualityComparer<T>.Default;
+            foreach (var kvp in map)
+            {
+                hash = unchecked(hash + (kvp.Key.GetHashCode() ^ comparer.GetHashCode(kvp.Value)));
+            }
+            return hash;
+        }
+
+        internal static string FormatModel(string name, List<string> members) =>
+            members.Count == 0 ? string.Concat(name, " {}") : string.Concat(name, " { ", string.Join(", ", members), " }");
+
+        internal static string FormatValue(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    return "null";
+                case string text:
+                    return string.Concat("\"", text.Replace("\\", "\\\\").Replace("\"", "\\\""), "\"");
+                case bool flag:
+                    return flag ? "true" : "false";
+                case DateTime time:
+                    return FormatDateTime(time);
+                case IFormattable formattable:
+                    return formattable.ToString(null, CultureInfo.InvariantCulture);
+                default:
+                    return value.ToString();
+            }
+        }
+
+        internal static string FormatEncoded(string value) => value ?? "null";
+
+        internal static string FormatList<T>(List<T> list, Func<T, string> format = null)
+        {
+            if (list == null || list.Count == 0)
+            {
+                return "[]";
+            }
+
+            var items = new List<string>(list.Count);
+            foreach (var item in list)
+            {
+                items.Add(format == null ? FormatValue(item) : format(item));
+            }
+            return string.Concat("[", string.Join(", ", items), "]");
+        }
+
+        internal static string FormatMap<T>(IDictionary<string, T> map, Func<T, string> format = null)
+        {
+            if (map == null || map.Count == 0)
+            {
+                return "{}";
+            }
+
+            var entries = new List<string>(map.Count);
+            foreach (var kvp in map)
+            {
+                entries.Add(string.Concat(FormatValue(kvp.Key), ": ", format == null ? FormatValue(kvp.Value) : format(kvp.Value)));
+            }
+            return string.Concat("{ ", string.Join(", ", entries), " }");
+        }
+
+        {{- range $url, $path := .Paths }}
+        {{- range $method, $operation := $path}}
+        {{- range $auth := $operation.Auth }}
+
+        /// <summary>
+        /// {{ $operation.Summary | stripNewlines }}
+        /// </summary>
+        {{- if $operation.Result.Type }}
+        public async Task<{{ $operation.Result.Type }}> {{ $operation.OperationId | methodName }}(
+        {{- else }}
+        public async Task {{ $operation.OperationId | methodName }}(
+        {{- end}}
+
+        {{- $isPreviousParam := false}}
+        {{- range $argument := $auth.Arguments }}
+        {{- if eq $isPreviousParam true}},{{- end}}
+            string {{ $argument }}
+        {{- $isPreviousParam = true}}
+        {{- end }}
+        {{- range $parameter := $operation.OrderedParameters }}
+        {{- if eq $isPreviousParam true}},{{- end}}
+        {{- if eq $parameter.In "path" }}
+            {{ parameterType $parameter }} {{ parameterName $parameter }}
+        {{- else if eq $parameter.In "body" }}
+            {{- if eq $parameter.Schema.Type "string" }}
+            string{{- if not $parameter.Required }}? {{ parameterName $parameter }} = null{{- else }} {{ parameterName $parameter }}{{- end }}
+            {{- else }}
+            {{ $parameter.Schema.Ref | cleanRef }}{{- if not $parameter.Required }}? {{ parameterName $parameter }} = null{{- else }} {{ parameterName $parameter }}{{- end }}
+            {{- end }}
+        {{- else }}
+            {{ parameterType $parameter }} {{ argumentName $parameter.Name }}{{ if not $parameter.Required }} = null{{ end }}
+        {{- end }}
+        {{- $isPreviousParam = true}}
+    {{- end }}{{ if $isPreviousParam }},{{ end }}
+            CancellationToken? cancellationToken = null,
+            RequestOptions options = null)
+        {
+            {{- range $parameter := $operation.Parameters }}
+            {{- if checksNull $parameter }}
+            {{- $argument := parameterName $parameter }}
+            if ({{ $argument }} == null)
+            {
+                throw new ArgumentException("'{{ $argument }}' is required but was null.");
+            }
+            {{- end }}
+        {{- end }}
+
+            var urlpath = "{{- $url }}";
+
+            {{- range $parameter := $operation.ParametersIn "path" }}
+            urlpath = urlpath.Replace("{{- print "{" $parameter.Name "}"}}", {{ formatPathArgument $parameter }});
+            {{- end }}
+
+            var queryParams = "";
+            {{- range $parameter := $operation.ParametersIn "query" }}
+            {{- $argument := argumentName $parameter.Name }}
+            {{- if isRepeated $parameter }}
+            foreach (var elem in {{ $argument }} ?? new {{ itemType $parameter }}[0])
+            {
+                queryParams = string.Concat(queryParams, "{{ $parameter.Name }}=", Uri.EscapeDataString({{ formatItem $parameter "elem" }}), "&");
+            }
+            {{- else if eq $parameter.Type "object" }}
+            foreach (var kvp in {{ $argument }} ?? new Dictionary<string, {{ mapValueType $parameter }}>())
+            {
+                queryParams = string.Concat(queryParams, "{{ $parameter.Name }}[", Uri.EscapeDataString(kvp.Key), "]=", Uri.EscapeDataString({{ formatMapValue $parameter "kvp.Value" }}), "&");
+            }
+            {{- else }}
+            if ({{ $argument }} != null) {
+                queryParams = string.Concat(queryParams, "{{ $parameter.Name }}=", Uri.EscapeDataString({{ formatArgument $parameter }}), "&");
+            }
+            {{- end }}
+            {{- end }}
+            {{- range $credential := $auth.CredentialsIn "query" }}
+            {{- $argument := index $credential.Arguments 0 }}
+            if (!string.IsNullOrEmpty({{ $argument }})) {
+                queryParams = string.Concat(queryParams, "{{ $credential.Name }}=", Uri.EscapeDataString({{ $argument }}), "&");
+            }
+            {{- end }}
+
+            string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
+
+            var uri = new UriBuilder(_baseUri)
+            {
+                Path = path,
+                Query = queryParams
+            }.Uri;
+
+            var httpMethod = "{{- $method | uppercase }}";
+            var headers = new Dictionary<string, string>();
+
+            {{- range $credential := $auth.Credentials }}
+            {{- if eq $credential.Kind "basic" }}
+            if (!string.IsNullOrEmpty(basicAuthUsername))
+            {
+                var credentials = Encoding.UTF8.GetBytes(basicAuthUsername + ":" + basicAuthPassword);
+                var header = string.Concat("Basic ", Convert.ToBase64String(credentials));
+                headers.Add("Authorization", header);
+            }
+            {{- else if eq $credential.Kind "bearer" }}
+            if (!string.IsNullOrEmpty(bearerToken))
+            {
+                var header = string.Concat("Bearer ", bearerToken);
+                headers.Add("Authorization", header);
+            }
+            {{- end }}
+            {{- end }}
+            {{- range $credential := $auth.CredentialsIn "header" }}
+            {{- $argument := index $credential.Arguments 0 }}
+            if (!string.IsNullOrEmpty({{ $argument }}))
+            {
+                headers.Add("{{ $credential.Name }}", {{ $argument }});
+            }
+            {{- end }}
+            {{- range $parameter := $operation.ParametersIn "header" }}
+            if ({{ argumentName $parameter.Name }} != null)
+            {
+                headers.Add("{{ $parameter.Name }}", {{ formatArgument $parameter }});
+            }
+            {{- end }}
+            {{- if or ($operation.ParametersIn "cookie") ($auth.CredentialsIn "cookie") }}
+            var cookies = new List<string>();
+            {{- range $credential := $auth.CredentialsIn "cookie" }}
+            {{- $argument := index $credential.Arguments 0 }}
+            if (!string.IsNullOrEmpty({{ $argument }}))
+            {
+                cookies.Add(string.Concat("{{ $credential.Name }}=", Uri.EscapeDataString({{ $argument }})));
+            }
+            {{- end }}
+            {{- range $parameter := $operation.ParametersIn "cookie" }}
+            if ({{ argumentName $parameter.Name }} != null)
+            {
+                cookies.Add(string.Concat("{{ $parameter.Name }}=", Uri.EscapeDataString({{ formatArgument $parameter }})));
+            }
+            {{- end }}
+            if (cookies.Count > 0)
+            {
+                headers.Add("Cookie", string.Join("; ", cookies));
+            }
+            {{- end }}
+
+            byte[] content = null;
+            {{- range $parameter := $operation.Parameters }}
+            {{- if eq $parameter.In "body" }}
+            {{- if $operation.Protobuf }}
+            var writer = new ApiProtoWriter();
+            {{ parameterName $parameter }}.WriteFields(writer);
+            content = writer.ToArray();
+            {{- else if eq $.JSON "system" }}
+            content = JsonSerializer.SerializeToUtf8Bytes({{ parameterName $parameter }}, JsonOptions);
+            {{- else if eq $.JSON "newtonsoft" }}
+            content = Encoding.UTF8.GetBytes(JsonConvert.SerializeObject({{ parameterName $parameter }}, JsonSettings));
+            {{- else }}
+            var writer = new ApiJsonWriter();
+            {{- if eq $parameter.Schema.Type "string" }}
+            writer.WriteString({{ parameterName $parameter }});
+            {{- else }}
+            writer.WriteObject({{ parameterName $parameter }});
+            {{- end }}
+            content = Encoding.UTF8.GetBytes(writer.ToString());
+            {{- end }}
+            {{- end }}
+            {{- end }}
+            {{- if $operation.ParametersIn "formData" }}
+            var form = new List<KeyValuePair<string, object>>();
+            {{- range $parameter := $operation.ParametersIn "formData" }}
+            if ({{ argumentName $parameter.Name }} != null)
+            {
+                {{- if eq $parameter.Type "file" }}
+                form.Add(new KeyValuePair<string, object>("{{ $parameter.Name }}", {{ argumentName $parameter.Name }}));
+                {{- else }}
+                form.Add(new KeyValuePair<string, object>("{{ $parameter.Name }}", {{ formatArgument $parameter }}));
+                {{- end }}
+            }
+            {{- end }}
+            {{- if $operation.Multipart }}
+            var boundary = Guid.NewGuid().ToString("N");
+            content = EncodeMultipart(form, boundary);
+            headers.Add("Content-Type", string.Concat("multipart/form-data; boundary=", boundary));
+            {{- else }}
+            content = EncodeForm(form);
+            headers.Add("Content-Type", "application/x-www-form-urlencoded");
+            {{- end }}
+            {{- end }}
+
+            {{- $send := "SendAsync" }}
+            {{- if $operation.Protobuf }}
+            {{- $send = "SendBinaryAsync" }}
+            {{- end }}
+            {{- if $operation.Result.Type }}
+            var contents = await {{ $send }}(httpMethod, uri, headers, content, cancellationToken, options);
+            return {{ $operation.Result.Parse }};
+            {{- else }}
+            await {{ $send }}(httpMethod, uri, headers, content, cancellationToken, options);
+            {{- end }}
+        }
+        {{- end }}
+        {{- end }}
+    {{- end }}
+    }
+}
+`
+
+// definitionsTemplate renders the interface and class, or enum, of every definition in the schema.
+const definitionsTemplate string = `
+{{- define "definitions" }}
+    {{- range $defname, $definition := .Definitions }}
+    {{- $classname := $defname | title }}
+
+    {{- if isRefToEnum $defname }}
+    {{- $members := enumMembers $definition }}
+    {{- $unknown := enumUnknown $definition }}
+
+    /// <summary>
+    /// {{ enumSummary $definition | commentify }}
+    /// </summary>
+    public enum {{ $classname }}
+    {
+        {{- range $member := $members }}
+        /// <summary>
+        /// {{ $member.Description | stripNewlines }}
+        /// </summary>
+        {{ $member.Name }} = {{ $member.Value }},
+        {{- end }}
+        /// <summary>
+        /// {{ $unknown.Description }}
+        /// </summary>
+        {{ $unknown.Name }} = {{ $unknown.Value }},
+    }
+
+    /// <summary>
+    /// Converts <see cref="{{ $classname }}"/> to and from JSON, which may carry a member by name or by number.
+    /// </summary>
+    internal static class {{ $classname }}Converter
+    {
+        public static {{ $classname }} Parse(string value)
+        {
+            switch (value)
+            {
+                case null:
+                case "":
+                    return default({{ $classname }});
+                {{- range $member := $members }}
+                case "{{ $member.Wire }}":
+                {{- if and (not $member.Alias) (ne $member.Wire (print $member.Value)) }}
+                case "{{ $member.Value }}":
+                {{- end }}
+                    return {{ $classname }}.{{ $member.Name }};
+                {{- end }}
+                default:
+                    return {{ $classname }}.{{ $unknown.Name }};
+            }
+        }
+
+        public static string Format({{ $classname }} value)
+        {
+            switch (value)
+            {
+                {{- range $member := $members }}
+                {{- if not $member.Alias }}
+                case {{ $classname }}.{{ $member.Name }}:
+                    return "{{ $member.Wire }}";
+                {{- end }}
+                {{- end }}
+                default:
+                    return ((int) value).ToString(CultureInfo.InvariantCulture);
+            }
+        }
+    }
+    {{- else }}
+
+    /// <summary>
+    /// {{ (descriptionOrTitle $definition.Description $definition.Title) | stripNewlines }}
+    /// </summary>
+    public interface I{{ $classname }}{{ range $i, $parent := $definition.Extends }}{{ if $i }}, {{ else }} : {{ end }}{{ $parent }}{{ end }}
+    {
+        {{- range $property := interfaceProperties $definition }}
+        {{- $propname := $property.Name }}
+        {{- $fieldname := $propname | snakeToPascal }}
+
+        /// <summary>
+        /// {{ (descriptionOrTitle $property.Description $property.Title) | stripNewlines }}
+        /// </summary>
+        {{- $type := primitive $property.Type $property.Format | nullable $property.Nullable }}
+        {{- if $type }}
+        {{ $type }} {{ $fieldname }} { get; }
+        {{- else if eq $property.Type "array"}}
+            {{- $itemType := primitive $property.Items.Type $property.Items.Format }}
+            {{- if $itemType }}
+        List<{{ $itemType }}> {{ $fieldname }} { get; }
+            {{- else if isRefToEnum (cleanRef $property.Items.Ref) }}
+        List<{{ $property.Items.Ref | cleanRef }}> {{ $fieldname }} { get; }
+            {{- else}}
+        IEnumerable<I{{ $property.Items.Ref | cleanRef }}> {{ $fieldname }} { get; }
+            {{- end }}
+        {{- else if eq $property.Type "object"}}
+            {{- $valueType := primitive $property.AdditionalProperties.Type $property.AdditionalProperties.Format }}
+            {{- if $valueType }}
+        IDictionary<string, {{ $valueType }}> {{$fieldname}} { get; }
+            {{- else if isRefToEnum (cleanRef $property.AdditionalProperties.Ref) }}
+        IDictionary<string, {{ $property.AdditionalProperties.Ref | cleanRef }}> {{$fieldname}} { get; }
+            {{- else }}
+        IDictionary<string, I{{$property.AdditionalProperties.Ref | cleanRef}}> {{$fieldname}} { get; }
+            {{- end}}
+        {{- else if isRefToEnum (cleanRef $property.Ref) }}
+        {{ $property.Ref | cleanRef | nullable $property.Nullable }} {{ $fieldname }} { get; }
+        {{- else }}
+        I{{ $property.Ref | cleanRef }} {{ $fieldname }} { get; }
+        {{- end }}
+        {{- end }}
+        {{- range $variant := $definition.Variants }}
+
+        /// <summary>
+        /// The value as a <see cref="I{{ $variant.Class }}"/>, or null when it holds another variant.
+        /// </summary>
+        I{{ $variant.Class }} {{ $variant.Name }} { get; }
+        {{- end }}
+
+        /// <summary>
+        /// Creates a deep copy, along with the models, lists and maps it holds.
+        /// </summary>
+        {{ if $definition.Extends }}new {{ end }}I{{ $classname }} Clone();
+    }
+
+    /// <inheritdoc />
+    internal class {{ $classname }} : {{ if $definition.Base }}{{ $definition.Base }}, {{ end }}I{{ $classname }}{{ range $definition.Implements }}, {{ . }}{{ end }}{{ if and (not $definition.Base) (eq $.JSON "tinyjson") }}, IApiJsonObject{{ end }}{{ if and (not $definition.Base) (eq $.Transport "protobuf") }}, IApiProtoMessage{{ end }}
+    {
+        {{- range $property := properties $definition }}
+        {{- $propname := $property.Name }}
+        {{- $fieldname := $propname | snakeToPascal }}
+        {{- $attrDataName := $propname | camelToSnake }}
+
+        /// <inheritdoc />
+        {{- $type := primitive $property.Type $property.Format | nullable $property.Nullable }}
+        {{- if and $type (isEncoded $property.Type $property.Format) }}
+        [{{ jsonIgnore }}]
+        public {{ $type }} {{ $fieldname }}
+        {
+            {{- if isNullable $type }}
+            get => _{{ $propname | snakeToCamel }} == null ? ({{ $type }}) null : ApiClient.Parse{{ converter $type }}(_{{ $propname | snakeToCamel }});
+            set => _{{ $propname | snakeToCamel }} = value.HasValue ? ApiClient.Format{{ converter $type }}(value.Value) : null;
+            {{- else }}
+            get => ApiClient.Parse{{ converter $type }}(_{{ $propname | snakeToCamel }});
+            set => _{{ $propname | snakeToCamel }} = ApiClient.Format{{ converter $type }}(value);
+            {{- end }}
+        }
+        [{{ jsonProperty $attrDataName }}]
+        public string _{{ $propname | snakeToCamel }} { get; set; }
+        {{- else if $type }}
+        [{{ jsonProperty $attrDataName }}]
+        public {{ $type }} {{ $fieldname }} { get; set; }
+        {{- else if eq $property.Type "array" }}
+            {{- $itemType := primitive $property.Items.Type $property.Items.Format }}
+            {{- if and $itemType (isEncoded $property.Items.Type $property.Items.Format) }}
+        [{{ jsonIgnore }}]
+        public List<{{ $itemType }}> {{ $fieldname }}
+        {
+            get => _{{ $propname | snakeToCamel }}?.ConvertAll(ApiClient.Parse{{ converter $itemType }});
+            set => _{{ $propname | snakeToCamel }} = value?.ConvertAll(ApiClient.Format{{ converter $itemType }});
+        }
+        [{{ jsonProperty $attrDataName }}]
+        public List<string> _{{ $propname | snakeToCamel }} { get; set; }
+            {{- else if $itemType }}
+        [{{ jsonProperty $attrDataName }}]
+        public List<{{ $itemType }}> {{ $fieldname }} { get; set; }
+            {{- else if isRefToEnum (cleanRef $property.Items.Ref) }}
+        [{{ jsonIgnore }}]
+        public List<{{ $property.Items.Ref | cleanRef }}> {{ $fieldname }}
+        {
+            get => _{{ $propname | snakeToCamel }}?.ConvertAll({{ enumConverter $property.Items.Ref }}.Parse);
+            set => _{{ $propname | snakeToCamel }} = value?.ConvertAll({{ enumConverter $property.Items.Ref }}.Format);
+        }
+        [{{ jsonProperty $attrDataName }}]
+        public List<string> _{{ $propname | snakeToCamel }} { get; set; }
+            {{- else}}
+        [{{ jsonIgnore }}]
+        public IEnumerable<I{{ $property.Items.Ref | cleanRef }}> {{ $fieldname }} => _{{ $propname | snakeToCamel }} ?? new List<{{ $property.Items.Ref | cleanRef }}>(0);
+        [{{ jsonProperty $attrDataName }}]
+        public List<{{ $property.Items.Ref | cleanRef }}> _{{ $propname | snakeToCamel }} { get; set; }
             {{- end }}
-        {{- else }}
-           {{- if eq $isPreviousParam true}},{{- end}}
-           {{- $isPreviousParam = true}}
-            string bearerToken
-        {{- end }}
-        {{- range $parameter := $operation.Parameters }}
-        {{- if eq $isPreviousParam true}},{{- end}}
-        {{- if eq $parameter.In "path" }}
-            {{ $parameter.Type }}{{- if not $parameter.Required }}?{{- end }} {{ $parameter.Name | snakeToCamel}}
-        {{- else if eq $parameter.In "body" }}
-            {{- if eq $parameter.Schema.Type "string" }}
-            string{{- if not $parameter.Required }}?{{- end }} {{ $parameter.Name | snakeToCamel}}
-            {{- else }}
-            {{ $parameter.Schema.Ref | cleanRef }}{{- if not $parameter.Required }}?{{- end }} {{ $parameter.Name | snakeToCamel}}
-            {{- end }}
-        {{- else if eq $parameter.Type "array"}}
-            IEnumerable<{{ $parameter.Items.Type }}> {{ $parameter.Name | snakeToCamel }}
-        {{- else if eq $parameter.Type "object"}}
-            {{- if eq $parameter.AdditionalProperties.Type "string"}}
-        IDictionary<string, string> {{ $parameter.Name }}
-            {{- else if eq $parameter.Items.Type "integer"}}
-        IDictionary<string, int> {{ $parameter.Name }}
-            {{- else if eq $parameter.Items.Type "boolean"}}
-        IDictionary<string, int> {{ $parameter.Name }}
+        {{- else if eq $property.Type "object"}}
+            {{- $valueType := primitive $property.AdditionalProperties.Type $property.AdditionalProperties.Format }}
+            {{- if and $valueType (isEncoded $property.AdditionalProperties.Type $property.AdditionalProperties.Format) }}
+        [{{ jsonIgnore }}]
+        public IDictionary<string, {{ $valueType }}> {{ $fieldname }} => ApiClient.ConvertMap<string, {{ $valueType }}>(_{{ $propname | snakeToCamel }}, ApiClient.Parse{{ converter $valueType }}) ?? new Dictionary<string, {{ $valueType }}>();
+        [{{ jsonProperty $attrDataName }}]
+        public Dictionary<string, string> _{{ $propname | snakeToCamel }} { get; set; }
+            {{- else if $valueType }}
+        [{{ jsonIgnore }}]
+        public IDictionary<string, {{ $valueType }}> {{ $fieldname }} => _{{ $propname | snakeToCamel }} ?? new Dictionary<string, {{ $valueType }}>();
+        [{{ jsonProperty $attrDataName }}]
+        public Dictionary<string, {{ $valueType }}> _{{ $propname | snakeToCamel }} { get; set; }
+            {{- else if isRefToEnum (cleanRef $property.AdditionalProperties.Ref) }}
+            {{- $valueType := $property.AdditionalProperties.Ref | cleanRef }}
+        [{{ jsonIgnore }}]
+        public IDictionary<string, {{ $valueType }}> {{ $fieldname }} => ApiClient.ConvertMap<string, {{ $valueType }}>(_{{ $propname | snakeToCamel }}, {{ enumConverter $valueType }}.Parse) ?? new Dictionary<string, {{ $valueType }}>();
+        [{{ jsonProperty $attrDataName }}]
+        public Dictionary<string, string> _{{ $propname | snakeToCamel }} { get; set; }
             {{- else}}
-        IDictionary<string, {{ $parameter.Items.Type }}> {{ $parameter.Name }}
+            {{- $valueType := $property.AdditionalProperties.Ref | cleanRef }}
+        [{{ jsonIgnore }}]
+        public IDictionary<string, I{{ $valueType }}> {{ $fieldname }} => ApiClient.ConvertMap<{{ $valueType }}, I{{ $valueType }}>(_{{ $propname | snakeToCamel }}, value => value) ?? new Dictionary<string, I{{ $valueType }}>();
+        [{{ jsonProperty $attrDataName }}]
+        public Dictionary<string, {{ $valueType }}> _{{ $propname | snakeToCamel }} { get; set; }
             {{- end}}
-        {{- else if eq $parameter.Type "integer" }}
-            int? {{ $parameter.Name }}
-        {{- else if eq $parameter.Type "boolean" }}
-            bool? {{ $parameter.Name }}
-        {{- else if eq $parameter.Type "string" }}
-            string {{ $parameter.Name | snakeToCamel}}
+        {{- else if isRefToEnum (cleanRef $property.Ref) }}
+        {{- $enumType := $property.Ref | cleanRef | nullable $property.Nullable }}
+        [{{ jsonIgnore }}]
+        public {{ $enumType }} {{ $fieldname }}
+        {
+            {{- if isNullable $enumType }}
+            get => _{{ $propname | snakeToCamel }} == null ? ({{ $enumType }}) null : {{ enumConverter $property.Ref }}.Parse(_{{ $propname | snakeToCamel }});
+            set => _{{ $propname | snakeToCamel }} = value.HasValue ? {{ enumConverter $property.Ref }}.Format(value.Value) : null;
+            {{- else }}
+            get => {{ enumConverter $property.Ref }}.Parse(_{{ $propname | snakeToCamel }});
+            set => _{{ $propname | snakeToCamel }} = {{ enumConverter $property.Ref }}.Format(value);
+            {{- end }}
+        }
+        [{{ jsonProperty $attrDataName }}]
+        public string _{{ $propname | snakeToCamel }} { get; set; }
         {{- else }}
-            {{ $parameter.Type }} {{ $parameter.Name | snakeToCamel}}
+        [{{ jsonIgnore }}]
+        public I{{ $property.Ref | cleanRef }} {{ $fieldname }} => _{{ $propname | snakeToCamel }};
+        [{{ jsonProperty $attrDataName }}]
+        public {{ $property.Ref | cleanRef }} _{{ $propname | snakeToCamel }} { get; set; }
         {{- end }}
-        {{- $isPreviousParam = true}}
-    {{- end }},
-            CancellationToken? cancellationToken)
+        {{- end }}
+        {{- range $variant := $definition.Variants }}
+
+        /// <inheritdoc />
+        [{{ jsonIgnore }}]
+        public I{{ $variant.Class }} {{ $variant.Name }} => {{ if $variant.Condition }}{{ $variant.Condition }} ? this : null{{ else }}this{{ end }};
+        {{- end }}
+
+        {{- if eq $.JSON "tinyjson" }}
+
+        /// <inheritdoc />
+        public {{ if $definition.Base }}override{{ else }}virtual{{ end }} bool ReadMember(string name, ApiJsonReader reader)
         {
-            {{- range $parameter := $operation.Parameters }}
-            {{- if $parameter.Required }}
-            if ({{ $parameter.Name | snakeToCamel}} == null)
+            switch (name)
             {
-                throw new ArgumentException("'{{ $parameter.Name | snakeToCamel }}' is required but was null.");
+                {{- range $property := properties $definition }}
+                {{- $member := jsonMember $property }}
+                case "{{ $member.Name }}":
+                    {{ $member.Member }} = {{ $member.Read }};
+                    return true;
+                {{- end }}
+                default:
+                    return {{ if $definition.Base }}base.ReadMember(name, reader){{ else }}false{{ end }};
             }
-            {{- end }}
-        {{- end }}
-
-            var urlpath = "{{- $url }}";
+        }
 
-            {{- range $parameter := $operation.Parameters }}
-            {{- $camelToSnake := $parameter.Name | camelToSnake }}
-            {{- if eq $parameter.In "path" }}
-            urlpath = urlpath.Replace("{{- print "{" $parameter.Name "}"}}", Uri.EscapeDataString({{- $parameter.Name | snakeToCamel }}));
+        /// <inheritdoc />
+        public {{ if $definition.Base }}override{{ else }}virtual{{ end }} void WriteMembers(ApiJsonWriter writer)
+        {
+            {{- if $definition.Base }}
+            base.WriteMembers(writer);
+            {{- end }}
+            {{- range $property := properties $definition }}
+            {{- $member := jsonMember $property }}
+            {{- if $member.CheckNull }}
+            if ({{ $member.Member }} != null)
+            {
+                writer.WriteName("{{ $member.Name }}");
+                {{ $member.Write }};
+            }
+            {{- else }}
+            writer.WriteName("{{ $member.Name }}");
+            {{ $member.Write }};
+            {{- end }}
             {{- end }}
+        }
         {{- end }}
 
-            var queryParams = "";
-            {{- range $parameter := $operation.Parameters }}
-            {{- $camelToSnake := $parameter.Name | camelToSnake }}
-            {{- if eq $parameter.In "query"}}
-                {{- if eq $parameter.Type "integer" }}
-            if ({{ $parameter.Name }} != null) {
-                queryParams = string.Concat(queryParams, "{{- $camelToSnake }}=", {{ $parameter.Name }}, "&");
-            }
-                {{- else if eq $parameter.Type "string" }}
-            if ({{ $parameter.Name | snakeToCamel }} != null) {
-                queryParams = string.Concat(queryParams, "{{- $camelToSnake }}=", Uri.EscapeDataString({{ $parameter.Name | snakeToCamel }}), "&");
+        {{- if eq $.Transport "protobuf" }}
+
+        /// <inheritdoc />
+        public {{ if $definition.Base }}override{{ else }}virtual{{ end }} bool ReadField(int number, ApiProtoReader reader)
+        {
+            switch (number)
+            {
+                {{- range $property := properties $definition }}
+                {{- $member := protoMember $property }}
+                case {{ $member.Number }}:
+                    {{ $member.Member }} = {{ $member.Read }};
+                    return true;
+                {{- end }}
+                default:
+                    return {{ if $definition.Base }}base.ReadField(number, reader){{ else }}false{{ end }};
             }
-                {{- else if eq $parameter.Type "boolean" }}
-            if ({{ $parameter.Name }} != null) {
-                queryParams = string.Concat(queryParams, "{{- $camelToSnake }}=", {{ $parameter.Name }}.ToString().ToLower(), "&");
+        }
+
+        /// <inheritdoc />
+        public {{ if $definition.Base }}override{{ else }}virtual{{ end }} void WriteFields(ApiProtoWriter writer)
+        {
+            {{- if $definition.Base }}
+            base.WriteFields(writer);
+            {{- end }}
+            {{- range $property := properties $definition }}
+            {{- $member := protoMember $property }}
+            {{- if $member.Repeated }}
+            if ({{ $member.Member }} != null)
+            {
+                foreach (var item in {{ $member.Member }})
+                {
+                    {{ $member.Write }};
+                }
             }
-                {{- else if eq $parameter.Type "array" }}
-            foreach (var elem in {{ $parameter.Name | snakeToCamel }} ?? new {{ $parameter.Items.Type }}[0])
+            {{- else }}
+            if ({{ $member.Condition }})
             {
-                {{- if eq $parameter.Items.Type "string" }}
-                queryParams = string.Concat(queryParams, "{{- $camelToSnake }}=", Uri.EscapeDataString(elem), "&");
-                    {{- else }}
-                queryParams = string.Concat(queryParams, "{{- $camelToSnake }}=", elem, "&");
-                    {{- end }}
+                {{ $member.Write }};
             }
-                {{- else }}
-            {{ $parameter }} // ERROR
-                {{- end }}
             {{- end }}
+            {{- end }}
+        }
         {{- end }}
 
-            string path = _baseUri.AbsolutePath.TrimEnd('/') + urlpath;
+        {{- $values := valueMembers $definition }}
 
-            var uri = new UriBuilder(_baseUri)
-            {
-                Path = path,
-                Query = queryParams
-            }.Uri;
+        /// <inheritdoc />
+        public {{ if $definition.Base }}new {{ end }}I{{ $classname }} Clone() => ({{ $classname }}) CloneModel();
+        {{- range $parent := cloneInterfaces $definition }}
 
-            var httpMethod = "{{- $method | uppercase }}";
-            var headers = new Dictionary<string, string>();
+        {{ $parent }} {{ $parent }}.Clone() => ({{ $classname }}) CloneModel();
+        {{- end }}
 
-            {{- if $operation.Security }}
-                {{- range $idx, $security := $operation.Security }}
-                    {{- range $key, $value := $security }}
-                        {{- if or (eq $key "BasicAuth") (eq $key "HttpKeyAuth")}}
-            if (!string.IsNullOrEmpty(basicAuthUsername))
+        internal {{ if $definition.Base }}override{{ else }}virtual{{ end }} object CloneModel()
+        {
+            var clone = ({{ $classname }}) {{ if $definition.Base }}base.CloneModel(){{ else }}MemberwiseClone(){{ end }};
+            {{- range $value := $values }}
+            {{- if $value.Clone }}
+            clone.{{ $value.Member }} = {{ $value.Clone }};
+            {{- end }}
+            {{- end }}
+            return clone;
+        }
+
+        public override bool Equals(object obj)
+        {
+            {{- if $definition.Base }}
+            if (!base.Equals(obj))
             {
-                var credentials = Encoding.UTF8.GetBytes(basicAuthUsername + ":" + basicAuthPassword);
-                var header = string.Concat("Basic ", Convert.ToBase64String(credentials));
-                headers.Add("Authorization", header);
+                return false;
             }
-                        {{- else if (eq $key "BearerJwt") }}
-            if (!string.IsNullOrEmpty(bearerToken))
+            {{- else }}
+            if (ReferenceEquals(this, obj))
             {
-                var header = string.Concat("Bearer ", bearerToken);
-                headers.Add("Authorization", header);
+                return true;
+            }
+            if (obj == null || obj.GetType() != GetType())
+            {
+                return false;
             }
-                       {{- end }}
-                   {{- end }}
-                {{- end }}
-            {{- else }}
-            var header = string.Concat("Bearer ", bearerToken);
-            headers.Add("Authorization", header);
             {{- end }}
+            {{- if $values }}
 
-            byte[] content = null;
-            {{- range $parameter := $operation.Parameters }}
-            {{- if eq $parameter.In "body" }}
-            var jsonBody = {{ $parameter.Name }}.ToJson();
-            content = Encoding.UTF8.GetBytes(jsonBody);
-            {{- end }}
+            var other = ({{ $classname }}) obj;
+            return {{ range $i, $value := $values }}{{ if $i }} &&
+                {{ end }}{{ $value.Equals }}{{ end }};
+            {{- else }}
+            return true;
             {{- end }}
+        }
 
-            {{- if $operation.Responses.Ok.Schema.Ref }}
-            var contents = await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
-            return contents.FromJson<{{ $operation.Responses.Ok.Schema.Ref | cleanRef }}>();
+        public override int GetHashCode()
+        {
+            {{- if $values }}
+            unchecked
+            {
+                var hash = {{ if $definition.Base }}base.GetHashCode(){{ else }}17{{ end }};
+                {{- range $value := $values }}
+                hash = hash * 31 + {{ $value.Hash }};
+                {{- end }}
+                return hash;
+            }
             {{- else }}
-            await HttpAdapter.SendAsync(httpMethod, uri, headers, content, Timeout, cancellationToken);
+            return {{ if $definition.Base }}base.GetHashCode(){{ else }}17{{ end }};
             {{- end }}
         }
+
+        {{- if not $definition.Base }}
+
+        public override string ToString()
+        {
+            var members = new List<string>();
+            FormatMembers(members);
+            return ApiClient.FormatModel(GetType().Name, members);
+        }
         {{- end }}
-    {{- end }}
+
+        internal {{ if $definition.Base }}override{{ else }}virtual{{ end }} void FormatMembers(List<string> members)
+        {
+            {{- if $definition.Base }}
+            base.FormatMembers(members);
+            {{- end }}
+            {{- range $value := $values }}
+            members.Add("{{ $value.Name }}: " + {{ $value.Format }});
+            {{- end }}
+        }
     }
-}
-`
+    {{- end }}
+
+
+    {{- end }}
+{{- end }}`
 
 func convertRefToClassName(input string) (className string) {
 	cleanRef := strings.TrimPrefix(input, "#/definitions/")
@@ -528,15 +2234,103 @@ func pascalToCamel(input string) (camelCase string) {
 	return camelCase
 }
 
-func splitEnumDescription(description string, idx int) []string {
-	if description == "" {
-		return make([]string, idx+1)
+// orderedProperties lists a definition's properties in declaration order, or sorted by name when it is unknown.
+func orderedProperties(definition ObjectDefinition) []NamedProperty {
+	names := definition.PropertyOrder
+	if len(names) != len(definition.Properties) {
+		names = make([]string, 0, len(definition.Properties))
+		for name := range definition.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	properties := make([]NamedProperty, 0, len(names))
+	for _, name := range names {
+		properties = append(properties, NamedProperty{Name: name, ObjectProperty: definition.Properties[name]})
+	}
+	return properties
+}
+
+// interfaceProperties lists the properties a definition's interface declares, leaving out those of the interfaces
+// it extends.
+func interfaceProperties(definition ObjectDefinition) []NamedProperty {
+	properties := orderedProperties(definition)
+	declared := properties[:0:0]
+	for _, property := range properties {
+		if !definition.Inherited[property.Name] {
+			declared = append(declared, property)
+		}
+	}
+	return declared
+}
+
+// primitive maps a JSON schema primitive and its format to the C# type it is exposed as, or "" for other types.
+func primitive(schemaType, format string) string {
+	switch schemaType {
+	case "integer":
+		switch format {
+		case "int64":
+			return "long"
+		case "uint64":
+			return "ulong"
+		}
+		return "int"
+	case "number":
+		if format == "float" {
+			return "float"
+		}
+		return "double"
+	case "boolean":
+		return "bool"
+	case "string":
+		switch format {
+		case "int64":
+			return "long"
+		case "uint64":
+			return "ulong"
+		case "date-time":
+			return "DateTime"
+		case "byte":
+			return "byte[]"
+		}
+		return "string"
+	}
+	return ""
+}
+
+// isEncoded reports primitives which JSON carries as strings, and so are serialized through a string member.
+func isEncoded(schemaType, format string) bool {
+	return schemaType == "string" && primitive(schemaType, format) != "string"
+}
+
+// nullable makes a value type nullable when the property tells an unset value apart from the zero value.
+func nullable(isNullable bool, csharpType string) string {
+	switch csharpType {
+	case "", "string", "byte[]":
+		return csharpType
+	}
+	if isNullable {
+		return csharpType + "?"
 	}
-	tokens := strings.Split(description, "\n")
-	if len(tokens)-1 < idx {
-		return make([]string, idx+1)
+	return csharpType
+}
+
+func isNullable(csharpType string) bool {
+	return strings.HasSuffix(csharpType, "?")
+}
+
+// converter names the ApiClient methods which parse an encoded primitive from its string form and format it back.
+func converter(csharpType string) string {
+	switch csharpType = strings.TrimSuffix(csharpType, "?"); csharpType {
+	case "long":
+		return "Int64"
+	case "ulong":
+		return "UInt64"
+	case "byte[]":
+		return "Bytes"
 	}
-	return tokens
+	return csharpType
 }
 
 func stripNewlines(input string) string {
@@ -547,6 +2341,12 @@ func stripOperationPrefix(input string) string {
 	return strings.Replace(input, "Nakama_", "", 1)
 }
 
+// methodName is the name of the ApiClient method generated for an operation, e.g. GetAccountAsync for
+// Nakama_GetAccount, which the facade method that calls it is named after too.
+func methodName(operationId string) string {
+	return snakeToPascal(stripOperationPrefix(operationId)) + "Async"
+}
+
 func descriptionOrTitle(description string, title string) string {
 	if description != "" {
 		return description
@@ -571,71 +2371,110 @@ func camelToPascal(camelCase string) (pascalCase string) {
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		if command, ok := commands[os.Args[1]]; ok {
+			command(os.Args[2:])
+			return
+		}
+	}
+
 	// Argument flags
 	var output = flag.String("output", "", "The output for generated code.")
+	var realtime = flag.String("realtime", "", "Generate the socket protocol from this envelope message of a descriptor set, e.g. nakama.realtime.Envelope.")
+	var client = flag.Bool("client", false, "Generate the IClient interface and Client facade methods instead of the ApiClient.")
+	var clientConfig = flag.String("client-config", "", "A JSON file which shapes the generated facade methods.")
+	var jsonLibrary = flag.String("json", "tinyjson", "The JSON library to serialize with: tinyjson, system (System.Text.Json) or newtonsoft (Newtonsoft.Json).")
+	var transport = flag.String("transport", "json", "The encoding of request and response bodies: json, or protobuf for the wire format of a descriptor set input.")
+	var check = flag.Bool("check", false, "Check that the -output file is up to date, printing a unified diff and exiting non-zero when it isn't. Nothing is written.")
+	var preview = flag.Bool("diff", false, "Print the unified diff of the -output file against the code which would be generated, without writing it.")
+	var diagnostics = diagnosticsFlag(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "openapi-gen [flags] inputs...")
+		flag.PrintDefaults()
+	}
 	flag.Parse()
+	setDiagnosticFormat(*diagnostics)
+
+	if (*check || *preview) && *output == "" {
+		usageError(flag.CommandLine, "The -check and -diff options compare with the -output file, which must be given.")
+	}
 
 	inputs := flag.Args()
 	if len(inputs) < 1 {
-		fmt.Printf("No input file found: %s\n\n", inputs)
-		fmt.Println("openapi-gen [flags] inputs...")
-		flag.PrintDefaults()
-		return
+		usageError(flag.CommandLine, "No input file found: %s", inputs)
 	}
 
 	inputFile := inputs[0]
+	diagnosticFile = inputFile
 	content, err := os.ReadFile(inputFile)
 	if err != nil {
-		fmt.Printf("Unable to read file: %s\n", err)
-		return
+		fatalf("", "Unable to read file: %s", err)
 	}
 
 	var namespace (string) = ""
 
 	if len(inputs) > 1 {
 		if len(inputs[1]) <= 0 {
-			fmt.Println("Empty Namespace provided.")
-			return
+			usageError(flag.CommandLine, "Empty Namespace provided.")
 		}
 
 		namespace = inputs[1]
 	}
 
-	var schema *Schema
-	if err := json.Unmarshal(content, &schema); err != nil {
-		fmt.Printf("Unable to decode input file %s : %s\n", inputFile, err)
-		return
+	backend, ok := jsonBackends[*jsonLibrary]
+	if !ok {
+		usageError(flag.CommandLine, "Unknown JSON library %s, which must be tinyjson, system or newtonsoft.", *jsonLibrary)
+	}
+
+	if !transports[*transport] {
+		usageError(flag.CommandLine, "Unknown transport %s, which must be json or protobuf.", *transport)
+	}
+	if *transport != "json" && *realtime != "" {
+		usageError(flag.CommandLine, "Realtime generation only supports the json transport.")
+	}
+
+	if *realtime != "" && !isFileDescriptorSet(content) {
+		fatalf("", "Realtime generation requires a descriptor set input.")
+	}
+	schema, err := decodeInput(content, *realtime)
+	if err != nil {
+		fatalf("", "Unable to decode input file %s : %s", inputFile, err)
 	}
 	schema.Namespace = namespace
+	schema.JSON = *jsonLibrary
+	schema.Transport = *transport
 
-	generateBodyDefinitionFromSchema(schema)
+	if err := resolveSchema(schema); err != nil {
+		fatalf("", "Invalid spec %s : %s", inputFile, err)
+	}
+	resolveResults(schema)
+	resolveParameterEnums(schema)
+	if err := resolveTransport(schema); err != nil {
+		fatalf("", "Unable to generate the %s transport for %s : %s", schema.Transport, inputFile, err)
+	}
+
+	if *client {
+		config, err := loadClientConfig(*clientConfig)
+		if err != nil {
+			report(Diagnostic{Severity: "error", File: *clientConfig, Message: fmt.Sprintf("Unable to read client config: %s", err)})
+			os.Exit(1)
+		}
+		generateFacade(schema, config)
+	}
 
 	fmap := template.FuncMap{
 		"snakeToCamel": snakeToCamel,
 		"camelToSnake": camelToSnake,
 		"cleanRef":     convertRefToClassName,
-		"isRefToEnum": func(ref string) bool {
+		"isRefToEnum": func(ref string) (bool, error) {
 			// swagger schema definition keys have inconsistent casing
-			var camelOk bool
-			var pascalOk bool
-			var enums []string
-
-			asCamel := pascalToCamel(ref)
-			if _, camelOk = schema.Definitions[asCamel]; camelOk {
-				enums = schema.Definitions[asCamel].Enum
-			}
-
-			asPascal := camelToPascal(ref)
-			if _, pascalOk = schema.Definitions[asPascal]; pascalOk {
-				enums = schema.Definitions[asPascal].Enum
+			def, ok := schema.lookupDefinition(ref)
+			if !ok {
+				// References are checked when the spec is resolved, so this only fails for those the template makes.
+				return false, fmt.Errorf("no definition found for %s", ref)
 			}
 
-			if !pascalOk && !camelOk {
-				fmt.Printf("no definition found: %v", ref)
-				return false
-			}
-
-			return len(enums) > 0
+			return len(def.Enum) > 0, nil
 		},
 		"pascalToCamel":        pascalToCamel,
 		"snakeToPascal":        snakeToPascal,
@@ -643,82 +2482,367 @@ func main() {
 		"title":                strings.Title,
 		"uppercase":            strings.ToUpper,
 		"camelToPascal":        camelToPascal,
-		"splitEnumDescription": splitEnumDescription,
 		"stripOperationPrefix": stripOperationPrefix,
+		"methodName":           methodName,
 		"descriptionOrTitle":   descriptionOrTitle,
 		"commentify":           commentify,
+		"properties":           orderedProperties,
+		"interfaceProperties":  interfaceProperties,
+		"primitive":            primitive,
+		"isEncoded":            isEncoded,
+		"converter":            converter,
+		"nullable":             nullable,
+		"isNullable":           isNullable,
+		"enumMembers":          enumMembers,
+		"enumUnknown":          enumUnknown,
+		"enumSummary":          enumSummary,
+		"enumConverter":        enumConverter,
+		"isArgument":           isArgument,
+		"argumentName":         argumentName,
+		"parameterName":        parameterName,
+		"parameterType":        parameterType,
+		"itemType":             itemType,
+		"mapValueType":         mapValueType,
+		"isRepeated":           isRepeated,
+		"formatArgument":       formatArgument,
+		"formatItem":           formatItem,
+		"formatMapValue":       formatMapValue,
+		"formatPathArgument":   formatPathArgument,
+		"checksNull":           checksNull,
+		"jsonMember":           schema.jsonMember,
+		"jsonNamespaces":       func() []string { return backend.Namespaces },
+		"jsonProperty":         backend.property,
+		"jsonIgnore":           func() string { return backend.Ignore },
+		"envelopeMember":       envelopeMember,
+		"protoMember":          schema.protoMember,
+		"valueMembers":         schema.valueMembers,
+		"cloneInterfaces":      cloneInterfaces,
 	}
 
-	tmpl, err := template.New(inputFile).Funcs(fmap).Parse(codeTemplate)
+	tmpl, err := template.New(inputFile).Funcs(fmap).Parse(definitionsTemplate)
 	if err != nil {
 		panic(err)
 	}
+	mainTemplate := codeTemplate
+	switch {
+	case schema.Envelope != nil:
+		mainTemplate = realtimeTemplate
+	case schema.Facade != nil:
+		mainTemplate = clientTemplate
+	}
+	if tmpl, err = tmpl.Parse(mainTemplate); err != nil {
+		panic(err)
+	}
 
+	// The code is rendered in full before anything is written, so a failure leaves no partial output behind.
+	var generated bytes.Buffer
+	if err := tmpl.Execute(&generated, schema); err != nil {
+		fatalf("", "Unable to render %s : %s", inputFile, err)
+	}
 	if len(*output) < 1 {
-		if err := tmpl.Execute(os.Stdout, schema); err != nil {
-			panic(err)
+		if _, err := os.Stdout.Write(generated.Bytes()); err != nil {
+			fatalf("", "Unable to write the output: %s", err)
 		}
 		return
 	}
 
-	f, err := os.Create(*output)
-	if err != nil {
-		fmt.Printf("Unable to create file: %s\n", err)
+	if *check || *preview {
+		// A missing output file is compared as empty, so the diff shows everything it would hold.
+		existing, err := os.ReadFile(*output)
+		if err != nil && !os.IsNotExist(err) {
+			fatalf("", "Unable to read file: %s", err)
+		}
+		diff := unifiedDiff(*output, *output+" (generated)", string(existing), generated.String())
+		fmt.Print(diff)
+		if *check {
+			if diff != "" {
+				fmt.Fprintf(os.Stderr, "%s is out of date with %s, regenerate it\n", *output, inputFile)
+				os.Exit(1)
+			}
+			fmt.Fprintf(os.Stderr, "%s is up to date\n", *output)
+		}
 		return
 	}
-	defer f.Close()
 
-	writer := bufio.NewWriter(f)
-	tmpl.Execute(writer, schema)
-	writer.Flush()
+	if err := writeFileAtomic(*output, generated.Bytes()); err != nil {
+		fatalf("", "Unable to write file: %s", err)
+	}
 }
 
-type Schema struct {
-	Namespace string
-	Paths     map[string]map[string]struct {
-		Summary     string
-		OperationId string
-		Responses   struct {
-			Ok struct {
-				Schema struct {
-					Ref string `json:"$ref"`
-				}
-			} `json:"200"`
+// commands are run in place of code generation when named by the first argument, and parse the arguments after it.
+var commands = map[string]func(args []string){
+	"mock":   mockCommand,
+	"record": recordCommand,
+	"diff":   diffCommand,
+	"replay": replayCommand,
+}
+
+// decodeInput decodes a Swagger 2.0 or OpenAPI 3.x document, in JSON or YAML, or a descriptor set, into the model of
+// a spec. With an envelope message, the descriptor set is decoded into the socket protocol instead.
+func decodeInput(content []byte, envelope string) (*Schema, error) {
+	switch {
+	case envelope != "":
+		return loadRealtimeSchema(content, envelope)
+	case isFileDescriptorSet(content):
+		return loadDescriptorSchema(content)
+	case isYAMLDocument(content):
+		converted, err := yamlToJSON(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid YAML: %w", err)
 		}
-		Parameters []struct {
-			Name     string
-			In       string
-			Required bool
-			Type     string // used with primitives
-			Items    struct { // used with type "array"
-				Type string
-			}
-			Format string       // used with type "boolean"
-			Schema ObjectSchema `json:"schema"`
+		content = converted
+	}
+	schema, err := loadSchema(content)
+	if err != nil {
+		return nil, err
+	}
+	schema.Document = "swagger"
+	if isOpenAPI3(content) {
+		schema.Document = "openapi3"
+	}
+	locateSources(schema)
+	return schema, nil
+}
+
+// resolveSchema resolves the parts of a decoded spec which everything reading it relies on: inline schemas are
+// hoisted into definitions, composed schemas resolved, path templates checked, and the error model and security of
+// operations set. It fails when the paths of the spec don't match their parameters.
+func resolveSchema(s *Schema) error {
+	hoistInlineSchemas(s)
+	composeDefinitions(s)
+	if err := resolvePathParameters(s); err != nil {
+		return err
+	}
+	if err := checkReferences(s); err != nil {
+		return err
+	}
+	resolveErrorModel(s)
+	resolveSecurity(s)
+	return nil
+}
+
+// checkReferences reports the references of properties, request bodies and success responses to definitions the spec
+// doesn't have, as errors at the schemas which make them, since the code generated for them wouldn't compile.
+func checkReferences(s *Schema) error {
+	var problems []Diagnostic
+	check := func(ref, pointer, subject string) {
+		if ref == "" {
+			return
 		}
-		Security []map[string][]struct {
+		if _, ok := s.lookupDefinition(convertRefToClassName(ref)); !ok {
+			problems = append(problems, Diagnostic{Pointer: pointer, Message: fmt.Sprintf("%s references unknown definition %s", subject, ref)})
+		}
+	}
+	for name, def := range s.Definitions {
+		for _, property := range orderedProperties(def) {
+			pointer := childPointer(def.Source, "properties", property.Name)
+			subject := fmt.Sprintf("Property %s of %s", property.Name, name)
+			check(property.Ref, pointer, subject)
+			check(property.Items.Ref, childPointer(pointer, "items"), subject)
+			check(property.AdditionalProperties.Ref, childPointer(pointer, "additionalProperties"), subject)
+		}
+	}
+	for _, path := range s.Paths {
+		for _, operation := range path {
+			for _, parameter := range operation.ParametersIn("body") {
+				check(parameter.Schema.Ref, operation.Source, fmt.Sprintf("The body of %s", operation.OperationId))
+			}
+			// The error model of default responses is optional, and resolveErrorModel warns when it's missing.
+			response := operation.Responses.Ok
+			pointer := childPointer(operation.Source, "responses", response.Status)
+			subject := fmt.Sprintf("The %s response of %s", response.Status, operation.OperationId)
+			check(response.Schema.Ref, pointer, subject)
+			check(response.Schema.Items.Ref, pointer, subject)
+			check(response.Schema.AdditionalProperties.Ref, pointer, subject)
 		}
 	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Slice(problems, func(i, j int) bool { return problems[i].Message < problems[j].Message })
+	for _, problem := range problems {
+		errorf(problem.Pointer, "%s", problem.Message)
+	}
+	return fmt.Errorf("the spec references definitions it doesn't have")
+}
+
+// readSpec reads an input file and resolves its spec, for the commands which serve or compare a spec rather than
+// generate code from it. Diagnostics are reported against the file.
+func readSpec(inputFile string) (*Schema, error) {
+	diagnosticFile = inputFile
+	content, err := os.ReadFile(inputFile)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := decodeInput(content, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveSchema(schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// writeFileAtomic writes a file through a temporary file beside it, which is renamed over it once written in full,
+// so that the file is never left half written. An existing file keeps its permissions.
+func writeFileAtomic(path string, content []byte) error {
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(mode); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+type Schema struct {
+	Namespace string
+	// The JSON library the generated code serializes with: tinyjson, system or newtonsoft.
+	JSON string `json:"-"`
+	// The encoding of request and response bodies: json, or protobuf for the wire format of descriptor sets.
+	Transport string `json:"-"`
+	// The kind of JSON document the spec was read from, swagger or openapi3, which diagnostics point into. It's
+	// empty for descriptor sets.
+	Document    string `json:"-"`
+	Paths       map[string]map[string]Operation
 	Definitions map[string]ObjectDefinition
+	// The security schemes, and the requirements of operations which declare none.
+	SecurityDefinitions map[string]SecurityScheme
+	Security            []map[string][]struct{}
+	// Definitions referenced by this schema but generated into another file.
+	Imports map[string]ObjectDefinition `json:"-"`
+	// The realtime message envelope, only set when generating the socket protocol.
+	Envelope *Envelope `json:"-"`
+	// The facade methods, only set when generating the Client.
+	Facade *Facade `json:"-"`
+	// The error response failures are parsed into, when the schema declares one.
+	Errors *ErrorModel `json:"-"`
 }
 
-type ObjectSchema struct {
-	Type       string
-	Ref        string `json:"$ref"`
-	Properties map[string]struct {
-		Type        string
-		Description string
+// lookupDefinition finds a definition by reference name, tolerating the inconsistent casing of definition keys.
+func (s *Schema) lookupDefinition(ref string) (ObjectDefinition, bool) {
+	for _, definitions := range []map[string]ObjectDefinition{s.Definitions, s.Imports} {
+		if def, ok := definitions[camelToPascal(ref)]; ok {
+			return def, true
+		}
+		if def, ok := definitions[pascalToCamel(ref)]; ok {
+			return def, true
+		}
+	}
+	return ObjectDefinition{}, false
+}
+
+// isEnum reports whether a reference names an enum definition.
+func (s *Schema) isEnum(ref string) bool {
+	def, ok := s.lookupDefinition(strings.TrimPrefix(ref, "#/definitions/"))
+	return ok && len(def.Enum) > 0
+}
+
+type Operation struct {
+	Summary     string
+	OperationId string
+	Responses   Responses
+	// The media types of the request, which pick the encoding of form parameters.
+	Consumes []string
+	// The value the method resolves to, from the success response.
+	Result     Result `json:"-"`
+	Parameters []Parameter
+	// The security requirements, which are those of the spec when nil and none when empty.
+	Security []map[string][]struct {
 	}
+	// The ways to authenticate, resolved from the security requirements.
+	Auth []Authentication `json:"-"`
+	// Whether the body and result are sent in the protobuf wire format, rather than as JSON.
+	Protobuf bool `json:"-"`
+	// Shapes the facade method generated for this operation.
+	Client *ClientOptions `json:"x-client"`
+	// The JSON pointer of the operation in the spec, which diagnostics about it are located by.
+	Source string `json:"-"`
+}
+
+type Parameter struct {
+	Name     string
+	In       string
+	Required bool
+	Type     string // used with primitives
+	Items    Items  // used with type "array"
+	// How the items of an array are sent: csv, ssv, tsv, pipes, or multi for a pair per item.
+	CollectionFormat     string
+	AdditionalProperties AdditionalProperties // used with type "object"
+	Format               string               // used with type "boolean"
+	// The pattern of a path parameter, as in the "{name=projects/*}" variables of grpc-gateway.
+	Pattern string
+	// The members of an inline enum, and the enum definition the parameter is typed by.
+	Enum        enumNames
+	Ref         string       `json:"-"`
+	Schema      ObjectSchema `json:"schema"`
 	Description string
 }
 
+type ObjectSchema struct {
+	Type                 string
+	Ref                  string `json:"$ref"`
+	Format               string
+	Items                Items                // used with type "array"
+	AdditionalProperties AdditionalProperties // used with type "object"
+	Properties           map[string]ObjectProperty
+	Description          string
+}
+
 type ObjectDefinition struct {
 	Properties map[string]ObjectProperty
-
-	Enum        []string
-	Description string
+	// The JSON pointer of the schema the definition was read from, which diagnostics about it are located by.
+	Source string `json:"-"`
+	// Declaration order of the properties, when the input preserves it.
+	PropertyOrder []string `json:"-"`
+
+	Enum enumNames
+	// Numeric values of the enum members, when the input declares them apart from their names.
+	EnumValues []int `json:"-"`
+	// Member names of an integer enum.
+	EnumVarNames []string `json:"x-enum-varnames"`
+	Description  string
+	// An example of the whole object, which the mock server responds with.
+	Example json.RawMessage
 	// used only by enums
 	Title string
+
+	// Set on the members of composed schemas.
+	Ref           string `json:"$ref"`
+	AllOf         []ObjectDefinition
+	OneOf         []ObjectDefinition
+	AnyOf         []ObjectDefinition
+	Discriminator *Discriminator
+	// Resolved from the composed schemas: the class the definition derives from, the interfaces its own interface
+	// extends along with the properties it inherits from them, and the variants of a oneOf or anyOf.
+	Base       string          `json:"-"`
+	Extends    []string        `json:"-"`
+	Implements []string        `json:"-"`
+	Inherited  map[string]bool `json:"-"`
+	Variants   []Variant       `json:"-"`
+}
+
+// NamedProperty pairs a property with its name so definitions can be rendered in declaration order.
+type NamedProperty struct {
+	Name string
+	ObjectProperty
 }
 
 type ObjectProperty struct {
@@ -729,61 +2853,28 @@ type ObjectProperty struct {
 	Format               string // used with type "boolean"
 	Description          string
 	Title                string // used by enums
+	// Whether an unset value is distinct from the zero value, as with protobuf wrappers and optional fields.
+	Nullable bool `json:"x-nullable"`
+	// The properties of an anonymous object, until it is hoisted into a definition.
+	Properties map[string]ObjectProperty
+	// How the property is encoded in the protobuf wire format, only known for definitions of descriptor sets.
+	Proto *ProtoField `json:"-"`
+	// The example and default values the spec gives, which the mock server responds with.
+	Example json.RawMessage
+	Default json.RawMessage
 }
 
 type Items struct {
-	Type string
-	Ref  string `json:"$ref"`
+	Type       string
+	Format     string
+	Enum       enumNames                 // used by query parameters
+	Ref        string                    `json:"$ref"`
+	Properties map[string]ObjectProperty // until hoisted
 }
 
 type AdditionalProperties struct {
-	Type   string // used with type "map"
-	Format string // used with type "map"
-	Ref    string `json:"$ref"` // used with object
-}
-
-func generateBodyDefinitionFromSchema(s *Schema) {
-	// Needed because of this change: https://github.com/grpc-ecosystem/grpc-gateway/issues/1670
-	for _, path := range s.Paths {
-		// Iterate through each HTTP method (e.g., "get", "post", "put") for the current path
-		for verb, operation := range path {
-			// Check if the HTTP method is one that can contain a body
-			if verb == "post" || verb == "put" {
-				// Iterate through the parameters of the operation
-				for idx, param := range operation.Parameters {
-					// Check if the parameter is a body parameter with an inline schema
-					if param.In == "body" && param.Name == "body" && param.Schema.Ref == "" {
-						// Construct a unique name for the new definition
-						objectName := "Api" + strings.TrimPrefix(operation.OperationId, fmt.Sprintf("%s_", s.Namespace)) + "Request"
-
-						// Update the parameter's schema reference to the new definition
-						param.Schema.Ref = "#/definitions/" + objectName
-
-						// Update the parameter in the original operation object
-						operation.Parameters[idx] = param
-
-						// Create the new definition
-						properties := make(map[string]ObjectProperty)
-
-						for key, p := range param.Schema.Properties {
-							properties[key] = ObjectProperty{
-								Type:  p.Type,
-								Items: Items{},
-								AdditionalProperties: AdditionalProperties{
-									Type: "string",
-								},
-								Description: p.Description,
-							}
-						}
-
-						// Add the new definition to the schema's definitions map
-						s.Definitions[objectName] = ObjectDefinition{
-							Properties:  properties,
-							Description: param.Schema.Description,
-						}
-					}
-				}
-			}
-		}
-	}
+	Type       string                    // used with type "map"
+	Format     string                    // used with type "map"
+	Ref        string                    `json:"$ref"` // used with object
+	Properties map[string]ObjectProperty // until hoisted
 }
@@ -0,0 +1,294 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "Rewrite the golden files with the output of the generator.")
+
+// TestMain runs the command in place of the tests when the test binary is re-executed by runCommand, so that the
+// tests exercise the flags, output and exit status of the real command.
+func TestMain(m *testing.M) {
+	if os.Getenv("CODEGEN_TEST_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runCommand runs the command with the arguments and returns its standard output and error, and its exit code.
+func runCommand(t *testing.T, args ...string) (stdout, stderr string, code int) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), "CODEGEN_TEST_MAIN=1")
+	var out, errOut bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &out, &errOut
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			t.Fatalf("running %s: %s", strings.Join(args, " "), err)
+		}
+		code = exitErr.ExitCode()
+	}
+	return out.String(), errOut.String(), code
+}
+
+// goldens lists the files of testdata which hold the expected output of the command for some arguments.
+var goldens = []struct {
+	golden string
+	args   []string
+}{
+	{"testdata/nakama.swagger.cs", []string{"testdata/nakama.swagger.json", "Nakama"}},
+	// An OpenAPI 3 spec generates the same client as the equivalent Swagger spec.
+	{"testdata/nakama.swagger.cs", []string{"testdata/nakama.openapi3.json", "Nakama"}},
+	{"testdata/greeter.pb.cs", []string{"testdata/greeter.pb", "Example"}},
+	{"testdata/formats.swagger.cs", []string{"testdata/formats.swagger.json", "Nakama"}},
+	// Nullable fields are spelled "nullable" in OpenAPI 3.0 and as a "null" type in OpenAPI 3.1.
+	{"testdata/formats.swagger.cs", []string{"testdata/formats.openapi3.json", "Nakama"}},
+	{"testdata/formats.system.cs", []string{"-json", "system", "testdata/formats.swagger.json", "Nakama"}},
+	{"testdata/formats.newtonsoft.cs", []string{"-json", "newtonsoft", "testdata/formats.swagger.json", "Nakama"}},
+	{"testdata/compose.openapi3.cs", []string{"testdata/compose.openapi3.json", "Nakama"}},
+	{"testdata/inline.swagger.cs", []string{"testdata/inline.swagger.json", "Nakama"}},
+	{"testdata/errors.swagger.cs", []string{"testdata/errors.swagger.json", "Nakama"}},
+	{"testdata/responses.swagger.cs", []string{"testdata/responses.swagger.json", "Nakama"}},
+	{"testdata/params.swagger.cs", []string{"testdata/params.swagger.json", "Nakama"}},
+	{"testdata/params.openapi3.cs", []string{"testdata/params.openapi3.json", "Nakama"}},
+	{"testdata/query.swagger.cs", []string{"testdata/query.swagger.json", "Nakama"}},
+	{"testdata/path.swagger.cs", []string{"testdata/path.swagger.json", "Nakama"}},
+	// A spec written in YAML generates the same client as the same spec written in JSON.
+	{"testdata/path.swagger.cs", []string{"testdata/path.swagger.yaml", "Nakama"}},
+	{"testdata/collisions.swagger.cs", []string{"testdata/collisions.swagger.json", "Nakama"}},
+	{"testdata/collisions.client.cs", []string{"-client", "testdata/collisions.swagger.json", "Nakama"}},
+	{"testdata/security.swagger.cs", []string{"testdata/security.swagger.json", "Nakama"}},
+	{"testdata/security.openapi3.cs", []string{"testdata/security.openapi3.json", "Nakama"}},
+	{"testdata/nakama.client.cs", []string{"-client", "-client-config", "testdata/nakama.client.json", "testdata/nakama.swagger.json", "Nakama"}},
+	// The x-client extension of an operation configures its method like an entry of the config file.
+	{"testdata/greeter.client.cs", []string{"-client", "testdata/greeter.pb", "Example"}},
+	{"testdata/chat.pb.cs", []string{"-realtime", "example.realtime.Envelope", "testdata/chat.pb", "Example"}},
+	{"testdata/kinds.pb.cs", []string{"-transport", "protobuf", "testdata/kinds.pb", "Example"}},
+}
+
+// TestGolden compares the output of the command with the golden files. Run the tests with -update to rewrite them.
+func TestGolden(t *testing.T) {
+	for _, test := range goldens {
+		name := strings.Join(test.args, " ")
+		t.Run(name, func(t *testing.T) {
+			stdout, stderr, code := runCommand(t, test.args...)
+			if code != 0 {
+				t.Fatalf("exit code %d: %s", code, stderr)
+			}
+			if *update {
+				if err := os.WriteFile(test.golden, []byte(stdout), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(test.golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := unifiedDiff(test.golden, "output", string(want), stdout); diff != "" {
+				t.Errorf("the output differs from %s:\n%s", test.golden, diff)
+			}
+		})
+	}
+}
+
+func TestPrimitive(t *testing.T) {
+	tests := []struct {
+		schemaType, format string
+		want               string
+		encoded            bool
+	}{
+		{"integer", "int32", "int", false},
+		{"integer", "", "int", false},
+		{"integer", "int64", "long", false},
+		{"integer", "uint64", "ulong", false},
+		{"number", "float", "float", false},
+		{"number", "double", "double", false},
+		{"number", "", "double", false},
+		{"boolean", "", "bool", false},
+		{"string", "", "string", false},
+		{"string", "int64", "long", true},
+		{"string", "uint64", "ulong", true},
+		{"string", "date-time", "DateTime", true},
+		{"string", "byte", "byte[]", true},
+		{"object", "", "", false},
+		{"array", "", "", false},
+	}
+	for _, test := range tests {
+		if got := primitive(test.schemaType, test.format); got != test.want {
+			t.Errorf("primitive(%q, %q) = %q, want %q", test.schemaType, test.format, got, test.want)
+		}
+		if got := isEncoded(test.schemaType, test.format); got != test.encoded {
+			t.Errorf("isEncoded(%q, %q) = %v, want %v", test.schemaType, test.format, got, test.encoded)
+		}
+	}
+}
+
+func TestUnknownJSONLibrary(t *testing.T) {
+	_, stderr, code := runCommand(t, "-json", "jackson", "testdata/formats.swagger.json", "Nakama")
+	if want := "Unknown JSON library jackson, which must be tinyjson, system or newtonsoft.\n\nopenapi-gen [flags] inputs...\n"; code != 2 || !strings.HasPrefix(stderr, want) {
+		t.Errorf("got exit code %d and error %q, want 2 and %q with the usage", code, stderr, want)
+	}
+}
+
+func TestProtobufTransportErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		code int
+		want string
+	}{
+		{
+			[]string{"-transport", "grpc", "testdata/kinds.pb", "Example"},
+			2,
+			"Unknown transport grpc, which must be json or protobuf.\n",
+		},
+		// Only descriptor sets declare the field numbers of the wire format.
+		{
+			[]string{"-transport", "protobuf", "testdata/formats.swagger.json", "Nakama"},
+			1,
+			"testdata/formats.swagger.json: error: Unable to generate the protobuf transport for testdata/formats.swagger.json : property",
+		},
+	}
+	for _, test := range tests {
+		stdout, stderr, code := runCommand(t, test.args...)
+		if code != test.code || stdout != "" || !strings.HasPrefix(stderr, test.want) {
+			t.Errorf("%s: got exit code %d, output %q and error %q, want %d and the error %q", strings.Join(test.args, " "), code, stdout, stderr, test.code, test.want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	want, err := os.ReadFile("testdata/query.swagger.cs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(t.TempDir(), "ApiClient.gen.cs")
+	args := []string{"-check", "-output", output, "testdata/query.swagger.json", "Nakama"}
+
+	// A missing output file is out of date, and its diff adds the whole file.
+	stdout, _, code := runCommand(t, args...)
+	if code != 1 || !strings.HasPrefix(stdout, "--- "+output+"\n+++ "+output+" (generated)\n@@ -0,0 +1,") {
+		t.Errorf("got exit code %d and output %q for a missing file, want 1 and a diff adding it", code, stdout)
+	}
+
+	if err := os.WriteFile(output, want, 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr, code := runCommand(t, args...)
+	if code != 0 || stdout != "" || stderr != output+" is up to date\n" {
+		t.Errorf("got exit code %d, output %q and error %q for an up to date file", code, stdout, stderr)
+	}
+
+	stale := strings.Replace(string(want), "internal class ApiClient", "internal class StaleApiClient", 1)
+	if err := os.WriteFile(output, []byte(stale), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr, code = runCommand(t, args...)
+	if code != 1 || !strings.Contains(stdout, "-    internal class StaleApiClient") || !strings.Contains(stdout, "+    internal class ApiClient") {
+		t.Errorf("got exit code %d and output %q for a stale file, want 1 and its diff", code, stdout)
+	}
+	if want := output + " is out of date with testdata/query.swagger.json, regenerate it\n"; stderr != want {
+		t.Errorf("got error %q, want %q", stderr, want)
+	}
+
+	// -diff shows the same diff without failing.
+	preview, _, code := runCommand(t, "-diff", "-output", output, "testdata/query.swagger.json", "Nakama")
+	if code != 0 || preview != stdout {
+		t.Errorf("got exit code %d and output %q from -diff, want 0 and the diff of -check", code, preview)
+	}
+	// Neither option writes the file.
+	if content, _ := os.ReadFile(output); string(content) != stale {
+		t.Error("-check or -diff rewrote the output file")
+	}
+
+	_, stderr, code = runCommand(t, "-check", "testdata/query.swagger.json", "Nakama")
+	if want := "The -check and -diff options compare with the -output file, which must be given.\n"; code != 2 || !strings.HasPrefix(stderr, want) {
+		t.Errorf("got exit code %d and error %q without -output, want 2 and %q", code, stderr, want)
+	}
+}
+
+func TestOutput(t *testing.T) {
+	want, err := os.ReadFile("testdata/query.swagger.cs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	output := filepath.Join(dir, "ApiClient.gen.cs")
+	// Only the output file is left in its directory, whether the command succeeds or fails.
+	checkDir := func() {
+		t.Helper()
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 || entries[0].Name() != filepath.Base(output) {
+			var names []string
+			for _, entry := range entries {
+				names = append(names, entry.Name())
+			}
+			t.Errorf("the output directory holds %q, want only the output file", names)
+		}
+	}
+
+	if _, stderr, code := runCommand(t, "-output", output, "testdata/query.swagger.json", "Nakama"); code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+	if content, _ := os.ReadFile(output); string(content) != string(want) {
+		t.Errorf("the output file differs from testdata/query.swagger.cs")
+	}
+	if info, err := os.Stat(output); err != nil || info.Mode().Perm() != 0644 {
+		t.Errorf("got the output file %v, %v, want it created with mode 0644", info, err)
+	}
+	checkDir()
+
+	// An existing file keeps its permissions when it's replaced.
+	if err := os.WriteFile(output, []byte("stale"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(output, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, stderr, code := runCommand(t, "-output", output, "testdata/query.swagger.json", "Nakama"); code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+	if info, err := os.Stat(output); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("got the output file %v, %v, want it to keep mode 0600", info, err)
+	}
+	checkDir()
+
+	// A failure leaves the existing file as it was.
+	if err := os.WriteFile(output, []byte("stale"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	_, stderr, code := runCommand(t, "-transport", "protobuf", "-output", output, "testdata/formats.swagger.json", "Nakama")
+	if code != 1 || !strings.Contains(stderr, ": error: ") {
+		t.Errorf("got exit code %d and error %q for a failure, want 1 and the error", code, stderr)
+	}
+	if content, _ := os.ReadFile(output); string(content) != "stale" {
+		t.Errorf("a failure rewrote the output file with %q", content)
+	}
+	checkDir()
+}
@@ -0,0 +1,834 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// mockCommand serves the operations of a spec with canned responses, so that clients can be tested without a server.
+func mockCommand(args []string) {
+	flags := flag.NewFlagSet("mock", flag.ExitOnError)
+	addr := flags.String("addr", "127.0.0.1:7350", "The address to listen on.")
+	fixtures := flags.String("fixtures", "", "A directory of <operationId>.json responses, served in place of those generated from the spec.")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "openapi-gen mock [flags] input")
+		flags.PrintDefaults()
+	}
+	diagnostics := diagnosticsFlag(flags)
+	flags.Parse(args)
+	setDiagnosticFormat(*diagnostics)
+	if flags.NArg() < 1 {
+		usageError(flags, "")
+	}
+
+	serveMock(flags.Arg(0), *addr, *fixtures, false)
+}
+
+// serveMock serves the operations of a spec until the server fails. In replay mode, operations are only answered
+// with their fixtures.
+func serveMock(inputFile, addr, fixtures string, replay bool) {
+	schema, err := readSpec(inputFile)
+	if err != nil {
+		fatalf("", "Unable to read spec %s : %s", inputFile, err)
+	}
+	server := newMockServer(schema)
+	server.replay = replay
+	if fixtures != "" {
+		if err := server.loadFixtures(fixtures); err != nil {
+			fatalf("", "Unable to read fixtures %s : %s", fixtures, err)
+		}
+	}
+
+	// The spec is read, so the failures which follow aren't located in it.
+	diagnosticFile = ""
+	fmt.Fprintf(os.Stderr, "Serving %d operations of %s on http://%s\n", len(server.routes), inputFile, addr)
+	if err := http.ListenAndServe(addr, server); err != nil {
+		fatalf("", "Unable to serve on %s : %s", addr, err)
+	}
+}
+
+// mockServer responds to the operations of a spec, after checking the credentials, parameters and body of each
+// request against it.
+type mockServer struct {
+	schema *Schema
+	routes []mockRoute
+	// Responses served in place of those generated from the spec, by operation ID.
+	fixtures map[string][]mockFixture
+	// Whether operations are only answered with their fixtures, as recorded traffic is replayed.
+	replay bool
+}
+
+// mockRoute matches the requests of one operation by their method and path.
+type mockRoute struct {
+	method    string
+	template  string
+	pattern   *regexp.Regexp
+	variables []string
+	operation Operation
+}
+
+// mockFixture is a response served for an operation in place of the one generated from the spec.
+type mockFixture struct {
+	// The request the response answers, or nil when it answers any request of the operation.
+	Request *mockRequest `json:"request,omitempty"`
+	// The status code, which defaults to that of the operation's success response.
+	Status  int               `json:"status,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// mockRequest is a request as it's recorded, with its secrets redacted, and matched against those a fixture answers.
+type mockRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  url.Values      `json:"query,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+func newMockRequest(r *http.Request, body []byte) *mockRequest {
+	request := &mockRequest{Method: r.Method, Path: r.URL.EscapedPath(), Body: redactBody(body)}
+	if query := r.URL.Query(); len(query) > 0 {
+		request.Query = redactQuery(query)
+	}
+	return request
+}
+
+// matches reports whether a request is the one recorded, comparing JSON bodies by value.
+func (f *mockRequest) matches(request *mockRequest) bool {
+	if f.Method != request.Method || f.Path != request.Path || len(f.Query) != len(request.Query) {
+		return false
+	}
+	if len(f.Query) > 0 && !reflect.DeepEqual(f.Query, request.Query) {
+		return false
+	}
+	if len(f.Body) == 0 || len(request.Body) == 0 {
+		return len(f.Body) == len(request.Body)
+	}
+	var a, b interface{}
+	if json.Unmarshal(f.Body, &a) != nil || json.Unmarshal(request.Body, &b) != nil {
+		return bytes.Equal(f.Body, request.Body)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// mockResponse is the response to one request, along with the problems found with the request.
+type mockResponse struct {
+	operation string
+	status    int
+	headers   map[string]string
+	body      []byte
+	problems  []string
+}
+
+// mockType is the shape of a value the mock server checks or generates, which may come from the schema of a body or
+// response, a property, the items of an array or the values of a map.
+type mockType struct {
+	Type   string
+	Format string
+	Ref    string
+	Items  *mockType
+	Values *mockType
+	// The example and default the spec gives for the value.
+	Example json.RawMessage
+	Default json.RawMessage
+}
+
+func schemaType(schema ObjectSchema) mockType {
+	return mockType{
+		Type:   schema.Type,
+		Format: schema.Format,
+		Ref:    schema.Ref,
+		Items:  itemsType(schema.Items),
+		Values: valuesType(schema.AdditionalProperties),
+	}
+}
+
+func propertyType(property ObjectProperty) mockType {
+	return mockType{
+		Type:    property.Type,
+		Format:  property.Format,
+		Ref:     property.Ref,
+		Items:   itemsType(property.Items),
+		Values:  valuesType(property.AdditionalProperties),
+		Example: property.Example,
+		Default: property.Default,
+	}
+}
+
+func itemsType(items Items) *mockType {
+	if items.Type == "" && items.Ref == "" {
+		return nil
+	}
+	return &mockType{Type: items.Type, Format: items.Format, Ref: items.Ref}
+}
+
+func valuesType(values AdditionalProperties) *mockType {
+	if values.Type == "" && values.Ref == "" {
+		return nil
+	}
+	return &mockType{Type: values.Type, Format: values.Format, Ref: values.Ref}
+}
+
+func newMockServer(schema *Schema) *mockServer {
+	m := &mockServer{schema: schema, fixtures: make(map[string][]mockFixture)}
+	for template, path := range schema.Paths {
+		for method, operation := range path {
+			m.routes = append(m.routes, newMockRoute(method, template, operation))
+		}
+	}
+	// Paths with more literal text are matched first, so that "/v2/user/me" takes precedence over "/v2/user/{id}".
+	sort.Slice(m.routes, func(i, j int) bool {
+		a, b := m.routes[i], m.routes[j]
+		if literalA, literalB := len(pathParamPattern.ReplaceAllString(a.template, "")), len(pathParamPattern.ReplaceAllString(b.template, "")); literalA != literalB {
+			return literalA > literalB
+		}
+		if a.template != b.template {
+			return a.template < b.template
+		}
+		return a.method < b.method
+	})
+	return m
+}
+
+// newMockRoute matches the path template of an operation, where a variable takes one segment unless its pattern
+// spans several.
+func newMockRoute(method, template string, operation Operation) mockRoute {
+	multiSegment := make(map[string]bool)
+	for _, parameter := range operation.ParametersIn("path") {
+		multiSegment[parameter.Name] = isMultiSegment(parameter)
+	}
+
+	route := mockRoute{method: strings.ToUpper(method), template: template, operation: operation}
+	pattern := "^"
+	last := 0
+	for _, match := range pathParamPattern.FindAllStringSubmatchIndex(template, -1) {
+		name := template[match[2]:match[3]]
+		pattern += regexp.QuoteMeta(template[last:match[0]])
+		if multiSegment[name] {
+			pattern += "(.+)"
+		} else {
+			pattern += "([^/]+)"
+		}
+		route.variables = append(route.variables, name)
+		last = match[1]
+	}
+	route.pattern = regexp.MustCompile(pattern + regexp.QuoteMeta(template[last:]) + "$")
+	return route
+}
+
+// loadFixtures reads the responses of a directory of <operationId>.json files, each holding one fixture or a list of
+// them, and warns about those which don't match the spec.
+func (m *mockServer) loadFixtures(dir string) error {
+	operations := make(map[string]Operation)
+	for _, route := range m.routes {
+		operations[route.operation.OperationId] = route.operation
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		id := strings.TrimSuffix(filepath.Base(file), ".json")
+		operation, ok := operations[id]
+		if !ok {
+			report(Diagnostic{Severity: "warning", File: file, Message: "The fixture matches no operation, and is left out"})
+			continue
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var fixtures []mockFixture
+		// The fixtures of an array are located by their index, while a single fixture is the whole file.
+		array := false
+		if content = bytes.TrimSpace(content); len(content) > 0 && content[0] == '[' {
+			array = true
+			err = json.Unmarshal(content, &fixtures)
+		} else {
+			fixtures = make([]mockFixture, 1)
+			err = json.Unmarshal(content, &fixtures[0])
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		for i, fixture := range fixtures {
+			var body bytes.Buffer
+			if json.Compact(&body, fixture.Body) == nil {
+				fixtures[i].Body = body.Bytes()
+			}
+			pointer := ""
+			if array {
+				pointer = "/" + strconv.Itoa(i)
+			}
+			for _, problem := range m.checkFixture(operation, fixture) {
+				report(Diagnostic{Severity: "warning", File: file, Pointer: pointer, Message: fmt.Sprintf("The fixture doesn't match the response of %s: %s", id, problem)})
+			}
+		}
+		m.fixtures[id] = fixtures
+	}
+	return nil
+}
+
+// checkFixture checks the body of a successful response against the response schema of its operation.
+func (m *mockServer) checkFixture(operation Operation, fixture mockFixture) []string {
+	if (fixture.Status != 0 && fixture.Status/100 != 2) || len(fixture.Body) == 0 {
+		return nil
+	}
+	return m.checkJSON(fixture.Body, schemaType(operation.Responses.Ok.Schema), "body")
+}
+
+func (m *mockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	response := m.respond(r)
+	if response.operation == "" {
+		response.operation = "-"
+	}
+	fmt.Fprintf(os.Stderr, "%s %s %s %d\n", r.Method, r.URL.Path, response.operation, response.status)
+	for _, problem := range response.problems {
+		fmt.Fprintf(os.Stderr, "  %s\n", problem)
+	}
+
+	if _, ok := response.headers["Content-Type"]; !ok && len(response.body) > 0 {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	for name, value := range response.headers {
+		w.Header().Set(name, value)
+	}
+	w.WriteHeader(response.status)
+	w.Write(response.body)
+}
+
+// route finds the operation of a request, along with the values of its path variables. When there is none, it
+// returns the status to fail with: 405 when the path is that of another method, else 404.
+func (m *mockServer) route(r *http.Request) (mockRoute, []string, int) {
+	path := r.URL.EscapedPath()
+	status := http.StatusNotFound
+	for _, route := range m.routes {
+		match := route.pattern.FindStringSubmatch(path)
+		if match == nil {
+			continue
+		}
+		if route.method != r.Method {
+			status = http.StatusMethodNotAllowed
+			continue
+		}
+		return route, match[1:], 0
+	}
+	return mockRoute{}, nil, status
+}
+
+// respond routes a request to its operation, and responds to it when the request matches the spec.
+func (m *mockServer) respond(r *http.Request) mockResponse {
+	route, variables, status := m.route(r)
+	switch status {
+	case http.StatusMethodNotAllowed:
+		return failure("", status, 12, "Method Not Allowed", nil)
+	case http.StatusNotFound:
+		return failure("", status, 5, "Not Found", nil)
+	}
+
+	operation := route.operation
+	if problem := m.authenticate(operation, r); problem != "" {
+		return failure(operation.OperationId, http.StatusUnauthorized, 16, problem, nil)
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return failure(operation.OperationId, http.StatusBadRequest, 3, fmt.Sprintf("The body can't be read: %s", err), nil)
+	}
+	problems := m.checkParameters(route, variables, r, body)
+	if len(problems) > 0 {
+		return failure(operation.OperationId, http.StatusBadRequest, 3, "The request doesn't match the spec", problems)
+	}
+	return m.success(operation, newMockRequest(r, body))
+}
+
+// failure is an error response with a gRPC status code, in the form of the status grpc-gateway responds with.
+func failure(operation string, status, code int, message string, problems []string) mockResponse {
+	if len(problems) > 0 {
+		message = fmt.Sprintf("%s: %s", message, strings.Join(problems, "; "))
+	}
+	body, _ := json.Marshal(map[string]interface{}{"code": code, "message": message})
+	return mockResponse{operation: operation, status: status, body: body, problems: problems}
+}
+
+// success is the response of an operation: the first of its fixtures which answers the request, else its example,
+// else a value generated from its schema. In replay mode, a request no fixture answers fails instead.
+func (m *mockServer) success(operation Operation, request *mockRequest) mockResponse {
+	response := mockResponse{operation: operation.OperationId, status: http.StatusOK}
+	if status, err := strconv.Atoi(operation.Responses.Ok.Status); err == nil {
+		response.status = status
+	}
+
+	for _, fixture := range m.fixtures[operation.OperationId] {
+		if fixture.Request != nil && !fixture.Request.matches(request) {
+			continue
+		}
+		if fixture.Status != 0 {
+			response.status = fixture.Status
+		}
+		response.headers = fixture.Headers
+		response.body = fixture.Body
+		return response
+	}
+	if m.replay {
+		return failure(operation.OperationId, http.StatusNotImplemented, 12, fmt.Sprintf("No fixture of %s answers the request", operation.OperationId), nil)
+	}
+	if response.status == http.StatusNoContent {
+		return response
+	}
+
+	if example, ok := operation.Responses.Ok.Examples["application/json"]; ok {
+		response.body = example
+		return response
+	}
+	value, ok := m.example(schemaType(operation.Responses.Ok.Schema), make(map[string]bool))
+	if !ok {
+		value = map[string]interface{}{}
+	}
+	response.body, _ = json.Marshal(value)
+	return response
+}
+
+// authenticate checks that a request carries the credentials of one of the security requirements of its operation,
+// and otherwise describes those which are missing.
+func (m *mockServer) authenticate(operation Operation, r *http.Request) string {
+	requirements := m.schema.requirements(operation)
+	if len(requirements) == 0 {
+		return ""
+	}
+
+	var alternatives []string
+	for _, requirement := range requirements {
+		var missing []string
+		for _, name := range sortedKeys(requirement) {
+			if problem := checkCredential(m.schema.credential(name), r); problem != "" {
+				missing = append(missing, problem)
+			}
+		}
+		if len(missing) == 0 {
+			return ""
+		}
+		alternatives = append(alternatives, strings.Join(missing, " and "))
+	}
+	return strings.Join(alternatives, ", or ")
+}
+
+// checkCredential describes how a request lacks a credential, and is empty when it carries it.
+func checkCredential(credential Credential, r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	switch credential.Kind {
+	case "basic":
+		if len(authorization) < 6 || !strings.EqualFold(authorization[:6], "Basic ") {
+			return "expected basic credentials in the Authorization header"
+		}
+		decoded, err := base64.StdEncoding.DecodeString(authorization[6:])
+		if err != nil || !strings.Contains(string(decoded), ":") {
+			return "expected the basic credentials of the Authorization header to be base64 of username:password"
+		}
+	case "apiKey":
+		var value string
+		switch credential.In {
+		case "query":
+			value = r.URL.Query().Get(credential.Name)
+		case "cookie":
+			if cookie, err := r.Cookie(credential.Name); err == nil {
+				value = cookie.Value
+			}
+		default:
+			value = r.Header.Get(credential.Name)
+		}
+		if value == "" {
+			return fmt.Sprintf("expected the API key %s in the %s", credential.Name, credential.In)
+		}
+	default:
+		if len(authorization) < 7 || !strings.EqualFold(authorization[:7], "Bearer ") || strings.TrimSpace(authorization[7:]) == "" {
+			return "expected a bearer token in the Authorization header"
+		}
+	}
+	return ""
+}
+
+// checkParameters checks the path, query, header and body parameters of a request against those of its operation.
+func (m *mockServer) checkParameters(route mockRoute, variables []string, r *http.Request, body []byte) []string {
+	var problems []string
+	for i, name := range route.variables {
+		value, err := url.PathUnescape(variables[i])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("path parameter %s is not escaped properly", name))
+			continue
+		}
+		for _, parameter := range route.operation.ParametersIn("path") {
+			if parameter.Name == name {
+				problems = append(problems, m.checkParameter(parameter, []string{value})...)
+			}
+		}
+	}
+
+	query := r.URL.Query()
+	for _, parameter := range route.operation.ParametersIn("query") {
+		problems = append(problems, m.checkParameter(parameter, query[parameter.Name])...)
+	}
+	for _, parameter := range route.operation.ParametersIn("header") {
+		problems = append(problems, m.checkParameter(parameter, r.Header.Values(parameter.Name))...)
+	}
+
+	if form := route.operation.ParametersIn("formData"); len(form) > 0 {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if err := r.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return append(problems, fmt.Sprintf("the form can't be read: %s", err))
+		}
+		for _, parameter := range form {
+			if parameter.Type == "file" {
+				if _, _, err := r.FormFile(parameter.Name); err != nil && parameter.Required {
+					problems = append(problems, fmt.Sprintf("form parameter %s is required", parameter.Name))
+				}
+				continue
+			}
+			problems = append(problems, m.checkParameter(parameter, r.PostForm[parameter.Name])...)
+		}
+	}
+	for _, parameter := range route.operation.ParametersIn("body") {
+		if len(bytes.TrimSpace(body)) == 0 {
+			if parameter.Required {
+				problems = append(problems, "the body is required")
+			}
+			continue
+		}
+		problems = append(problems, m.checkJSON(body, schemaType(parameter.Schema), "body")...)
+	}
+	return problems
+}
+
+// checkParameter checks the values a parameter is given, which are split into items for an array.
+func (m *mockServer) checkParameter(parameter Parameter, values []string) []string {
+	where := fmt.Sprintf("%s parameter %s", parameter.In, parameter.Name)
+	if len(values) == 0 {
+		if parameter.Required {
+			return []string{where + " is required"}
+		}
+		return nil
+	}
+
+	if parameter.Type != "array" {
+		if len(values) > 1 {
+			return []string{fmt.Sprintf("%s is given %d times", where, len(values))}
+		}
+		if problem := m.checkText(values[0], parameter.Type, parameter.Format, parameter.Ref, parameter.Enum); problem != "" {
+			return []string{fmt.Sprintf("%s: %s", where, problem)}
+		}
+		return nil
+	}
+
+	var items []string
+	for _, value := range values {
+		switch parameter.CollectionFormat {
+		case "", "multi":
+			items = append(items, value)
+		case "ssv":
+			items = append(items, strings.Split(value, " ")...)
+		case "tsv":
+			items = append(items, strings.Split(value, "\t")...)
+		case "pipes":
+			items = append(items, strings.Split(value, "|")...)
+		default:
+			items = append(items, strings.Split(value, ",")...)
+		}
+	}
+	var problems []string
+	for _, item := range items {
+		if problem := m.checkText(item, parameter.Items.Type, parameter.Items.Format, parameter.Items.Ref, parameter.Items.Enum); problem != "" {
+			problems = append(problems, fmt.Sprintf("%s: %s", where, problem))
+		}
+	}
+	return problems
+}
+
+// checkText checks a value given as text, as parameters and the JSON form of some primitives are, against its type.
+func (m *mockServer) checkText(value, schemaType, format, ref string, enum []string) string {
+	if def, ok := m.definition(ref); ok && len(def.Enum) > 0 {
+		enum = def.Enum
+	}
+	if len(enum) > 0 {
+		for _, name := range enum {
+			if value == name {
+				return ""
+			}
+		}
+		if _, err := strconv.Atoi(value); err == nil {
+			return ""
+		}
+		return fmt.Sprintf("%q is not one of %s", value, strings.Join(enum, ", "))
+	}
+
+	var err error
+	switch {
+	case format == "int64" || format == "int32":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case format == "uint64" || format == "uint32":
+		_, err = strconv.ParseUint(value, 10, 64)
+	case schemaType == "integer":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case schemaType == "number":
+		switch value {
+		case "NaN", "Infinity", "-Infinity":
+		default:
+			_, err = strconv.ParseFloat(value, 64)
+		}
+	case schemaType == "boolean":
+		_, err = strconv.ParseBool(value)
+	case format == "date-time":
+		_, err = time.Parse(time.RFC3339Nano, value)
+	case format == "byte":
+		if _, err = base64.StdEncoding.DecodeString(value); err != nil {
+			_, err = base64.URLEncoding.DecodeString(value)
+		}
+	default:
+		return ""
+	}
+	if err != nil {
+		return fmt.Sprintf("%q is not a valid %s", value, typeName(schemaType, format))
+	}
+	return ""
+}
+
+func typeName(schemaType, format string) string {
+	if format != "" {
+		return format
+	}
+	return schemaType
+}
+
+// checkJSON checks a JSON document against its type, and lists its problems by the JSON pointer of each value.
+func (m *mockServer) checkJSON(content []byte, t mockType, where string) []string {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return []string{fmt.Sprintf("%s is not valid JSON: %s", where, err)}
+	}
+	var problems []string
+	m.check(value, t, where, "", &problems)
+	return problems
+}
+
+// check checks a decoded JSON value against its type. A null is the default value of any type, as in the protobuf
+// JSON mapping.
+func (m *mockServer) check(value interface{}, t mockType, where, pointer string, problems *[]string) {
+	if value == nil {
+		return
+	}
+	report := func(format string, args ...interface{}) {
+		location := where
+		if pointer != "" {
+			location = fmt.Sprintf("%s at %s", where, pointer)
+		}
+		*problems = append(*problems, fmt.Sprintf("%s: %s", location, fmt.Sprintf(format, args...)))
+	}
+
+	if t.Ref != "" {
+		def, ok := m.definition(t.Ref)
+		if !ok {
+			return
+		}
+		if len(def.Enum) > 0 {
+			if problem := m.checkScalar(value, "string", "", t.Ref); problem != "" {
+				report("%s", problem)
+			}
+			return
+		}
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			report("expected an object of %s", convertRefToClassName(t.Ref))
+			return
+		}
+		properties := make(map[string]ObjectProperty)
+		for _, property := range m.schema.classProperties(def) {
+			properties[property.Name] = property.ObjectProperty
+		}
+		for _, name := range sortedMapKeys(object) {
+			property, ok := properties[name]
+			if !ok {
+				*problems = append(*problems, fmt.Sprintf("%s at %s: %s is not a property of %s", where, pointer+"/"+escapePointer(name), name, convertRefToClassName(t.Ref)))
+				continue
+			}
+			m.check(object[name], propertyType(property), where, pointer+"/"+escapePointer(name), problems)
+		}
+		return
+	}
+
+	switch t.Type {
+	case "array":
+		list, ok := value.([]interface{})
+		if !ok {
+			report("expected an array")
+			return
+		}
+		if t.Items != nil {
+			for i, item := range list {
+				m.check(item, *t.Items, where, fmt.Sprintf("%s/%d", pointer, i), problems)
+			}
+		}
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			report("expected an object")
+			return
+		}
+		if t.Values != nil {
+			for _, name := range sortedMapKeys(object) {
+				m.check(object[name], *t.Values, where, pointer+"/"+escapePointer(name), problems)
+			}
+		}
+	case "string", "integer", "number", "boolean":
+		if problem := m.checkScalar(value, t.Type, t.Format, ""); problem != "" {
+			report("%s", problem)
+		}
+	}
+}
+
+// checkScalar checks a primitive JSON value. As in the protobuf JSON mapping, integers may also be given as strings,
+// and 64-bit integers as numbers.
+func (m *mockServer) checkScalar(value interface{}, schemaType, format, ref string) string {
+	switch v := value.(type) {
+	case string:
+		if schemaType == "boolean" {
+			return "expected a boolean"
+		}
+		return m.checkText(v, schemaType, format, ref, nil)
+	case json.Number:
+		switch {
+		case schemaType == "number":
+			return ""
+		case schemaType == "integer", format == "int64", format == "uint64", ref != "":
+			return m.checkText(string(v), "integer", format, ref, nil)
+		}
+		return fmt.Sprintf("expected a %s, not the number %s", typeName(schemaType, format), v)
+	case bool:
+		if schemaType != "boolean" {
+			return fmt.Sprintf("expected a %s, not a boolean", typeName(schemaType, format))
+		}
+		return ""
+	}
+	return fmt.Sprintf("expected a %s", typeName(schemaType, format))
+}
+
+// example generates a value of a type: the example or default the spec gives, else a placeholder of its type. A
+// model isn't generated again within itself, so that recursive models end.
+func (m *mockServer) example(t mockType, generating map[string]bool) (interface{}, bool) {
+	switch {
+	case len(t.Example) > 0:
+		return t.Example, true
+	case len(t.Default) > 0:
+		return t.Default, true
+	}
+
+	if t.Ref != "" {
+		def, ok := m.definition(t.Ref)
+		if !ok || generating[t.Ref] {
+			return nil, false
+		}
+		if len(def.Example) > 0 {
+			return def.Example, true
+		}
+		if len(def.Enum) > 0 {
+			wire := enumMembers(def)[0].Wire
+			if _, err := strconv.Atoi(wire); err == nil {
+				return json.Number(wire), true
+			}
+			return wire, true
+		}
+
+		generating[t.Ref] = true
+		defer delete(generating, t.Ref)
+		// A oneOf or anyOf is generated as its first variant.
+		if len(def.Variants) > 0 {
+			if variant, ok := m.definition(def.Variants[0].Class); ok {
+				def = variant
+			}
+		}
+		object := make(map[string]interface{})
+		for _, property := range m.schema.classProperties(def) {
+			if value, ok := m.example(propertyType(property.ObjectProperty), generating); ok {
+				object[property.Name] = value
+			}
+		}
+		return object, true
+	}
+
+	switch t.Type {
+	case "array":
+		if t.Items != nil {
+			if item, ok := m.example(*t.Items, generating); ok {
+				return []interface{}{item}, true
+			}
+		}
+		return []interface{}{}, true
+	case "object":
+		if t.Values != nil {
+			if value, ok := m.example(*t.Values, generating); ok {
+				return map[string]interface{}{"key": value}, true
+			}
+		}
+		return map[string]interface{}{}, true
+	case "boolean":
+		return false, true
+	case "integer", "number":
+		return 0, true
+	case "string":
+		switch t.Format {
+		case "int64", "uint64":
+			return "0", true
+		case "date-time":
+			return "1970-01-01T00:00:00Z", true
+		case "byte":
+			return "", true
+		}
+		return "string", true
+	}
+	return nil, false
+}
+
+// definition finds the definition of a reference, or of a class name.
+func (m *mockServer) definition(ref string) (ObjectDefinition, bool) {
+	if ref == "" {
+		return ObjectDefinition{}, false
+	}
+	return m.schema.lookupDefinition(strings.TrimPrefix(ref, "#/definitions/"))
+}
+
+func sortedMapKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newTestMockServer serves testdata/mock.openapi3.json.
+func newTestMockServer(t *testing.T) *mockServer {
+	t.Helper()
+	schema, err := readSpec("testdata/mock.openapi3.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newMockServer(schema)
+}
+
+// serve sends a request to a mock server and returns its status and body.
+func serve(m *mockServer, method, target, body string) (int, string) {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+	return w.Code, w.Body.String()
+}
+
+func TestMockServer(t *testing.T) {
+	m := newTestMockServer(t)
+	tests := []struct {
+		name           string
+		method, target string
+		body           string
+		status         int
+		want           string
+	}{
+		{
+			"a response is generated from the examples and defaults of the spec",
+			"GET", "/items/5?http_key=k", "",
+			http.StatusOK, `{"id":"42","kind":"A","name":"n"}`,
+		},
+		{
+			"the example of a response is served as is",
+			"PUT", "/items/5?http_key=k", `{"id": "5", "kind": "B"}`,
+			http.StatusOK, `{"id": "7"}`,
+		},
+		{
+			"an anonymous operation with a multi-segment path",
+			"GET", "/files/a/b/c", "",
+			http.StatusNoContent, "",
+		},
+		{
+			"the credentials of the spec's security requirement are missing",
+			"GET", "/items/5", "",
+			http.StatusUnauthorized, `{"code":16,"message":"expected the API key http_key in the query"}`,
+		},
+		{
+			"parameters are checked against their types",
+			"GET", "/items/x?http_key=k&limit=z", "",
+			http.StatusBadRequest,
+			`{"code":3,"message":"The request doesn't match the spec: path parameter id: \"x\" is not a valid int64; query parameter limit: \"z\" is not a valid int32"}`,
+		},
+		{
+			"bodies are checked against their schema",
+			"PUT", "/items/5?http_key=k", `{"kind": "C"}`,
+			http.StatusBadRequest, `{"code":3,"message":"The request doesn't match the spec: body at /kind: \"C\" is not one of A, B"}`,
+		},
+		{
+			"a path of the spec with another method",
+			"DELETE", "/items/5?http_key=k", "",
+			http.StatusMethodNotAllowed, `{"code":12,"message":"Method Not Allowed"}`,
+		},
+		{
+			"a path which isn't in the spec",
+			"GET", "/nope", "",
+			http.StatusNotFound, `{"code":5,"message":"Not Found"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status, body := serve(m, test.method, test.target, test.body)
+			if status != test.status || body != test.want {
+				t.Errorf("got %d %s, want %d %s", status, body, test.status, test.want)
+			}
+		})
+	}
+}
+
+func TestMockFixtures(t *testing.T) {
+	dir := t.TempDir()
+	fixtures := map[string]string{
+		"GetItem.json": `{"status": 201, "headers": {"X-Mock": "yes"}, "body": {"id": "9", "name": "fixture"}}`,
+		// Fixtures named after no operation are left out.
+		"Unknown.json": `{"body": {}}`,
+	}
+	for name, content := range fixtures {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := newTestMockServer(t)
+	if err := m.loadFixtures(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.fixtures["Unknown"]; ok {
+		t.Error("the fixture of an unknown operation was loaded")
+	}
+
+	r := httptest.NewRequest("GET", "/items/5?http_key=k", nil)
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+	if w.Code != http.StatusCreated || w.Header().Get("X-Mock") != "yes" || w.Body.String() != `{"id":"9","name":"fixture"}` {
+		t.Errorf("got %d %v %s", w.Code, w.Header(), w.Body.String())
+	}
+}
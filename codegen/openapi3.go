@@ -0,0 +1,622 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// openAPI3Document is the subset of an OpenAPI 3.0/3.1 document the generator understands.
+type openAPI3Document struct {
+	OpenAPI    string
+	Paths      map[string]map[string]json.RawMessage
+	Components struct {
+		Schemas         map[string]*openAPI3Schema
+		Parameters      map[string]*openAPI3Parameter
+		RequestBodies   map[string]*openAPI3RequestBody
+		Responses       map[string]*openAPI3Response
+		SecuritySchemes map[string]SecurityScheme
+	}
+	Security []map[string][]struct{}
+}
+
+type openAPI3Operation struct {
+	Summary     string
+	OperationId string
+	Parameters  []*openAPI3Parameter
+	RequestBody *openAPI3RequestBody
+	Responses   map[string]*openAPI3Response
+	Security    []map[string][]struct {
+	}
+	// Name given to the body parameter, as used by other OpenAPI generators.
+	RequestBodyName string         `json:"x-codegen-request-body-name"`
+	Client          *ClientOptions `json:"x-client"`
+}
+
+type openAPI3Parameter struct {
+	Ref         string `json:"$ref"`
+	Name        string
+	In          string
+	Required    bool
+	Description string
+	Schema      *openAPI3Schema
+	Style       string
+	Explode     *bool
+}
+
+type openAPI3RequestBody struct {
+	Ref         string `json:"$ref"`
+	Description string
+	Required    bool
+	Content     map[string]openAPI3MediaType
+}
+
+type openAPI3Response struct {
+	Ref         string `json:"$ref"`
+	Description string
+	Content     map[string]openAPI3MediaType
+}
+
+type openAPI3MediaType struct {
+	Schema  *openAPI3Schema
+	Example json.RawMessage
+}
+
+type openAPI3Schema struct {
+	Ref                  string `json:"$ref"`
+	Type                 openAPI3Type
+	Format               string
+	Title                string
+	Description          string
+	Enum                 []interface{}
+	EnumVarNames         []string `json:"x-enum-varnames"`
+	Items                *openAPI3Schema
+	Properties           map[string]*openAPI3Schema
+	Required             []string
+	AdditionalProperties json.RawMessage
+	AllOf                []*openAPI3Schema
+	OneOf                []*openAPI3Schema
+	AnyOf                []*openAPI3Schema
+	Discriminator        *Discriminator
+	// The OpenAPI 3.0 form of a nullable schema.
+	Nullable bool
+	Example  json.RawMessage
+	Default  json.RawMessage
+}
+
+// openAPI3Type is a schema "type", which OpenAPI 3.1 also allows to be a list such as ["string", "null"].
+type openAPI3Type struct {
+	Name string
+	// Whether the list includes "null".
+	Nullable bool
+}
+
+func (t *openAPI3Type) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		t.Name = single
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	for _, v := range multiple {
+		if v == "null" {
+			t.Nullable = true
+		} else if t.Name == "" {
+			t.Name = v
+		}
+	}
+	return nil
+}
+
+// isOpenAPI3 reports whether the content is an OpenAPI 3.x document rather than a Swagger 2.0 one.
+func isOpenAPI3(content []byte) bool {
+	var version struct {
+		OpenAPI string
+	}
+	if err := json.Unmarshal(content, &version); err != nil {
+		return false
+	}
+	return strings.HasPrefix(version.OpenAPI, "3.")
+}
+
+// loadSchema decodes a Swagger 2.0 or OpenAPI 3.x document into the model consumed by the template.
+func loadSchema(content []byte) (*Schema, error) {
+	if !isOpenAPI3(content) {
+		var schema *Schema
+		if err := json.Unmarshal(content, &schema); err != nil {
+			return nil, err
+		}
+		return schema, nil
+	}
+
+	var doc *openAPI3Document
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+	return doc.schema()
+}
+
+// schema normalizes the OpenAPI 3.x document into the same model a Swagger 2.0 document decodes into.
+func (d *openAPI3Document) schema() (*Schema, error) {
+	s := &Schema{
+		Paths:       make(map[string]map[string]Operation, len(d.Paths)),
+		Definitions: make(map[string]ObjectDefinition, len(d.Components.Schemas)),
+		// Security schemes keep their shape, as Swagger 2.0 basic and OpenAPI 3 http schemes are told apart later.
+		SecurityDefinitions: d.Components.SecuritySchemes,
+		Security:            d.Security,
+	}
+
+	for name, schema := range d.Components.Schemas {
+		s.Definitions[name] = schema.definition()
+	}
+
+	for url, item := range d.Paths {
+		// Parameters declared on the path item apply to every operation beneath it.
+		var shared []*openAPI3Parameter
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &shared); err != nil {
+				return nil, fmt.Errorf("invalid parameters for path %s: %w", url, err)
+			}
+		}
+
+		operations := make(map[string]Operation)
+		for method, raw := range item {
+			switch method {
+			case "get", "put", "post", "delete", "options", "head", "patch", "trace":
+			default:
+				continue
+			}
+
+			var op *openAPI3Operation
+			if err := json.Unmarshal(raw, &op); err != nil {
+				return nil, fmt.Errorf("invalid operation %s %s: %w", method, url, err)
+			}
+			operation, err := d.operation(op, shared)
+			if err != nil {
+				return nil, fmt.Errorf("invalid operation %s %s: %w", method, url, err)
+			}
+			operations[method] = operation
+		}
+		s.Paths[url] = operations
+	}
+
+	return s, nil
+}
+
+func (d *openAPI3Document) operation(op *openAPI3Operation, shared []*openAPI3Parameter) (Operation, error) {
+	operation := Operation{
+		Summary:     op.Summary,
+		OperationId: op.OperationId,
+		Security:    op.Security,
+		Client:      op.Client,
+	}
+
+	// Operation parameters override path item parameters with the same name and location.
+	params := make([]*openAPI3Parameter, 0, len(shared)+len(op.Parameters))
+	for _, p := range shared {
+		p, err := d.resolveParameter(p)
+		if err != nil {
+			return operation, err
+		}
+		params = append(params, p)
+	}
+	for _, p := range op.Parameters {
+		p, err := d.resolveParameter(p)
+		if err != nil {
+			return operation, err
+		}
+		replaced := false
+		for i, existing := range params {
+			if existing.Name == p.Name && existing.In == p.In {
+				params[i] = p
+				replaced = true
+			}
+		}
+		if !replaced {
+			params = append(params, p)
+		}
+	}
+
+	for _, p := range params {
+		param := Parameter{
+			Name:        p.Name,
+			In:          p.In,
+			Required:    p.Required,
+			Description: p.Description,
+		}
+		if p.Schema != nil {
+			schema := d.resolveSchema(p.Schema)
+			param.Type = schema.Type.Name
+			param.Format = schema.Format
+			if len(schema.Enum) > 0 {
+				param.Ref = convertOpenAPI3Ref(p.Schema.ref())
+				param.Enum = enumNamesOf(schema.Enum)
+			}
+			if schema.Items != nil {
+				param.Items = schema.Items.items()
+				if items := d.resolveSchema(schema.Items); len(items.Enum) > 0 {
+					param.Items.Enum = enumNamesOf(items.Enum)
+				}
+			}
+			if additional := schema.additionalProperties(); additional != nil {
+				param.AdditionalProperties = additional.additionalPropertiesSchema()
+			}
+			if param.Type == "array" {
+				param.CollectionFormat = p.collectionFormat()
+			}
+		}
+		operation.Parameters = append(operation.Parameters, param)
+	}
+
+	if op.RequestBody != nil {
+		body, err := d.resolveRequestBody(op.RequestBody)
+		if err != nil {
+			return operation, err
+		}
+		if mediaType, schema := formMediaSchema(body.Content); schema != nil {
+			operation.Consumes = []string{mediaType}
+			operation.Parameters = append(operation.Parameters, d.formParameters(d.resolveSchema(schema))...)
+		} else if schema := jsonMediaSchema(body.Content); schema != nil {
+			name := op.RequestBodyName
+			if name == "" {
+				name = "body"
+			}
+			param := Parameter{
+				Name:     name,
+				In:       "body",
+				Required: body.Required,
+				Schema:   schema.objectSchema(),
+			}
+			if param.Schema.Description == "" {
+				param.Schema.Description = body.Description
+			}
+			// Match the grpc-gateway ordering of path parameters, then the body, then query parameters.
+			idx := 0
+			for idx < len(operation.Parameters) && operation.Parameters[idx].In == "path" {
+				idx++
+			}
+			operation.Parameters = append(operation.Parameters[:idx], append([]Parameter{param}, operation.Parameters[idx:]...)...)
+		}
+	}
+
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		codes = append(codes, code)
+	}
+	if status := successStatus(codes); status != "" {
+		response, err := d.resolveResponse(op.Responses[status])
+		if err != nil {
+			return operation, err
+		}
+		operation.Responses.Ok.Status = status
+		if schema := jsonMediaSchema(response.Content); schema != nil {
+			operation.Responses.Ok.Schema = schema.objectSchema()
+		}
+		for mediaType, media := range response.Content {
+			if len(media.Example) > 0 {
+				if operation.Responses.Ok.Examples == nil {
+					operation.Responses.Ok.Examples = make(map[string]json.RawMessage)
+				}
+				operation.Responses.Ok.Examples[mediaType] = media.Example
+			}
+		}
+	}
+	if response, ok := op.Responses["default"]; ok {
+		response, err := d.resolveResponse(response)
+		if err != nil {
+			return operation, err
+		}
+		if schema := jsonMediaSchema(response.Content); schema != nil {
+			operation.Responses.Default.Schema = schema.objectSchema()
+		}
+	}
+
+	return operation, nil
+}
+
+func (d *openAPI3Document) resolveParameter(p *openAPI3Parameter) (*openAPI3Parameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+	resolved, ok := d.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+	if !ok {
+		return nil, fmt.Errorf("unresolved parameter reference %s", p.Ref)
+	}
+	return resolved, nil
+}
+
+func (d *openAPI3Document) resolveRequestBody(b *openAPI3RequestBody) (*openAPI3RequestBody, error) {
+	if b.Ref == "" {
+		return b, nil
+	}
+	resolved, ok := d.Components.RequestBodies[strings.TrimPrefix(b.Ref, "#/components/requestBodies/")]
+	if !ok {
+		return nil, fmt.Errorf("unresolved request body reference %s", b.Ref)
+	}
+	return resolved, nil
+}
+
+func (d *openAPI3Document) resolveResponse(r *openAPI3Response) (*openAPI3Response, error) {
+	if r.Ref == "" {
+		return r, nil
+	}
+	resolved, ok := d.Components.Responses[strings.TrimPrefix(r.Ref, "#/components/responses/")]
+	if !ok {
+		return nil, fmt.Errorf("unresolved response reference %s", r.Ref)
+	}
+	return resolved, nil
+}
+
+// resolveSchema follows a component reference so that parameters typed by a shared schema keep their primitive type.
+func (d *openAPI3Document) resolveSchema(s *openAPI3Schema) *openAPI3Schema {
+	ref := s.ref()
+	if ref == "" {
+		return s
+	}
+	if resolved, ok := d.Components.Schemas[strings.TrimPrefix(ref, "#/components/schemas/")]; ok {
+		return resolved
+	}
+	return s
+}
+
+// jsonMediaSchema picks the JSON representation from a content map, falling back to the first media type declared.
+func jsonMediaSchema(content map[string]openAPI3MediaType) *openAPI3Schema {
+	if media, ok := content["application/json"]; ok {
+		return media.Schema
+	}
+
+	keys := make([]string, 0, len(content))
+	for k := range content {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if content[k].Schema != nil {
+			return content[k].Schema
+		}
+	}
+	return nil
+}
+
+// enumNamesOf returns the members of an enum, which may be declared as numbers.
+func enumNamesOf(values []interface{}) enumNames {
+	var names enumNames
+	for _, v := range values {
+		names = append(names, fmt.Sprint(v))
+	}
+	return names
+}
+
+// collectionFormat maps the style of an array parameter onto the Swagger 2.0 collectionFormat. Query parameters
+// default to the form style with exploded items, which repeats the parameter per item.
+func (p *openAPI3Parameter) collectionFormat() string {
+	explode := p.Explode == nil || *p.Explode
+	if p.Style != "" && p.Style != "form" {
+		explode = p.Explode != nil && *p.Explode
+	}
+	switch {
+	case explode:
+		return "multi"
+	case p.Style == "spaceDelimited":
+		return "ssv"
+	case p.Style == "pipeDelimited":
+		return "pipes"
+	}
+	return "csv"
+}
+
+// formMediaSchema picks the form representation from a content map, which is only used without a JSON one.
+func formMediaSchema(content map[string]openAPI3MediaType) (string, *openAPI3Schema) {
+	if _, ok := content["application/json"]; ok {
+		return "", nil
+	}
+	for _, mediaType := range []string{"multipart/form-data", "application/x-www-form-urlencoded"} {
+		if media, ok := content[mediaType]; ok && media.Schema != nil {
+			return mediaType, media.Schema
+		}
+	}
+	return "", nil
+}
+
+// formParameters converts the properties of a form body into formData parameters, as Swagger 2.0 declares them.
+// Binary properties become files.
+func (d *openAPI3Document) formParameters(schema *openAPI3Schema) []Parameter {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	parameters := make([]Parameter, 0, len(names))
+	for _, name := range names {
+		property := d.resolveSchema(schema.Properties[name])
+		param := Parameter{
+			Name:        name,
+			In:          "formData",
+			Required:    required[name],
+			Type:        property.Type.Name,
+			Format:      property.Format,
+			Description: property.Description,
+		}
+		if property.Type.Name == "string" && property.Format == "binary" {
+			param.Type = "file"
+			param.Format = ""
+		}
+		if property.Items != nil {
+			param.Items = property.Items.items()
+		}
+		parameters = append(parameters, param)
+	}
+	return parameters
+}
+
+// convertOpenAPI3Ref rewrites a component reference into the Swagger 2.0 form used throughout the template.
+func convertOpenAPI3Ref(ref string) string {
+	if ref == "" {
+		return ""
+	}
+	return "#/definitions/" + strings.TrimPrefix(ref, "#/components/schemas/")
+}
+
+// ref returns the schema reference, including one wrapped in a single-element allOf to carry sibling keywords.
+func (s *openAPI3Schema) ref() string {
+	if s.Ref != "" {
+		return s.Ref
+	}
+	if len(s.AllOf) == 1 && s.AllOf[0].Ref != "" {
+		return s.AllOf[0].Ref
+	}
+	return ""
+}
+
+func (s *openAPI3Schema) definition() ObjectDefinition {
+	def := ObjectDefinition{
+		Description:  s.Description,
+		Title:        s.Title,
+		EnumVarNames: s.EnumVarNames,
+		Ref:          convertOpenAPI3Ref(s.Ref),
+		Example:      s.Example,
+	}
+	for _, part := range s.AllOf {
+		def.AllOf = append(def.AllOf, part.definition())
+	}
+	for _, part := range s.OneOf {
+		def.OneOf = append(def.OneOf, part.definition())
+	}
+	for _, part := range s.AnyOf {
+		def.AnyOf = append(def.AnyOf, part.definition())
+	}
+	if s.Discriminator != nil {
+		def.Discriminator = &Discriminator{PropertyName: s.Discriminator.PropertyName}
+		for value, ref := range s.Discriminator.Mapping {
+			if def.Discriminator.Mapping == nil {
+				def.Discriminator.Mapping = make(map[string]string, len(s.Discriminator.Mapping))
+			}
+			// A mapping may also name the schema alone.
+			if !strings.HasPrefix(ref, "#/") {
+				ref = "#/components/schemas/" + ref
+			}
+			def.Discriminator.Mapping[value] = convertOpenAPI3Ref(ref)
+		}
+	}
+	def.Enum = enumNamesOf(s.Enum)
+	if len(s.Properties) > 0 {
+		def.Properties = make(map[string]ObjectProperty, len(s.Properties))
+		for name, p := range s.Properties {
+			def.Properties[name] = p.property()
+		}
+	}
+	return def
+}
+
+func (s *openAPI3Schema) objectSchema() ObjectSchema {
+	schema := ObjectSchema{
+		Type:        s.Type.Name,
+		Ref:         convertOpenAPI3Ref(s.ref()),
+		Format:      s.Format,
+		Description: s.Description,
+	}
+	if s.Items != nil {
+		schema.Items = s.Items.items()
+	}
+	if additional := s.additionalProperties(); additional != nil {
+		schema.AdditionalProperties = additional.additionalPropertiesSchema()
+	}
+	if len(s.Properties) > 0 {
+		schema.Properties = make(map[string]ObjectProperty, len(s.Properties))
+		for name, p := range s.Properties {
+			schema.Properties[name] = p.property()
+		}
+	}
+	return schema
+}
+
+func (s *openAPI3Schema) property() ObjectProperty {
+	p := ObjectProperty{
+		Type:        s.Type.Name,
+		Format:      s.Format,
+		Description: s.Description,
+		Title:       s.Title,
+		Nullable:    s.Nullable || s.Type.Nullable,
+		Example:     s.Example,
+		Default:     s.Default,
+	}
+	if ref := s.ref(); ref != "" {
+		// Swagger 2.0 references carry no type of their own.
+		p.Type = ""
+		p.Ref = convertOpenAPI3Ref(ref)
+	}
+	if s.Items != nil {
+		p.Items = s.Items.items()
+	}
+	if additional := s.additionalProperties(); additional != nil {
+		p.AdditionalProperties = additional.additionalPropertiesSchema()
+	}
+	p.Properties = s.inlineProperties()
+	return p
+}
+
+func (s *openAPI3Schema) items() Items {
+	return Items{
+		Type:       s.Type.Name,
+		Format:     s.Format,
+		Ref:        convertOpenAPI3Ref(s.ref()),
+		Properties: s.inlineProperties(),
+	}
+}
+
+func (s *openAPI3Schema) additionalPropertiesSchema() AdditionalProperties {
+	return AdditionalProperties{
+		Type:       s.Type.Name,
+		Format:     s.Format,
+		Ref:        convertOpenAPI3Ref(s.ref()),
+		Properties: s.inlineProperties(),
+	}
+}
+
+// inlineProperties converts the properties of an anonymous object schema, which are hoisted into a definition later.
+func (s *openAPI3Schema) inlineProperties() map[string]ObjectProperty {
+	if s.ref() != "" || len(s.Properties) == 0 {
+		return nil
+	}
+	properties := make(map[string]ObjectProperty, len(s.Properties))
+	for name, p := range s.Properties {
+		properties[name] = p.property()
+	}
+	return properties
+}
+
+// additionalProperties returns the map value schema, ignoring the boolean form of the keyword.
+func (s *openAPI3Schema) additionalProperties() *openAPI3Schema {
+	if len(s.AdditionalProperties) == 0 || s.AdditionalProperties[0] != '{' {
+		return nil
+	}
+	var schema *openAPI3Schema
+	if err := json.Unmarshal(s.AdditionalProperties, &schema); err != nil {
+		return nil
+	}
+	return schema
+}
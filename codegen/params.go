@@ -0,0 +1,277 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParametersIn returns the parameters of an operation sent in one location, e.g. "header" or "formData".
+func (o Operation) ParametersIn(in string) []Parameter {
+	var parameters []Parameter
+	for _, parameter := range o.Parameters {
+		if parameter.In == in {
+			parameters = append(parameters, parameter)
+		}
+	}
+	return parameters
+}
+
+// OrderedParameters returns the parameters of an operation in the order its method declares them: the required ones
+// first, then the optional ones, which default to null so that calls keep compiling as new ones are added.
+func (o Operation) OrderedParameters() []Parameter {
+	parameters := make([]Parameter, 0, len(o.Parameters))
+	for _, parameter := range o.Parameters {
+		if parameter.Required {
+			parameters = append(parameters, parameter)
+		}
+	}
+	for _, parameter := range o.Parameters {
+		if !parameter.Required {
+			parameters = append(parameters, parameter)
+		}
+	}
+	return parameters
+}
+
+// Multipart reports whether the form parameters of an operation are sent as multipart/form-data rather than
+// url-encoded, which files always are.
+func (o Operation) Multipart() bool {
+	for _, mediaType := range o.Consumes {
+		if mediaType == "multipart/form-data" {
+			return true
+		}
+	}
+	for _, parameter := range o.ParametersIn("formData") {
+		if parameter.Type == "file" {
+			return true
+		}
+	}
+	return false
+}
+
+// isArgument reports the parameters which are declared by parameterType and sent as formatted by formatArgument,
+// which are all but the path parameters and the body.
+func isArgument(parameter Parameter) bool {
+	switch parameter.In {
+	case "path", "body":
+		return false
+	}
+	return true
+}
+
+// argumentName is the C# argument a parameter is passed as, e.g. xRequestId for the X-Request-Id header.
+func argumentName(name string) string {
+	return unclashedName(csharpIdentifier(snakeToCamel(strings.NewReplacer(".", "_", "-", "_").Replace(name))), declaredNames)
+}
+
+// parameterName is the C# argument of any parameter.
+func parameterName(parameter Parameter) string {
+	if parameter.In == "body" {
+		return unclashedName(snakeToCamel(parameter.Name), declaredNames)
+	}
+	return argumentName(parameter.Name)
+}
+
+// declaredNames are the names which the generated ApiClient methods declare besides the arguments of parameters:
+// their credentials and trailing arguments, their locals and the parameters of their lambdas.
+var declaredNames = map[string]bool{
+	"basicAuthPassword": true, "basicAuthUsername": true, "bearerToken": true, "boundary": true,
+	"cancellationToken": true, "content": true, "contents": true, "cookies": true, "credentials": true, "elem": true,
+	"form": true, "header": true, "headers": true, "httpMethod": true, "kvp": true, "options": true, "path": true,
+	"queryParams": true, "reader": true, "uri": true, "urlpath": true, "value": true, "writer": true,
+}
+
+// unclashedName renames an argument which would clash with a name the generated method declares, e.g. the path
+// parameter of /files/{path=**} is passed as pathParam.
+func unclashedName(name string, declared map[string]bool) string {
+	if declared[name] {
+		return name + "Param"
+	}
+	return name
+}
+
+// parameterType is the C# type of a parameter outside the body. Query, header, form and cookie arguments are
+// nullable so that they can be left out, while path arguments are always required. Strings of 64-bit integers are
+// passed as numbers, and strings of any other format in the form they are sent in.
+func parameterType(parameter Parameter) string {
+	if parameter.In == "path" {
+		return valueType(parameter.Type, parameter.Format, parameter.Ref)
+	}
+	switch parameter.Type {
+	case "array":
+		return "IEnumerable<" + itemType(parameter) + ">"
+	case "object":
+		return "IDictionary<string, " + mapValueType(parameter) + ">"
+	}
+	return nullable(true, valueType(parameter.Type, parameter.Format, parameter.Ref))
+}
+
+// checksNull reports the required parameters which the method checks are passed, which are those of a reference or
+// nullable type.
+func checksNull(parameter Parameter) bool {
+	if !parameter.Required {
+		return false
+	}
+	if parameter.In == "body" || parameter.Type == "array" || parameter.Type == "object" {
+		return true
+	}
+	csharpType := parameterType(parameter)
+	return csharpType == "string" || csharpType == "byte[]" || isNullable(csharpType)
+}
+
+// valueType is the C# type of a parameter value, or of its items or map values.
+func valueType(schemaType, format, ref string) string {
+	if ref != "" {
+		return convertRefToClassName(ref)
+	}
+	switch schemaType {
+	case "file":
+		return "byte[]"
+	case "integer", "number", "boolean":
+		return primitive(schemaType, format)
+	}
+	if format == "int64" || format == "uint64" {
+		return primitive(schemaType, format)
+	}
+	return "string"
+}
+
+// collectionSeparator is the separator of array items sent as a single value, by the Swagger collectionFormat of a
+// query parameter. Headers and other locations always separate them with commas.
+func collectionSeparator(parameter Parameter) string {
+	if parameter.In != "query" {
+		return ","
+	}
+	switch parameter.CollectionFormat {
+	case "ssv":
+		return " "
+	case "tsv":
+		return "\\t"
+	case "pipes":
+		return "|"
+	}
+	return ","
+}
+
+// isRepeated reports array query parameters which are sent as one pair per item, as grpc-gateway expects.
+func isRepeated(parameter Parameter) bool {
+	return parameter.In == "query" && parameter.Type == "array" && parameter.CollectionFormat == "multi"
+}
+
+// itemType is the C# type of the items of an array parameter.
+func itemType(parameter Parameter) string {
+	return valueType(parameter.Items.Type, parameter.Items.Format, parameter.Items.Ref)
+}
+
+// mapValueType is the C# type of the values of a map parameter.
+func mapValueType(parameter Parameter) string {
+	values := parameter.AdditionalProperties
+	return valueType(values.Type, values.Format, values.Ref)
+}
+
+// formatArgument returns the expression which formats a non-null argument as the string it is sent as. Arrays are
+// joined by their collectionSeparator.
+func formatArgument(parameter Parameter) string {
+	name := argumentName(parameter.Name)
+	if parameter.Type == "array" {
+		item := formatItem(parameter, "value")
+		separator := collectionSeparator(parameter)
+		if item == "value" {
+			return fmt.Sprintf("string.Join(\"%s\", %s)", separator, name)
+		}
+		return fmt.Sprintf("string.Join(\"%s\", new List<%s>(%s).ConvertAll(value => %s))", separator, itemType(parameter), name, item)
+	}
+	if isNullable(parameterType(parameter)) {
+		name += ".Value"
+	}
+	return formatValue(parameter.Type, parameter.Format, parameter.Ref, name)
+}
+
+// formatItem formats an item of an array parameter.
+func formatItem(parameter Parameter, value string) string {
+	return formatValue(parameter.Items.Type, parameter.Items.Format, parameter.Items.Ref, value)
+}
+
+// formatMapValue formats a value of a map parameter.
+func formatMapValue(parameter Parameter, value string) string {
+	values := parameter.AdditionalProperties
+	return formatValue(values.Type, values.Format, values.Ref, value)
+}
+
+// formatValue formats a value as a string, independent of the culture of the client. Enums are sent by name, unless
+// the parameter declares them as integers.
+func formatValue(schemaType, format, ref, value string) string {
+	switch csharpType := valueType(schemaType, format, ref); {
+	case ref != "" && schemaType == "integer":
+		return fmt.Sprintf("((int) %s).ToString(CultureInfo.InvariantCulture)", value)
+	case ref != "":
+		return fmt.Sprintf("%s.Format(%s)", enumConverter(ref), value)
+	case csharpType == "string", csharpType == "byte[]":
+		return value
+	case csharpType == "bool":
+		return fmt.Sprintf("%s ? \"true\" : \"false\"", value)
+	}
+	return fmt.Sprintf("%s.ToString(CultureInfo.InvariantCulture)", value)
+}
+
+// resolveParameterEnums types the parameters which declare the members of an enum inline, as Swagger 2.0 parameters
+// do, by the enum definition with the same members. Others keep the type of their values.
+func resolveParameterEnums(s *Schema) {
+	enums := make(map[string]string)
+	for name, def := range s.Definitions {
+		if len(def.Enum) > 0 {
+			key := strings.Join(sortedCopy(def.Enum), ",")
+			if other, ok := enums[key]; !ok || name < other {
+				enums[key] = name
+			}
+		}
+	}
+	lookup := func(members enumNames) string {
+		if name, ok := enums[strings.Join(sortedCopy(members), ",")]; ok && len(members) > 0 {
+			return "#/definitions/" + name
+		}
+		return ""
+	}
+
+	for url, path := range s.Paths {
+		for method, operation := range path {
+			parameters := make([]Parameter, len(operation.Parameters))
+			copy(parameters, operation.Parameters)
+			for i, parameter := range parameters {
+				if parameter.In == "body" {
+					continue
+				}
+				if parameter.Ref == "" && parameter.Type == "string" {
+					parameters[i].Ref = lookup(parameter.Enum)
+				}
+				if parameter.Items.Ref == "" && parameter.Items.Type == "string" {
+					parameters[i].Items.Ref = lookup(parameter.Items.Enum)
+				}
+			}
+			operation.Parameters = parameters
+			s.Paths[url][method] = operation
+		}
+	}
+}
+
+func sortedCopy(values []string) []string {
+	sorted := make([]string, len(values))
+	copy(sorted, values)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArgumentName(t *testing.T) {
+	tests := map[string]string{
+		"X-Request-Id":    "xRequestId",
+		"Idempotency-Key": "idempotencyKey",
+		"session_id":      "sessionId",
+		"options.limit":   "optionsLimit",
+		"event":           "@event",
+		// Arguments are renamed when they clash with the names the generated methods declare.
+		"path":               "pathParam",
+		"cancellation_token": "cancellationTokenParam",
+	}
+	for name, want := range tests {
+		if got := argumentName(name); got != want {
+			t.Errorf("argumentName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestParameterType(t *testing.T) {
+	tests := []struct {
+		parameter Parameter
+		want      string
+	}{
+		{Parameter{In: "query", Type: "string"}, "string"},
+		{Parameter{In: "query", Type: "integer", Format: "int32"}, "int?"},
+		// 64-bit integers are strings in JSON, but are passed as numbers.
+		{Parameter{In: "query", Type: "string", Format: "int64"}, "long?"},
+		{Parameter{In: "header", Type: "string", Format: "uint64"}, "ulong?"},
+		{Parameter{In: "formData", Type: "string", Format: "int64"}, "long?"},
+		{Parameter{In: "path", Type: "string", Format: "int64"}, "long"},
+		{Parameter{In: "query", Type: "string", Format: "date-time"}, "string"},
+		{Parameter{In: "query", Type: "array", Items: Items{Type: "string", Format: "int64"}}, "IEnumerable<long>"},
+		{Parameter{In: "query", Type: "string", Ref: "#/definitions/apiColor"}, "ApiColor?"},
+	}
+	for _, test := range tests {
+		if got := parameterType(test.parameter); got != test.want {
+			t.Errorf("parameterType(%+v) = %s, want %s", test.parameter, got, test.want)
+		}
+	}
+}
+
+func TestFormatArgument(t *testing.T) {
+	tests := []struct {
+		parameter Parameter
+		want      string
+	}{
+		{Parameter{Name: "X-Request-Id", In: "header", Type: "string"}, "xRequestId"},
+		{Parameter{Name: "X-Attempt", In: "header", Type: "integer"}, "xAttempt.Value.ToString(CultureInfo.InvariantCulture)"},
+		{Parameter{Name: "X-Debug", In: "header", Type: "boolean"}, `xDebug.Value ? "true" : "false"`},
+		{Parameter{Name: "id", In: "formData", Type: "string", Format: "int64"}, "id.Value.ToString(CultureInfo.InvariantCulture)"},
+		{Parameter{Name: "expiry", In: "query", Type: "string", Format: "uint64"}, "expiry.Value.ToString(CultureInfo.InvariantCulture)"},
+		{Parameter{Name: "X-Cursor", In: "header", Type: "string", Format: "byte"}, "xCursor"},
+		{Parameter{Name: "color", In: "query", Type: "string", Ref: "#/definitions/apiColor"}, "ApiColorConverter.Format(color.Value)"},
+		{Parameter{Name: "level", In: "query", Type: "integer", Ref: "#/definitions/apiLevel"}, "((int) level.Value).ToString(CultureInfo.InvariantCulture)"},
+		{Parameter{Name: "X-Names", In: "header", Type: "array", Items: Items{Type: "string"}}, `string.Join(",", xNames)`},
+		{
+			Parameter{Name: "X-Tags", In: "header", Type: "array", Items: Items{Type: "integer", Format: "int32"}, CollectionFormat: "ssv"},
+			`string.Join(",", new List<int>(xTags).ConvertAll(value => value.ToString(CultureInfo.InvariantCulture)))`,
+		},
+		{Parameter{Name: "tsv", In: "query", Type: "array", Items: Items{Type: "string"}, CollectionFormat: "tsv"}, `string.Join("\t", tsv)`},
+		{
+			Parameter{Name: "pipes", In: "query", Type: "array", Items: Items{Type: "number"}, CollectionFormat: "pipes"},
+			`string.Join("|", new List<double>(pipes).ConvertAll(value => value.ToString(CultureInfo.InvariantCulture)))`,
+		},
+	}
+	for _, test := range tests {
+		if got := formatArgument(test.parameter); got != test.want {
+			t.Errorf("formatArgument(%s) = %s, want %s", test.parameter.Name, got, test.want)
+		}
+	}
+}
+
+func TestMultipart(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation Operation
+		want      bool
+	}{
+		{"url-encoded", Operation{Parameters: []Parameter{{Name: "name", In: "formData", Type: "string"}}}, false},
+		{"declared", Operation{Consumes: []string{"multipart/form-data"}}, true},
+		{"file", Operation{Parameters: []Parameter{{Name: "file", In: "formData", Type: "file"}}}, true},
+	}
+	for _, test := range tests {
+		if got := test.operation.Multipart(); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestIsRepeated(t *testing.T) {
+	tests := []struct {
+		parameter Parameter
+		want      bool
+	}{
+		{Parameter{In: "query", Type: "array", CollectionFormat: "multi"}, true},
+		{Parameter{In: "query", Type: "array", CollectionFormat: "csv"}, false},
+		{Parameter{In: "query", Type: "array"}, false},
+		{Parameter{In: "header", Type: "array", CollectionFormat: "multi"}, false},
+	}
+	for _, test := range tests {
+		if got := isRepeated(test.parameter); got != test.want {
+			t.Errorf("isRepeated(%+v) = %v, want %v", test.parameter, got, test.want)
+		}
+	}
+}
+
+func TestOrderedParameters(t *testing.T) {
+	operation := Operation{Parameters: []Parameter{
+		{Name: "limit", In: "query"},
+		{Name: "id", In: "path", Required: true},
+		{Name: "cursor", In: "query"},
+		{Name: "body", In: "body", Required: true},
+	}}
+	var got []string
+	for _, parameter := range operation.OrderedParameters() {
+		got = append(got, parameter.Name)
+	}
+	// Required parameters keep their order and come first, followed by the optional ones in theirs.
+	if want := []string{"id", "body", "limit", "cursor"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// resolvePathParameters reduces the "{name=pattern}" variables of grpc-gateway path templates to "{name}", keeping
+// the pattern on the parameter, and checks that the variables of every path match the path parameters of its
+// operations one to one. Each mismatch is reported as an error at its operation before it fails.
+func resolvePathParameters(s *Schema) error {
+	urls := make([]string, 0, len(s.Paths))
+	for url := range s.Paths {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	paths := make(map[string]map[string]Operation, len(s.Paths))
+	type problem struct {
+		pointer string
+		message string
+	}
+	var problems []problem
+	for _, url := range urls {
+		patterns := make(map[string]string)
+		for _, match := range pathParamPattern.FindAllStringSubmatch(url, -1) {
+			patterns[match[1]] = strings.TrimPrefix(match[2], "=")
+		}
+		template := pathParamPattern.ReplaceAllString(url, "{$1}")
+		if _, ok := paths[template]; !ok {
+			paths[template] = make(map[string]Operation, len(s.Paths[url]))
+		}
+
+		methods := make([]string, 0, len(s.Paths[url]))
+		for method := range s.Paths[url] {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			operation := s.Paths[url][method]
+			if other, ok := paths[template][method]; ok {
+				problems = append(problems, problem{operation.Source, fmt.Sprintf("operations %s and %s are both %s %s", other.OperationId, operation.OperationId, strings.ToUpper(method), template)})
+				continue
+			}
+			parameters := make([]Parameter, len(operation.Parameters))
+			copy(parameters, operation.Parameters)
+			declared := make(map[string]bool)
+			for i, parameter := range parameters {
+				if parameter.In != "path" {
+					continue
+				}
+				declared[parameter.Name] = true
+				pattern, ok := patterns[parameter.Name]
+				if !ok {
+					problems = append(problems, problem{operation.Source, fmt.Sprintf("operation %s declares the path parameter %s, which is not a variable of %s", operation.OperationId, parameter.Name, url)})
+					continue
+				}
+				// A path variable is always required, and its value can't be left out.
+				parameters[i].Required = true
+				if pattern != "" {
+					parameters[i].Pattern = pattern
+				}
+			}
+			for name := range patterns {
+				if !declared[name] {
+					problems = append(problems, problem{operation.Source, fmt.Sprintf("operation %s has no path parameter for the variable %s of %s", operation.OperationId, name, url)})
+				}
+			}
+			operation.Parameters = parameters
+			paths[template][method] = operation
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Slice(problems, func(i, j int) bool { return problems[i].message < problems[j].message })
+		for _, p := range problems {
+			errorf(p.pointer, "%s", p.message)
+		}
+		return fmt.Errorf("the paths don't match the parameters of their operations")
+	}
+	s.Paths = paths
+	return nil
+}
+
+// isMultiSegment reports path parameters whose pattern spans several segments, such as "projects/*" or "**", whose
+// values keep their slashes.
+func isMultiSegment(parameter Parameter) bool {
+	return strings.Contains(parameter.Pattern, "/") || strings.Contains(parameter.Pattern, "**")
+}
+
+// formatPathArgument returns the expression which formats and escapes a path argument for its variable.
+func formatPathArgument(parameter Parameter) string {
+	value := formatValue(parameter.Type, parameter.Format, parameter.Ref, parameterName(parameter))
+	if isMultiSegment(parameter) {
+		return fmt.Sprintf("string.Join(\"/\", Array.ConvertAll(%s.Split('/'), Uri.EscapeDataString))", value)
+	}
+	return fmt.Sprintf("Uri.EscapeDataString(%s)", value)
+}
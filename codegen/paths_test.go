@@ -0,0 +1,72 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestResolvePathParameters(t *testing.T) {
+	s := &Schema{Paths: map[string]map[string]Operation{
+		"/v1/{name=projects/*/things/*}/{index}": {
+			"get": {OperationId: "Nakama_GetThing", Parameters: []Parameter{
+				{Name: "name", In: "path", Type: "string"},
+				{Name: "index", In: "path", Type: "integer"},
+				{Name: "limit", In: "query", Type: "integer"},
+			}},
+		},
+	}}
+	if err := resolvePathParameters(s); err != nil {
+		t.Fatal(err)
+	}
+	operation, ok := s.Paths["/v1/{name}/{index}"]["get"]
+	if !ok {
+		t.Fatalf("the template was not reduced, got paths %v", s.Paths)
+	}
+	name, index, limit := operation.Parameters[0], operation.Parameters[1], operation.Parameters[2]
+	if name.Pattern != "projects/*/things/*" || !name.Required || !isMultiSegment(name) {
+		t.Errorf("name = %+v, want a required multi-segment parameter with the pattern of its variable", name)
+	}
+	if index.Pattern != "" || !index.Required || isMultiSegment(index) {
+		t.Errorf("index = %+v, want a required single-segment parameter", index)
+	}
+	if limit.Required {
+		t.Errorf("limit = %+v, want the query parameter left optional", limit)
+	}
+}
+
+func TestResolvePathParametersErrors(t *testing.T) {
+	s := &Schema{Paths: map[string]map[string]Operation{
+		"/v1/{id}/x": {
+			"get": {OperationId: "Nakama_GetThing", Parameters: []Parameter{{Name: "name", In: "path", Type: "string"}}},
+		},
+		"/v1/{id}/y": {
+			"get": {OperationId: "Nakama_GetOther", Parameters: []Parameter{{Name: "id", In: "path", Type: "string"}}},
+		},
+		"/v1/{id=*}/y": {
+			"get": {OperationId: "Nakama_GetAnother", Parameters: []Parameter{{Name: "id", In: "path", Type: "string"}}},
+		},
+	}}
+	diagnostics := captureDiagnostics(t)
+	if err := resolvePathParameters(s); err == nil {
+		t.Fatal("want an error for the mismatched and duplicate operations")
+	}
+	want := "error: operation Nakama_GetThing declares the path parameter name, which is not a variable of /v1/{id}/x\n" +
+		"error: operation Nakama_GetThing has no path parameter for the variable id of /v1/{id}/x\n" +
+		"error: operations Nakama_GetAnother and Nakama_GetOther are both GET /v1/{id}/y\n"
+	if diagnostics.String() != want {
+		t.Errorf("got diagnostics\n%s\nwant\n%s", diagnostics, want)
+	}
+}
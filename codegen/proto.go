@@ -0,0 +1,327 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProtoField is how a property is encoded in the protobuf wire format.
+type ProtoField struct {
+	Number int
+	// The kind of the field's values, e.g. int32, sint64, enum or message, and of its keys when it is a map.
+	Kind string
+	Key  string
+	// The well-known message the values are, e.g. google.protobuf.Timestamp, which the model holds in its JSON form.
+	Message string
+	// Whether the field is a proto3 optional field, whose zero value is sent when it is set.
+	Optional bool
+}
+
+// ProtoMember is how a model reads and writes one property in the wire format, through the same C# member which
+// holds its JSON form.
+type ProtoMember struct {
+	Number int
+	Member string
+	// The expression the member is assigned from "reader" when the field is read.
+	Read string
+	// Whether the member is a list or map, of which each item is written, rather than a value written when it is set.
+	Repeated  bool
+	Condition string
+	// The statement which writes the value, or each "item", to "writer".
+	Write string
+}
+
+// protoScalar is how the values of a scalar kind are read and written, converted from and to the C# type the model
+// holds them as.
+type protoScalar struct {
+	// The ApiProtoReader and ApiProtoWriter methods of the kind, after the Read and Write prefix.
+	Method string
+	// Formats converting the value read into the model's type, and the model's value into the one written.
+	Read  string
+	Write string
+	// The model's value for zero, for the wrappers and map entries which leave it out.
+	Zero string
+}
+
+var protoScalars = map[string]protoScalar{
+	"bool":     {"Bool", "%s", "%s", "false"},
+	"int32":    {"Int32", "%s", "%s", "0"},
+	"sint32":   {"SInt32", "%s", "%s", "0"},
+	"sfixed32": {"SFixed32", "%s", "%s", "0"},
+	"uint32":   {"UInt32", "(long) %s", "(uint) %s", "0L"},
+	"fixed32":  {"Fixed32", "(long) %s", "(uint) %s", "0L"},
+	"int64":    {"Int64", "ApiClient.FormatInt64(%s)", "ApiClient.ParseInt64(%s)", `"0"`},
+	"sint64":   {"SInt64", "ApiClient.FormatInt64(%s)", "ApiClient.ParseInt64(%s)", `"0"`},
+	"sfixed64": {"SFixed64", "ApiClient.FormatInt64(%s)", "ApiClient.ParseInt64(%s)", `"0"`},
+	"uint64":   {"UInt64", "ApiClient.FormatUInt64(%s)", "ApiClient.ParseUInt64(%s)", `"0"`},
+	"fixed64":  {"Fixed64", "ApiClient.FormatUInt64(%s)", "ApiClient.ParseUInt64(%s)", `"0"`},
+	"float":    {"Float", "%s", "%s", "0f"},
+	"double":   {"Double", "%s", "%s", "0d"},
+	"string":   {"String", "%s", "%s", `""`},
+	"bytes":    {"Bytes", "ApiClient.FormatBytes(%s)", "ApiClient.ParseBytes(%s)", `""`},
+}
+
+// protoMapKeys converts the keys of a map, which the model holds as strings whatever their kind, and gives the key an
+// entry without one has.
+var protoMapKeys = map[string]struct{ Read, Write, Zero string }{
+	"string":   {"%s", "%s", `""`},
+	"bool":     {`%s ? "true" : "false"`, `%s == "true"`, `"false"`},
+	"int32":    {"ApiClient.FormatInt64(%s)", "(int) ApiClient.ParseInt64(%s)", `"0"`},
+	"sint32":   {"ApiClient.FormatInt64(%s)", "(int) ApiClient.ParseInt64(%s)", `"0"`},
+	"sfixed32": {"ApiClient.FormatInt64(%s)", "(int) ApiClient.ParseInt64(%s)", `"0"`},
+	"uint32":   {"ApiClient.FormatInt64(%s)", "(uint) ApiClient.ParseInt64(%s)", `"0"`},
+	"fixed32":  {"ApiClient.FormatInt64(%s)", "(uint) ApiClient.ParseInt64(%s)", `"0"`},
+	"int64":    {"ApiClient.FormatInt64(%s)", "ApiClient.ParseInt64(%s)", `"0"`},
+	"sint64":   {"ApiClient.FormatInt64(%s)", "ApiClient.ParseInt64(%s)", `"0"`},
+	"sfixed64": {"ApiClient.FormatInt64(%s)", "ApiClient.ParseInt64(%s)", `"0"`},
+	"uint64":   {"ApiClient.FormatUInt64(%s)", "ApiClient.ParseUInt64(%s)", `"0"`},
+	"fixed64":  {"ApiClient.FormatUInt64(%s)", "ApiClient.ParseUInt64(%s)", `"0"`},
+}
+
+// wrapperKinds are the kinds of the value the google.protobuf wrappers hold in their first field.
+var wrapperKinds = map[string]string{
+	"google.protobuf.DoubleValue": "double",
+	"google.protobuf.FloatValue":  "float",
+	"google.protobuf.Int64Value":  "int64",
+	"google.protobuf.UInt64Value": "uint64",
+	"google.protobuf.Int32Value":  "int32",
+	"google.protobuf.UInt32Value": "uint32",
+	"google.protobuf.BoolValue":   "bool",
+	"google.protobuf.StringValue": "string",
+	"google.protobuf.BytesValue":  "bytes",
+}
+
+// protoCodec is how one value of a field is read and written.
+type protoCodec struct {
+	// The expression which reads the value from "reader".
+	Read string
+	// The format of the statement which writes a value, given the writer, field number and value.
+	Write string
+	Zero  string
+	// Whether a repeated field of the kind may be packed into a single length-delimited record.
+	Packed bool
+}
+
+// codec returns how the values of a field are read and written, where ref is the definition of enum and message
+// values.
+func (s *Schema) codec(field *ProtoField, ref string) (protoCodec, error) {
+	if scalar, ok := protoScalars[field.Kind]; ok {
+		return protoCodec{
+			Read:   fmt.Sprintf(scalar.Read, "reader.Read"+scalar.Method+"()"),
+			Write:  "%[1]s.Write" + scalar.Method + "(%[2]d, " + fmt.Sprintf(scalar.Write, "%[3]s") + ")",
+			Zero:   scalar.Zero,
+			Packed: field.Kind != "string" && field.Kind != "bytes",
+		}, nil
+	}
+
+	switch {
+	case field.Kind == "enum":
+		enum, converter := convertRefToClassName(ref), enumConverter(ref)
+		return protoCodec{
+			Read:   fmt.Sprintf("%s.Format((%s) reader.ReadInt32())", converter, enum),
+			Write:  "%[1]s.WriteInt32(%[2]d, ApiProtoWriter.EnumNumber(%[3]s, name => (int) " + converter + ".Parse(name)))",
+			Zero:   fmt.Sprintf("%s.Format((%s) 0)", converter, enum),
+			Packed: true,
+		}, nil
+	case field.Kind != "message":
+		return protoCodec{}, fmt.Errorf("is a %s field, which the protobuf transport does not support", field.Kind)
+	case field.Message == "google.protobuf.Timestamp":
+		return protoCodec{
+			Read:  "reader.ReadTimestamp()",
+			Write: "%[1]s.WriteTimestamp(%[2]d, %[3]s)",
+			Zero:  `"1970-01-01T00:00:00Z"`,
+		}, nil
+	case field.Message == "google.protobuf.Duration":
+		return protoCodec{
+			Read:  "reader.ReadDuration()",
+			Write: "%[1]s.WriteDuration(%[2]d, %[3]s)",
+			Zero:  `"0s"`,
+		}, nil
+	case wrapperKinds[field.Message] != "":
+		value, err := s.codec(&ProtoField{Number: 1, Kind: wrapperKinds[field.Message]}, "")
+		if err != nil {
+			return protoCodec{}, err
+		}
+		return protoCodec{
+			Read:  fmt.Sprintf("reader.ReadWrapper(%s, %s)", readFunc(value.Read), value.Zero),
+			Write: "%[1]s.WriteMessage(%[2]d, wrapper => " + fmt.Sprintf(value.Write, "wrapper", 1, "%[3]s") + ")",
+			Zero:  value.Zero,
+		}, nil
+	case field.Message != "":
+		return protoCodec{}, fmt.Errorf("is a %s, which the protobuf transport does not support", field.Message)
+	case ref == "":
+		return protoCodec{}, errors.New("is a message without a definition")
+	}
+
+	class := convertRefToClassName(ref)
+	return protoCodec{
+		Read:  fmt.Sprintf("reader.ReadMessage<%s>()", class),
+		Write: "%[1]s.WriteMessage(%[2]d, %[3]s)",
+		Zero:  fmt.Sprintf("new %s()", class),
+	}, nil
+}
+
+// readFunc turns an expression which reads a value into a delegate, as a method group when it's a plain call.
+func readFunc(read string) string {
+	if method := strings.TrimSuffix(read, "()"); !strings.ContainsAny(method, "() ") {
+		return method
+	}
+	return "() => " + read
+}
+
+// protoMember returns how a property is read and written in the wire format.
+func (s *Schema) protoMember(property NamedProperty) (ProtoMember, error) {
+	field := property.Proto
+	if field == nil {
+		return ProtoMember{}, errors.New("has no field number, which only descriptor set inputs declare")
+	}
+	member, t := s.storage(property)
+
+	switch t.Container {
+	case "list":
+		codec, err := s.codec(field, property.Items.Ref)
+		if err != nil {
+			return ProtoMember{}, err
+		}
+		method := "ReadRepeated"
+		if codec.Packed {
+			method = "ReadPacked"
+		}
+		return ProtoMember{
+			Number:   field.Number,
+			Member:   member,
+			Read:     fmt.Sprintf("reader.%s(%s, %s)", method, member, readFunc(codec.Read)),
+			Repeated: true,
+			Write:    fmt.Sprintf(codec.Write, "writer", field.Number, "item"),
+		}, nil
+	case "map":
+		codec, err := s.codec(field, property.AdditionalProperties.Ref)
+		if err != nil {
+			return ProtoMember{}, err
+		}
+		key, ok := protoMapKeys[field.Key]
+		if !ok {
+			return ProtoMember{}, fmt.Errorf("is a map of %s keys, which the protobuf transport does not support", field.Key)
+		}
+		keyCodec := protoScalars[field.Key]
+		readKey := fmt.Sprintf(key.Read, "reader.Read"+keyCodec.Method+"()")
+		writeKey := fmt.Sprintf("entry.Write%s(1, %s)", keyCodec.Method, fmt.Sprintf(key.Write, "item.Key"))
+		return ProtoMember{
+			Number:   field.Number,
+			Member:   member,
+			Read:     fmt.Sprintf("reader.ReadMapEntry(%s, %s, %s, %s, %s)", member, readFunc(readKey), key.Zero, readFunc(codec.Read), codec.Zero),
+			Repeated: true,
+			Write:    fmt.Sprintf("writer.WriteMessage(%d, entry => { %s; %s; })", field.Number, writeKey, fmt.Sprintf(codec.Write, "entry", 2, "item.Value")),
+		}, nil
+	}
+
+	codec, err := s.codec(field, property.Ref)
+	if err != nil {
+		return ProtoMember{}, err
+	}
+	value := member
+	var condition string
+	switch {
+	case t.Nullable:
+		condition, value = member+" != null", member+".Value"
+	case t.Element == "string" && (property.Nullable || field.Optional):
+		condition = member + " != null"
+	case t.Element == "string":
+		condition = fmt.Sprintf("!string.IsNullOrEmpty(%s)", member)
+	case t.Element == "bool":
+		condition = member
+	case isValueType(t.Element):
+		condition = member + " != 0"
+	default:
+		condition = member + " != null"
+	}
+	return ProtoMember{
+		Number:    field.Number,
+		Member:    member,
+		Read:      codec.Read,
+		Condition: condition,
+		Write:     fmt.Sprintf(codec.Write, "writer", field.Number, value),
+	}, nil
+}
+
+// transports are the encodings of the -transport option.
+var transports = map[string]bool{
+	"json":     true,
+	"protobuf": true,
+}
+
+// resolveTransport checks that every model can be encoded in the wire format when the protobuf transport is
+// generated, and sends the operations in it whose body and result are messages. grpc-gateway encodes nothing else in
+// the wire format, so other operations stay on JSON.
+func resolveTransport(s *Schema) error {
+	if s.Transport != "protobuf" {
+		return nil
+	}
+
+	names := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		definition := s.Definitions[name]
+		if len(definition.Enum) > 0 {
+			continue
+		}
+		for _, property := range orderedProperties(definition) {
+			if _, err := s.protoMember(property); err != nil {
+				return fmt.Errorf("property %s of %s %w", property.Name, name, err)
+			}
+		}
+	}
+
+	for url, path := range s.Paths {
+		for method, operation := range path {
+			if reason := s.jsonOnly(operation); reason != "" {
+				warnf(operation.Source, "Operation %s %s, so it is sent as JSON", operation.OperationId, reason)
+				continue
+			}
+			operation.Protobuf = true
+			if operation.Result.Type != "" {
+				operation.Result.Parse = fmt.Sprintf("ParseResponse<%s>(contents)", convertRefToClassName(operation.Responses.Ok.Schema.Ref))
+			}
+			s.Paths[url][method] = operation
+		}
+	}
+	return nil
+}
+
+// jsonOnly returns why an operation can't be sent in the wire format, or nothing when it can.
+func (s *Schema) jsonOnly(operation Operation) string {
+	isMessage := func(ref string) bool {
+		return ref != "" && !s.isEnum(ref)
+	}
+	for _, parameter := range operation.Parameters {
+		switch {
+		case parameter.In == "formData":
+			return "sends form parameters"
+		case parameter.In == "body" && !isMessage(parameter.Schema.Ref):
+			return "sends a body which is not a message"
+		}
+	}
+	if operation.Result.Type != "" && !isMessage(operation.Responses.Ok.Schema.Ref) {
+		return "responds with a value which is not a message"
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestProtoMember(t *testing.T) {
+	s := &Schema{Definitions: map[string]ObjectDefinition{
+		"apiState": {Enum: enumNames{"PENDING", "DONE"}},
+		"apiUser":  {},
+	}}
+	tests := []struct {
+		property NamedProperty
+		want     ProtoMember
+	}{
+		{
+			NamedProperty{"count", ObjectProperty{Type: "integer", Format: "int32", Proto: &ProtoField{Number: 1, Kind: "sint32"}}},
+			ProtoMember{Number: 1, Member: "Count", Read: "reader.ReadSInt32()", Condition: "Count != 0", Write: "writer.WriteSInt32(1, Count)"},
+		},
+		// int64 fields are held in their JSON form, and converted as they are read and written.
+		{
+			NamedProperty{"sent_at", ObjectProperty{Type: "string", Format: "int64", Proto: &ProtoField{Number: 2, Kind: "int64"}}},
+			ProtoMember{
+				Number: 2, Member: "_sentAt", Read: "ApiClient.FormatInt64(reader.ReadInt64())",
+				Condition: "!string.IsNullOrEmpty(_sentAt)", Write: "writer.WriteInt64(2, ApiClient.ParseInt64(_sentAt))",
+			},
+		},
+		// A proto3 optional field sends its zero value when it is set.
+		{
+			NamedProperty{"note", ObjectProperty{Type: "string", Proto: &ProtoField{Number: 3, Kind: "string", Optional: true}}},
+			ProtoMember{Number: 3, Member: "Note", Read: "reader.ReadString()", Condition: "Note != null", Write: "writer.WriteString(3, Note)"},
+		},
+		{
+			NamedProperty{"limit", ObjectProperty{Type: "integer", Format: "int32", Nullable: true, Proto: &ProtoField{Number: 4, Kind: "message", Message: "google.protobuf.Int32Value"}}},
+			ProtoMember{
+				Number: 4, Member: "Limit", Read: "reader.ReadWrapper(reader.ReadInt32, 0)",
+				Condition: "Limit != null", Write: "writer.WriteMessage(4, wrapper => wrapper.WriteInt32(1, Limit.Value))",
+			},
+		},
+		{
+			NamedProperty{"state", ObjectProperty{Ref: "#/definitions/apiState", Proto: &ProtoField{Number: 5, Kind: "enum"}}},
+			ProtoMember{
+				Number: 5, Member: "_state", Read: "ApiStateConverter.Format((ApiState) reader.ReadInt32())", Condition: "!string.IsNullOrEmpty(_state)",
+				Write: "writer.WriteInt32(5, ApiProtoWriter.EnumNumber(_state, name => (int) ApiStateConverter.Parse(name)))",
+			},
+		},
+		// Repeated scalars are read packed or not, and repeated messages one record at a time.
+		{
+			NamedProperty{"scores", ObjectProperty{Type: "array", Items: Items{Type: "number", Format: "double"}, Proto: &ProtoField{Number: 6, Kind: "double"}}},
+			ProtoMember{Number: 6, Member: "Scores", Read: "reader.ReadPacked(Scores, reader.ReadDouble)", Repeated: true, Write: "writer.WriteDouble(6, item)"},
+		},
+		{
+			NamedProperty{"users", ObjectProperty{Type: "array", Items: Items{Ref: "#/definitions/apiUser"}, Proto: &ProtoField{Number: 7, Kind: "message"}}},
+			ProtoMember{Number: 7, Member: "_users", Read: "reader.ReadRepeated(_users, reader.ReadMessage<ApiUser>)", Repeated: true, Write: "writer.WriteMessage(7, item)"},
+		},
+		// Map keys are held as strings whatever their kind.
+		{
+			NamedProperty{"flags", ObjectProperty{Type: "object", AdditionalProperties: AdditionalProperties{Type: "string"}, Proto: &ProtoField{Number: 8, Kind: "string", Key: "bool"}}},
+			ProtoMember{
+				Number: 8, Member: "_flags",
+				Read:     `reader.ReadMapEntry(_flags, () => reader.ReadBool() ? "true" : "false", "false", reader.ReadString, "")`,
+				Repeated: true,
+				Write:    `writer.WriteMessage(8, entry => { entry.WriteBool(1, item.Key == "true"); entry.WriteString(2, item.Value); })`,
+			},
+		},
+	}
+	for _, test := range tests {
+		got, err := s.protoMember(test.property)
+		if err != nil {
+			t.Errorf("protoMember(%s): %s", test.property.Name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("protoMember(%s) = %+v, want %+v", test.property.Name, got, test.want)
+		}
+	}
+}
+
+func TestProtoMemberErrors(t *testing.T) {
+	s := &Schema{}
+	tests := []struct {
+		property NamedProperty
+		want     string
+	}{
+		{NamedProperty{"name", ObjectProperty{Type: "string"}}, "has no field number, which only descriptor set inputs declare"},
+		{NamedProperty{"data", ObjectProperty{Type: "object", Proto: &ProtoField{Number: 1, Kind: "message", Message: "google.protobuf.Struct"}}}, "is a google.protobuf.Struct, which the protobuf transport does not support"},
+		{NamedProperty{"group", ObjectProperty{Type: "object", Proto: &ProtoField{Number: 2, Kind: "group"}}}, "is a group field, which the protobuf transport does not support"},
+		{NamedProperty{"ratios", ObjectProperty{Type: "object", AdditionalProperties: AdditionalProperties{Type: "string"}, Proto: &ProtoField{Number: 3, Kind: "string", Key: "double"}}}, "is a map of double keys, which the protobuf transport does not support"},
+	}
+	for _, test := range tests {
+		_, err := s.protoMember(test.property)
+		if err == nil || err.Error() != test.want {
+			t.Errorf("protoMember(%s) returned the error %v, want %q", test.property.Name, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const realtimeTemplate string = `/* Code generated by codegen/main.go. DO NOT EDIT. */
+
+{{- if ne .Namespace "" }}
+namespace {{.Namespace}}
+{{- end }}
+{
+    using System;
+    using System.Collections.Generic;
+    using System.Globalization;
+    {{- range jsonNamespaces }}
+    using {{ . }};
+    {{- end }}
+    {{- template "definitions" . }}
+
+    /// <summary>
+    /// {{ .Envelope.Description | stripNewlines }}
+    /// </summary>
+    internal class WebSocketMessageEnvelope{{ if eq .JSON "tinyjson" }} : IApiJsonObject{{ end }}
+    {
+        {{- range $field := .Envelope.Fields }}
+
+        [{{ jsonProperty $field.Name }}]
+        public {{ $field.Type }} {{ $field.Name | snakeToPascal }} { get; set; }
+        {{- end }}
+
+        {{- if eq .JSON "tinyjson" }}
+
+        /// <inheritdoc />
+        public bool ReadMember(string name, ApiJsonReader reader)
+        {
+            switch (name)
+            {
+                {{- range $field := .Envelope.Fields }}
+                {{- $member := envelopeMember $field }}
+                case "{{ $member.Name }}":
+                    {{ $member.Member }} = {{ $member.Read }};
+                    return true;
+                {{- end }}
+                default:
+                    return false;
+            }
+        }
+
+        /// <inheritdoc />
+        public void WriteMembers(ApiJsonWriter writer)
+        {
+            {{- range $field := .Envelope.Fields }}
+            {{- $member := envelopeMember $field }}
+            {{- if $member.CheckNull }}
+            if ({{ $member.Member }} != null)
+            {
+                writer.WriteName("{{ $member.Name }}");
+                {{ $member.Write }};
+            }
+            {{- else }}
+            writer.WriteName("{{ $member.Name }}");
+            {{ $member.Write }};
+            {{- end }}
+            {{- end }}
+        }
+        {{- end }}
+
+        public override string ToString()
+        {
+            return "WebSocketMessageEnvelope";
+        }
+    }
+}
+`
+
+// Envelope describes the message every realtime request and response is wrapped in on the socket.
+type Envelope struct {
+	Description string
+	Fields      []EnvelopeField
+}
+
+// EnvelopeField is one member of the envelope, typed with the class generated for its message.
+type EnvelopeField struct {
+	Name string
+	Type string
+}
+
+// loadRealtimeSchema builds the socket protocol model from the envelope message of a FileDescriptorSet. Messages of
+// the envelope's package are generated, while those of other packages are already declared by the HTTP client.
+func loadRealtimeSchema(content []byte, envelope string) (*Schema, error) {
+	l, err := newDescriptorLoader(content)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := l.files.FindDescriptorByName(protoreflect.FullName(envelope))
+	if err != nil {
+		return nil, fmt.Errorf("envelope message %s not found: %w", envelope, err)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("envelope %s is not a message", envelope)
+	}
+
+	pkg := md.ParentFile().Package()
+	l.imported = func(d protoreflect.Descriptor) bool {
+		return d.ParentFile().Package() != pkg
+	}
+	// Realtime classes are named after their message alone, without the legacy package prefix.
+	for fullName := range l.names {
+		if strings.HasPrefix(string(fullName), string(pkg)+".") {
+			l.names[fullName] = strings.ReplaceAll(strings.TrimPrefix(string(fullName), string(pkg)+"."), ".", "")
+		}
+	}
+
+	l.schema.Envelope = &Envelope{
+		Description: comment(md),
+	}
+	if l.schema.Envelope.Description == "" {
+		l.schema.Envelope.Description = "An envelope for messages received or sent on a <c>WebSocket</c>."
+	}
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		field := EnvelopeField{
+			Name: string(fd.Name()),
+		}
+		switch {
+		case fd.IsList() || fd.IsMap():
+			return nil, fmt.Errorf("envelope field %s must not be repeated", fd.FullName())
+		case fd.Kind() == protoreflect.MessageKind:
+			field.Type = convertRefToClassName(l.ref(fd.Message()))
+		case fd.Kind() == protoreflect.StringKind:
+			field.Type = "string"
+		case fd.Kind() == protoreflect.BoolKind:
+			field.Type = "bool"
+		case fd.Kind() == protoreflect.Int32Kind:
+			field.Type = "int"
+		default:
+			return nil, fmt.Errorf("envelope field %s has unsupported type %s", fd.FullName(), fd.Kind())
+		}
+		l.schema.Envelope.Fields = append(l.schema.Envelope.Fields, field)
+	}
+
+	return l.schema, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestLoadRealtimeSchema(t *testing.T) {
+	content, err := os.ReadFile("testdata/chat.pb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := loadRealtimeSchema(content, "example.realtime.Envelope")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantFields := []EnvelopeField{
+		{Name: "cid", Type: "string"},
+		{Name: "error", Type: "Error"},
+		{Name: "channel", Type: "Channel"},
+		{Name: "channel_join", Type: "ChannelJoin"},
+		{Name: "greeting", Type: "ApiGreeting"},
+	}
+	if !reflect.DeepEqual(schema.Envelope.Fields, wantFields) {
+		t.Errorf("got envelope fields %+v, want %+v", schema.Envelope.Fields, wantFields)
+	}
+	if schema.Envelope.Description != "The realtime protocol of the greeter." {
+		t.Errorf("got envelope description %q", schema.Envelope.Description)
+	}
+
+	// Messages of the envelope's package are named after the message alone, and those of other packages are imported.
+	for _, name := range []string{"Error", "Channel", "ChannelPresence", "ChannelJoin", "ChannelJoinType"} {
+		if _, ok := schema.Definitions[name]; !ok {
+			t.Errorf("%s isn't defined", name)
+		}
+	}
+	for _, name := range []string{"apiGreeting", "exampleapiUser"} {
+		if _, ok := schema.Definitions[name]; ok {
+			t.Errorf("%s of the HTTP API is defined again", name)
+		}
+		if _, ok := schema.Imports[name]; !ok {
+			t.Errorf("%s isn't imported", name)
+		}
+	}
+
+	for _, envelope := range []string{"example.realtime.Missing", "example.realtime.ChannelJoin.Type"} {
+		if _, err := loadRealtimeSchema(content, envelope); err == nil {
+			t.Errorf("loading the envelope %s succeeded", envelope)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// recordCommand forwards requests to a server and records the exchanges of each operation as its fixtures.
+func recordCommand(args []string) {
+	flags := flag.NewFlagSet("record", flag.ExitOnError)
+	addr := flags.String("addr", "127.0.0.1:7351", "The address to listen on.")
+	target := flags.String("target", "http://127.0.0.1:7350", "The server requests are forwarded to.")
+	fixtures := flags.String("fixtures", "fixtures", "The directory the <operationId>.json fixtures are written to.")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "openapi-gen record [flags] input")
+		flags.PrintDefaults()
+	}
+	diagnostics := diagnosticsFlag(flags)
+	flags.Parse(args)
+	setDiagnosticFormat(*diagnostics)
+	if flags.NArg() < 1 {
+		usageError(flags, "")
+	}
+
+	inputFile := flags.Arg(0)
+	schema, err := readSpec(inputFile)
+	if err != nil {
+		fatalf("", "Unable to read spec %s : %s", inputFile, err)
+	}
+	// The spec is read, so the failures which follow aren't located in it.
+	diagnosticFile = ""
+	targetURL, err := url.Parse(*target)
+	if err != nil || targetURL.Host == "" {
+		usageError(flags, "Invalid target %s", *target)
+	}
+	if err := os.MkdirAll(*fixtures, 0755); err != nil {
+		fatalf("", "Unable to create fixtures %s : %s", *fixtures, err)
+	}
+
+	rec := &recorder{
+		mock:     newMockServer(schema),
+		proxy:    httputil.NewSingleHostReverseProxy(targetURL),
+		dir:      *fixtures,
+		fixtures: make(map[string][]mockFixture),
+	}
+	fmt.Fprintf(os.Stderr, "Recording %s on http://%s into %s\n", *target, *addr, *fixtures)
+	if err := http.ListenAndServe(*addr, rec); err != nil {
+		fatalf("", "Unable to serve on %s : %s", *addr, err)
+	}
+}
+
+// replayCommand serves the fixtures recorded for a spec, and fails requests which none of them answers.
+func replayCommand(args []string) {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	addr := flags.String("addr", "127.0.0.1:7350", "The address to listen on.")
+	fixtures := flags.String("fixtures", "fixtures", "The directory of recorded <operationId>.json fixtures.")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "openapi-gen replay [flags] input")
+		flags.PrintDefaults()
+	}
+	diagnostics := diagnosticsFlag(flags)
+	flags.Parse(args)
+	setDiagnosticFormat(*diagnostics)
+	if flags.NArg() < 1 {
+		usageError(flags, "")
+	}
+
+	serveMock(flags.Arg(0), *addr, *fixtures, true)
+}
+
+// recorder is a proxy which records the exchanges of the operations of a spec. An operation's fixtures are written
+// again after each exchange, where a request recorded before is answered by its latest response.
+type recorder struct {
+	// The mock server of the spec, which routes requests to their operations.
+	mock  *mockServer
+	proxy *httputil.ReverseProxy
+	dir   string
+
+	mutex    sync.Mutex
+	fixtures map[string][]mockFixture
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	// Responses are recorded as they're sent, so they're requested without compression.
+	r.Header.Del("Accept-Encoding")
+
+	route, _, status := rec.mock.route(r)
+	if status != 0 {
+		fmt.Fprintf(os.Stderr, "%s %s matches no operation, and is forwarded without being recorded\n", r.Method, r.URL.Path)
+		rec.proxy.ServeHTTP(w, r)
+		return
+	}
+
+	request := newMockRequest(r, body)
+	response := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+	rec.proxy.ServeHTTP(response, r)
+
+	id := route.operation.OperationId
+	fmt.Fprintf(os.Stderr, "%s %s %s %d\n", r.Method, r.URL.Path, id, response.status)
+	content := bytes.TrimSpace(response.body.Bytes())
+	if len(content) > 0 && !json.Valid(content) {
+		fmt.Fprintf(os.Stderr, "  the response is not JSON, and is left out\n")
+		return
+	}
+	fixture := mockFixture{Request: request, Status: response.status, Body: redactBody(content)}
+	for _, problem := range rec.mock.checkFixture(route.operation, fixture) {
+		fmt.Fprintf(os.Stderr, "  the response doesn't match the spec: %s\n", problem)
+	}
+	if err := rec.save(id, fixture); err != nil {
+		fmt.Fprintf(os.Stderr, "  the fixture can't be written: %s\n", err)
+	}
+}
+
+// save adds an exchange to the fixtures of its operation, replacing the one of the same request, and writes them.
+func (rec *recorder) save(id string, fixture mockFixture) error {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	fixtures := rec.fixtures[id]
+	replaced := false
+	for i, recorded := range fixtures {
+		if recorded.Request.matches(fixture.Request) {
+			fixtures[i] = fixture
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		fixtures = append(fixtures, fixture)
+	}
+	rec.fixtures[id] = fixtures
+
+	content, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(rec.dir, id+".json"), append(content, '\n'))
+}
+
+// recordingWriter passes a response on while keeping its status and body.
+type recordingWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	w.body.Write(p)
+	return w.ResponseWriter.Write(p)
+}
+
+// secretPattern matches the normalised names of the JSON members and query parameters whose values are redacted
+// from fixtures, e.g. password, refreshtoken, clientsecret, httpkey and serverkey.
+var secretPattern = regexp.MustCompile(`(httpkey|serverkey|password|token|secret)$`)
+
+// isSecret reports whether a JSON member or query parameter holds a secret, whether it's named in snake_case,
+// kebab-case or camelCase, e.g. http_key, http-key or httpKey.
+func isSecret(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	return secretPattern.MatchString(name)
+}
+
+const redacted = "REDACTED"
+
+// redactBody redacts the secrets of a JSON body. A body which isn't JSON, e.g. a form, is kept as a JSON string.
+func redactBody(content []byte) json.RawMessage {
+	content = bytes.TrimSpace(content)
+	if len(content) == 0 {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		text, _ := json.Marshal(string(content))
+		return text
+	}
+	redactedContent, _ := json.Marshal(redactValue("", value))
+	return redactedContent
+}
+
+// redactValue redacts the strings of the secret members of a decoded JSON value, including those of the lists a
+// secret member holds.
+func redactValue(name string, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, member := range v {
+			v[key] = redactValue(key, member)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(name, item)
+		}
+	case string:
+		if isSecret(name) {
+			return redactSecret(v)
+		}
+	}
+	return value
+}
+
+func redactQuery(query url.Values) url.Values {
+	for name, values := range query {
+		if isSecret(name) {
+			for i, value := range values {
+				values[i] = redactSecret(value)
+			}
+		}
+	}
+	return query
+}
+
+// redactSecret replaces a secret. A JWT keeps its header and claims, which sessions are parsed from, and only loses
+// its signature, so that a replayed session still carries its user and expiry.
+func redactSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	if parts := strings.Split(secret, "."); len(parts) == 3 {
+		header, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[0], "="))
+		if err == nil && json.Valid(header) {
+			return parts[0] + "." + parts[1] + "." + redacted
+		}
+	}
+	return redacted
+}
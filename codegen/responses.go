@@ -0,0 +1,200 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Responses holds the two responses of an operation the client reads: the one it succeeds with, and the default
+// response it fails with.
+type Responses struct {
+	Ok      Response
+	Default Response
+}
+
+// Response is a response of an operation.
+type Response struct {
+	// The status code the response is declared under, e.g. 200 or 204.
+	Status string `json:"-"`
+	Schema ObjectSchema
+	// Example bodies by media type, which the mock server responds with.
+	Examples map[string]json.RawMessage
+}
+
+// UnmarshalJSON reads the responses of an operation by status code, picking the success response by successStatus.
+func (r *Responses) UnmarshalJSON(data []byte) error {
+	var responses map[string]Response
+	if err := json.Unmarshal(data, &responses); err != nil {
+		return err
+	}
+	codes := make([]string, 0, len(responses))
+	for code := range responses {
+		codes = append(codes, code)
+	}
+	if status := successStatus(codes); status != "" {
+		r.Ok = responses[status]
+		r.Ok.Status = status
+	}
+	r.Default = responses["default"]
+	return nil
+}
+
+// successStatus picks the status code of the response an operation succeeds with: 200 when it is declared, else the
+// lowest other 2xx code, else the 2XX range of OpenAPI 3. It's empty when the operation declares no success response.
+func successStatus(codes []string) string {
+	sorted := make([]string, len(codes))
+	copy(sorted, codes)
+	sort.Strings(sorted)
+
+	status := ""
+	for _, code := range sorted {
+		switch {
+		case code == "200":
+			return code
+		case status == "" && len(code) == 3 && code[0] == '2' && strings.Trim(code, "0123456789") == "":
+			status = code
+		}
+	}
+	if status != "" {
+		return status
+	}
+	for _, code := range sorted {
+		if strings.EqualFold(code, "2XX") {
+			return code
+		}
+	}
+	return ""
+}
+
+// Result is the value an operation's method resolves to, parsed from the contents of its success response.
+type Result struct {
+	// The C# type of the result, empty when the method has none.
+	Type string
+	// The expression which parses the result from the response contents.
+	Parse string
+}
+
+// resolveResults sets the result of every operation from the schema of its success response. A response without
+// content, such as a 204 or an empty object, has no result.
+func resolveResults(s *Schema) {
+	for url, path := range s.Paths {
+		for method, operation := range path {
+			result, err := s.result(operation.Responses.Ok)
+			if err != nil {
+				warnf(childPointer(operation.Source, "responses", operation.Responses.Ok.Status), "Operation %s has no result, as its %s response %s", operation.OperationId, operation.Responses.Ok.Status, err)
+			}
+			operation.Result = result
+			s.Paths[url][method] = operation
+		}
+	}
+}
+
+func (s *Schema) result(response Response) (Result, error) {
+	schema := response.Schema
+	switch {
+	case response.Status == "204":
+		return Result{}, nil
+	case schema.Ref != "":
+		return s.refResult(schema.Ref)
+	case schema.Type == "array":
+		items := schema.Items
+		if items.Ref != "" {
+			if s.isEnum(items.Ref) {
+				return Result{
+					Type:  fmt.Sprintf("List<%s>", convertRefToClassName(items.Ref)),
+					Parse: fmt.Sprintf("%s?.ConvertAll(%s.Parse)", s.parseResponse(jsonType{Element: "string", Container: "list"}), enumConverter(items.Ref)),
+				}, nil
+			}
+			class := convertRefToClassName(items.Ref)
+			return Result{
+				Type:  fmt.Sprintf("IEnumerable<I%s>", class),
+				Parse: s.parseResponse(jsonType{Element: class, Container: "list"}),
+			}, nil
+		}
+		itemType := primitive(items.Type, items.Format)
+		if itemType == "" {
+			return Result{}, fmt.Errorf("is an array of %q items", items.Type)
+		}
+		if isEncoded(items.Type, items.Format) {
+			return Result{
+				Type:  fmt.Sprintf("List<%s>", itemType),
+				Parse: fmt.Sprintf("%s?.ConvertAll(Parse%s)", s.parseResponse(jsonType{Element: "string", Container: "list"}), converter(itemType)),
+			}, nil
+		}
+		return Result{
+			Type:  fmt.Sprintf("List<%s>", itemType),
+			Parse: s.parseResponse(jsonType{Element: itemType, Container: "list"}),
+		}, nil
+	case schema.Type == "object" || schema.Type == "":
+		values := schema.AdditionalProperties
+		if values.Ref != "" {
+			if s.isEnum(values.Ref) {
+				enum := convertRefToClassName(values.Ref)
+				return Result{
+					Type:  fmt.Sprintf("IDictionary<string, %s>", enum),
+					Parse: fmt.Sprintf("ConvertMap<string, %s>(%s, %s.Parse)", enum, s.parseResponse(jsonType{Element: "string", Container: "map"}), enumConverter(values.Ref)),
+				}, nil
+			}
+			class := convertRefToClassName(values.Ref)
+			return Result{
+				Type:  fmt.Sprintf("IDictionary<string, I%s>", class),
+				Parse: fmt.Sprintf("ConvertMap<%s, I%s>(%s, value => value)", class, class, s.parseResponse(jsonType{Element: class, Container: "map"})),
+			}, nil
+		}
+		if values.Type == "" {
+			// No schema, or an empty object such as google.protobuf.Empty, carries nothing to return.
+			return Result{}, nil
+		}
+		valueType := primitive(values.Type, values.Format)
+		if valueType == "" {
+			return Result{}, fmt.Errorf("is a map of %q values", values.Type)
+		}
+		if isEncoded(values.Type, values.Format) {
+			return Result{
+				Type:  fmt.Sprintf("IDictionary<string, %s>", valueType),
+				Parse: fmt.Sprintf("ConvertMap<string, %s>(%s, Parse%s)", valueType, s.parseResponse(jsonType{Element: "string", Container: "map"}), converter(valueType)),
+			}, nil
+		}
+		return Result{
+			Type:  fmt.Sprintf("IDictionary<string, %s>", valueType),
+			Parse: s.parseResponse(jsonType{Element: valueType, Container: "map"}),
+		}, nil
+	}
+
+	csharpType := primitive(schema.Type, schema.Format)
+	if csharpType == "" {
+		return Result{}, fmt.Errorf("has the unknown type %q", schema.Type)
+	}
+	if isEncoded(schema.Type, schema.Format) {
+		return Result{Type: csharpType, Parse: fmt.Sprintf("Parse%s(%s)", converter(csharpType), s.parseResponse(jsonType{Element: "string"}))}, nil
+	}
+	return Result{Type: csharpType, Parse: s.parseResponse(jsonType{Element: csharpType})}, nil
+}
+
+// refResult returns a referenced definition, or the member of a referenced enum.
+func (s *Schema) refResult(ref string) (Result, error) {
+	class := convertRefToClassName(ref)
+	if s.isEnum(ref) {
+		return Result{Type: class, Parse: fmt.Sprintf("%s.Parse(%s)", enumConverter(ref), s.parseResponse(jsonType{Element: "string"}))}, nil
+	}
+	if _, ok := s.lookupDefinition(class); !ok {
+		return Result{}, fmt.Errorf("references the unknown definition %s", ref)
+	}
+	return Result{Type: "I" + class, Parse: s.parseResponse(jsonType{Element: class})}, nil
+}
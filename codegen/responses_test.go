@@ -0,0 +1,38 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestSuccessStatus(t *testing.T) {
+	tests := []struct {
+		codes []string
+		want  string
+	}{
+		{[]string{"default", "200", "201"}, "200"},
+		{[]string{"default", "204", "201"}, "201"},
+		{[]string{"2XX", "default"}, "2XX"},
+		{[]string{"202", "2XX"}, "202"},
+		{[]string{"default", "404"}, ""},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		if got := successStatus(test.codes); got != test.want {
+			t.Errorf("successStatus(%v) = %q, want %q", test.codes, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SecurityScheme is an entry of the securityDefinitions of a spec.
+type SecurityScheme struct {
+	// basic, apiKey or oauth2, along with http (with a Scheme of basic or bearer) and openIdConnect in OpenAPI 3.
+	Type   string
+	Scheme string
+	// The name and location (header, query or cookie) of an API key.
+	Name string
+	In   string
+}
+
+// Credential is how an operation authenticates with one security scheme, and the arguments it takes for it.
+type Credential struct {
+	// basic, bearer or apiKey.
+	Kind string
+	// The name and location of an API key.
+	Name      string
+	In        string
+	Arguments []string
+}
+
+// Authentication is one of the alternative security requirements of an operation, which is generated as an overload
+// of its method taking the arguments of every credential. An anonymous operation has one without credentials.
+type Authentication struct {
+	Credentials []Credential
+}
+
+// Arguments returns the C# arguments of the credentials.
+func (a Authentication) Arguments() []string {
+	var arguments []string
+	for _, credential := range a.Credentials {
+		arguments = append(arguments, credential.Arguments...)
+	}
+	return arguments
+}
+
+// CredentialsIn returns the API keys of an authentication sent in one location.
+func (a Authentication) CredentialsIn(in string) []Credential {
+	var credentials []Credential
+	for _, credential := range a.Credentials {
+		if credential.Kind == "apiKey" && credential.In == in {
+			credentials = append(credentials, credential)
+		}
+	}
+	return credentials
+}
+
+// resolveSecurity sets the authentications of every operation from its security requirements, or from those of the
+// spec when it declares none. An empty list of requirements makes the operation anonymous. Requirements whose
+// arguments can't be told apart by their types are merged into one overload, whose credentials are each sent when
+// they are passed.
+func resolveSecurity(s *Schema) {
+	// Undefined schemes are reported where they're first required, by the spec and then by the operations in order.
+	// grpc-gateway specs require a bearer scheme they don't define, e.g. Nakama's BearerJwt, which isn't reported.
+	undefined := make(map[string]bool)
+	check := func(requirements []map[string][]struct{}, pointer string) {
+		for i, requirement := range requirements {
+			for _, name := range sortedKeys(requirement) {
+				if _, ok := s.SecurityDefinitions[name]; !ok && !undefined[name] && !isImplicitBearer(name) {
+					warnf(childPointer(pointer, strconv.Itoa(i), name), "Security scheme %s is not defined, so it is taken as a bearer token", name)
+					undefined[name] = true
+				}
+			}
+		}
+	}
+	check(s.Security, s.pointer("security"))
+	urls := make([]string, 0, len(s.Paths))
+	for url := range s.Paths {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+	for _, url := range urls {
+		methods := make([]string, 0, len(s.Paths[url]))
+		for method := range s.Paths[url] {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			operation := s.Paths[url][method]
+			check(operation.Security, childPointer(operation.Source, "security"))
+		}
+	}
+
+	for url, path := range s.Paths {
+		for method, operation := range path {
+			var auth []Authentication
+			for _, requirement := range s.requirements(operation) {
+				var a Authentication
+				for _, name := range sortedKeys(requirement) {
+					a.Credentials = append(a.Credentials, s.credential(name))
+				}
+				auth = append(auth, a)
+			}
+			if len(auth) == 0 {
+				auth = []Authentication{{}}
+			}
+			operation.Auth = mergeAuthentications(auth)
+			s.Paths[url][method] = operation
+		}
+	}
+}
+
+// isImplicitBearer reports whether an undefined security scheme is named as a bearer token, which it's taken as
+// without being defined.
+func isImplicitBearer(name string) bool {
+	return strings.Contains(strings.ToLower(name), "bearer")
+}
+
+// credential returns how to authenticate with a security scheme of the spec. A scheme the spec doesn't define is
+// taken as a bearer token.
+func (s *Schema) credential(name string) Credential {
+	scheme, ok := s.SecurityDefinitions[name]
+	switch {
+	case !ok:
+	case scheme.Type == "basic", scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "basic"):
+		return Credential{Kind: "basic", Arguments: []string{"basicAuthUsername", "basicAuthPassword"}}
+	case scheme.Type == "apiKey" && scheme.In == "header" && strings.EqualFold(scheme.Name, "Authorization"):
+		// Swagger 2.0 has no bearer scheme, so grpc-gateway specs declare the session token as an API key in the
+		// Authorization header.
+	case scheme.Type == "apiKey":
+		return Credential{Kind: "apiKey", Name: scheme.Name, In: scheme.In, Arguments: []string{argumentName(name)}}
+	}
+	// Bearer tokens of HTTP, OAuth 2.0 and OpenID Connect schemes.
+	return Credential{Kind: "bearer", Arguments: []string{"bearerToken"}}
+}
+
+// requirements returns the security requirements of an operation, which are those of the spec when it declares none.
+func (s *Schema) requirements(operation Operation) []map[string][]struct{} {
+	if operation.Security == nil {
+		return s.Security
+	}
+	return operation.Security
+}
+
+// mergeAuthentications merges the alternative authentications which take as many arguments, as their overloads
+// would both take only strings.
+func mergeAuthentications(auth []Authentication) []Authentication {
+	for {
+		merged := false
+		for i := 0; i < len(auth) && !merged; i++ {
+			for j := i + 1; j < len(auth) && !merged; j++ {
+				if len(auth[i].Arguments()) != len(auth[j].Arguments()) {
+					continue
+				}
+				auth[i] = mergeAuthentication(auth[i], auth[j])
+				auth = append(auth[:j], auth[j+1:]...)
+				merged = true
+			}
+		}
+		if !merged {
+			return auth
+		}
+	}
+}
+
+// mergeAuthentication returns the credentials of both authentications, leaving out those which take the same
+// arguments as one before them.
+func mergeAuthentication(a, b Authentication) Authentication {
+	seen := make(map[string]bool)
+	var merged Authentication
+	for _, credential := range append(append([]Credential(nil), a.Credentials...), b.Credentials...) {
+		key := strings.Join(credential.Arguments, ",")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		merged.Credentials = append(merged.Credentials, credential)
+	}
+	return merged
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// requirements builds security requirements, each of which needs all of its schemes.
+func requirements(schemes ...[]string) []map[string][]struct{} {
+	list := make([]map[string][]struct{}, 0, len(schemes))
+	for _, names := range schemes {
+		requirement := make(map[string][]struct{}, len(names))
+		for _, name := range names {
+			requirement[name] = []struct{}{}
+		}
+		list = append(list, requirement)
+	}
+	return list
+}
+
+func TestResolveSecurity(t *testing.T) {
+	s := &Schema{
+		SecurityDefinitions: map[string]SecurityScheme{
+			"Basic":     {Type: "basic"},
+			"Token":     {Type: "oauth2"},
+			"HeaderKey": {Type: "apiKey", Name: "X-Api-Key", In: "header"},
+			"QueryKey":  {Type: "apiKey", Name: "api_key", In: "query"},
+			"Jwt":       {Type: "apiKey", Name: "Authorization", In: "header"},
+		},
+		Security: requirements([]string{"Token"}),
+		Paths: map[string]map[string]Operation{
+			"/default": {"get": {OperationId: "Default"}},
+			"/open":    {"get": {OperationId: "Open", Security: requirements()}},
+			"/jwt":     {"get": {OperationId: "Jwt", Security: requirements([]string{"Jwt"})}},
+			"/either": {"post": {OperationId: "Either", Security: requirements(
+				[]string{"Basic"}, []string{"QueryKey"}, []string{"HeaderKey", "QueryKey"},
+			)}},
+		},
+	}
+	resolveSecurity(s)
+
+	bearer := Credential{Kind: "bearer", Arguments: []string{"bearerToken"}}
+	basic := Credential{Kind: "basic", Arguments: []string{"basicAuthUsername", "basicAuthPassword"}}
+	headerKey := Credential{Kind: "apiKey", Name: "X-Api-Key", In: "header", Arguments: []string{"headerKey"}}
+	queryKey := Credential{Kind: "apiKey", Name: "api_key", In: "query", Arguments: []string{"queryKey"}}
+	tests := []struct {
+		url, method string
+		want        []Authentication
+	}{
+		// Operations without requirements take those of the spec.
+		{"/default", "get", []Authentication{{Credentials: []Credential{bearer}}}},
+		// An API key in the Authorization header is how Swagger 2.0 specs declare a bearer token.
+		{"/jwt", "get", []Authentication{{Credentials: []Credential{bearer}}}},
+		// An empty list of requirements makes an operation anonymous.
+		{"/open", "get", []Authentication{{}}},
+		// Basic auth and the pair of keys both take two strings, so they're merged into one overload, leaving out
+		// the query key the second already takes.
+		{"/either", "post", []Authentication{
+			{Credentials: []Credential{basic, headerKey, queryKey}},
+			{Credentials: []Credential{queryKey}},
+		}},
+	}
+	for _, test := range tests {
+		got := s.Paths[test.url][test.method].Auth
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s %s: got %+v, want %+v", test.method, test.url, got, test.want)
+		}
+	}
+
+	either := s.Paths["/either"]["post"].Auth[0]
+	if want := []string{"basicAuthUsername", "basicAuthPassword", "headerKey", "queryKey"}; !reflect.DeepEqual(either.Arguments(), want) {
+		t.Errorf("got arguments %v, want %v", either.Arguments(), want)
+	}
+	if got := either.CredentialsIn("header"); !reflect.DeepEqual(got, []Credential{headerKey}) {
+		t.Errorf("got header credentials %+v", got)
+	}
+}
+
+func TestResolveSecurityUndefined(t *testing.T) {
+	s := &Schema{
+		Security: requirements([]string{"BearerJwt"}),
+		Paths: map[string]map[string]Operation{
+			"/a": {"get": {OperationId: "A", Source: "/paths/~1a/get", Security: requirements([]string{"ApiKey"})}},
+			"/b": {"get": {OperationId: "B", Source: "/paths/~1b/get", Security: requirements([]string{"ApiKey"})}},
+			"/c": {"get": {OperationId: "C", Source: "/paths/~1c/get"}},
+		},
+	}
+	diagnostics := captureDiagnostics(t)
+	resolveSecurity(s)
+
+	// A scheme named as a bearer token is taken as one silently, and others are reported where they're first required.
+	want := "#/paths/~1a/get/security/0/ApiKey: warning: Security scheme ApiKey is not defined, so it is taken as a bearer token\n"
+	if diagnostics.String() != want {
+		t.Errorf("got diagnostics\n%s\nwant\n%s", diagnostics, want)
+	}
+	for _, operation := range []Operation{s.Paths["/a"]["get"], s.Paths["/b"]["get"], s.Paths["/c"]["get"]} {
+		if got := operation.Auth[0].Credentials[0].Kind; got != "bearer" {
+			t.Errorf("%s is authenticated by %s, want bearer", operation.OperationId, got)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around the changes of a unified diff.
+const diffContext = 3
+
+// maxEditDistance bounds the search for the shortest diff between two files. Past it, the lines which differ are
+// shown as removed and added as a whole, which is still a valid diff of files which barely have lines in common.
+const maxEditDistance = 4000
+
+// diffLine is a line of a diff: kept, removed from the old file or added by the new one.
+type diffLine struct {
+	Op   byte
+	Text string
+}
+
+// unifiedDiff returns the unified diff which turns the old content into the new, or "" when they're the same.
+func unifiedDiff(oldName, newName, oldContent, newContent string) string {
+	if oldContent == newContent {
+		return ""
+	}
+	lines := diffLines(splitLines(oldContent), splitLines(newContent))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	oldLine, newLine := 1, 1
+	for start := 0; start < len(lines); {
+		// A hunk spans the changes which are no more than twice the context apart.
+		first := start
+		for first < len(lines) && lines[first].Op == ' ' {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+		last := first
+		for i := first; i < len(lines) && i-last-1 <= 2*diffContext; i++ {
+			if lines[i].Op != ' ' {
+				last = i
+			}
+		}
+		from := max(first-diffContext, start)
+		to := min(last+diffContext+1, len(lines))
+
+		// The lines skipped before the hunk are unchanged, so they're in both files.
+		oldLine, newLine = oldLine+from-start, newLine+from-start
+		oldCount, newCount := 0, 0
+		for _, line := range lines[from:to] {
+			if line.Op != '+' {
+				oldCount++
+			}
+			if line.Op != '-' {
+				newCount++
+			}
+		}
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(oldLine, oldCount), hunkRange(newLine, newCount))
+		for _, line := range lines[from:to] {
+			b.WriteByte(line.Op)
+			b.WriteString(line.Text)
+			if !strings.HasSuffix(line.Text, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		oldLine, newLine = oldLine+oldCount, newLine+newCount
+		start = to
+	}
+	return b.String()
+}
+
+// hunkRange formats the lines of one file a hunk spans, where an empty range starts at the line before it.
+func hunkRange(line, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", line-1)
+	case 1:
+		return fmt.Sprintf("%d", line)
+	}
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+// splitLines splits content into its lines, each keeping its line ending.
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the shortest edit which turns one list of lines into another. The lines they start and end with
+// are set aside first, as they are for the small changes regenerating a file usually makes.
+func diffLines(a, b []string) []diffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]diffLine, 0, len(a)+len(b)-prefix-suffix)
+	for _, text := range a[:prefix] {
+		lines = append(lines, diffLine{' ', text})
+	}
+	lines = append(lines, myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, text := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{' ', text})
+	}
+	return lines
+}
+
+// myersDiff finds the shortest edit between two lists of lines with Myers' algorithm, keeping the furthest reaching
+// paths of each edit distance to trace the edit back from the end.
+func myersDiff(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	if n+m == 0 {
+		return nil
+	}
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	// trace[d] holds the paths of diagonals -d-1 to d+1 before the edits of distance d are searched.
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		if d > maxEditDistance {
+			return replaceLines(a, b)
+		}
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return traceDiff(trace, a, b)
+			}
+		}
+	}
+	return replaceLines(a, b)
+}
+
+// traceDiff follows the paths of a Myers search back from the end of both lists, and returns the edit in order.
+func traceDiff(trace [][]int, a, b []string) []diffLine {
+	var lines []diffLine
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d > 0; d-- {
+		path := func(k int) int { return trace[d][k+d+1] }
+		k := x - y
+		previous := k - 1
+		if k == -d || (k != d && path(k-1) < path(k+1)) {
+			previous = k + 1
+		}
+		previousX := path(previous)
+		previousY := previousX - previous
+		for x > previousX && y > previousY {
+			lines = append(lines, diffLine{' ', a[x-1]})
+			x, y = x-1, y-1
+		}
+		if previous == k+1 {
+			lines = append(lines, diffLine{'+', b[y-1]})
+			y--
+		} else {
+			lines = append(lines, diffLine{'-', a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		lines = append(lines, diffLine{' ', a[x-1]})
+		x, y = x-1, y-1
+	}
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return lines
+}
+
+// replaceLines is the edit which removes every line of one list and adds every line of the other.
+func replaceLines(a, b []string) []diffLine {
+	lines := make([]diffLine, 0, len(a)+len(b))
+	for _, text := range a {
+		lines = append(lines, diffLine{'-', text})
+	}
+	for _, text := range b {
+		lines = append(lines, diffLine{'+', text})
+	}
+	return lines
+}
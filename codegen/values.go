@@ -0,0 +1,148 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "fmt"
+
+// ValueMember is how a model compares, hashes, copies and formats one property, through the member which holds it.
+type ValueMember struct {
+	// The name of the property in C#, and the member which holds it.
+	Name   string
+	Member string
+	// The expression which compares the member with that of "other", and the one which hashes it.
+	Equals string
+	Hash   string
+	// The expression which deeply copies the member, empty when copying the model's fields already copies it.
+	Clone string
+	// The expression which formats the member for ToString.
+	Format string
+}
+
+// valueMember returns how a property takes part in the equality, copies and formatting of its model. Properties
+// are compared as they are held, so encoded primitives and enums compare by their wire form.
+func (s *Schema) valueMember(property NamedProperty) ValueMember {
+	member, t := s.storage(property)
+	value := ValueMember{Name: snakeToPascal(property.Name), Member: member}
+	model := !isValueType(t.Element) && t.Element != "string"
+	// Encoded numbers and timestamps are formatted as the values of their typed property, and enums and bytes in
+	// their wire form, without the quotes of text.
+	format := ""
+	if t.Element == "string" && !isText(property) {
+		format = ", ApiClient.FormatEncoded"
+	}
+	formatted := member
+	if isTypedNumberOrTime(property) {
+		format, formatted = "", value.Name
+	}
+
+	switch t.Container {
+	case "list":
+		value.Equals = fmt.Sprintf("ApiClient.ListEquals(%[1]s, other.%[1]s)", member)
+		value.Hash = fmt.Sprintf("ApiClient.ListHashCode(%s)", member)
+		value.Format = fmt.Sprintf("ApiClient.FormatList(%s%s)", formatted, format)
+		if model {
+			value.Clone = fmt.Sprintf("%s?.ConvertAll(item => (%s) item?.CloneModel())", member, t.Element)
+		} else {
+			value.Clone = fmt.Sprintf("%s?.ConvertAll(item => item)", member)
+		}
+	case "map":
+		value.Equals = fmt.Sprintf("ApiClient.MapEquals(%[1]s, other.%[1]s)", member)
+		value.Hash = fmt.Sprintf("ApiClient.MapHashCode(%s)", member)
+		value.Format = fmt.Sprintf("ApiClient.FormatMap(%s%s)", formatted, format)
+		if model {
+			value.Clone = fmt.Sprintf("ApiClient.ConvertMap<%[2]s, %[2]s>(%[1]s, item => (%[2]s) item?.CloneModel())", member, t.Element)
+		} else {
+			value.Clone = fmt.Sprintf("ApiClient.ConvertMap<%[2]s, %[2]s>(%[1]s, item => item)", member, t.Element)
+		}
+	default:
+		value.Format = fmt.Sprintf("ApiClient.FormatValue(%s)", formatted)
+		if format != "" {
+			value.Format = fmt.Sprintf("ApiClient.FormatEncoded(%s)", member)
+		}
+		switch {
+		case model:
+			value.Equals = fmt.Sprintf("Equals(%[1]s, other.%[1]s)", member)
+			value.Hash = fmt.Sprintf("(%s?.GetHashCode() ?? 0)", member)
+			value.Clone = fmt.Sprintf("(%s) %s?.CloneModel()", t.Element, member)
+		case t.Nullable:
+			value.Equals = fmt.Sprintf("Nullable.Equals(%[1]s, other.%[1]s)", member)
+			value.Hash = fmt.Sprintf("%s.GetHashCode()", member)
+		case t.Element == "string":
+			value.Equals = fmt.Sprintf("%[1]s == other.%[1]s", member)
+			value.Hash = fmt.Sprintf("(%s?.GetHashCode() ?? 0)", member)
+		case t.Element == "double" || t.Element == "float":
+			// Equals rather than == so that NaN equals itself, as it must for hashing.
+			value.Equals = fmt.Sprintf("%[1]s.Equals(other.%[1]s)", member)
+			value.Hash = fmt.Sprintf("%s.GetHashCode()", member)
+		default:
+			value.Equals = fmt.Sprintf("%[1]s == other.%[1]s", member)
+			value.Hash = fmt.Sprintf("%s.GetHashCode()", member)
+		}
+	}
+	return value
+}
+
+// isText reports whether a property holds text, rather than primitives or enums which are encoded as strings.
+func isText(property NamedProperty) bool {
+	switch property.Type {
+	case "array":
+		return primitive(property.Items.Type, property.Items.Format) == "string"
+	case "object":
+		return primitive(property.AdditionalProperties.Type, property.AdditionalProperties.Format) == "string"
+	}
+	return primitive(property.Type, property.Format) == "string"
+}
+
+// isTypedNumberOrTime reports whether a property, or its items or map values, is a 64-bit integer or timestamp held in
+// its JSON form, which its typed property parses.
+func isTypedNumberOrTime(property NamedProperty) bool {
+	schemaType, format := property.Type, property.Format
+	switch property.Type {
+	case "array":
+		schemaType, format = property.Items.Type, property.Items.Format
+	case "object":
+		schemaType, format = property.AdditionalProperties.Type, property.AdditionalProperties.Format
+	}
+	switch primitive(schemaType, format) {
+	case "long", "ulong", "DateTime":
+		return isEncoded(schemaType, format)
+	}
+	return false
+}
+
+// valueMembers returns how each property a definition's class declares takes part in its equality, copies and
+// formatting.
+func (s *Schema) valueMembers(definition ObjectDefinition) []ValueMember {
+	properties := orderedProperties(definition)
+	values := make([]ValueMember, 0, len(properties))
+	for _, property := range properties {
+		values = append(values, s.valueMember(property))
+	}
+	return values
+}
+
+// cloneInterfaces lists the other interfaces whose Clone a definition's class implements explicitly: those its own
+// interface extends and those of its variants.
+func cloneInterfaces(definition ObjectDefinition) []string {
+	seen := make(map[string]bool)
+	var interfaces []string
+	for _, name := range append(append([]string(nil), definition.Extends...), definition.Implements...) {
+		if !seen[name] {
+			seen[name] = true
+			interfaces = append(interfaces, name)
+		}
+	}
+	return interfaces
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValueMember(t *testing.T) {
+	s := &Schema{Definitions: map[string]ObjectDefinition{
+		"apiColor": {Enum: enumNames{"RED", "GREEN"}},
+		"apiUser":  {},
+	}}
+	tests := []struct {
+		property NamedProperty
+		want     ValueMember
+	}{
+		{
+			NamedProperty{"name", ObjectProperty{Type: "string"}},
+			ValueMember{
+				Name: "Name", Member: "Name", Equals: "Name == other.Name", Hash: "(Name?.GetHashCode() ?? 0)",
+				Format: "ApiClient.FormatValue(Name)",
+			},
+		},
+		// Floating point members compare with Equals, so that NaN equals itself.
+		{
+			NamedProperty{"score", ObjectProperty{Type: "number", Format: "double"}},
+			ValueMember{
+				Name: "Score", Member: "Score", Equals: "Score.Equals(other.Score)", Hash: "Score.GetHashCode()",
+				Format: "ApiClient.FormatValue(Score)",
+			},
+		},
+		{
+			NamedProperty{"open", ObjectProperty{Type: "boolean", Nullable: true}},
+			ValueMember{
+				Name: "Open", Member: "Open", Equals: "Nullable.Equals(Open, other.Open)", Hash: "Open.GetHashCode()",
+				Format: "ApiClient.FormatValue(Open)",
+			},
+		},
+		// Encoded primitives and enums compare by their wire form, and aren't quoted as text.
+		{
+			NamedProperty{"color", ObjectProperty{Ref: "#/definitions/apiColor"}},
+			ValueMember{
+				Name: "Color", Member: "_color", Equals: "_color == other._color", Hash: "(_color?.GetHashCode() ?? 0)",
+				Format: "ApiClient.FormatEncoded(_color)",
+			},
+		},
+		// 64-bit integers and timestamps are formatted as the values of their typed property.
+		{
+			NamedProperty{"sent_at", ObjectProperty{Type: "string", Format: "date-time"}},
+			ValueMember{
+				Name: "SentAt", Member: "_sentAt", Equals: "_sentAt == other._sentAt", Hash: "(_sentAt?.GetHashCode() ?? 0)",
+				Format: "ApiClient.FormatValue(SentAt)",
+			},
+		},
+		// Models, and lists and maps of them, are copied deeply.
+		{
+			NamedProperty{"user", ObjectProperty{Ref: "#/definitions/apiUser"}},
+			ValueMember{
+				Name: "User", Member: "_user", Equals: "Equals(_user, other._user)", Hash: "(_user?.GetHashCode() ?? 0)",
+				Clone: "(ApiUser) _user?.CloneModel()", Format: "ApiClient.FormatValue(_user)",
+			},
+		},
+		{
+			NamedProperty{"ids", ObjectProperty{Type: "array", Items: Items{Type: "string", Format: "int64"}}},
+			ValueMember{
+				Name: "Ids", Member: "_ids", Equals: "ApiClient.ListEquals(_ids, other._ids)", Hash: "ApiClient.ListHashCode(_ids)",
+				Clone: "_ids?.ConvertAll(item => item)", Format: "ApiClient.FormatList(Ids)",
+			},
+		},
+		{
+			NamedProperty{"users", ObjectProperty{Type: "object", AdditionalProperties: AdditionalProperties{Ref: "#/definitions/apiUser"}}},
+			ValueMember{
+				Name: "Users", Member: "_users", Equals: "ApiClient.MapEquals(_users, other._users)", Hash: "ApiClient.MapHashCode(_users)",
+				Clone:  "ApiClient.ConvertMap<ApiUser, ApiUser>(_users, item => (ApiUser) item?.CloneModel())",
+				Format: "ApiClient.FormatMap(_users)",
+			},
+		},
+	}
+	for _, test := range tests {
+		if got := s.valueMember(test.property); got != test.want {
+			t.Errorf("valueMember(%s) = %+v, want %+v", test.property.Name, got, test.want)
+		}
+	}
+}
+
+func TestCloneInterfaces(t *testing.T) {
+	definition := ObjectDefinition{Extends: []string{"IApiBase", "IApiNamed"}, Implements: []string{"IApiNamed", "IApiVariant"}}
+	if got, want := cloneInterfaces(definition), []string{"IApiBase", "IApiNamed", "IApiVariant"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
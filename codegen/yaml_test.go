@@ -0,0 +1,74 @@
+// Copyright 2026 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestIsYAMLDocument(t *testing.T) {
+	descriptorSet, err := os.ReadFile("testdata/greeter.pb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		content []byte
+		want    bool
+	}{
+		{"yaml", []byte("swagger: \"2.0\"\npaths: {}\n"), true},
+		{"yaml document marker", []byte("---\nopenapi: 3.0.0\n"), true},
+		{"json", []byte("  {\"swagger\": \"2.0\"}"), false},
+		{"empty", []byte(" \n"), false},
+		{"descriptor set", descriptorSet, false},
+	}
+	for _, test := range tests {
+		if got := isYAMLDocument(test.content); got != test.want {
+			t.Errorf("isYAMLDocument(%s) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestYAMLToJSON(t *testing.T) {
+	tests := []struct {
+		yaml string
+		want string
+	}{
+		// Keys keep their order, and response codes are strings as they are in JSON.
+		{"paths: {}\nresponses:\n  200: ok\n  404: missing\n", `{"paths":{},"responses":{"200":"ok","404":"missing"}}`},
+		{"values: [1, 1.50, true, null, ~, \"7\", 2024-01-02]", `{"values":[1,1.50,true,null,null,"7","2024-01-02"]}`},
+		// Numbers which aren't JSON numbers are converted.
+		{"mask: 0x1F\nhuge: 1e3", `{"mask":31,"huge":1e3}`},
+		{"base: &base {type: string}\nname: *base", `{"base":{"type":"string"},"name":{"type":"string"}}`},
+		{"description: |\n  Line one.\n  Line two.\n", `{"description":"Line one.\nLine two.\n"}`},
+	}
+	for _, test := range tests {
+		got, err := yamlToJSON([]byte(test.yaml))
+		if err != nil {
+			t.Errorf("yamlToJSON(%q) failed: %s", test.yaml, err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("yamlToJSON(%q) = %s, want %s", test.yaml, got, test.want)
+		}
+	}
+
+	for _, invalid := range []string{"infinite: .inf", "a: [1, 2"} {
+		if _, err := yamlToJSON([]byte(invalid)); err == nil {
+			t.Errorf("yamlToJSON(%q) succeeded, want an error", invalid)
+		}
+	}
+}